@@ -0,0 +1,111 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AddonPropertyService handles the properties of Atlassian Connect apps.
+// App properties are key-value pairs that a Connect app can use to store its configuration in Jira.
+// The calls are only permitted for the Connect app that owns the properties.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-group-app-properties
+type AddonPropertyService service
+
+// AddonProperty represents a single property of a Connect app.
+type AddonProperty struct {
+	Self  string      `json:"self,omitempty" structs:"self,omitempty"`
+	Key   string      `json:"key,omitempty" structs:"key,omitempty"`
+	Value interface{} `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// AddonPropertyKeys is the list of property keys of a Connect app.
+type AddonPropertyKeys struct {
+	Keys []AddonPropertyKey `json:"keys" structs:"keys"`
+}
+
+// AddonPropertyKey is the reference to a single property of a Connect app.
+type AddonPropertyKey struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Key  string `json:"key,omitempty" structs:"key,omitempty"`
+}
+
+// GetKeys returns all property keys for the Connect app with the given addonKey.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-get
+func (s *AddonPropertyService) GetKeys(ctx context.Context, addonKey string) (*AddonPropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties", url.PathEscape(addonKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(AddonPropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return keys, resp, nil
+}
+
+// Get returns the key and value of a Connect app's property.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-get
+func (s *AddonPropertyService) Get(ctx context.Context, addonKey, propertyKey string) (*AddonProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties/%s", url.PathEscape(addonKey), url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(AddonProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return property, resp, nil
+}
+
+// Set sets the value of a Connect app's property.
+// The value is JSON encoded and must not exceed 32 KB.
+// Jira creates the property if it does not exist yet.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-put
+// Caller must close resp.Body
+func (s *AddonPropertyService) Set(ctx context.Context, addonKey, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties/%s", url.PathEscape(addonKey), url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete deletes a Connect app's property.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-app-properties/#api-rest-atlassian-connect-1-addons-addonkey-properties-propertykey-delete
+// Caller must close resp.Body
+func (s *AddonPropertyService) Delete(ctx context.Context, addonKey, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/atlassian-connect/1/addons/%s/properties/%s", url.PathEscape(addonKey), url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,96 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAddonPropertyService_GetKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/atlassian-connect/1/addons/example-app/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/atlassian-connect/1/addons/example-app/properties/config","key":"config"}]}`)
+	})
+
+	keys, _, err := testClient.AddonProperty.GetKeys(context.Background(), "example-app")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if keys == nil || len(keys.Keys) != 1 || keys.Keys[0].Key != "config" {
+		t.Errorf("Unexpected keys: %+v", keys)
+	}
+}
+
+func TestAddonPropertyService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/atlassian-connect/1/addons/example-app/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"config","value":{"enabled":true},"self":"https://your-domain.atlassian.net/rest/atlassian-connect/1/addons/example-app/properties/config"}`)
+	})
+
+	property, _, err := testClient.AddonProperty.Get(context.Background(), "example-app", "config")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil {
+		t.Fatal("Expected property. Property is nil")
+	}
+	value, ok := property.Value.(map[string]interface{})
+	if !ok || value["enabled"] != true {
+		t.Errorf("Unexpected property value: %+v", property.Value)
+	}
+}
+
+func TestAddonPropertyService_Set(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/atlassian-connect/1/addons/example-app/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["enabled"] != true {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"message":"Property created.","statusCode":201}`)
+	})
+
+	_, err := testClient.AddonProperty.Set(context.Background(), "example-app", "config", map[string]bool{"enabled": true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAddonPropertyService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/atlassian-connect/1/addons/example-app/properties/config"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.AddonProperty.Delete(context.Background(), "example-app", "config")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
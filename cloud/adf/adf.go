@@ -0,0 +1,282 @@
+// Package adf builds documents in the Atlassian Document Format (ADF).
+//
+// Version 3 of the Jira Cloud platform REST API formats rich text fields,
+// like the description of issues and the body of comments, in ADF.
+// The typed methods of the cloud client use version 2, which formats them as strings,
+// so documents are sent and received with Client.Call on the rest/api/3 paths.
+// The builders return nodes that marshal to valid ADF JSON:
+//
+//	doc := adf.Doc(
+//		adf.Paragraph(
+//			adf.Text("Hello "),
+//			adf.Mention("5b10ac8d82e05b22cc7d4ef5", "@Jane"),
+//			adf.Text(", see "),
+//			adf.Text("the docs", adf.Link("https://developer.atlassian.com")),
+//		),
+//		adf.CodeBlock("go", `fmt.Println("hello")`),
+//	)
+//	_, err := client.Call(ctx, http.MethodPost, "rest/api/3/issue/EX-1/comment", nil, map[string]interface{}{"body": doc}, nil)
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+package adf
+
+// Version is the version of the ADF documents built by Doc.
+const Version = 1
+
+// Node types.
+const (
+	TypeDoc         = "doc"
+	TypeParagraph   = "paragraph"
+	TypeText        = "text"
+	TypeHeading     = "heading"
+	TypeHardBreak   = "hardBreak"
+	TypeRule        = "rule"
+	TypeMention     = "mention"
+	TypeEmoji       = "emoji"
+	TypeInlineCard  = "inlineCard"
+	TypeCodeBlock   = "codeBlock"
+	TypeBlockquote  = "blockquote"
+	TypePanel       = "panel"
+	TypeBulletList  = "bulletList"
+	TypeOrderedList = "orderedList"
+	TypeListItem    = "listItem"
+	TypeTable       = "table"
+	TypeTableRow    = "tableRow"
+	TypeTableHeader = "tableHeader"
+	TypeTableCell   = "tableCell"
+	TypeMediaSingle = "mediaSingle"
+	TypeMediaGroup  = "mediaGroup"
+	TypeMedia       = "media"
+)
+
+// Mark types.
+const (
+	MarkStrong    = "strong"
+	MarkEm        = "em"
+	MarkCode      = "code"
+	MarkStrike    = "strike"
+	MarkUnderline = "underline"
+	MarkLink      = "link"
+	MarkTextColor = "textColor"
+	MarkSubSup    = "subsup"
+)
+
+// PanelType is the type of a panel, which determines its color and icon.
+type PanelType string
+
+// Panel types.
+const (
+	PanelInfo    PanelType = "info"
+	PanelNote    PanelType = "note"
+	PanelWarning PanelType = "warning"
+	PanelSuccess PanelType = "success"
+	PanelError   PanelType = "error"
+)
+
+// Node is a node of an ADF document.
+// Documents are trees of nodes with a doc node as root.
+type Node struct {
+	Type    string                 `json:"type"`
+	Version int                    `json:"version,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Content []*Node                `json:"content,omitempty"`
+	Text    string                 `json:"text,omitempty"`
+	Marks   []*Mark                `json:"marks,omitempty"`
+}
+
+// Mark formats a text node, e.g. as bold text or as a link.
+type Mark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// Append adds the nodes to the content of n and returns n.
+func (n *Node) Append(content ...*Node) *Node {
+	n.Content = append(n.Content, content...)
+	return n
+}
+
+// Attr returns the attribute of n with the key, nil if not set.
+func (n *Node) Attr(key string) interface{} {
+	if n == nil || n.Attrs == nil {
+		return nil
+	}
+	return n.Attrs[key]
+}
+
+func node(typ string, content []*Node) *Node {
+	return &Node{Type: typ, Content: content}
+}
+
+// Doc returns the root node of a document.
+func Doc(content ...*Node) *Node {
+	return &Node{Type: TypeDoc, Version: Version, Content: content}
+}
+
+// Paragraph returns a paragraph of inline nodes, like text and mentions.
+func Paragraph(content ...*Node) *Node {
+	return node(TypeParagraph, content)
+}
+
+// Text returns a text node formatted with the marks.
+// The text must not be empty.
+func Text(text string, marks ...*Mark) *Node {
+	return &Node{Type: TypeText, Text: text, Marks: marks}
+}
+
+// Heading returns a heading of the level 1 to 6.
+func Heading(level int, content ...*Node) *Node {
+	return &Node{Type: TypeHeading, Attrs: map[string]interface{}{"level": level}, Content: content}
+}
+
+// HardBreak returns a line break within a paragraph.
+func HardBreak() *Node {
+	return &Node{Type: TypeHardBreak}
+}
+
+// Rule returns a horizontal rule.
+func Rule() *Node {
+	return &Node{Type: TypeRule}
+}
+
+// Mention returns a mention of the user with the account ID.
+// text is displayed if the user can not be resolved, e.g. "@Jane".
+func Mention(accountID, text string) *Node {
+	attrs := map[string]interface{}{"id": accountID}
+	if text != "" {
+		attrs["text"] = text
+	}
+	return &Node{Type: TypeMention, Attrs: attrs}
+}
+
+// Emoji returns the emoji with the short name, e.g. ":smile:".
+func Emoji(shortName string) *Node {
+	return &Node{Type: TypeEmoji, Attrs: map[string]interface{}{"shortName": shortName}}
+}
+
+// InlineCard returns a link displayed as a card, e.g. of an issue.
+func InlineCard(url string) *Node {
+	return &Node{Type: TypeInlineCard, Attrs: map[string]interface{}{"url": url}}
+}
+
+// CodeBlock returns a block of code. language is optional.
+func CodeBlock(language, code string) *Node {
+	n := &Node{Type: TypeCodeBlock}
+	if language != "" {
+		n.Attrs = map[string]interface{}{"language": language}
+	}
+	if code != "" {
+		n.Content = []*Node{Text(code)}
+	}
+	return n
+}
+
+// Blockquote returns a quote of paragraphs.
+func Blockquote(content ...*Node) *Node {
+	return node(TypeBlockquote, content)
+}
+
+// Panel returns a panel of the type containing the block nodes.
+func Panel(panelType PanelType, content ...*Node) *Node {
+	return &Node{Type: TypePanel, Attrs: map[string]interface{}{"panelType": string(panelType)}, Content: content}
+}
+
+// BulletList returns an unordered list of list items.
+func BulletList(items ...*Node) *Node {
+	return node(TypeBulletList, items)
+}
+
+// OrderedList returns an ordered list of list items, numbered from 1.
+func OrderedList(items ...*Node) *Node {
+	return node(TypeOrderedList, items)
+}
+
+// ListItem returns a list item containing block nodes, like paragraphs and nested lists.
+func ListItem(content ...*Node) *Node {
+	return node(TypeListItem, content)
+}
+
+// Table returns a table of table rows.
+func Table(rows ...*Node) *Node {
+	return node(TypeTable, rows)
+}
+
+// TableRow returns a row of table headers or cells.
+func TableRow(cells ...*Node) *Node {
+	return node(TypeTableRow, cells)
+}
+
+// TableHeader returns a header cell containing block nodes.
+func TableHeader(content ...*Node) *Node {
+	return node(TypeTableHeader, content)
+}
+
+// TableCell returns a cell containing block nodes.
+func TableCell(content ...*Node) *Node {
+	return node(TypeTableCell, content)
+}
+
+// MediaSingle returns a single media node displayed as a block.
+func MediaSingle(media *Node) *Node {
+	return &Node{Type: TypeMediaSingle, Attrs: map[string]interface{}{"layout": "center"}, Content: []*Node{media}}
+}
+
+// MediaGroup returns a group of media nodes, e.g. attached files.
+func MediaGroup(media ...*Node) *Node {
+	return node(TypeMediaGroup, media)
+}
+
+// Media returns a file uploaded to the media API with the ID and collection.
+func Media(id, collection string) *Node {
+	return &Node{Type: TypeMedia, Attrs: map[string]interface{}{"type": "file", "id": id, "collection": collection}}
+}
+
+// ExternalMedia returns an image hosted at url.
+func ExternalMedia(url string) *Node {
+	return &Node{Type: TypeMedia, Attrs: map[string]interface{}{"type": "link", "url": url}}
+}
+
+// Strong formats text as bold.
+func Strong() *Mark {
+	return &Mark{Type: MarkStrong}
+}
+
+// Em formats text as italic.
+func Em() *Mark {
+	return &Mark{Type: MarkEm}
+}
+
+// Code formats text as inline code.
+func Code() *Mark {
+	return &Mark{Type: MarkCode}
+}
+
+// Strike formats text as struck through.
+func Strike() *Mark {
+	return &Mark{Type: MarkStrike}
+}
+
+// Underline formats text as underlined.
+func Underline() *Mark {
+	return &Mark{Type: MarkUnderline}
+}
+
+// Link formats text as a link to href.
+func Link(href string) *Mark {
+	return &Mark{Type: MarkLink, Attrs: map[string]interface{}{"href": href}}
+}
+
+// TextColor formats text in the color, a hex color like "#ff5630".
+func TextColor(color string) *Mark {
+	return &Mark{Type: MarkTextColor, Attrs: map[string]interface{}{"color": color}}
+}
+
+// Sub formats text as subscript.
+func Sub() *Mark {
+	return &Mark{Type: MarkSubSup, Attrs: map[string]interface{}{"type": "sub"}}
+}
+
+// Sup formats text as superscript.
+func Sup() *Mark {
+	return &Mark{Type: MarkSubSup, Attrs: map[string]interface{}{"type": "sup"}}
+}
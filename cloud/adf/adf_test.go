@@ -0,0 +1,75 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDoc_Marshal(t *testing.T) {
+	doc := Doc(
+		Heading(2, Text("Summary")),
+		Paragraph(
+			Text("Hello "),
+			Mention("5b10ac8d82e05b22cc7d4ef5", "@Jane"),
+			Text(", see "),
+			Text("the docs", Link("https://developer.atlassian.com"), Strong()),
+		),
+		CodeBlock("go", `fmt.Println("hello")`),
+		Panel(PanelWarning, Paragraph(Text("Careful"))),
+	)
+
+	got, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"type":"doc","version":1,"content":[` +
+		`{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Summary"}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Hello "},{"type":"mention","attrs":{"id":"5b10ac8d82e05b22cc7d4ef5","text":"@Jane"}},` +
+		`{"type":"text","text":", see "},{"type":"text","text":"the docs","marks":[{"type":"link","attrs":{"href":"https://developer.atlassian.com"}},{"type":"strong"}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"go"},"content":[{"type":"text","text":"fmt.Println(\"hello\")"}]},` +
+		`{"type":"panel","attrs":{"panelType":"warning"},"content":[{"type":"paragraph","content":[{"type":"text","text":"Careful"}]}]}]}`
+	if string(got) != want {
+		t.Errorf("Unexpected JSON\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestTable_Marshal(t *testing.T) {
+	table := Table(
+		TableRow(TableHeader(Paragraph(Text("Key"))), TableHeader(Paragraph(Text("Status")))),
+		TableRow(TableCell(Paragraph(Text("EX-1"))), TableCell(Paragraph(Text("Done")))),
+	)
+
+	got, err := json.Marshal(table)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"type":"table","content":[` +
+		`{"type":"tableRow","content":[{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Key"}]}]},{"type":"tableHeader","content":[{"type":"paragraph","content":[{"type":"text","text":"Status"}]}]}]},` +
+		`{"type":"tableRow","content":[{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"EX-1"}]}]},{"type":"tableCell","content":[{"type":"paragraph","content":[{"type":"text","text":"Done"}]}]}]}]}`
+	if string(got) != want {
+		t.Errorf("Unexpected JSON\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestNode_Unmarshal(t *testing.T) {
+	in := `{"type":"doc","version":1,"content":[{"type":"mediaSingle","attrs":{"layout":"center"},"content":[{"type":"media","attrs":{"collection":"jira","id":"abc","type":"file"}}]}]}`
+
+	var doc Node
+	if err := json.Unmarshal([]byte(in), &doc); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	got, _ := json.Marshal(Doc(MediaSingle(Media("abc", "jira"))))
+	if string(got) != in {
+		t.Errorf("Unexpected JSON\n got: %s\nwant: %s", got, in)
+	}
+	if media := doc.Content[0].Content[0]; media.Attr("id") != "abc" {
+		t.Errorf("Expected the media ID abc, got %v", media.Attr("id"))
+	}
+}
+
+func TestList_Append(t *testing.T) {
+	list := BulletList().Append(ListItem(Paragraph(Text("a"))), ListItem(Paragraph(Text("b"))))
+	if len(list.Content) != 2 {
+		t.Errorf("Expected 2 items, got %d", len(list.Content))
+	}
+}
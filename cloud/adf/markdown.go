@@ -0,0 +1,534 @@
+package adf
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FromMarkdown converts Markdown to an ADF document, e.g. to post the output of release notes generators
+// or bots as formatted descriptions and comments.
+//
+// The conversion is best effort: headings, paragraphs, emphasis, strong, strikethrough, inline code,
+// links, fenced and indented code blocks, block quotes, rules, nested lists and GitHub Flavored Markdown tables
+// are converted. Embedded HTML and other unsupported syntax is kept as text.
+// Images are converted to external media if they stand alone in a paragraph and to links otherwise.
+func FromMarkdown(markdown string) *Node {
+	markdown = strings.ReplaceAll(markdown, "\r\n", "\n")
+	lines := strings.Split(markdown, "\n")
+	for i, line := range lines {
+		lines[i] = expandTabs(line)
+	}
+	return Doc(parseBlocks(lines)...)
+}
+
+var (
+	headingRe       = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	fenceRe         = regexp.MustCompile("^ {0,3}(```+|~~~+)[ \t]*([^` \t]*)")
+	ruleRe          = regexp.MustCompile(`^ {0,3}((\*[ \t]*){3,}|(-[ \t]*){3,}|(_[ \t]*){3,})$`)
+	quoteRe         = regexp.MustCompile(`^ {0,3}> ?`)
+	listRe          = regexp.MustCompile(`^( *)([-*+]|\d{1,9}[.)])( +|$)`)
+	tableDelimRe    = regexp.MustCompile(`^ *\|? *:?-+:? *(\| *:?-+:? *)*\|? *$`)
+	imageOnlyRe     = regexp.MustCompile(`^!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)$`)
+	blankLineRe     = regexp.MustCompile(`^\s*$`)
+	leadingSpacesRe = regexp.MustCompile(`^ *`)
+)
+
+// parseBlocks parses the lines into block nodes.
+func parseBlocks(lines []string) []*Node {
+	var blocks []*Node
+	for i := 0; i < len(lines); {
+		line := lines[i]
+
+		switch {
+		case blankLineRe.MatchString(line):
+			i++
+		case fenceRe.MatchString(line):
+			var n *Node
+			n, i = parseFence(lines, i)
+			blocks = append(blocks, n)
+		case indent(line) >= 4:
+			var n *Node
+			n, i = parseIndentedCode(lines, i)
+			blocks = append(blocks, n)
+		case headingRe.MatchString(line):
+			m := headingRe.FindStringSubmatch(line)
+			blocks = append(blocks, Heading(len(m[1]), parseInline(m[2])...))
+			i++
+		case ruleRe.MatchString(line):
+			blocks = append(blocks, Rule())
+			i++
+		case quoteRe.MatchString(line):
+			var quoted []string
+			for ; i < len(lines) && quoteRe.MatchString(lines[i]); i++ {
+				quoted = append(quoted, quoteRe.ReplaceAllString(lines[i], ""))
+			}
+			blocks = append(blocks, Blockquote(parseBlocks(quoted)...))
+		case listRe.MatchString(line):
+			var n *Node
+			n, i = parseList(lines, i)
+			blocks = append(blocks, n)
+		case i+1 < len(lines) && strings.Contains(line, "|") && tableDelimRe.MatchString(lines[i+1]):
+			var n *Node
+			n, i = parseTable(lines, i)
+			blocks = append(blocks, n)
+		default:
+			var n *Node
+			n, i = parseParagraph(lines, i)
+			blocks = append(blocks, n)
+		}
+	}
+	return blocks
+}
+
+// parseFence parses the fenced code block starting at lines[i].
+func parseFence(lines []string, i int) (*Node, int) {
+	m := fenceRe.FindStringSubmatch(lines[i])
+	fence, language := m[1], m[2]
+	var code []string
+	for i++; i < len(lines); i++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[i]), fence) && strings.Trim(strings.TrimSpace(lines[i]), fence[:1]) == "" {
+			i++
+			break
+		}
+		code = append(code, lines[i])
+	}
+	return CodeBlock(language, strings.Join(code, "\n")), i
+}
+
+// parseIndentedCode parses the code block indented by 4 spaces starting at lines[i].
+func parseIndentedCode(lines []string, i int) (*Node, int) {
+	var code []string
+	for ; i < len(lines) && (indent(lines[i]) >= 4 || blankLineRe.MatchString(lines[i])); i++ {
+		code = append(code, strings.TrimPrefix(lines[i], "    "))
+	}
+	// Trailing blank lines separate the block from the next one.
+	for len(code) > 0 && blankLineRe.MatchString(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+	return CodeBlock("", strings.Join(code, "\n")), i
+}
+
+// parseParagraph parses the paragraph starting at lines[i], which ends at a blank line or another block.
+func parseParagraph(lines []string, i int) (*Node, int) {
+	start := i
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if blankLineRe.MatchString(line) || headingRe.MatchString(line) || fenceRe.MatchString(line) ||
+			ruleRe.MatchString(line) || quoteRe.MatchString(line) || listRe.MatchString(line) {
+			break
+		}
+	}
+
+	text := strings.Join(lines[start:i], "\n")
+	if m := imageOnlyRe.FindStringSubmatch(strings.TrimSpace(text)); m != nil {
+		media := ExternalMedia(m[2])
+		if m[1] != "" {
+			media.Attrs["alt"] = m[1]
+		}
+		return MediaSingle(media), i
+	}
+	return Paragraph(parseInline(text)...), i
+}
+
+// parseList parses the list starting at lines[i], including nested lists.
+func parseList(lines []string, i int) (*Node, int) {
+	m := listRe.FindStringSubmatch(lines[i])
+	listIndent := len(m[1])
+	ordered := !strings.ContainsAny(m[2], "-*+")
+	delim := m[2][len(m[2])-1:]
+
+	list := BulletList()
+	if ordered {
+		list = OrderedList()
+		if start, _ := strconv.Atoi(m[2][:len(m[2])-1]); start != 1 {
+			list.Attrs = map[string]interface{}{"order": start}
+		}
+	}
+
+	for i < len(lines) {
+		m := listRe.FindStringSubmatch(lines[i])
+		if m == nil || len(m[1]) != listIndent || !strings.HasSuffix(m[2], delim) {
+			break
+		}
+		contentIndent := len(m[0])
+		if m[3] == "" || len(m[3]) > 4 {
+			// Empty items and items starting with indented code are indented by one space.
+			contentIndent = len(m[1]) + len(m[2]) + 1
+		}
+		item := []string{strings.TrimSpace(lines[i][min(len(m[0]), len(lines[i])):])}
+
+		for i++; i < len(lines); i++ {
+			line := lines[i]
+			if blankLineRe.MatchString(line) {
+				// Blank lines belong to the item if it is continued below.
+				next := i + 1
+				for next < len(lines) && blankLineRe.MatchString(lines[next]) {
+					next++
+				}
+				if next < len(lines) && indent(lines[next]) >= contentIndent {
+					item = append(item, "")
+					continue
+				}
+				break
+			}
+			if lm := listRe.FindStringSubmatch(line); lm != nil {
+				if len(lm[1]) <= listIndent {
+					break
+				}
+				// Nested lists may be indented less than the content of the item.
+				item = append(item, line[min(len(lm[1]), contentIndent):])
+				continue
+			}
+			if indent(line) >= contentIndent {
+				item = append(item, line[contentIndent:])
+				continue
+			}
+			if item[len(item)-1] != "" && !headingRe.MatchString(line) && !fenceRe.MatchString(line) &&
+				!ruleRe.MatchString(line) && !quoteRe.MatchString(line) {
+				// Lazy continuation of the paragraph of the item.
+				item = append(item, strings.TrimSpace(line))
+				continue
+			}
+			break
+		}
+
+		list.Append(ListItem(parseBlocks(item)...))
+
+		// The list continues after blank lines if another item follows.
+		next := i
+		for next < len(lines) && blankLineRe.MatchString(lines[next]) {
+			next++
+		}
+		if next < len(lines) {
+			if nm := listRe.FindStringSubmatch(lines[next]); nm != nil && len(nm[1]) == listIndent && strings.HasSuffix(nm[2], delim) {
+				i = next
+			}
+		}
+	}
+	return list, i
+}
+
+// parseTable parses the GitHub Flavored Markdown table starting at lines[i].
+func parseTable(lines []string, i int) (*Node, int) {
+	table := Table(tableRow(lines[i], TableHeader))
+	for i += 2; i < len(lines) && strings.Contains(lines[i], "|") && !blankLineRe.MatchString(lines[i]); i++ {
+		table.Append(tableRow(lines[i], TableCell))
+	}
+	return table, i
+}
+
+func tableRow(line string, cell func(content ...*Node) *Node) *Node {
+	row := TableRow()
+	for _, text := range splitTableRow(line) {
+		row.Append(cell(Paragraph(parseInline(text)...)))
+	}
+	return row
+}
+
+// splitTableRow splits a table row at the pipes, which may be escaped with a backslash.
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+// parseInline parses the inline Markdown of a paragraph or heading into text, link and line break nodes.
+func parseInline(text string) []*Node {
+	p := &inlineParser{}
+	p.parse(strings.TrimSpace(text), nil)
+	return p.nodes
+}
+
+type inlineParser struct {
+	nodes []*Node
+}
+
+// parse adds the nodes of s formatted with marks.
+func (p *inlineParser) parse(s string, marks []*Mark) {
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			p.addText(text.String(), marks)
+			text.Reset()
+		}
+	}
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '\n':
+			flush()
+			p.nodes = append(p.nodes, HardBreak())
+			i += 2
+		case c == '\\' && i+1 < len(s) && isPunct(s[i+1]):
+			text.WriteByte(s[i+1])
+			i += 2
+		case c == '\n':
+			if strings.HasSuffix(text.String(), "  ") {
+				trimmed := strings.TrimRight(text.String(), " ")
+				text.Reset()
+				text.WriteString(trimmed)
+				flush()
+				p.nodes = append(p.nodes, HardBreak())
+			} else {
+				text.WriteByte(' ')
+			}
+			i++
+			for i < len(s) && s[i] == ' ' {
+				i++
+			}
+		case c == '`':
+			run := leadingRun(s[i:], '`')
+			end := strings.Index(s[i+run:], strings.Repeat("`", run))
+			if end < 0 {
+				text.WriteString(s[i : i+run])
+				i += run
+				continue
+			}
+			flush()
+			code := s[i+run : i+run+end]
+			if trimmed := strings.TrimSpace(code); trimmed != "" {
+				code = trimmed
+			}
+			p.addText(strings.ReplaceAll(code, "\n", " "), withMark(linkMarks(marks), Code()))
+			i += run + end + run
+		case c == '!' && i+1 < len(s) && s[i+1] == '[':
+			label, url, n := parseLink(s[i+1:])
+			if n == 0 {
+				text.WriteByte(c)
+				i++
+				continue
+			}
+			flush()
+			if label == "" {
+				label = url
+			}
+			p.parse(label, withMark(marks, Link(url)))
+			i += 1 + n
+		case c == '[':
+			label, url, n := parseLink(s[i:])
+			if n == 0 || label == "" {
+				text.WriteByte(c)
+				i++
+				continue
+			}
+			flush()
+			p.parse(label, withMark(marks, Link(url)))
+			i += n
+		case c == '<':
+			end := strings.IndexByte(s[i:], '>')
+			url := ""
+			if end > 0 {
+				url = s[i+1 : i+end]
+			}
+			if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "mailto:") ||
+				strings.ContainsAny(url, " \n") {
+				text.WriteByte(c)
+				i++
+				continue
+			}
+			flush()
+			p.addText(strings.TrimPrefix(url, "mailto:"), withMark(marks, Link(url)))
+			i += end + 1
+		case c == '*' || c == '_' || c == '~':
+			delim := emphasisDelim(s[i:], c)
+			if delim == "" || (c == '_' && i > 0 && isAlnum(s[i-1])) {
+				text.WriteString(s[i : i+leadingRun(s[i:], c)])
+				i += leadingRun(s[i:], c)
+				continue
+			}
+			end := closingDelim(s[i+len(delim):], delim)
+			if end < 0 {
+				text.WriteString(s[i : i+leadingRun(s[i:], c)])
+				i += leadingRun(s[i:], c)
+				continue
+			}
+			flush()
+			mark := Em()
+			switch {
+			case c == '~':
+				mark = Strike()
+			case len(delim) == 2:
+				mark = Strong()
+			}
+			p.parse(s[i+len(delim):i+len(delim)+end], withMark(marks, mark))
+			i += len(delim) + end + len(delim)
+		default:
+			text.WriteByte(c)
+			i++
+		}
+	}
+	flush()
+}
+
+// addText adds a text node, merged with the previous one if their marks are equal.
+func (p *inlineParser) addText(text string, marks []*Mark) {
+	if text == "" {
+		return
+	}
+	if len(p.nodes) > 0 {
+		last := p.nodes[len(p.nodes)-1]
+		if last.Type == TypeText && equalMarks(last.Marks, marks) {
+			last.Text += text
+			return
+		}
+	}
+	p.nodes = append(p.nodes, Text(text, marks...))
+}
+
+// parseLink parses a link like "[label](url "title")" at the start of s.
+// It returns the number of bytes of the link, 0 if s does not start with a link.
+func parseLink(s string) (label, url string, n int) {
+	depth := 0
+	closing := -1
+	for i := 0; i < len(s) && closing < 0; i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				closing = i
+			}
+		}
+	}
+	if closing < 0 || closing+1 >= len(s) || s[closing+1] != '(' {
+		return "", "", 0
+	}
+	end := strings.IndexByte(s[closing+2:], ')')
+	if end < 0 {
+		return "", "", 0
+	}
+	target := strings.TrimSpace(s[closing+2 : closing+2+end])
+	if fields := strings.Fields(target); len(fields) > 0 {
+		target = fields[0]
+	}
+	target = strings.TrimSuffix(strings.TrimPrefix(target, "<"), ">")
+	if target == "" {
+		return "", "", 0
+	}
+	return s[1:closing], target, closing + 2 + end + 1
+}
+
+// emphasisDelim returns the delimiter of the emphasis at the start of s: c, c twice or "" if there is none.
+// Strikethrough is only delimited by "~~".
+func emphasisDelim(s string, c byte) string {
+	run := leadingRun(s, c)
+	if run >= len(s) || s[run] == ' ' || s[run] == '\n' {
+		return ""
+	}
+	switch {
+	case run >= 2:
+		return strings.Repeat(string(c), 2)
+	case c == '~':
+		return ""
+	default:
+		return string(c)
+	}
+}
+
+// closingDelim returns the index of the delimiter closing an emphasis in s, -1 if it is not closed.
+func closingDelim(s, delim string) int {
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			i++
+		case s[i] == '`':
+			// Delimiters in code spans do not close the emphasis.
+			run := leadingRun(s[i:], '`')
+			if end := strings.Index(s[i+run:], strings.Repeat("`", run)); end >= 0 {
+				i += run + end + run - 1
+			}
+		case strings.HasPrefix(s[i:], delim) && i > 0 && s[i-1] != ' ':
+			run := leadingRun(s[i:], delim[0])
+			if len(delim) == 1 && run == 2 {
+				// Skip the delimiter of a nested strong emphasis.
+				i++
+				continue
+			}
+			if delim[0] == '_' && i+len(delim) < len(s) && isAlnum(s[i+len(delim)]) {
+				continue
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// withMark returns a copy of marks with m added.
+func withMark(marks []*Mark, m *Mark) []*Mark {
+	return append(append(make([]*Mark, 0, len(marks)+1), marks...), m)
+}
+
+// linkMarks returns the link marks of marks, as code can only be combined with links.
+func linkMarks(marks []*Mark) []*Mark {
+	var links []*Mark
+	for _, m := range marks {
+		if m.Type == MarkLink {
+			links = append(links, m)
+		}
+	}
+	return links
+}
+
+func equalMarks(a, b []*Mark) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func leadingRun(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func indent(line string) int {
+	return len(leadingSpacesRe.FindString(line))
+}
+
+// expandTabs replaces the leading tabs of line with 4 spaces.
+func expandTabs(line string) string {
+	i := 0
+	for i < len(line) && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return strings.ReplaceAll(line[:i], "\t", "    ") + line[i:]
+}
+
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isAlnum(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
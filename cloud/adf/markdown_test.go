@@ -0,0 +1,60 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFromMarkdown(t *testing.T) {
+	in := "# Release 1.2\n\n" +
+		"Thanks to **all** contributors, see [the _docs_](https://example.com/docs).\n\n" +
+		"- one\n- two\n  1. nested\n\n" +
+		"```go\nx := 1\n```\n\n" +
+		"> quoted\n\n" +
+		"---"
+
+	got, _ := json.Marshal(FromMarkdown(in))
+	want, _ := json.Marshal(Doc(
+		Heading(1, Text("Release 1.2")),
+		Paragraph(
+			Text("Thanks to "),
+			Text("all", Strong()),
+			Text(" contributors, see "),
+			Text("the ", Link("https://example.com/docs")),
+			Text("docs", Link("https://example.com/docs"), Em()),
+			Text("."),
+		),
+		BulletList(
+			ListItem(Paragraph(Text("one"))),
+			ListItem(Paragraph(Text("two")), OrderedList(ListItem(Paragraph(Text("nested"))))),
+		),
+		CodeBlock("go", "x := 1"),
+		Blockquote(Paragraph(Text("quoted"))),
+		Rule(),
+	))
+	if string(got) != string(want) {
+		t.Errorf("Unexpected ADF\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestFromMarkdown_RoundTrip(t *testing.T) {
+	for _, md := range []string{
+		"Run `go test ./...` and ~~ignore~~ **check** the _output_",
+		"3. third\n4. fourth",
+		"| Key | Status |\n| --- | --- |\n| EX-1 | a\\|b |",
+		"line\\\nnext",
+		"![diagram](https://example.com/diagram.png)",
+	} {
+		if got := Markdown(FromMarkdown(md)); got != md {
+			t.Errorf("Round trip of %q = %q", md, got)
+		}
+	}
+}
+
+func TestFromMarkdown_Unsupported(t *testing.T) {
+	got := PlainText(FromMarkdown("2 * 3 = 6 and snake_case_name, <b>html</b> and [not a link]"))
+	want := "2 * 3 = 6 and snake_case_name, <b>html</b> and [not a link]"
+	if got != want {
+		t.Errorf("Expected the text to be kept, got %q", got)
+	}
+}
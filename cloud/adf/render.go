@@ -0,0 +1,285 @@
+package adf
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PlainText renders the document n as plain text, e.g. for notifications or search indexes.
+// Blocks are separated by blank lines, list items are prefixed with "- " or their number
+// and table cells are separated by " | ". Formatting and media are dropped.
+func PlainText(n *Node) string {
+	return (&renderer{}).block(n)
+}
+
+// Markdown renders the document n as GitHub Flavored Markdown.
+// Mentions are rendered as their display text and files uploaded to the media API are dropped,
+// as both can not be resolved outside of Jira.
+func Markdown(n *Node) string {
+	return (&renderer{markdown: true}).block(n)
+}
+
+type renderer struct {
+	markdown bool
+}
+
+// blocks renders the block nodes separated by sep.
+func (r *renderer) blocks(nodes []*Node, sep string) string {
+	parts := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if s := r.block(n); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+func (r *renderer) block(n *Node) string {
+	if n == nil {
+		return ""
+	}
+
+	switch n.Type {
+	case TypeParagraph:
+		return r.inline(n.Content)
+	case TypeHeading:
+		text := r.inline(n.Content)
+		if !r.markdown {
+			return text
+		}
+		level := intAttr(n, "level")
+		if level < 1 || level > 6 {
+			level = 1
+		}
+		return strings.Repeat("#", level) + " " + text
+	case TypeCodeBlock:
+		code := textContent(n)
+		if !r.markdown {
+			return code
+		}
+		language, _ := n.Attr("language").(string)
+		fence := "```"
+		for strings.Contains(code, fence) {
+			fence += "`"
+		}
+		return fence + language + "\n" + code + "\n" + fence
+	case TypeBlockquote:
+		return prefixLines(r.blocks(n.Content, "\n\n"), "> ")
+	case TypePanel:
+		content := r.blocks(n.Content, "\n\n")
+		if !r.markdown {
+			return content
+		}
+		return prefixLines(content, "> ")
+	case TypeBulletList, TypeOrderedList:
+		return r.list(n)
+	case TypeTable:
+		return r.table(n)
+	case TypeRule:
+		return "---"
+	case TypeMediaSingle, TypeMediaGroup:
+		return r.blocks(n.Content, "\n\n")
+	case TypeMedia:
+		url, _ := n.Attr("url").(string)
+		if url == "" {
+			return ""
+		}
+		if !r.markdown {
+			return url
+		}
+		alt, _ := n.Attr("alt").(string)
+		return "![" + escapeMarkdown(alt) + "](" + url + ")"
+	case TypeText, TypeHardBreak, TypeMention, TypeEmoji, TypeInlineCard, "status", "date":
+		return r.inline([]*Node{n})
+	default:
+		// Containers like doc, tableCell and expand, or unknown blocks.
+		return r.blocks(n.Content, "\n\n")
+	}
+}
+
+// list renders the items of a bullet or ordered list.
+// The content of the items is indented below the marker, so nested lists keep their level.
+func (r *renderer) list(n *Node) string {
+	start := 1
+	if order := intAttr(n, "order"); order > 0 {
+		start = order
+	}
+
+	items := make([]string, 0, len(n.Content))
+	for i, item := range n.Content {
+		marker := "- "
+		if n.Type == TypeOrderedList {
+			marker = fmt.Sprintf("%d. ", start+i)
+		}
+		content := r.blocks(item.Content, "\n")
+		indent := strings.Repeat(" ", len(marker))
+		items = append(items, marker+strings.ReplaceAll(content, "\n", "\n"+indent))
+	}
+	return strings.Join(items, "\n")
+}
+
+// table renders a table, in Markdown with the first row as header.
+func (r *renderer) table(n *Node) string {
+	var lines []string
+	for i, row := range n.Content {
+		cells := make([]string, 0, len(row.Content))
+		for _, cell := range row.Content {
+			text := r.blocks(cell.Content, "\n")
+			if r.markdown {
+				text = strings.ReplaceAll(strings.ReplaceAll(text, "\\\n", "\n"), "\n", "<br>")
+			} else {
+				text = strings.ReplaceAll(text, "\n", " ")
+			}
+			cells = append(cells, text)
+		}
+
+		if !r.markdown {
+			lines = append(lines, strings.Join(cells, " | "))
+			continue
+		}
+		lines = append(lines, "| "+strings.Join(cells, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", len(cells)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inline renders inline nodes, like text, mentions and emojis.
+func (r *renderer) inline(nodes []*Node) string {
+	var b strings.Builder
+	for _, n := range nodes {
+		switch n.Type {
+		case TypeText:
+			b.WriteString(r.text(n))
+		case TypeHardBreak:
+			if r.markdown {
+				b.WriteString("\\")
+			}
+			b.WriteString("\n")
+		case TypeMention:
+			text, _ := n.Attr("text").(string)
+			if text == "" {
+				id, _ := n.Attr("id").(string)
+				text = "@" + id
+			}
+			b.WriteString(r.escape(text))
+		case TypeEmoji:
+			text, _ := n.Attr("text").(string)
+			if text == "" {
+				text, _ = n.Attr("shortName").(string)
+			}
+			b.WriteString(text)
+		case TypeInlineCard:
+			url, _ := n.Attr("url").(string)
+			if r.markdown && url != "" {
+				url = "<" + url + ">"
+			}
+			b.WriteString(url)
+		case "status":
+			text, _ := n.Attr("text").(string)
+			b.WriteString(r.escape(text))
+		default:
+			b.WriteString(r.inline(n.Content))
+		}
+	}
+	return b.String()
+}
+
+// text renders a text node, in Markdown with its marks.
+func (r *renderer) text(n *Node) string {
+	if !r.markdown {
+		return n.Text
+	}
+
+	// Emphasis must not start or end with whitespace, so it is moved outside of the marks.
+	core := strings.TrimSpace(n.Text)
+	if core == "" {
+		return n.Text
+	}
+	start := strings.Index(n.Text, core)
+	leading, trailing := n.Text[:start], n.Text[start+len(core):]
+
+	var href string
+	code := false
+	for _, m := range n.Marks {
+		if m.Type == MarkCode {
+			code = true
+		}
+	}
+	if code {
+		core = "`" + core + "`"
+	} else {
+		core = escapeMarkdown(core)
+	}
+	for _, m := range n.Marks {
+		switch m.Type {
+		case MarkStrong:
+			core = "**" + core + "**"
+		case MarkEm:
+			core = "_" + core + "_"
+		case MarkStrike:
+			core = "~~" + core + "~~"
+		case MarkLink:
+			href, _ = m.Attrs["href"].(string)
+		}
+	}
+	if href != "" {
+		core = "[" + core + "](" + href + ")"
+	}
+	return leading + core + trailing
+}
+
+func (r *renderer) escape(s string) string {
+	if !r.markdown {
+		return s
+	}
+	return escapeMarkdown(s)
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`,
+	`<`, `\<`, `>`, `\>`, `~`, `\~`, `|`, `\|`, `#`, `\#`,
+)
+
+// escapeMarkdown escapes the characters of s with a meaning in Markdown.
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// intAttr returns the number attribute of n with the key, 0 if not set.
+// Attributes are ints if built and float64 if decoded from JSON.
+func intAttr(n *Node, key string) int {
+	switch v := n.Attr(key).(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return 0
+}
+
+// textContent returns the concatenated text of the text nodes below n.
+func textContent(n *Node) string {
+	var b strings.Builder
+	for _, c := range n.Content {
+		if c.Type == TypeText {
+			b.WriteString(c.Text)
+		} else {
+			b.WriteString(textContent(c))
+		}
+	}
+	return b.String()
+}
+
+// prefixLines prefixes every line of s with prefix.
+func prefixLines(s, prefix string) string {
+	if s == "" {
+		return ""
+	}
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(prefix+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,73 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func testDoc() *Node {
+	return Doc(
+		Heading(2, Text("Release notes")),
+		Paragraph(
+			Text("Thanks "),
+			Mention("5b10ac8d82e05b22cc7d4ef5", "@Jane"),
+			Text(", see "),
+			Text("the docs", Link("https://example.com/docs")),
+			Text(" and "),
+			Text("run ", Strong()),
+			Text("make", Code()),
+		),
+		BulletList(
+			ListItem(Paragraph(Text("one"))),
+			ListItem(
+				Paragraph(Text("two")),
+				OrderedList(ListItem(Paragraph(Text("nested")))),
+			),
+		),
+		CodeBlock("go", "x := 1"),
+		Table(
+			TableRow(TableHeader(Paragraph(Text("Key"))), TableHeader(Paragraph(Text("Status")))),
+			TableRow(TableCell(Paragraph(Text("EX-1"))), TableCell(Paragraph(Text("a|b")))),
+		),
+	)
+}
+
+func TestMarkdown(t *testing.T) {
+	want := "## Release notes\n\n" +
+		"Thanks @Jane, see [the docs](https://example.com/docs) and **run** `make`\n\n" +
+		"- one\n- two\n  1. nested\n\n" +
+		"```go\nx := 1\n```\n\n" +
+		"| Key | Status |\n| --- | --- |\n| EX-1 | a\\|b |"
+	if got := Markdown(testDoc()); got != want {
+		t.Errorf("Unexpected Markdown\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestPlainText(t *testing.T) {
+	want := "Release notes\n\n" +
+		"Thanks @Jane, see the docs and run make\n\n" +
+		"- one\n- two\n  1. nested\n\n" +
+		"x := 1\n\n" +
+		"Key | Status\nEX-1 | a|b"
+	if got := PlainText(testDoc()); got != want {
+		t.Errorf("Unexpected plain text\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestMarkdown_Decoded(t *testing.T) {
+	in := `{"type":"doc","version":1,"content":[
+		{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Title"}]},
+		{"type":"blockquote","content":[{"type":"paragraph","content":[{"type":"text","text":"line"},{"type":"hardBreak"},{"type":"text","text":"next"}]}]},
+		{"type":"orderedList","attrs":{"order":3},"content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"third"}]}]}]},
+		{"type":"mediaSingle","content":[{"type":"media","attrs":{"type":"file","id":"abc","collection":"jira"}}]}
+	]}`
+
+	var doc Node
+	if err := json.Unmarshal([]byte(in), &doc); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := "### Title\n\n> line\\\n> next\n\n3. third"
+	if got := Markdown(&doc); got != want {
+		t.Errorf("Unexpected Markdown\n got: %q\nwant: %q", got, want)
+	}
+}
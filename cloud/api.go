@@ -0,0 +1,569 @@
+package cloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// API contains the services of a Client as interfaces, so code depending on API instead of
+// the Client can be tested with mocks, e.g. generated by gomock or mockery from the interfaces.
+// The Iterate methods are not part of the interfaces, as they need Go 1.23.
+type API struct {
+	Issue               IssueAPI
+	Search              SearchAPI
+	Project             ProjectAPI
+	Board               BoardAPI
+	Sprint              SprintAPI
+	User                UserAPI
+	Group               GroupAPI
+	Version             VersionAPI
+	Priority            PriorityAPI
+	Field               FieldAPI
+	Component           ComponentAPI
+	Resolution          ResolutionAPI
+	StatusCategory      StatusCategoryAPI
+	Filter              FilterAPI
+	Role                RoleAPI
+	PermissionScheme    PermissionSchemeAPI
+	Status              StatusAPI
+	IssueLinkType       IssueLinkTypeAPI
+	Organization        OrganizationAPI
+	ServiceDesk         ServiceDeskAPI
+	Customer            CustomerAPI
+	Request             RequestAPI
+	IssueEvent          IssueEventAPI
+	UIModification      UIModificationAPI
+	AddonProperty       AddonPropertyAPI
+	ClassificationLevel ClassificationLevelAPI
+	Plan                PlanAPI
+	Backlog             BacklogAPI
+	Epic                EpicAPI
+	Build               BuildAPI
+	Deployment          DeploymentAPI
+	FeatureFlag         FeatureFlagAPI
+	RemoteLink          RemoteLinkAPI
+	DevInfo             DevInfoAPI
+	Assets              AssetsAPI
+	Task                TaskAPI
+	Configuration       ConfigurationAPI
+	Workflow            WorkflowAPI
+}
+
+// API returns the services of c as interfaces.
+func (c *Client) API() *API {
+	return &API{
+		Issue:               c.Issue,
+		Search:              c.Issue,
+		Project:             c.Project,
+		Board:               c.Board,
+		Sprint:              c.Sprint,
+		User:                c.User,
+		Group:               c.Group,
+		Version:             c.Version,
+		Priority:            c.Priority,
+		Field:               c.Field,
+		Component:           c.Component,
+		Resolution:          c.Resolution,
+		StatusCategory:      c.StatusCategory,
+		Filter:              c.Filter,
+		Role:                c.Role,
+		PermissionScheme:    c.PermissionScheme,
+		Status:              c.Status,
+		IssueLinkType:       c.IssueLinkType,
+		Organization:        c.Organization,
+		ServiceDesk:         c.ServiceDesk,
+		Customer:            c.Customer,
+		Request:             c.Request,
+		IssueEvent:          c.IssueEvent,
+		UIModification:      c.UIModification,
+		AddonProperty:       c.AddonProperty,
+		ClassificationLevel: c.ClassificationLevel,
+		Plan:                c.Plan,
+		Backlog:             c.Backlog,
+		Epic:                c.Epic,
+		Build:               c.Build,
+		Deployment:          c.Deployment,
+		FeatureFlag:         c.FeatureFlag,
+		RemoteLink:          c.RemoteLink,
+		DevInfo:             c.DevInfo,
+		Assets:              c.Assets,
+		Task:                c.Task,
+		Configuration:       c.Configuration,
+		Workflow:            c.Workflow,
+	}
+}
+
+// SearchAPI is the interface of the issue search methods of IssueService.
+type SearchAPI interface {
+	Search(ctx context.Context, jql string, options *SearchOptions, opts ...RequestOption) ([]Issue, *Response, error)
+	SearchStream(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error, opts ...RequestOption) (*Response, error)
+	SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error
+	SearchAll(ctx context.Context, jql string, options *SearchOptions, pager *PagerOptions) ([]Issue, error)
+}
+
+// IssueAPI is the interface of IssueService.
+type IssueAPI interface {
+	SearchAPI
+
+	Get(ctx context.Context, issueID string, options *GetQueryOptions, opts ...RequestOption) (*Issue, *Response, error)
+	BulkGetIssues(ctx context.Context, keys []string, options *GetQueryOptions, concurrency int, opts ...RequestOption) (map[string]*Issue, map[string]error)
+	DownloadAttachment(ctx context.Context, attachmentID string) (*Response, error)
+	PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	DeleteAttachment(ctx context.Context, attachmentID string) (*Response, error)
+	DeleteLink(ctx context.Context, linkID string) (*Response, error)
+	GetWorklogs(ctx context.Context, issueID string, options ...RequestOption) (*Worklog, *Response, error)
+	Create(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	Update(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error)
+	UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error)
+	GetComments(ctx context.Context, issueID string, opts ...RequestOption) (*Comments, *Response, error)
+	AddComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	UpdateComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	DeleteComment(ctx context.Context, issueID, commentID string) error
+	AddWorklogRecord(ctx context.Context, issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+	UpdateWorklogRecord(ctx context.Context, issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+	AddLink(ctx context.Context, issueLink *IssueLink) (*Response, error)
+	GetCustomFields(ctx context.Context, issueID string) (CustomFields, *Response, error)
+	GetTransitions(ctx context.Context, id string) ([]Transition, *Response, error)
+	DoTransition(ctx context.Context, ticketID, transitionID string) (*Response, error)
+	DoTransitionWithPayload(ctx context.Context, ticketID, payload interface{}) (*Response, error)
+	Delete(ctx context.Context, issueID string) (*Response, error)
+	GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error)
+	AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+	RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+	UpdateAssignee(ctx context.Context, issueID string, assignee *User) (*Response, error)
+	GetRemoteLinks(ctx context.Context, id string) (*[]RemoteLink, *Response, error)
+	AddRemoteLink(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error)
+	UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *RemoteLink) (*Response, error)
+	GetPickerSuggestions(ctx context.Context, options *IssuePickerOptions) (*IssuePickerSuggestions, *Response, error)
+	Rank(ctx context.Context, rank *IssuesWrapper) (*IssueRankResult, *Response, error)
+	GetEstimation(ctx context.Context, issueIDOrKey string, boardID int64) (*IssueEstimation, *Response, error)
+	SetEstimation(ctx context.Context, issueIDOrKey string, boardID int64, value string) (*IssueEstimation, *Response, error)
+	CreatePayload(ctx context.Context, payload *IssuePayload) (*Issue, *Response, error)
+	UpdatePayload(ctx context.Context, issueID string, payload *IssuePayload, opts *UpdateQueryOptions) (*Response, error)
+	CreateBulk(ctx context.Context, payloads []*IssuePayload) (*BulkCreateResult, *Response, error)
+	CreateBulkAll(ctx context.Context, payloads []*IssuePayload) (*BulkCreateResult, error)
+	CloneIssue(ctx context.Context, issueIDOrKey string, options *CloneOptions) (*Issue, *Response, error)
+	Export(ctx context.Context, jql string, w io.Writer, options *ExportOptions) (*Response, error)
+	GetIssueFull(ctx context.Context, issueIDOrKey string) (*IssueFull, error)
+	CreateIdempotent(ctx context.Context, payload *IssuePayload, key string) (*Issue, *Response, error)
+	FindByIdempotencyKey(ctx context.Context, projectKeyOrID, key string) (*Issue, *Response, error)
+	SyncIssues(ctx context.Context, jql string, since time.Time, options *SyncOptions, f func(SyncChange) error) (string, error)
+	GetIssueTypeHierarchy(ctx context.Context) (*IssueTypeHierarchy, *Response, error)
+	GetCreateMeta(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error)
+	GetEditMeta(ctx context.Context, issue *Issue) (*EditMetaInfo, *Response, error)
+}
+
+// ProjectAPI is the interface of ProjectService.
+type ProjectAPI interface {
+	GetAll(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error)
+	Search(ctx context.Context, options *ProjectSearchOptions) (*ProjectSearchResult, *Response, error)
+	SearchAll(ctx context.Context, options *ProjectSearchOptions, pager *PagerOptions) ([]Project, error)
+	Get(ctx context.Context, projectID string) (*Project, *Response, error)
+	GetPermissionScheme(ctx context.Context, projectID string) (*PermissionScheme, *Response, error)
+	GetDefaultClassification(ctx context.Context, projectIDOrKey string) (*ClassificationLevel, *Response, error)
+	UpdateDefaultClassification(ctx context.Context, projectIDOrKey, classificationLevelID string) (*Response, error)
+	RemoveDefaultClassification(ctx context.Context, projectIDOrKey string) (*Response, error)
+	GetRecent(ctx context.Context, options *RecentProjectsOptions) ([]Project, *Response, error)
+	GetHierarchy(ctx context.Context, projectID string) (*ProjectIssueTypeHierarchy, *Response, error)
+	GetNotificationScheme(ctx context.Context, projectIDOrKey string, options *ProjectSchemeOptions) (*NotificationScheme, *Response, error)
+	AssignPermissionScheme(ctx context.Context, projectIDOrKey string, permissionSchemeID int, options *ProjectSchemeOptions) (*PermissionScheme, *Response, error)
+	GetIssueSecurityLevelScheme(ctx context.Context, projectIDOrKey string) (*IssueSecurityLevelScheme, *Response, error)
+}
+
+// BoardAPI is the interface of BoardService.
+type BoardAPI interface {
+	GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error)
+	ListAllBoards(ctx context.Context, opt *BoardListOptions, pager *PagerOptions) ([]Board, error)
+	GetBoard(ctx context.Context, boardID int64) (*Board, *Response, error)
+	CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error)
+	DeleteBoard(ctx context.Context, boardID int) (*Board, *Response, error)
+	GetBoardsByFilter(ctx context.Context, filterID int64, options *SearchOptions) (*BoardsByFilterList, *Response, error)
+	GetBacklogIssues(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetEpics(ctx context.Context, boardID int64, options *GetEpicsOptions) (*EpicsList, *Response, error)
+	GetEpicIssues(ctx context.Context, boardID, epicID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetIssuesWithoutEpic(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetProjects(ctx context.Context, boardID int64, options *SearchOptions) (*BoardProjectsList, *Response, error)
+	GetVersions(ctx context.Context, boardID int64, options *GetVersionsOptions) (*BoardVersionsList, *Response, error)
+	GetPropertyKeys(ctx context.Context, boardID int64) (*EntityPropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, boardID int64, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, boardID int64, propertyKey string, value interface{}) (*Response, error)
+	DeleteProperty(ctx context.Context, boardID int64, propertyKey string) (*Response, error)
+	GetQuickFilters(ctx context.Context, boardID int64, options *SearchOptions) (*QuickFiltersList, *Response, error)
+	GetQuickFilter(ctx context.Context, boardID, quickFilterID int64) (*QuickFilter, *Response, error)
+	GetAllSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions) (*SprintsList, *Response, error)
+	ListAllSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions, pager *PagerOptions) ([]Sprint, error)
+	GetSprintIssues(ctx context.Context, boardID, sprintID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error)
+}
+
+// SprintAPI is the interface of SprintService.
+type SprintAPI interface {
+	Create(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error)
+	Get(ctx context.Context, sprintID int) (*Sprint, *Response, error)
+	Update(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error)
+	PartialUpdate(ctx context.Context, sprintID int, sprint *Sprint) (*Sprint, *Response, error)
+	Delete(ctx context.Context, sprintID int) (*Response, error)
+	Swap(ctx context.Context, sprintID, sprintToSwapWith int) (*Response, error)
+	GetPropertyKeys(ctx context.Context, sprintID int) (*EntityPropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, sprintID int, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, sprintID int, propertyKey string, value interface{}) (*Response, error)
+	DeleteProperty(ctx context.Context, sprintID int, propertyKey string) (*Response, error)
+	MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*Response, error)
+	MoveIssues(ctx context.Context, sprintID int, issues *IssuesWrapper) (*Response, error)
+	GetIssuesForSprint(ctx context.Context, sprintID int) ([]Issue, *Response, error)
+	GetIssues(ctx context.Context, sprintID int, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetIssue(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+}
+
+// UserAPI is the interface of UserService.
+type UserAPI interface {
+	Get(ctx context.Context, accountId string) (*User, *Response, error)
+	GetByAccountID(ctx context.Context, accountID string) (*User, *Response, error)
+	Create(ctx context.Context, user *User) (*User, *Response, error)
+	Delete(ctx context.Context, accountId string) (*Response, error)
+	GetGroups(ctx context.Context, accountId string) (*[]UserGroup, *Response, error)
+	GetCurrentUser(ctx context.Context) (*User, *Response, error)
+	Find(ctx context.Context, property string, tweaks ...UserSearchF) ([]User, *Response, error)
+	FindAll(ctx context.Context, property string, pager *PagerOptions, tweaks ...UserSearchF) ([]User, error)
+	FindUsersAndGroups(ctx context.Context, options *UserAndGroupPickerOptions) (*UsersAndGroups, *Response, error)
+	GetAccountIDs(ctx context.Context, options *UserMigrationOptions) ([]UserMigration, *Response, error)
+	ConvertJQL(ctx context.Context, queries []string) (*JQLConversion, *Response, error)
+}
+
+// GroupAPI is the interface of GroupService.
+type GroupAPI interface {
+	Get(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error)
+	GetAll(ctx context.Context, name string, options *GroupSearchOptions, pager *PagerOptions) ([]GroupMember, error)
+	AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*Group, *Response, error)
+	RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error)
+}
+
+// VersionAPI is the interface of VersionService.
+type VersionAPI interface {
+	Get(ctx context.Context, versionID int) (*Version, *Response, error)
+	Create(ctx context.Context, version *Version) (*Version, *Response, error)
+	Update(ctx context.Context, version *Version) (*Version, *Response, error)
+	GetRelatedWork(ctx context.Context, versionID string) ([]VersionRelatedWork, *Response, error)
+	CreateRelatedWork(ctx context.Context, versionID string, relatedWork *VersionRelatedWork) (*VersionRelatedWork, *Response, error)
+	UpdateRelatedWork(ctx context.Context, versionID string, relatedWork *VersionRelatedWork) (*VersionRelatedWork, *Response, error)
+	DeleteRelatedWork(ctx context.Context, versionID, relatedWorkID string) (*Response, error)
+}
+
+// PriorityAPI is the interface of PriorityService.
+type PriorityAPI interface {
+	GetList(ctx context.Context) ([]Priority, *Response, error)
+}
+
+// FieldAPI is the interface of FieldService.
+type FieldAPI interface {
+	GetList(ctx context.Context) ([]Field, *Response, error)
+	CreateCustom(ctx context.Context, options *FieldCreateOptions) (*Field, *Response, error)
+	UpdateCustom(ctx context.Context, fieldId string, options *FieldCreateOptions) (*Response, error)
+	DeleteCustom(ctx context.Context, fieldId string) (*Response, error)
+}
+
+// ComponentAPI is the interface of ComponentService.
+type ComponentAPI interface {
+	Create(ctx context.Context, options *ComponentCreateOptions) (*ProjectComponent, *Response, error)
+	Get(ctx context.Context, componentID string) (*ProjectComponent, *Response, error)
+	ListProjectComponents(ctx context.Context, projectIDOrKey string, options *ComponentListOptions) (*ComponentList, *Response, error)
+}
+
+// ResolutionAPI is the interface of ResolutionService.
+type ResolutionAPI interface {
+	GetList(ctx context.Context) ([]Resolution, *Response, error)
+}
+
+// StatusCategoryAPI is the interface of StatusCategoryService.
+type StatusCategoryAPI interface {
+	GetList(ctx context.Context) ([]StatusCategory, *Response, error)
+	Get(ctx context.Context, statusCategoryID string) (*StatusCategory, *Response, error)
+}
+
+// FilterAPI is the interface of FilterService.
+type FilterAPI interface {
+	GetList(ctx context.Context) ([]*Filter, *Response, error)
+	GetFavouriteList(ctx context.Context) ([]*Filter, *Response, error)
+	Get(ctx context.Context, filterID int) (*Filter, *Response, error)
+	GetMyFilters(ctx context.Context, opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error)
+	Search(ctx context.Context, opt *FilterSearchOptions) (*FiltersList, *Response, error)
+}
+
+// RoleAPI is the interface of RoleService.
+type RoleAPI interface {
+	GetList(ctx context.Context) (*[]Role, *Response, error)
+	Get(ctx context.Context, roleID int) (*Role, *Response, error)
+}
+
+// PermissionSchemeAPI is the interface of PermissionSchemeService.
+type PermissionSchemeAPI interface {
+	GetList(ctx context.Context) (*PermissionSchemes, *Response, error)
+	Get(ctx context.Context, schemeID int) (*PermissionScheme, *Response, error)
+}
+
+// StatusAPI is the interface of StatusService.
+type StatusAPI interface {
+	GetAllStatuses(ctx context.Context) ([]Status, *Response, error)
+}
+
+// IssueLinkTypeAPI is the interface of IssueLinkTypeService.
+type IssueLinkTypeAPI interface {
+	GetList(ctx context.Context) ([]IssueLinkType, *Response, error)
+	Get(ctx context.Context, ID string) (*IssueLinkType, *Response, error)
+	Create(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Update(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Delete(ctx context.Context, ID string) (*Response, error)
+}
+
+// OrganizationAPI is the interface of OrganizationService.
+type OrganizationAPI interface {
+	GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+	CreateOrganization(ctx context.Context, name string) (*Organization, *Response, error)
+	GetOrganization(ctx context.Context, organizationID int) (*Organization, *Response, error)
+	DeleteOrganization(ctx context.Context, organizationID int) (*Response, error)
+	GetPropertiesKeys(ctx context.Context, organizationID int) (*PropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, organizationID int, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, organizationID int, propertyKey string, value interface{}) (*Response, error)
+	DeleteProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error)
+	GetUsers(ctx context.Context, organizationID int, start int, limit int) (*PagedDTO, *Response, error)
+	AddUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+	RemoveUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+}
+
+// ServiceDeskAPI is the interface of ServiceDeskService.
+type ServiceDeskAPI interface {
+	GetList(ctx context.Context, options *ServiceDeskListOptions) (*ServiceDeskList, *Response, error)
+	Get(ctx context.Context, serviceDeskID interface{}) (*ServiceDesk, *Response, error)
+	GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+	AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+	RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+	AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+	RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+	ListCustomers(ctx context.Context, serviceDeskID interface{}, options *CustomerListOptions) (*CustomerList, *Response, error)
+	GetRequestTypes(ctx context.Context, serviceDeskID interface{}, options *RequestTypeListOptions) (*RequestTypeList, *Response, error)
+	GetRequestType(ctx context.Context, serviceDeskID, requestTypeID interface{}) (*RequestType, *Response, error)
+	GetRequestTypeFields(ctx context.Context, serviceDeskID, requestTypeID interface{}) (*RequestTypeFieldsList, *Response, error)
+	GetRequestTypeGroups(ctx context.Context, serviceDeskID interface{}, options *ServiceDeskListOptions) (*RequestTypeGroupList, *Response, error)
+	GetQueues(ctx context.Context, serviceDeskID interface{}, options *QueueListOptions) (*QueueList, *Response, error)
+	GetQueue(ctx context.Context, serviceDeskID, queueID interface{}, options *QueueListOptions) (*Queue, *Response, error)
+	GetQueueIssues(ctx context.Context, serviceDeskID, queueID interface{}, options *ServiceDeskListOptions) (*QueueIssueList, *Response, error)
+	SearchArticles(ctx context.Context, options *KnowledgeBaseSearchOptions) (*KnowledgeBaseArticleList, *Response, error)
+	SearchServiceDeskArticles(ctx context.Context, serviceDeskID interface{}, options *KnowledgeBaseSearchOptions) (*KnowledgeBaseArticleList, *Response, error)
+	AttachTemporaryFile(ctx context.Context, serviceDeskID interface{}, r io.Reader, fileName string) (*TemporaryAttachments, *Response, error)
+	GetInfo(ctx context.Context) (*ServiceDeskInfo, *Response, error)
+}
+
+// CustomerAPI is the interface of CustomerService.
+type CustomerAPI interface {
+	Create(ctx context.Context, email, displayName string) (*Customer, *Response, error)
+}
+
+// RequestAPI is the interface of RequestService.
+type RequestAPI interface {
+	Create(ctx context.Context, requester string, participants []string, request *Request) (*Request, *Response, error)
+	GetList(ctx context.Context, options *RequestListOptions) (*RequestList, *Response, error)
+	Get(ctx context.Context, issueIDOrKey string, options *RequestGetOptions) (*Request, *Response, error)
+	CreateComment(ctx context.Context, issueIDOrKey string, comment *RequestComment) (*RequestComment, *Response, error)
+	GetComments(ctx context.Context, issueIDOrKey string, options *RequestCommentListOptions) (*RequestCommentList, *Response, error)
+	GetComment(ctx context.Context, issueIDOrKey string, commentID interface{}, options *RequestGetOptions) (*RequestComment, *Response, error)
+	GetParticipants(ctx context.Context, issueIDOrKey string, options *CustomerListOptions) (*CustomerList, *Response, error)
+	AddParticipants(ctx context.Context, issueIDOrKey string, accountIDs ...string) (*CustomerList, *Response, error)
+	RemoveParticipants(ctx context.Context, issueIDOrKey string, accountIDs ...string) (*CustomerList, *Response, error)
+	GetSLAs(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error)
+	GetSLA(ctx context.Context, issueIDOrKey string, slaMetricID interface{}) (*RequestSLA, *Response, error)
+	GetApprovals(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestApprovalList, *Response, error)
+	GetApproval(ctx context.Context, issueIDOrKey string, approvalID interface{}) (*RequestApproval, *Response, error)
+	AnswerApproval(ctx context.Context, issueIDOrKey string, approvalID interface{}, decision string) (*RequestApproval, *Response, error)
+	CreateAttachment(ctx context.Context, issueIDOrKey string, attachment *RequestAttachmentCreate) (*RequestAttachmentResult, *Response, error)
+	GetStatuses(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestStatusList, *Response, error)
+	GetTransitions(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*CustomerTransitionList, *Response, error)
+	DoTransition(ctx context.Context, issueIDOrKey string, transitionID interface{}, comment string) (*Response, error)
+	GetFeedback(ctx context.Context, issueIDOrKey string) (*RequestFeedback, *Response, error)
+	CreateFeedback(ctx context.Context, issueIDOrKey string, feedback *RequestFeedback) (*RequestFeedback, *Response, error)
+	DeleteFeedback(ctx context.Context, issueIDOrKey string) (*Response, error)
+	GetSubscription(ctx context.Context, issueIDOrKey string) (bool, *Response, error)
+	Subscribe(ctx context.Context, issueIDOrKey string) (*Response, error)
+	Unsubscribe(ctx context.Context, issueIDOrKey string) (*Response, error)
+}
+
+// IssueEventAPI is the interface of IssueEventService.
+type IssueEventAPI interface {
+	GetList(ctx context.Context) ([]IssueEvent, *Response, error)
+}
+
+// UIModificationAPI is the interface of UIModificationService.
+type UIModificationAPI interface {
+	GetList(ctx context.Context, options *UIModificationListOptions) (*UIModificationList, *Response, error)
+	Create(ctx context.Context, modification *UIModification) (*UIModification, *Response, error)
+	Update(ctx context.Context, modificationID string, modification *UIModification) (*Response, error)
+	Delete(ctx context.Context, modificationID string) (*Response, error)
+}
+
+// AddonPropertyAPI is the interface of AddonPropertyService.
+type AddonPropertyAPI interface {
+	GetKeys(ctx context.Context, addonKey string) (*AddonPropertyKeys, *Response, error)
+	Get(ctx context.Context, addonKey, propertyKey string) (*AddonProperty, *Response, error)
+	Set(ctx context.Context, addonKey, propertyKey string, value interface{}) (*Response, error)
+	Delete(ctx context.Context, addonKey, propertyKey string) (*Response, error)
+}
+
+// ClassificationLevelAPI is the interface of ClassificationLevelService.
+type ClassificationLevelAPI interface {
+	GetList(ctx context.Context, options *ClassificationLevelListOptions) ([]ClassificationLevel, *Response, error)
+}
+
+// PlanAPI is the interface of PlanService.
+type PlanAPI interface {
+	GetList(ctx context.Context, options *PlanListOptions) (*PlanList, *Response, error)
+	Get(ctx context.Context, planID int64, options *PlanGetOptions) (*Plan, *Response, error)
+	Create(ctx context.Context, plan *Plan, options *PlanGetOptions) (int64, *Response, error)
+	Update(ctx context.Context, planID int64, operations []PlanPatchOperation, options *PlanGetOptions) (*Response, error)
+	Archive(ctx context.Context, planID int64) (*Response, error)
+	Trash(ctx context.Context, planID int64) (*Response, error)
+	Duplicate(ctx context.Context, planID int64, name string) (int64, *Response, error)
+	GetTeams(ctx context.Context, planID int64, options *PlanTeamListOptions) (*PlanTeamList, *Response, error)
+	AddAtlassianTeam(ctx context.Context, planID int64, team *PlanAtlassianTeam) (*Response, error)
+	GetAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string) (*PlanAtlassianTeam, *Response, error)
+	UpdateAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string, operations []PlanPatchOperation) (*Response, error)
+	RemoveAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string) (*Response, error)
+	CreatePlanOnlyTeam(ctx context.Context, planID int64, team *PlanOnlyTeam) (int64, *Response, error)
+	GetPlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64) (*PlanOnlyTeam, *Response, error)
+	UpdatePlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64, operations []PlanPatchOperation) (*Response, error)
+	DeletePlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64) (*Response, error)
+}
+
+// BacklogAPI is the interface of BacklogService.
+type BacklogAPI interface {
+	MoveIssues(ctx context.Context, issueIDs []string) (*Response, error)
+	MoveIssuesForBoard(ctx context.Context, boardID int64, issues *IssuesWrapper) (*Response, error)
+}
+
+// EpicAPI is the interface of EpicService.
+type EpicAPI interface {
+	Get(ctx context.Context, epicIDOrKey string) (*Epic, *Response, error)
+	Update(ctx context.Context, epicIDOrKey string, update *EpicUpdate) (*Epic, *Response, error)
+	Rank(ctx context.Context, epicIDOrKey string, rank *EpicRank) (*Response, error)
+	GetIssues(ctx context.Context, epicIDOrKey string, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	MoveIssues(ctx context.Context, epicIDOrKey string, issueIDs []string) (*Response, error)
+	RemoveIssues(ctx context.Context, issueIDs []string) (*Response, error)
+}
+
+// BuildAPI is the interface of BuildService.
+type BuildAPI interface {
+	Submit(ctx context.Context, builds *BuildsSubmission) (*BuildsSubmissionResult, *Response, error)
+	Get(ctx context.Context, pipelineID string, buildNumber int64) (*Build, *Response, error)
+	Delete(ctx context.Context, pipelineID string, buildNumber int64) (*Response, error)
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error)
+}
+
+// DeploymentAPI is the interface of DeploymentService.
+type DeploymentAPI interface {
+	Submit(ctx context.Context, deployments *DeploymentsSubmission) (*DeploymentsSubmissionResult, *Response, error)
+	Get(ctx context.Context, key DeploymentKey) (*Deployment, *Response, error)
+	Delete(ctx context.Context, key DeploymentKey) (*Response, error)
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error)
+}
+
+// FeatureFlagAPI is the interface of FeatureFlagService.
+type FeatureFlagAPI interface {
+	Submit(ctx context.Context, flags *FeatureFlagsSubmission) (*FeatureFlagsSubmissionResult, *Response, error)
+	Get(ctx context.Context, featureFlagID string) (*FeatureFlag, *Response, error)
+	Delete(ctx context.Context, featureFlagID string) (*Response, error)
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error)
+}
+
+// RemoteLinkAPI is the interface of RemoteLinkService.
+type RemoteLinkAPI interface {
+	Submit(ctx context.Context, remoteLinks *RemoteLinksSubmission) (*RemoteLinksSubmissionResult, *Response, error)
+	Get(ctx context.Context, remoteLinkID string) (*ProviderRemoteLink, *Response, error)
+	Delete(ctx context.Context, remoteLinkID string) (*Response, error)
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error)
+}
+
+// DevInfoAPI is the interface of DevInfoService.
+type DevInfoAPI interface {
+	Submit(ctx context.Context, devInfo *DevInfoSubmission) (*DevInfoSubmissionResult, *Response, error)
+	GetRepository(ctx context.Context, repositoryID string) (*DevInfoRepository, *Response, error)
+	DeleteRepository(ctx context.Context, repositoryID string) (*Response, error)
+	DeleteEntity(ctx context.Context, repositoryID, entityType, entityID string) (*Response, error)
+	DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error)
+	ExistsByProperties(ctx context.Context, properties map[string]string) (bool, *Response, error)
+}
+
+// AssetsAPI is the interface of AssetsService.
+type AssetsAPI interface {
+	GetObject(ctx context.Context, workspaceID, objectID string) (*AssetObject, *Response, error)
+	CreateObject(ctx context.Context, workspaceID string, object *AssetObjectPayload) (*AssetObject, *Response, error)
+	UpdateObject(ctx context.Context, workspaceID, objectID string, object *AssetObjectPayload) (*AssetObject, *Response, error)
+	DeleteObject(ctx context.Context, workspaceID, objectID string) (*Response, error)
+	GetObjectAttributes(ctx context.Context, workspaceID, objectID string) ([]AssetObjectAttribute, *Response, error)
+	GetObjectHistory(ctx context.Context, workspaceID, objectID string) ([]AssetObjectHistory, *Response, error)
+	GetWorkspaces(ctx context.Context, options *ServiceDeskListOptions) (*AssetWorkspaceList, *Response, error)
+	SearchObjects(ctx context.Context, workspaceID, aql string, options *AssetAQLOptions) (*AssetObjectList, *Response, error)
+	SearchObjectsNavlist(ctx context.Context, workspaceID string, query *AssetNavlistQuery) (*AssetNavlistResult, *Response, error)
+	GetObjectSchemas(ctx context.Context, workspaceID string, options *AssetObjectSchemaListOptions) (*AssetObjectSchemaList, *Response, error)
+	GetObjectSchema(ctx context.Context, workspaceID, schemaID string) (*AssetObjectSchema, *Response, error)
+	GetObjectTypes(ctx context.Context, workspaceID, schemaID string) ([]AssetObjectType, *Response, error)
+	GetObjectType(ctx context.Context, workspaceID, objectTypeID string) (*AssetObjectType, *Response, error)
+	GetObjectTypeAttributes(ctx context.Context, workspaceID, objectTypeID string, options *AssetObjectTypeAttributeOptions) ([]AssetObjectTypeAttribute, *Response, error)
+}
+
+// TaskAPI is the interface of TaskService.
+type TaskAPI interface {
+	Get(ctx context.Context, taskID string) (*Task, *Response, error)
+	Cancel(ctx context.Context, taskID string) (*Response, error)
+}
+
+// ConfigurationAPI is the interface of ConfigurationService.
+type ConfigurationAPI interface {
+	Get(ctx context.Context) (*Configuration, *Response, error)
+}
+
+// WorkflowAPI is the interface of WorkflowService.
+type WorkflowAPI interface {
+	Search(ctx context.Context, options *WorkflowSearchOptions) (*WorkflowSearchResult, *Response, error)
+	SearchAll(ctx context.Context, options *WorkflowSearchOptions, pager *PagerOptions) ([]Workflow, error)
+}
+
+var (
+	_ IssueAPI               = (*IssueService)(nil)
+	_ ProjectAPI             = (*ProjectService)(nil)
+	_ BoardAPI               = (*BoardService)(nil)
+	_ SprintAPI              = (*SprintService)(nil)
+	_ UserAPI                = (*UserService)(nil)
+	_ GroupAPI               = (*GroupService)(nil)
+	_ VersionAPI             = (*VersionService)(nil)
+	_ PriorityAPI            = (*PriorityService)(nil)
+	_ FieldAPI               = (*FieldService)(nil)
+	_ ComponentAPI           = (*ComponentService)(nil)
+	_ ResolutionAPI          = (*ResolutionService)(nil)
+	_ StatusCategoryAPI      = (*StatusCategoryService)(nil)
+	_ FilterAPI              = (*FilterService)(nil)
+	_ RoleAPI                = (*RoleService)(nil)
+	_ PermissionSchemeAPI    = (*PermissionSchemeService)(nil)
+	_ StatusAPI              = (*StatusService)(nil)
+	_ IssueLinkTypeAPI       = (*IssueLinkTypeService)(nil)
+	_ OrganizationAPI        = (*OrganizationService)(nil)
+	_ ServiceDeskAPI         = (*ServiceDeskService)(nil)
+	_ CustomerAPI            = (*CustomerService)(nil)
+	_ RequestAPI             = (*RequestService)(nil)
+	_ IssueEventAPI          = (*IssueEventService)(nil)
+	_ UIModificationAPI      = (*UIModificationService)(nil)
+	_ AddonPropertyAPI       = (*AddonPropertyService)(nil)
+	_ ClassificationLevelAPI = (*ClassificationLevelService)(nil)
+	_ PlanAPI                = (*PlanService)(nil)
+	_ BacklogAPI             = (*BacklogService)(nil)
+	_ EpicAPI                = (*EpicService)(nil)
+	_ BuildAPI               = (*BuildService)(nil)
+	_ DeploymentAPI          = (*DeploymentService)(nil)
+	_ FeatureFlagAPI         = (*FeatureFlagService)(nil)
+	_ RemoteLinkAPI          = (*RemoteLinkService)(nil)
+	_ DevInfoAPI             = (*DevInfoService)(nil)
+	_ AssetsAPI              = (*AssetsService)(nil)
+	_ TaskAPI                = (*TaskService)(nil)
+	_ ConfigurationAPI       = (*ConfigurationService)(nil)
+	_ WorkflowAPI            = (*WorkflowService)(nil)
+	_ SearchAPI              = (*IssueService)(nil)
+)
@@ -0,0 +1,646 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// AssetsService handles objects of Jira Service Management Assets (formerly Insight).
+//
+// The Assets API is served per workspace. Requests are sent through the
+// gateway of the Jira site, so the authentication of the client is reused.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/
+type AssetsService service
+
+// assetsEndpoint returns the endpoint of path in the Assets API of workspaceID.
+func assetsEndpoint(workspaceID, path string) string {
+	return fmt.Sprintf("gateway/api/jsm/assets/workspace/%s/v1/%s", workspaceID, path)
+}
+
+// AssetObject represents an object in Assets.
+type AssetObject struct {
+	ID         string                 `json:"id,omitempty" structs:"id,omitempty"`
+	GlobalID   string                 `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Label      string                 `json:"label,omitempty" structs:"label,omitempty"`
+	ObjectKey  string                 `json:"objectKey,omitempty" structs:"objectKey,omitempty"`
+	ObjectType *AssetObjectType       `json:"objectType,omitempty" structs:"objectType,omitempty"`
+	Created    string                 `json:"created,omitempty" structs:"created,omitempty"`
+	Updated    string                 `json:"updated,omitempty" structs:"updated,omitempty"`
+	HasAvatar  bool                   `json:"hasAvatar,omitempty" structs:"hasAvatar,omitempty"`
+	Timestamp  int64                  `json:"timestamp,omitempty" structs:"timestamp,omitempty"`
+	Attributes []AssetObjectAttribute `json:"attributes,omitempty" structs:"attributes,omitempty"`
+	Links      *SelfLink              `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// AssetObjectType represents the type of an object in Assets.
+type AssetObjectType struct {
+	ID                 string     `json:"id,omitempty" structs:"id,omitempty"`
+	GlobalID           string     `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Name               string     `json:"name,omitempty" structs:"name,omitempty"`
+	Description        string     `json:"description,omitempty" structs:"description,omitempty"`
+	Icon               *AssetIcon `json:"icon,omitempty" structs:"icon,omitempty"`
+	Position           int        `json:"position,omitempty" structs:"position,omitempty"`
+	Created            string     `json:"created,omitempty" structs:"created,omitempty"`
+	Updated            string     `json:"updated,omitempty" structs:"updated,omitempty"`
+	ObjectCount        int        `json:"objectCount,omitempty" structs:"objectCount,omitempty"`
+	ParentObjectTypeID string     `json:"parentObjectTypeId,omitempty" structs:"parentObjectTypeId,omitempty"`
+	ObjectSchemaID     string     `json:"objectSchemaId,omitempty" structs:"objectSchemaId,omitempty"`
+	Inherited          bool       `json:"inherited,omitempty" structs:"inherited,omitempty"`
+	AbstractObjectType bool       `json:"abstractObjectType,omitempty" structs:"abstractObjectType,omitempty"`
+}
+
+// AssetIcon is the icon of an object type in Assets.
+type AssetIcon struct {
+	ID    string `json:"id,omitempty" structs:"id,omitempty"`
+	Name  string `json:"name,omitempty" structs:"name,omitempty"`
+	URL16 string `json:"url16,omitempty" structs:"url16,omitempty"`
+	URL48 string `json:"url48,omitempty" structs:"url48,omitempty"`
+}
+
+// AssetObjectAttribute is the value of an attribute of an object in Assets.
+// An attribute can hold several values, e.g. for references to other objects.
+type AssetObjectAttribute struct {
+	ID                    string                      `json:"id,omitempty" structs:"id,omitempty"`
+	ObjectTypeAttributeID string                      `json:"objectTypeAttributeId,omitempty" structs:"objectTypeAttributeId,omitempty"`
+	ObjectAttributeValues []AssetObjectAttributeValue `json:"objectAttributeValues,omitempty" structs:"objectAttributeValues,omitempty"`
+	ObjectID              string                      `json:"objectId,omitempty" structs:"objectId,omitempty"`
+}
+
+// AssetObjectAttributeValue is a single value of an attribute of an object in Assets.
+// Value is set for attributes holding plain values, ReferencedObject, User or Status
+// for attributes referencing other entities.
+type AssetObjectAttributeValue struct {
+	Value            interface{}  `json:"value,omitempty" structs:"value,omitempty"`
+	DisplayValue     string       `json:"displayValue,omitempty" structs:"displayValue,omitempty"`
+	SearchValue      string       `json:"searchValue,omitempty" structs:"searchValue,omitempty"`
+	ReferencedType   bool         `json:"referencedType,omitempty" structs:"referencedType,omitempty"`
+	ReferencedObject *AssetObject `json:"referencedObject,omitempty" structs:"referencedObject,omitempty"`
+	User             *AssetActor  `json:"user,omitempty" structs:"user,omitempty"`
+	Status           *AssetStatus `json:"status,omitempty" structs:"status,omitempty"`
+}
+
+// AssetStatus is the value of a status attribute in Assets.
+type AssetStatus struct {
+	ID       string `json:"id,omitempty" structs:"id,omitempty"`
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	Category int    `json:"category,omitempty" structs:"category,omitempty"`
+}
+
+// AssetActor is a user in Assets, e.g. the author of a change.
+type AssetActor struct {
+	AvatarURL    string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	DisplayName  string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	Key          string `json:"key,omitempty" structs:"key,omitempty"`
+	Mail         string `json:"mail,omitempty" structs:"mail,omitempty"`
+	RenderedLink string `json:"renderedLink,omitempty" structs:"renderedLink,omitempty"`
+	IsDeleted    bool   `json:"isDeleted,omitempty" structs:"isDeleted,omitempty"`
+}
+
+// AssetObjectPayload is the payload for creating or updating an object in Assets.
+type AssetObjectPayload struct {
+	ObjectTypeID string                        `json:"objectTypeId" structs:"objectTypeId"`
+	Attributes   []AssetObjectAttributePayload `json:"attributes" structs:"attributes"`
+	HasAvatar    bool                          `json:"hasAvatar,omitempty" structs:"hasAvatar,omitempty"`
+	AvatarUUID   string                        `json:"avatarUUID,omitempty" structs:"avatarUUID,omitempty"`
+}
+
+// AssetObjectAttributePayload sets the values of an attribute when creating or updating an object in Assets.
+// Values are given as strings, references to objects by their object key.
+type AssetObjectAttributePayload struct {
+	ObjectTypeAttributeID string                             `json:"objectTypeAttributeId" structs:"objectTypeAttributeId"`
+	ObjectAttributeValues []AssetObjectAttributeValuePayload `json:"objectAttributeValues" structs:"objectAttributeValues"`
+}
+
+// AssetObjectAttributeValuePayload is a single value of an AssetObjectAttributePayload.
+type AssetObjectAttributeValuePayload struct {
+	Value string `json:"value" structs:"value"`
+}
+
+// AssetObjectHistory is an entry of the change history of an object in Assets.
+type AssetObjectHistory struct {
+	ID                string      `json:"id,omitempty" structs:"id,omitempty"`
+	AffectedAttribute string      `json:"affectedAttribute,omitempty" structs:"affectedAttribute,omitempty"`
+	OldValue          string      `json:"oldValue,omitempty" structs:"oldValue,omitempty"`
+	NewValue          string      `json:"newValue,omitempty" structs:"newValue,omitempty"`
+	Actor             *AssetActor `json:"actor,omitempty" structs:"actor,omitempty"`
+	Type              int         `json:"type,omitempty" structs:"type,omitempty"`
+	Created           string      `json:"created,omitempty" structs:"created,omitempty"`
+	Updated           string      `json:"updated,omitempty" structs:"updated,omitempty"`
+	ObjectID          string      `json:"objectId,omitempty" structs:"objectId,omitempty"`
+}
+
+// GetObject returns an object in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-get
+func (s *AssetsService) GetObject(ctx context.Context, workspaceID, objectID string) (*AssetObject, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/"+objectID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	object := new(AssetObject)
+	resp, err := s.client.Do(req, object)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return object, resp, nil
+}
+
+// CreateObject creates an object in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-create-post
+func (s *AssetsService) CreateObject(ctx context.Context, workspaceID string, object *AssetObjectPayload) (*AssetObject, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/create")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, object)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(AssetObject)
+	resp, err := s.client.Do(req, created)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return created, resp, nil
+}
+
+// UpdateObject updates an object in Assets.
+// Only the attributes given in object are changed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-put
+func (s *AssetsService) UpdateObject(ctx context.Context, workspaceID, objectID string, object *AssetObjectPayload) (*AssetObject, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/"+objectID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, object)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updated := new(AssetObject)
+	resp, err := s.client.Do(req, updated)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return updated, resp, nil
+}
+
+// DeleteObject deletes an object in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-delete
+// Caller must close resp.Body
+func (s *AssetsService) DeleteObject(ctx context.Context, workspaceID, objectID string) (*Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/"+objectID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetObjectAttributes returns the attributes of an object in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-attributes-get
+func (s *AssetsService) GetObjectAttributes(ctx context.Context, workspaceID, objectID string) ([]AssetObjectAttribute, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/"+objectID+"/attributes")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attributes []AssetObjectAttribute
+	resp, err := s.client.Do(req, &attributes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return attributes, resp, nil
+}
+
+// GetObjectHistory returns the change history of an object in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-id-history-get
+func (s *AssetsService) GetObjectHistory(ctx context.Context, workspaceID, objectID string) ([]AssetObjectHistory, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/"+objectID+"/history")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var history []AssetObjectHistory
+	resp, err := s.client.Do(req, &history)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return history, resp, nil
+}
+
+// Attribute returns the attribute of the object with the given object type attribute ID,
+// or nil if the object has no such attribute.
+func (o *AssetObject) Attribute(objectTypeAttributeID string) *AssetObjectAttribute {
+	for i := range o.Attributes {
+		if o.Attributes[i].ObjectTypeAttributeID == objectTypeAttributeID {
+			return &o.Attributes[i]
+		}
+	}
+	return nil
+}
+
+// String returns the value as a string.
+// It returns an empty string if the value is not set, e.g. for references.
+func (v AssetObjectAttributeValue) String() string {
+	switch value := v.Value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// Int returns the value of an integer attribute.
+func (v AssetObjectAttributeValue) Int() (int64, error) {
+	if f, ok := v.Value.(float64); ok {
+		return int64(f), nil
+	}
+	return strconv.ParseInt(v.String(), 10, 64)
+}
+
+// Float returns the value of a double attribute.
+func (v AssetObjectAttributeValue) Float() (float64, error) {
+	if f, ok := v.Value.(float64); ok {
+		return f, nil
+	}
+	return strconv.ParseFloat(v.String(), 64)
+}
+
+// Bool returns the value of a boolean attribute.
+func (v AssetObjectAttributeValue) Bool() (bool, error) {
+	if b, ok := v.Value.(bool); ok {
+		return b, nil
+	}
+	return strconv.ParseBool(v.String())
+}
+
+// Time returns the value of a date or date time attribute.
+// Date attributes are returned at midnight UTC.
+func (v AssetObjectAttributeValue) Time() (time.Time, error) {
+	s := v.String()
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
+// AssetWorkspace is an Assets workspace of a Jira site.
+type AssetWorkspace struct {
+	WorkspaceID string `json:"workspaceId,omitempty" structs:"workspaceId,omitempty"`
+}
+
+// AssetWorkspaceList is a page of Assets workspaces.
+type AssetWorkspaceList = ServiceDeskPage[AssetWorkspace]
+
+// GetWorkspaces returns the Assets workspaces of the Jira site.
+// The workspace ID is needed for all other requests of the Assets API.
+// A site usually has a single workspace.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-assets/#api-rest-servicedeskapi-assets-workspace-get
+func (s *AssetsService) GetWorkspaces(ctx context.Context, options *ServiceDeskListOptions) (*AssetWorkspaceList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/assets/workspace", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	workspaces := new(AssetWorkspaceList)
+	resp, err := s.client.Do(req, workspaces)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return workspaces, resp, nil
+}
+
+// AssetObjectTypeAttribute is the definition of an attribute of an object type in Assets.
+type AssetObjectTypeAttribute struct {
+	ID                      string                           `json:"id,omitempty" structs:"id,omitempty"`
+	GlobalID                string                           `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Name                    string                           `json:"name,omitempty" structs:"name,omitempty"`
+	Label                   bool                             `json:"label,omitempty" structs:"label,omitempty"`
+	Type                    int                              `json:"type,omitempty" structs:"type,omitempty"`
+	Description             string                           `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultType             *AssetObjectTypeAttributeType    `json:"defaultType,omitempty" structs:"defaultType,omitempty"`
+	ReferenceObjectTypeID   string                           `json:"referenceObjectTypeId,omitempty" structs:"referenceObjectTypeId,omitempty"`
+	ReferenceObjectType     *AssetObjectType                 `json:"referenceObjectType,omitempty" structs:"referenceObjectType,omitempty"`
+	ReferenceType           *AssetObjectTypeAttributeRefType `json:"referenceType,omitempty" structs:"referenceType,omitempty"`
+	ObjectType              *AssetObjectType                 `json:"objectType,omitempty" structs:"objectType,omitempty"`
+	Editable                bool                             `json:"editable,omitempty" structs:"editable,omitempty"`
+	System                  bool                             `json:"system,omitempty" structs:"system,omitempty"`
+	Sortable                bool                             `json:"sortable,omitempty" structs:"sortable,omitempty"`
+	Summable                bool                             `json:"summable,omitempty" structs:"summable,omitempty"`
+	Indexed                 bool                             `json:"indexed,omitempty" structs:"indexed,omitempty"`
+	Hidden                  bool                             `json:"hidden,omitempty" structs:"hidden,omitempty"`
+	UniqueAttribute         bool                             `json:"uniqueAttribute,omitempty" structs:"uniqueAttribute,omitempty"`
+	IncludeChildObjectTypes bool                             `json:"includeChildObjectTypes,omitempty" structs:"includeChildObjectTypes,omitempty"`
+	MinimumCardinality      int                              `json:"minimumCardinality,omitempty" structs:"minimumCardinality,omitempty"`
+	MaximumCardinality      int                              `json:"maximumCardinality,omitempty" structs:"maximumCardinality,omitempty"`
+	RegexValidation         string                           `json:"regexValidation,omitempty" structs:"regexValidation,omitempty"`
+	Options                 string                           `json:"options,omitempty" structs:"options,omitempty"`
+	Suffix                  string                           `json:"suffix,omitempty" structs:"suffix,omitempty"`
+	Position                int                              `json:"position,omitempty" structs:"position,omitempty"`
+}
+
+// AssetObjectTypeAttributeType is the data type of a default attribute in Assets, e.g. Text or Integer.
+type AssetObjectTypeAttributeType struct {
+	ID   int    `json:"id" structs:"id"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// AssetObjectTypeAttributeRefType is the kind of reference of a reference attribute in Assets.
+type AssetObjectTypeAttributeRefType struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Color       string `json:"color,omitempty" structs:"color,omitempty"`
+}
+
+// AssetAQLOptions is the query options for searching objects with AQL.
+// Attributes are included in the result unless IncludeAttributes is set to false.
+type AssetAQLOptions struct {
+	StartAt           int   `url:"startAt,omitempty"`
+	MaxResults        int   `url:"maxResults,omitempty"`
+	IncludeAttributes *bool `url:"includeAttributes,omitempty"`
+}
+
+// AssetObjectList is a page of objects found with AQL.
+type AssetObjectList struct {
+	StartAt              int                        `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults           int                        `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total                int                        `json:"total,omitempty" structs:"total,omitempty"`
+	IsLast               bool                       `json:"isLast,omitempty" structs:"isLast,omitempty"`
+	Values               []AssetObject              `json:"values,omitempty" structs:"values,omitempty"`
+	ObjectTypeAttributes []AssetObjectTypeAttribute `json:"objectTypeAttributes,omitempty" structs:"objectTypeAttributes,omitempty"`
+}
+
+// SearchObjects returns a page of the objects matching an AQL (Assets Query Language) query.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-aql-post
+func (s *AssetsService) SearchObjects(ctx context.Context, workspaceID, aql string, options *AssetAQLOptions) (*AssetObjectList, *Response, error) {
+	apiEndpoint, err := addOptions(assetsEndpoint(workspaceID, "object/aql"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	payload := struct {
+		QLQuery string `json:"qlQuery"`
+	}{QLQuery: aql}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	allowRetry(req)
+
+	objects := new(AssetObjectList)
+	resp, err := s.client.Do(req, objects)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return objects, resp, nil
+}
+
+// objectsPager returns a Pager over the objects matching an AQL query.
+func (s *AssetsService) objectsPager(workspaceID, aql string, options *AssetAQLOptions, pager *PagerOptions) *Pager[AssetObject] {
+	var opts AssetAQLOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[AssetObject], *Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		list, resp, err := s.SearchObjects(ctx, workspaceID, aql, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[AssetObject]{Values: list.Values, Total: list.Total, IsLast: list.IsLast}, resp, nil
+	}, pager)
+}
+
+// AssetNavlistQuery is the query for listing the objects of an object type with AQL.
+// Page starts at 1.
+type AssetNavlistQuery struct {
+	ObjectTypeID        string                    `json:"objectTypeId" structs:"objectTypeId"`
+	ObjectSchemaID      string                    `json:"objectSchemaId,omitempty" structs:"objectSchemaId,omitempty"`
+	QLQuery             string                    `json:"qlQuery,omitempty" structs:"qlQuery,omitempty"`
+	Page                int                       `json:"page,omitempty" structs:"page,omitempty"`
+	ResultsPerPage      int                       `json:"resultsPerPage,omitempty" structs:"resultsPerPage,omitempty"`
+	OrderByTypeAttrID   int                       `json:"orderByTypeAttrId,omitempty" structs:"orderByTypeAttrId,omitempty"`
+	Asc                 int                       `json:"asc,omitempty" structs:"asc,omitempty"`
+	IncludeAttributes   *bool                     `json:"includeAttributes,omitempty" structs:"includeAttributes,omitempty"`
+	AttributesToDisplay *AssetAttributesToDisplay `json:"attributesToDisplay,omitempty" structs:"attributesToDisplay,omitempty"`
+}
+
+// AssetAttributesToDisplay limits the attributes returned by AssetsService.SearchObjectsNavlist.
+type AssetAttributesToDisplay struct {
+	AttributesToDisplayIDs []int `json:"attributesToDisplayIds,omitempty" structs:"attributesToDisplayIds,omitempty"`
+}
+
+// AssetNavlistResult is a page of objects of an object type found with AQL.
+type AssetNavlistResult struct {
+	ObjectEntries         []AssetObject              `json:"objectEntries,omitempty" structs:"objectEntries,omitempty"`
+	ObjectTypeAttributes  []AssetObjectTypeAttribute `json:"objectTypeAttributes,omitempty" structs:"objectTypeAttributes,omitempty"`
+	ObjectTypeID          string                     `json:"objectTypeId,omitempty" structs:"objectTypeId,omitempty"`
+	ObjectTypeIsInherited bool                       `json:"objectTypeIsInherited,omitempty" structs:"objectTypeIsInherited,omitempty"`
+	AbstractObjectType    bool                       `json:"abstractObjectType,omitempty" structs:"abstractObjectType,omitempty"`
+	TotalFilterCount      int                        `json:"totalFilterCount,omitempty" structs:"totalFilterCount,omitempty"`
+	StartIndex            int                        `json:"startIndex,omitempty" structs:"startIndex,omitempty"`
+	ToIndex               int                        `json:"toIndex,omitempty" structs:"toIndex,omitempty"`
+	PageObjectSize        int                        `json:"pageObjectSize,omitempty" structs:"pageObjectSize,omitempty"`
+	PageNumber            int                        `json:"pageNumber,omitempty" structs:"pageNumber,omitempty"`
+	PageSize              int                        `json:"pageSize,omitempty" structs:"pageSize,omitempty"`
+	QLQuery               string                     `json:"qlQuery,omitempty" structs:"qlQuery,omitempty"`
+	QLQuerySearchResult   bool                       `json:"qlQuerySearchResult,omitempty" structs:"qlQuerySearchResult,omitempty"`
+}
+
+// SearchObjectsNavlist returns a page of the objects of an object type matching an AQL query,
+// as shown in the object list of the Assets UI.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-object/#api-object-navlist-aql-post
+func (s *AssetsService) SearchObjectsNavlist(ctx context.Context, workspaceID string, query *AssetNavlistQuery) (*AssetNavlistResult, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "object/navlist/aql")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	allowRetry(req)
+
+	result := new(AssetNavlistResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// AssetObjectSchema represents an object schema in Assets.
+type AssetObjectSchema struct {
+	ID              string `json:"id,omitempty" structs:"id,omitempty"`
+	GlobalID        string `json:"globalId,omitempty" structs:"globalId,omitempty"`
+	Name            string `json:"name,omitempty" structs:"name,omitempty"`
+	ObjectSchemaKey string `json:"objectSchemaKey,omitempty" structs:"objectSchemaKey,omitempty"`
+	Description     string `json:"description,omitempty" structs:"description,omitempty"`
+	Status          string `json:"status,omitempty" structs:"status,omitempty"`
+	Created         string `json:"created,omitempty" structs:"created,omitempty"`
+	Updated         string `json:"updated,omitempty" structs:"updated,omitempty"`
+	ObjectCount     int    `json:"objectCount,omitempty" structs:"objectCount,omitempty"`
+	ObjectTypeCount int    `json:"objectTypeCount,omitempty" structs:"objectTypeCount,omitempty"`
+	CanManage       bool   `json:"canManage,omitempty" structs:"canManage,omitempty"`
+}
+
+// AssetObjectSchemaListOptions is the query options for listing object schemas.
+type AssetObjectSchemaListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// AssetObjectSchemaList is a page of object schemas.
+type AssetObjectSchemaList struct {
+	StartAt    int                 `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults int                 `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total      int                 `json:"total,omitempty" structs:"total,omitempty"`
+	IsLast     bool                `json:"isLast,omitempty" structs:"isLast,omitempty"`
+	Values     []AssetObjectSchema `json:"values,omitempty" structs:"values,omitempty"`
+}
+
+// GetObjectSchemas returns a page of the object schemas in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-list-get
+func (s *AssetsService) GetObjectSchemas(ctx context.Context, workspaceID string, options *AssetObjectSchemaListOptions) (*AssetObjectSchemaList, *Response, error) {
+	apiEndpoint, err := addOptions(assetsEndpoint(workspaceID, "objectschema/list"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemas := new(AssetObjectSchemaList)
+	resp, err := s.client.Do(req, schemas)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return schemas, resp, nil
+}
+
+// GetObjectSchema returns an object schema in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-id-get
+func (s *AssetsService) GetObjectSchema(ctx context.Context, workspaceID, schemaID string) (*AssetObjectSchema, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "objectschema/"+schemaID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := new(AssetObjectSchema)
+	resp, err := s.client.Do(req, schema)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return schema, resp, nil
+}
+
+// GetObjectTypes returns all object types of an object schema in Assets as a flat list.
+// The hierarchy can be rebuilt with ParentObjectTypeID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objectschema/#api-objectschema-id-objecttypes-flat-get
+func (s *AssetsService) GetObjectTypes(ctx context.Context, workspaceID, schemaID string) ([]AssetObjectType, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "objectschema/"+schemaID+"/objecttypes/flat")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var objectTypes []AssetObjectType
+	resp, err := s.client.Do(req, &objectTypes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return objectTypes, resp, nil
+}
+
+// GetObjectType returns an object type in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objecttype/#api-objecttype-id-get
+func (s *AssetsService) GetObjectType(ctx context.Context, workspaceID, objectTypeID string) (*AssetObjectType, *Response, error) {
+	apiEndpoint := assetsEndpoint(workspaceID, "objecttype/"+objectTypeID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objectType := new(AssetObjectType)
+	resp, err := s.client.Do(req, objectType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return objectType, resp, nil
+}
+
+// AssetObjectTypeAttributeOptions is the query options for listing the attributes of an object type.
+type AssetObjectTypeAttributeOptions struct {
+	OnlyValueEditable       bool   `url:"onlyValueEditable,omitempty"`
+	OrderByName             bool   `url:"orderByName,omitempty"`
+	Query                   string `url:"query,omitempty"`
+	IncludeValuesExist      bool   `url:"includeValuesExist,omitempty"`
+	ExcludeParentAttributes bool   `url:"excludeParentAttributes,omitempty"`
+	IncludeChildren         bool   `url:"includeChildren,omitempty"`
+	OrderByRequired         bool   `url:"orderByRequired,omitempty"`
+}
+
+// GetObjectTypeAttributes returns the attributes of an object type in Assets.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/assets/rest/api-group-objecttype/#api-objecttype-id-attributes-get
+func (s *AssetsService) GetObjectTypeAttributes(ctx context.Context, workspaceID, objectTypeID string, options *AssetObjectTypeAttributeOptions) ([]AssetObjectTypeAttribute, *Response, error) {
+	apiEndpoint, err := addOptions(assetsEndpoint(workspaceID, "objecttype/"+objectTypeID+"/attributes"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var attributes []AssetObjectTypeAttribute
+	resp, err := s.client.Do(req, &attributes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return attributes, resp, nil
+}
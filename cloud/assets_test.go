@@ -0,0 +1,448 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAssetsService_GetObject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/g2778e1d-939d-581d-c8e2-9d5g59de456b/v1/object/88"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"workspaceId":"g2778e1d-939d-581d-c8e2-9d5g59de456b","globalId":"g2778e1d-939d-581d-c8e2-9d5g59de456b:88","id":"88","label":"SYD-1","objectKey":"ITSM-88","objectType":{"id":"23","name":"Office","objectSchemaId":"6"},"created":"2021-02-16T20:04:41.527Z","updated":"2021-02-16T20:04:41.527Z","hasAvatar":false,"timestamp":1613505881527,"attributes":[{"id":"637","objectTypeAttributeId":"134","objectAttributeValues":[{"value":"SYD-1","displayValue":"SYD-1","searchValue":"SYD-1","referencedType":false}],"objectId":"88"}],"_links":{"self":"https://your-domain.atlassian.net/jira/servicedesk/assets/object/88"}}`)
+	})
+
+	object, _, err := testClient.Assets.GetObject(context.Background(), "g2778e1d-939d-581d-c8e2-9d5g59de456b", "88")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if object == nil || object.ObjectKey != "ITSM-88" || object.ObjectType == nil || object.ObjectType.Name != "Office" {
+		t.Fatalf("Unexpected object: %+v", object)
+	}
+	if len(object.Attributes) != 1 || object.Attributes[0].ObjectAttributeValues[0].Value != "SYD-1" {
+		t.Errorf("Unexpected attributes: %+v", object.Attributes)
+	}
+}
+
+func TestAssetsService_CreateObject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/create"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		want := map[string]interface{}{
+			"objectTypeId": "23",
+			"attributes": []interface{}{
+				map[string]interface{}{
+					"objectTypeAttributeId": "134",
+					"objectAttributeValues": []interface{}{map[string]interface{}{"value": "SYD-2"}},
+				},
+			},
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Unexpected payload %v, want %v", payload, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"89","label":"SYD-2","objectKey":"ITSM-89"}`)
+	})
+
+	object, _, err := testClient.Assets.CreateObject(context.Background(), "ws-1", &AssetObjectPayload{
+		ObjectTypeID: "23",
+		Attributes: []AssetObjectAttributePayload{
+			{ObjectTypeAttributeID: "134", ObjectAttributeValues: []AssetObjectAttributeValuePayload{{Value: "SYD-2"}}},
+		},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if object == nil || object.ID != "89" {
+		t.Errorf("Unexpected object: %+v", object)
+	}
+}
+
+func TestAssetsService_UpdateObject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/89"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"89","label":"SYD-3","objectKey":"ITSM-89"}`)
+	})
+
+	object, _, err := testClient.Assets.UpdateObject(context.Background(), "ws-1", "89", &AssetObjectPayload{
+		ObjectTypeID: "23",
+		Attributes: []AssetObjectAttributePayload{
+			{ObjectTypeAttributeID: "134", ObjectAttributeValues: []AssetObjectAttributeValuePayload{{Value: "SYD-3"}}},
+		},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if object == nil || object.Label != "SYD-3" {
+		t.Errorf("Unexpected object: %+v", object)
+	}
+}
+
+func TestAssetsService_DeleteObject(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/89"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Assets.DeleteObject(context.Background(), "ws-1", "89")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestAssetsService_GetObjectAttributes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/88/attributes"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"637","objectTypeAttributeId":"134","objectAttributeValues":[{"value":"SYD-1","displayValue":"SYD-1"}],"objectId":"88"},{"id":"638","objectTypeAttributeId":"135","objectAttributeValues":[{"referencedType":true,"displayValue":"Sydney","referencedObject":{"id":"12","objectKey":"ITSM-12","label":"Sydney"}}],"objectId":"88"}]`)
+	})
+
+	attributes, _, err := testClient.Assets.GetObjectAttributes(context.Background(), "ws-1", "88")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(attributes) != 2 {
+		t.Fatalf("Unexpected attributes: %+v", attributes)
+	}
+	ref := attributes[1].ObjectAttributeValues[0].ReferencedObject
+	if ref == nil || ref.ObjectKey != "ITSM-12" {
+		t.Errorf("Unexpected referenced object: %+v", ref)
+	}
+}
+
+func TestAssetsService_GetObjectHistory(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/88/history"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"1","affectedAttribute":"Name","oldValue":"SYD-0","newValue":"SYD-1","actor":{"displayName":"Alana Grant","key":"5b10ac8d82e05b22cc7d4ef5"},"type":2,"created":"2021-02-16T20:04:41.527Z","objectId":"88"}]`)
+	})
+
+	history, _, err := testClient.Assets.GetObjectHistory(context.Background(), "ws-1", "88")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(history) != 1 || history[0].NewValue != "SYD-1" || history[0].Actor == nil || history[0].Actor.DisplayName != "Alana Grant" {
+		t.Errorf("Unexpected history: %+v", history)
+	}
+}
+
+func TestAssetObjectAttributeValue_Typed(t *testing.T) {
+	tests := []struct {
+		value AssetObjectAttributeValue
+		check func(v AssetObjectAttributeValue) error
+	}{
+		{AssetObjectAttributeValue{Value: "42"}, func(v AssetObjectAttributeValue) error {
+			if i, err := v.Int(); err != nil || i != 42 {
+				return fmt.Errorf("Int() = %d, %v", i, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{Value: float64(42)}, func(v AssetObjectAttributeValue) error {
+			if i, err := v.Int(); err != nil || i != 42 {
+				return fmt.Errorf("Int() = %d, %v", i, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{Value: "1.5"}, func(v AssetObjectAttributeValue) error {
+			if f, err := v.Float(); err != nil || f != 1.5 {
+				return fmt.Errorf("Float() = %f, %v", f, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{Value: "true"}, func(v AssetObjectAttributeValue) error {
+			if b, err := v.Bool(); err != nil || !b {
+				return fmt.Errorf("Bool() = %t, %v", b, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{Value: "2021-02-16T20:04:41.527Z"}, func(v AssetObjectAttributeValue) error {
+			want := time.Date(2021, 2, 16, 20, 4, 41, 527000000, time.UTC)
+			if tm, err := v.Time(); err != nil || !tm.Equal(want) {
+				return fmt.Errorf("Time() = %s, %v", tm, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{Value: "2021-02-16"}, func(v AssetObjectAttributeValue) error {
+			want := time.Date(2021, 2, 16, 0, 0, 0, 0, time.UTC)
+			if tm, err := v.Time(); err != nil || !tm.Equal(want) {
+				return fmt.Errorf("Time() = %s, %v", tm, err)
+			}
+			return nil
+		}},
+		{AssetObjectAttributeValue{}, func(v AssetObjectAttributeValue) error {
+			if s := v.String(); s != "" {
+				return fmt.Errorf("String() = %q", s)
+			}
+			if _, err := v.Int(); err == nil {
+				return fmt.Errorf("Int() of empty value did not fail")
+			}
+			return nil
+		}},
+	}
+
+	for _, test := range tests {
+		if err := test.check(test.value); err != nil {
+			t.Errorf("%v: %s", test.value.Value, err)
+		}
+	}
+}
+
+func TestAssetsService_GetWorkspaces(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/assets/workspace"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"workspaceId":"g2778e1d-939d-581d-c8e2-9d5g59de456b"}]}`)
+	})
+
+	workspaces, _, err := testClient.Assets.GetWorkspaces(context.Background(), nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if workspaces == nil || len(workspaces.Values) != 1 || workspaces.Values[0].WorkspaceID != "g2778e1d-939d-581d-c8e2-9d5g59de456b" {
+		t.Errorf("Unexpected workspaces: %+v", workspaces)
+	}
+}
+
+func TestAssetsService_SearchObjects(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/aql"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"startAt": "25", "maxResults": "25", "includeAttributes": "false"})
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		if payload["qlQuery"] != `objectType = "Office"` {
+			t.Errorf("Unexpected query: %v", payload["qlQuery"])
+		}
+
+		fmt.Fprint(w, `{"startAt":25,"maxResults":25,"total":26,"isLast":true,"values":[{"id":"88","label":"SYD-1","objectKey":"ITSM-88"}],"objectTypeAttributes":[{"id":"134","name":"Name","label":true,"type":0,"defaultType":{"id":0,"name":"Text"}}]}`)
+	})
+
+	objects, _, err := testClient.Assets.SearchObjects(context.Background(), "ws-1", `objectType = "Office"`, &AssetAQLOptions{StartAt: 25, MaxResults: 25, IncludeAttributes: Bool(false)})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if objects == nil || objects.Total != 26 || !objects.IsLast || len(objects.Values) != 1 {
+		t.Fatalf("Unexpected objects: %+v", objects)
+	}
+	if len(objects.ObjectTypeAttributes) != 1 || objects.ObjectTypeAttributes[0].DefaultType.Name != "Text" {
+		t.Errorf("Unexpected object type attributes: %+v", objects.ObjectTypeAttributes)
+	}
+}
+
+func TestAssetsService_SearchObjects_Retry(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	calls := 0
+	testMux.HandleFunc("/gateway/api/jsm/assets/workspace/ws-1/v1/object/aql", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":25,"total":0,"isLast":true,"values":[]}`)
+	})
+
+	if _, _, err := testClient.Assets.SearchObjects(context.Background(), "ws-1", `objectType = "Office"`, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected the search to be retried, got %d calls", calls)
+	}
+}
+func TestAssetsService_SearchObjectsNavlist(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/object/navlist/aql"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		want := map[string]interface{}{
+			"objectTypeId":   "23",
+			"qlQuery":        `Name startsWith "SYD"`,
+			"page":           float64(1),
+			"resultsPerPage": float64(25),
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Unexpected payload %v, want %v", payload, want)
+		}
+
+		fmt.Fprint(w, `{"objectEntries":[{"id":"88","label":"SYD-1","objectKey":"ITSM-88"}],"objectTypeId":"23","totalFilterCount":1,"startIndex":0,"toIndex":1,"pageObjectSize":1,"pageNumber":1,"pageSize":1}`)
+	})
+
+	result, _, err := testClient.Assets.SearchObjectsNavlist(context.Background(), "ws-1", &AssetNavlistQuery{
+		ObjectTypeID:   "23",
+		QLQuery:        `Name startsWith "SYD"`,
+		Page:           1,
+		ResultsPerPage: 25,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil || result.TotalFilterCount != 1 || len(result.ObjectEntries) != 1 || result.ObjectEntries[0].ObjectKey != "ITSM-88" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+func TestAssetsService_GetObjectSchemas(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/objectschema/list"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"maxResults": "10"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":10,"total":1,"isLast":true,"values":[{"id":"6","name":"ITSM","objectSchemaKey":"ITSM","status":"Ok","objectCount":95,"objectTypeCount":34}]}`)
+	})
+
+	schemas, _, err := testClient.Assets.GetObjectSchemas(context.Background(), "ws-1", &AssetObjectSchemaListOptions{MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if schemas == nil || len(schemas.Values) != 1 || schemas.Values[0].ObjectSchemaKey != "ITSM" || schemas.Values[0].ObjectTypeCount != 34 {
+		t.Errorf("Unexpected schemas: %+v", schemas)
+	}
+}
+
+func TestAssetsService_GetObjectSchema(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/objectschema/6"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"6","name":"ITSM","objectSchemaKey":"ITSM"}`)
+	})
+
+	schema, _, err := testClient.Assets.GetObjectSchema(context.Background(), "ws-1", "6")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if schema == nil || schema.Name != "ITSM" {
+		t.Errorf("Unexpected schema: %+v", schema)
+	}
+}
+
+func TestAssetsService_GetObjectTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/objectschema/6/objecttypes/flat"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"22","name":"Locations","objectSchemaId":"6","abstractObjectType":true},{"id":"23","name":"Office","parentObjectTypeId":"22","objectSchemaId":"6","icon":{"id":"13","name":"Office","url16":"https://your-domain.atlassian.net/icon16.png"}}]`)
+	})
+
+	objectTypes, _, err := testClient.Assets.GetObjectTypes(context.Background(), "ws-1", "6")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(objectTypes) != 2 || objectTypes[1].ParentObjectTypeID != "22" || objectTypes[1].Icon == nil {
+		t.Errorf("Unexpected object types: %+v", objectTypes)
+	}
+}
+
+func TestAssetsService_GetObjectType(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/objecttype/23"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"23","name":"Office","objectSchemaId":"6","objectCount":5}`)
+	})
+
+	objectType, _, err := testClient.Assets.GetObjectType(context.Background(), "ws-1", "23")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if objectType == nil || objectType.ObjectCount != 5 {
+		t.Errorf("Unexpected object type: %+v", objectType)
+	}
+}
+
+func TestAssetsService_GetObjectTypeAttributes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/gateway/api/jsm/assets/workspace/ws-1/v1/objecttype/23/attributes"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"onlyValueEditable": "true"})
+		fmt.Fprint(w, `[{"id":"134","name":"Name","label":true,"type":0,"defaultType":{"id":0,"name":"Text"},"editable":true,"minimumCardinality":1,"maximumCardinality":1},{"id":"135","name":"City","type":1,"referenceObjectTypeId":"12","referenceType":{"id":"1","name":"Location"},"editable":true,"maximumCardinality":-1}]`)
+	})
+
+	attributes, _, err := testClient.Assets.GetObjectTypeAttributes(context.Background(), "ws-1", "23", &AssetObjectTypeAttributeOptions{OnlyValueEditable: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(attributes) != 2 {
+		t.Fatalf("Unexpected attributes: %+v", attributes)
+	}
+	if !attributes[0].Label || attributes[0].MinimumCardinality != 1 {
+		t.Errorf("Unexpected attribute: %+v", attributes[0])
+	}
+	if attributes[1].ReferenceType == nil || attributes[1].ReferenceType.Name != "Location" || attributes[1].MaximumCardinality != -1 {
+		t.Errorf("Unexpected reference attribute: %+v", attributes[1])
+	}
+}
@@ -0,0 +1,205 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// defaultRedactedPayloadFields are the fields of JSON payloads that are masked in audit records.
+var defaultRedactedPayloadFields = []string{"password", "token", "secret", "sharedSecret", "apiToken", "currentPassword"}
+
+// AuditRecord describes a mutation sent to Jira, e.g. an issue created or transitioned by an automation.
+type AuditRecord struct {
+	// Time is the time the request was sent.
+	Time time.Time
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint template of the request, see EndpointTemplate.
+	Endpoint string
+	// URL is the URL of the request with the credentials masked by the Redactor of the Auditor.
+	URL string
+	// Actor is who caused the mutation, see ContextWithAuditActor.
+	Actor string
+	// StatusCode is the status code of the response, 0 if no response was received.
+	StatusCode int
+	// Err is the error of the HTTP client if no response was received.
+	Err error
+	// PayloadDigest is the hex encoded SHA-256 digest of the sanitized payload, empty without payload.
+	// JSON payloads are digested in a canonical form, so equal payloads have equal digests.
+	PayloadDigest string
+	// Payload is the sanitized payload if Auditor.IncludePayload is set.
+	Payload []byte
+}
+
+// AuditSink stores audit records, e.g. in a compliance log. It must be safe for concurrent use.
+type AuditSink interface {
+	RecordMutation(ctx context.Context, record AuditRecord)
+}
+
+// AuditSinkFunc is an adapter to use an ordinary function as AuditSink.
+type AuditSinkFunc func(ctx context.Context, record AuditRecord)
+
+// RecordMutation calls f(ctx, record).
+func (f AuditSinkFunc) RecordMutation(ctx context.Context, record AuditRecord) {
+	f(ctx, record)
+}
+
+type auditActorKey struct{}
+
+// ContextWithAuditActor returns a copy of ctx with the actor of the mutations sent with it,
+// e.g. the user or job on whose behalf an automation changes Jira.
+func ContextWithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// Auditor records every mutation sent with Client.Do, i.e. every request that is not a GET, HEAD or OPTIONS request,
+// to Sink. Secrets in the payloads are masked before they are digested:
+// the JSON fields password, token, secret, sharedSecret, apiToken and currentPassword, the fields of
+// RedactedFields and the secrets of the Redactor.
+//
+// Example:
+//
+//	auditor := &cloud.Auditor{Sink: cloud.AuditSinkFunc(func(ctx context.Context, r cloud.AuditRecord) {
+//		log.Printf("%s %s %s by %s: %d %s", r.Time, r.Method, r.Endpoint, r.Actor, r.StatusCode, r.PayloadDigest)
+//	})}
+//	client.Use(auditor.Middleware())
+type Auditor struct {
+	// Sink receives the records, after the response was received.
+	Sink AuditSink
+	// Redactor masks credentials in the URLs and the secrets in the payloads.
+	Redactor *Redactor
+	// RedactedFields are the names of additional JSON fields masked in the payloads.
+	RedactedFields []string
+	// DefaultActor is the actor of mutations without ContextWithAuditActor.
+	DefaultActor string
+	// IncludePayload adds the sanitized payload to the records.
+	IncludePayload bool
+}
+
+// Middleware returns the middleware recording the mutations, see Client.Use.
+func (a *Auditor) Middleware() Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(req *http.Request) (*http.Response, error) {
+			if !isMutation(req.Method) {
+				return next.Do(req)
+			}
+			record := AuditRecord{
+				Time:     time.Now(),
+				Method:   req.Method,
+				Endpoint: EndpointTemplate(req.URL),
+				URL:      a.Redactor.URL(req.URL),
+				Actor:    a.DefaultActor,
+			}
+			if actor, ok := req.Context().Value(auditActorKey{}).(string); ok {
+				record.Actor = actor
+			}
+			payload, err := readPayload(req)
+			if err != nil {
+				return nil, err
+			}
+			if len(payload) > 0 {
+				sanitized := a.sanitize(payload)
+				digest := sha256.Sum256(sanitized)
+				record.PayloadDigest = hex.EncodeToString(digest[:])
+				if a.IncludePayload {
+					record.Payload = sanitized
+				}
+			}
+
+			resp, err := next.Do(req)
+			if resp != nil {
+				record.StatusCode = resp.StatusCode
+			}
+			record.Err = err
+			if a.Sink != nil {
+				a.Sink.RecordMutation(req.Context(), record)
+			}
+			return resp, err
+		})
+	}
+}
+
+// sanitize masks the secrets of payload. JSON payloads are returned in canonical form.
+func (a *Auditor) sanitize(payload []byte) []byte {
+	var v interface{}
+	if err := json.Unmarshal(payload, &v); err == nil {
+		v = a.sanitizeValue(v)
+		if canonical, err := json.Marshal(v); err == nil {
+			payload = canonical
+		}
+	}
+	return []byte(a.Redactor.String(string(payload)))
+}
+
+func (a *Auditor) sanitizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if a.isRedactedField(key) {
+				v[key] = redacted
+			} else {
+				v[key] = a.sanitizeValue(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = a.sanitizeValue(value)
+		}
+	}
+	return v
+}
+
+func (a *Auditor) isRedactedField(name string) bool {
+	for _, field := range defaultRedactedPayloadFields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	for _, field := range a.RedactedFields {
+		if strings.EqualFold(field, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func isMutation(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
+
+// readPayload returns the body of req, keeping it readable for sending.
+func readPayload(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+	payload, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(payload))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(payload)), nil
+	}
+	return payload, nil
+}
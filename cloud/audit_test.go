@@ -0,0 +1,78 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAuditor(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["fields"] == nil {
+				t.Errorf("Expected the payload to be sent unchanged, got %v, %v", body, err)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var records []AuditRecord
+	auditor := &Auditor{
+		Sink:           AuditSinkFunc(func(ctx context.Context, r AuditRecord) { records = append(records, r) }),
+		Redactor:       &Redactor{Secrets: []string{"hunter2"}},
+		RedactedFields: []string{"webhookSecret"},
+		DefaultActor:   "automation",
+		IncludePayload: true,
+	}
+	testClient.Use(auditor.Middleware())
+
+	ctx := ContextWithAuditActor(context.Background(), "alice")
+	payload := map[string]interface{}{
+		"fields": map[string]interface{}{"summary": "Contains hunter2", "labels": []string{"a"}},
+		"properties": []interface{}{
+			map[string]interface{}{"key": "config", "value": map[string]string{"password": "p", "webhookSecret": "s"}},
+		},
+	}
+	req, _ := testClient.NewRequest(ctx, http.MethodPut, "rest/api/2/issue/EX-1", payload)
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	req, _ = testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1", nil)
+	_, _ = testClient.Do(req, nil)
+	req, _ = testClient.NewRequest(context.Background(), http.MethodDelete, "rest/api/2/issue/EX-1", nil)
+	_, _ = testClient.Do(req, nil)
+
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 mutations, got %+v", records)
+	}
+	update := records[0]
+	if update.Method != http.MethodPut || update.Endpoint != "/rest/api/2/issue/{key}" || update.Actor != "alice" || update.StatusCode != http.StatusNoContent {
+		t.Errorf("Unexpected record %+v", update)
+	}
+	if len(update.PayloadDigest) != 64 {
+		t.Errorf("Expected a SHA-256 digest, got %q", update.PayloadDigest)
+	}
+	for _, secret := range []string{"hunter2", `"p"`, `"s"`} {
+		if strings.Contains(string(update.Payload), secret) {
+			t.Errorf("Expected %s to be masked in %s", secret, update.Payload)
+		}
+	}
+	if deletion := records[1]; deletion.Actor != "automation" || deletion.PayloadDigest != "" {
+		t.Errorf("Unexpected record %+v", deletion)
+	}
+}
+
+func TestAuditor_CanonicalDigest(t *testing.T) {
+	a := &Auditor{}
+	first := a.sanitize([]byte(`{"b":1,"a":{"password":"x","c":2}}`))
+	second := a.sanitize([]byte(`{"a": {"c": 2, "password": "y"}, "b": 1}`))
+
+	if string(first) != string(second) {
+		t.Errorf("Expected equal sanitized payloads, got %s and %s", first, second)
+	}
+}
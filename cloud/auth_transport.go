@@ -1,6 +1,10 @@
 package cloud
 
-import "net/http"
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
 
 // cloneRequest returns a clone of the provided *http.Request.
 // The clone is a shallow copy of the struct and its Header map.
@@ -15,3 +19,39 @@ func cloneRequest(r *http.Request) *http.Request {
 	}
 	return r2
 }
+
+// TokenProvider provides the credentials used by the auth transports,
+// e.g. an API token, password or shared secret.
+// Token is called for every request, so credentials can be rotated,
+// e.g. when read from a secret store, without creating a new client.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc is a function that implements TokenProvider.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token implements TokenProvider by calling f.
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// StaticToken is a TokenProvider that always returns the same token.
+type StaticToken string
+
+// Token implements TokenProvider.
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// providedToken returns the token of provider, or fallback if provider is nil.
+func providedToken(ctx context.Context, provider TokenProvider, fallback string) (string, error) {
+	if provider == nil {
+		return fallback, nil
+	}
+	token, err := provider.Token(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error getting token: %w", err)
+	}
+	return token, nil
+}
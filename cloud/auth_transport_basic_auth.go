@@ -1,6 +1,9 @@
 package cloud
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
 // using HTTP Basic Authentication with the provided username and a Personal API Token.
@@ -11,6 +14,10 @@ type BasicAuthTransport struct {
 	Username string
 	APIToken string
 
+	// APITokenProvider provides the API token for each request.
+	// If it is set, APIToken is ignored.
+	APITokenProvider TokenProvider
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
@@ -19,9 +26,14 @@ type BasicAuthTransport struct {
 // RoundTrip implements the RoundTripper interface.  We just add the
 // basic auth information and return the RoundTripper for this transport type.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	apiToken, err := providedToken(req.Context(), t.APITokenProvider, t.APIToken)
+	if err != nil {
+		return nil, fmt.Errorf("basicAuth: %w", err)
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 
-	req2.SetBasicAuth(t.Username, t.APIToken)
+	req2.SetBasicAuth(t.Username, apiToken)
 	return t.transport().RoundTrip(req2)
 }
 
@@ -2,7 +2,9 @@ package cloud
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -50,3 +52,48 @@ func TestBasicAuthTransport_transport(t *testing.T) {
 		t.Errorf("Expected custom transport to be used.")
 	}
 }
+
+func TestBasicAuthTransport_APITokenProvider(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tokens := []string{"first", "rotated"}
+	calls := 0
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		_, p, _ := r.BasicAuth()
+		if p != tokens[calls] {
+			t.Errorf("request contained basic auth password %q, want %q", p, tokens[calls])
+		}
+		calls++
+	})
+
+	current := 0
+	tp := &BasicAuthTransport{
+		Username: "username",
+		APIToken: "ignored",
+		APITokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return tokens[current], nil
+		}),
+	}
+
+	basicAuthClient, _ := NewClient(testServer.URL, tp.Client())
+	for current = range tokens {
+		req, _ := basicAuthClient.NewRequest(context.Background(), http.MethodGet, ".", nil)
+		basicAuthClient.Do(req, nil)
+	}
+	if calls != len(tokens) {
+		t.Errorf("Expected %d requests, got %d", len(tokens), calls)
+	}
+}
+
+func TestBasicAuthTransport_APITokenProviderError(t *testing.T) {
+	tp := &BasicAuthTransport{
+		APITokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "", errors.New("vault unavailable")
+		}),
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.atlassian.net", nil)
+	if _, err := tp.RoundTrip(req); err == nil || !strings.Contains(err.Error(), "vault unavailable") {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
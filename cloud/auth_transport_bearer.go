@@ -0,0 +1,50 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// BearerTokenAuthTransport is an http.RoundTripper that authenticates all requests
+// with a bearer token, e.g. for the APIs of apps like Tempo and Xray built on this client.
+// Jira Cloud itself doesn't accept bearer tokens, except for OAuth 2.0 and Forge, see
+// OAuth2Transport and ForgeAuthTransport.
+type BearerTokenAuthTransport struct {
+	// Token provides the token to use for a request.
+	// Use StaticToken for a token that doesn't change.
+	Token TokenProvider
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We just add the
+// bearer token and return the RoundTripper for this transport type.
+func (t *BearerTokenAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Token == nil {
+		return nil, errors.New("bearerTokenAuth: no token provider configured")
+	}
+	token, err := t.Token.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("bearerTokenAuth: error getting token: %w", err)
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// with the bearer token.
+func (t *BearerTokenAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *BearerTokenAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
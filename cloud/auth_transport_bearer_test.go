@@ -0,0 +1,35 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestBearerTokenAuthTransport_HeaderContainsToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer app-token" {
+			t.Errorf("Unexpected Authorization header %q", got)
+		}
+	})
+
+	tp := &BearerTokenAuthTransport{Token: StaticToken("app-token")}
+	client, _ := NewClient(testServer.URL, tp.Client())
+	client.User.GetCurrentUser(context.Background())
+}
+
+func TestBearerTokenAuthTransport_TokenError(t *testing.T) {
+	errNoToken := errors.New("no token")
+	tp := &BearerTokenAuthTransport{Token: TokenProviderFunc(func(context.Context) (string, error) {
+		return "", errNoToken
+	})}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.atlassian.net", nil)
+	if _, err := tp.RoundTrip(req); !errors.Is(err, errNoToken) {
+		t.Errorf("Expected the error of the token provider, got %v", err)
+	}
+}
@@ -0,0 +1,218 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	connectAuthorizationServer = "https://oauth-2-authorization-server.services.atlassian.com"
+	connectGrantType           = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// ConnectImpersonation issues access tokens for Connect apps to act as a user,
+// using the OAuth 2.0 JWT bearer token authorization grant.
+// The tokens are cached per account ID until they expire.
+//
+// The app descriptor needs the ACT_AS_USER scope.
+//
+//	imp := &cloud.ConnectImpersonation{Secret: sharedSecret, OAuthClientID: oauthClientID, BaseURL: baseURL}
+//	client, _ := cloud.NewClient(baseURL, imp.Client(accountID))
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/user-impersonation-for-connect-apps/
+type ConnectImpersonation struct {
+	// Secret is the shared secret received in the installed lifecycle callback.
+	Secret []byte
+	// OAuthClientID is the oauthClientId received in the installed lifecycle callback.
+	OAuthClientID string
+	// BaseURL is the baseUrl of the instance received in the installed lifecycle callback.
+	BaseURL string
+	// Scopes are the scopes of the tokens, e.g. READ and WRITE.
+	// They must not exceed the scopes of the app descriptor.
+	Scopes []string
+
+	// TokenURL defaults to the Atlassian authorization server if empty.
+	TokenURL string
+
+	// HTTPClient is used for the token requests.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+
+	mu       sync.Mutex
+	tokens   map[string]*OAuth2Token
+	requests map[string]*connectTokenRequest
+}
+
+// connectTokenRequest is a token request in flight and, once done is closed, its result.
+type connectTokenRequest struct {
+	done  chan struct{}
+	token *OAuth2Token
+	err   error
+}
+
+// Token returns an access token to act as the user with accountID.
+// Concurrent calls for the same user share one token request,
+// the token requests of different users don't wait for each other.
+func (c *ConnectImpersonation) Token(ctx context.Context, accountID string) (*OAuth2Token, error) {
+	for {
+		c.mu.Lock()
+		if token := c.tokens[accountID]; token.Valid() {
+			c.mu.Unlock()
+			return token, nil
+		}
+		if req, ok := c.requests[accountID]; ok {
+			c.mu.Unlock()
+			select {
+			case <-req.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			// The request in flight was canceled by its caller, not by this one.
+			if errors.Is(req.err, context.Canceled) || errors.Is(req.err, context.DeadlineExceeded) {
+				continue
+			}
+			return req.token, req.err
+		}
+		req := &connectTokenRequest{done: make(chan struct{})}
+		if c.requests == nil {
+			c.requests = make(map[string]*connectTokenRequest)
+		}
+		c.requests[accountID] = req
+		c.mu.Unlock()
+
+		req.token, req.err = c.requestToken(ctx, accountID)
+
+		c.mu.Lock()
+		delete(c.requests, accountID)
+		if req.err == nil {
+			c.storeToken(accountID, req.token)
+		}
+		c.mu.Unlock()
+		close(req.done)
+
+		return req.token, req.err
+	}
+}
+
+// storeToken caches the token of the user and drops the expired tokens of other users.
+// c.mu must be held.
+func (c *ConnectImpersonation) storeToken(accountID string, token *OAuth2Token) {
+	if c.tokens == nil {
+		c.tokens = make(map[string]*OAuth2Token)
+	}
+	for id, t := range c.tokens {
+		if !t.Valid() {
+			delete(c.tokens, id)
+		}
+	}
+	c.tokens[accountID] = token
+}
+
+// Client returns an *http.Client that makes requests as the user with accountID.
+func (c *ConnectImpersonation) Client(accountID string) *http.Client {
+	return &http.Client{Transport: &ConnectUserTransport{Impersonation: c, AccountID: accountID}}
+}
+
+func (c *ConnectImpersonation) requestToken(ctx context.Context, accountID string) (*OAuth2Token, error) {
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": "urn:atlassian:connect:clientid:" + c.OAuthClientID,
+		"sub": "urn:atlassian:connect:useraccountid:" + accountID,
+		"tnt": c.BaseURL,
+		"aud": connectAuthorizationServer,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Minute).Unix(),
+	}).SignedString(c.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("connectAuth: error signing assertion: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {connectGrantType},
+		"assertion":  {assertion},
+	}
+	if len(c.Scopes) > 0 {
+		form.Set("scope", strings.ToUpper(strings.Join(c.Scopes, " ")))
+	}
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = connectAuthorizationServer + "/oauth2/token"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("connectAuth: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("connectAuth: token request failed with status %d: %s", resp.StatusCode, data)
+	}
+
+	token := new(OAuth2Token)
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, fmt.Errorf("connectAuth: error decoding token: %w", err)
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// ConnectUserTransport is an http.RoundTripper that authenticates all requests
+// as a user with an access token of a ConnectImpersonation.
+type ConnectUserTransport struct {
+	Impersonation *ConnectImpersonation
+	AccountID     string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We just add the
+// access token of the user and return the RoundTripper for this transport type.
+func (t *ConnectUserTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.Impersonation.Token(req.Context(), t.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests as the user.
+func (t *ConnectUserTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *ConnectUserTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,155 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+func TestConnectImpersonation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sharedSecret := []byte("ssshh,it's a secret")
+	tokenRequests := map[string]int{}
+
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Error parsing form: %s", err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+			t.Errorf("Unexpected grant_type %q", got)
+		}
+		if got := r.PostForm.Get("scope"); got != "READ WRITE" {
+			t.Errorf("Unexpected scope %q", got)
+		}
+
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(r.PostForm.Get("assertion"), claims, func(token *jwt.Token) (interface{}, error) {
+			return sharedSecret, nil
+		})
+		if err != nil {
+			t.Fatalf("Error parsing assertion: %s", err)
+		}
+		if claims["iss"] != "urn:atlassian:connect:clientid:client-id" || claims["tnt"] != "https://your-domain.atlassian.net" || claims["aud"] != "https://oauth-2-authorization-server.services.atlassian.com" {
+			t.Errorf("Unexpected claims %v", claims)
+		}
+		sub := claims["sub"].(string)
+		tokenRequests[sub]++
+		fmt.Fprintf(w, `{"access_token":%q,"expires_in":900,"token_type":"Bearer"}`, "token-for-"+sub[len("urn:atlassian:connect:useraccountid:"):])
+	})
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"accountId":%q}`, r.Header.Get("Authorization"))
+	})
+
+	imp := &ConnectImpersonation{
+		Secret:        sharedSecret,
+		OAuthClientID: "client-id",
+		BaseURL:       "https://your-domain.atlassian.net",
+		Scopes:        []string{"read", "write"},
+		TokenURL:      testServer.URL + "/oauth2/token",
+	}
+
+	for _, accountID := range []string{"alice", "bob", "alice"} {
+		client, _ := NewClient(testServer.URL, imp.Client(accountID))
+		u, _, err := client.User.GetCurrentUser(context.Background())
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if want := "Bearer token-for-" + accountID; u.AccountID != want {
+			t.Errorf("Unexpected Authorization header %q, want %q", u.AccountID, want)
+		}
+	}
+
+	if tokenRequests["urn:atlassian:connect:useraccountid:alice"] != 1 || tokenRequests["urn:atlassian:connect:useraccountid:bob"] != 1 {
+		t.Errorf("Expected one token request per user, got %v", tokenRequests)
+	}
+}
+
+func TestConnectImpersonation_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid_grant"}`)
+	})
+
+	imp := &ConnectImpersonation{Secret: []byte("secret"), TokenURL: testServer.URL + "/oauth2/token"}
+	if _, err := imp.Token(context.Background(), "alice"); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestConnectImpersonation_Concurrent(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	tokenRequests := map[string]int{}
+	releaseAlice := make(chan struct{})
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		claims := jwt.MapClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(r.FormValue("assertion"), claims); err != nil {
+			t.Errorf("Error parsing assertion: %s", err)
+			return
+		}
+		accountID := strings.TrimPrefix(claims["sub"].(string), "urn:atlassian:connect:useraccountid:")
+		mu.Lock()
+		tokenRequests[accountID]++
+		mu.Unlock()
+		if accountID == "alice" {
+			<-releaseAlice
+		}
+		fmt.Fprintf(w, `{"access_token":%q,"expires_in":900}`, "token-for-"+accountID)
+	})
+
+	imp := &ConnectImpersonation{Secret: []byte("secret"), TokenURL: testServer.URL + "/oauth2/token"}
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := imp.Token(context.Background(), "alice")
+			if err != nil || token.AccessToken != "token-for-alice" {
+				t.Errorf("Unexpected token %+v, error %v", token, err)
+			}
+		}()
+	}
+
+	// Bob gets a token while the token request of alice is in flight.
+	token, err := imp.Token(context.Background(), "bob")
+	if err != nil || token.AccessToken != "token-for-bob" {
+		t.Errorf("Unexpected token %+v, error %v", token, err)
+	}
+	close(releaseAlice)
+	wg.Wait()
+
+	if tokenRequests["alice"] != 1 || tokenRequests["bob"] != 1 {
+		t.Errorf("Expected one token request per user, got %v", tokenRequests)
+	}
+}
+
+func TestConnectImpersonation_DropsExpiredTokens(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token":"token","expires_in":900}`)
+	})
+
+	imp := &ConnectImpersonation{Secret: []byte("secret"), TokenURL: testServer.URL + "/oauth2/token"}
+	imp.tokens = map[string]*OAuth2Token{"gone": {AccessToken: "expired", Expiry: time.Now().Add(-time.Minute)}}
+	if _, err := imp.Token(context.Background(), "alice"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, ok := imp.tokens["gone"]; ok || len(imp.tokens) != 1 {
+		t.Errorf("Expected only the token of alice, got %v", imp.tokens)
+	}
+}
@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ForgeAppTokenHeader is the header of requests to Forge remote backends
+	// holding the token for calling Jira as the app.
+	ForgeAppTokenHeader = "x-forge-oauth-system"
+	// ForgeUserTokenHeader is the header of requests to Forge remote backends
+	// holding the token for calling Jira as the user who invoked the app.
+	ForgeUserTokenHeader = "x-forge-oauth-user"
+)
+
+// ForgeAuthTransport is an http.RoundTripper that authenticates all requests
+// with a token issued by Forge to a remote backend.
+//
+// The tokens are only valid for api.atlassian.com, so the client has to use the
+// apiBaseUrl of the Forge invocation context, e.g. https://api.atlassian.com/ex/jira/<cloud-id>,
+// as base URL. See NewForgeClients for creating clients from an incoming request.
+//
+// Jira docs: https://developer.atlassian.com/platform/forge/remote/essentials/
+type ForgeAuthTransport struct {
+	// Token provides the token to use for a request.
+	// Use StaticToken for the token of a single invocation.
+	Token TokenProvider
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We just add the
+// Forge token and return the RoundTripper for this transport type.
+func (t *ForgeAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Token == nil {
+		return nil, errors.New("forgeAuth: no token provider configured")
+	}
+	token, err := t.Token.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("forgeAuth: error getting token: %w", err)
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// with the Forge token.
+func (t *ForgeAuthTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *ForgeAuthTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// NewForgeClients returns the clients for calling Jira as app and as user
+// with the tokens of an incoming request of a Forge remote backend.
+// apiBaseURL is the app.apiBaseUrl claim of the Forge invocation token, which has to be validated by the caller.
+//
+// asUser is nil if the request holds no user token, e.g. for scheduled triggers
+// or if the remote is not configured to receive user tokens.
+func NewForgeClients(r *http.Request, apiBaseURL string) (asApp, asUser *Client, err error) {
+	appToken := r.Header.Get(ForgeAppTokenHeader)
+	if appToken == "" {
+		return nil, nil, fmt.Errorf("forgeAuth: request has no %s header", ForgeAppTokenHeader)
+	}
+	asApp, err = NewClient(apiBaseURL, (&ForgeAuthTransport{Token: StaticToken(appToken)}).Client())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if userToken := r.Header.Get(ForgeUserTokenHeader); userToken != "" {
+		asUser, err = NewClient(apiBaseURL, (&ForgeAuthTransport{Token: StaticToken(userToken)}).Client())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return asApp, asUser, nil
+}
@@ -0,0 +1,75 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewForgeClients(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/ex/jira/cloud-id/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"accountId":%q}`, r.Header.Get("Authorization"))
+	})
+
+	incoming := httptest.NewRequest(http.MethodPost, "/remote", nil)
+	incoming.Header.Set(ForgeAppTokenHeader, "app-token")
+	incoming.Header.Set(ForgeUserTokenHeader, "user-token")
+
+	asApp, asUser, err := NewForgeClients(incoming, testServer.URL+"/ex/jira/cloud-id")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if asUser == nil {
+		t.Fatal("Expected a client for the user")
+	}
+
+	for client, want := range map[*Client]string{asApp: "Bearer app-token", asUser: "Bearer user-token"} {
+		u, _, err := client.User.GetCurrentUser(context.Background())
+		if err != nil {
+			t.Errorf("Error given: %s", err)
+			continue
+		}
+		if u.AccountID != want {
+			t.Errorf("Unexpected Authorization header %q, want %q", u.AccountID, want)
+		}
+	}
+}
+
+func TestNewForgeClients_WithoutUserToken(t *testing.T) {
+	incoming := httptest.NewRequest(http.MethodPost, "/remote", nil)
+	incoming.Header.Set(ForgeAppTokenHeader, "app-token")
+
+	asApp, asUser, err := NewForgeClients(incoming, "https://api.atlassian.com/ex/jira/cloud-id")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if asApp == nil || asUser != nil {
+		t.Errorf("Expected only a client for the app, got %v and %v", asApp, asUser)
+	}
+	if got := asApp.BaseURL.String(); got != "https://api.atlassian.com/ex/jira/cloud-id/" {
+		t.Errorf("Unexpected base URL %q", got)
+	}
+}
+
+func TestNewForgeClients_WithoutAppToken(t *testing.T) {
+	incoming := httptest.NewRequest(http.MethodPost, "/remote", nil)
+	if _, _, err := NewForgeClients(incoming, "https://api.atlassian.com/ex/jira/cloud-id"); err == nil {
+		t.Error("Expected an error")
+	}
+}
+
+func TestForgeAuthTransport_TokenError(t *testing.T) {
+	tp := &ForgeAuthTransport{Token: TokenProviderFunc(func(ctx context.Context) (string, error) {
+		return "", errors.New("no token")
+	})}
+	req, _ := http.NewRequest(http.MethodGet, "https://api.atlassian.com/ex/jira/cloud-id/rest/api/3/myself", nil)
+	if _, err := tp.RoundTrip(req); err == nil {
+		t.Error("Expected an error")
+	}
+}
@@ -24,9 +24,22 @@ import (
 //	https://bitbucket.org/atlassian/atlassian-jwt-ruby/src/d44a8e7a4649e4f23edaa784402655fda7c816ea/lib/atlassian/jwt.rb
 //	https://bitbucket.org/atlassian/atlassian-jwt-py/src/master/atlassian_jwt/url_utils.py
 type JWTAuthTransport struct {
+	// Secret is the shared secret received in the installed lifecycle callback.
 	Secret []byte
+	// SecretProvider provides the shared secret for each request.
+	// If it is set, Secret is ignored.
+	SecretProvider TokenProvider
+	// Issuer is the key of the add-on.
 	Issuer string
 
+	// ContextPath is the context path of the Jira base URL, e.g. "/jira".
+	// It is not part of the path used for the query string hash.
+	ContextPath string
+
+	// Expiry is the lifetime of the tokens.
+	// It will default to 59 seconds if zero.
+	Expiry time.Duration
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
@@ -43,19 +56,32 @@ func (t *JWTAuthTransport) transport() http.RoundTripper {
 	return http.DefaultTransport
 }
 
-// RoundTrip adds the session object to the request.
+// RoundTrip adds the JWT to the request.
 func (t *JWTAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	req2 := cloneRequest(req) // per RoundTripper contract
-	exp := time.Duration(59) * time.Second
+	exp := t.Expiry
+	if exp == 0 {
+		exp = time.Duration(59) * time.Second
+	}
+	now := time.Now()
 	qsh := t.createQueryStringHash(req.Method, req2.URL)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		"iss": t.Issuer,
-		"iat": time.Now().Unix(),
-		"exp": time.Now().Add(exp).Unix(),
+		"iat": now.Unix(),
+		"exp": now.Add(exp).Unix(),
 		"qsh": qsh,
 	})
 
-	jwtStr, err := token.SignedString(t.Secret)
+	secret := t.Secret
+	if t.SecretProvider != nil {
+		s, err := t.SecretProvider.Token(req.Context())
+		if err != nil {
+			return nil, fmt.Errorf("jwtAuth: error getting secret: %w", err)
+		}
+		secret = []byte(s)
+	}
+
+	jwtStr, err := token.SignedString(secret)
 	if err != nil {
 		return nil, fmt.Errorf("jwtAuth: error signing JWT: %w", err)
 	}
@@ -64,24 +90,53 @@ func (t *JWTAuthTransport) RoundTrip(req *http.Request) (*http.Response, error)
 	return t.transport().RoundTrip(req2)
 }
 
+// QueryStringHash returns the query string hash, the "qsh" claim, of a request with the method to u.
+// contextPath is stripped from the path of u, like JWTAuthTransport.ContextPath.
+// It is used to verify the JWT of requests Jira sends to Connect apps, e.g. webhooks.
+func QueryStringHash(method string, u *url.URL, contextPath string) string {
+	return (&JWTAuthTransport{ContextPath: contextPath}).createQueryStringHash(method, u)
+}
+
 func (t *JWTAuthTransport) createQueryStringHash(httpMethod string, jiraURL *url.URL) string {
 	canonicalRequest := t.canonicalizeRequest(httpMethod, jiraURL)
 	h := sha256.Sum256([]byte(canonicalRequest))
 	return hex.EncodeToString(h[:])
 }
 
+// canonicalizeRequest builds the canonical request the query string hash is computed from.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/#creating-a-query-string-hash
 func (t *JWTAuthTransport) canonicalizeRequest(httpMethod string, jiraURL *url.URL) string {
-	path := "/" + strings.Replace(strings.Trim(jiraURL.Path, "/"), "&", "%26", -1)
+	path := jiraURL.Path
+	if contextPath := strings.TrimRight(t.ContextPath, "/"); contextPath != "" {
+		path = strings.TrimPrefix(path, contextPath)
+	}
+	path = strings.TrimRight(path, "/")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	path = strings.Replace(path, "&", "%26", -1)
 
 	var canonicalQueryString []string
 	for k, v := range jiraURL.Query() {
 		if k == "jwt" {
 			continue
 		}
-		param := url.QueryEscape(k)
-		value := url.QueryEscape(strings.Join(v, ""))
-		canonicalQueryString = append(canonicalQueryString, strings.Replace(strings.Join([]string{param, value}, "="), "+", "%20", -1))
+		values := make([]string, len(v))
+		for i := range v {
+			values[i] = jwtPercentEncode(v[i])
+		}
+		sort.Strings(values)
+		canonicalQueryString = append(canonicalQueryString, jwtPercentEncode(k)+"="+strings.Join(values, ","))
 	}
 	sort.Strings(canonicalQueryString)
 	return fmt.Sprintf("%s&%s&%s", strings.ToUpper(httpMethod), path, strings.Join(canonicalQueryString, "&"))
 }
+
+// jwtPercentEncode encodes s as required for the query string hash (RFC 3986).
+func jwtPercentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.Replace(s, "+", "%20", -1)
+	s = strings.Replace(s, "*", "%2A", -1)
+	return strings.Replace(s, "%7E", "~", -1)
+}
@@ -2,9 +2,14 @@ package cloud
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
 )
 
 func TestJWTAuthTransport_HeaderContainsJWT(t *testing.T) {
@@ -30,3 +35,74 @@ func TestJWTAuthTransport_HeaderContainsJWT(t *testing.T) {
 	jwtClient, _ := NewClient(testServer.URL, jwtTransport.Client())
 	jwtClient.Issue.Get(context.Background(), "TEST-1", nil)
 }
+
+func TestJWTAuthTransport_canonicalizeRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		url         string
+		contextPath string
+		want        string
+	}{
+		{"no query", "get", "https://example.atlassian.net/rest/api/3/issue/TEST-1", "", "GET&/rest/api/3/issue/TEST-1&"},
+		{"trailing slash", "GET", "https://example.atlassian.net/rest/api/3/project/", "", "GET&/rest/api/3/project&"},
+		{"empty path", "GET", "https://example.atlassian.net", "", "GET&/&"},
+		{"context path", "GET", "https://example.com/jira/rest/api/3/myself", "/jira/", "GET&/rest/api/3/myself&"},
+		{"sorted query", "POST", "https://example.atlassian.net/rest/api/3/search?maxResults=10&jql=project%20%3D%20TEST&expand=names", "", "POST&/rest/api/3/search&expand=names&jql=project%20%3D%20TEST&maxResults=10"},
+		{"repeated parameter", "GET", "https://example.atlassian.net/rest/api/3/user?key=b&key=a", "", "GET&/rest/api/3/user&key=a,b"},
+		{"jwt parameter skipped", "GET", "https://example.atlassian.net/rest/api/3/user?jwt=abc&accountId=1", "", "GET&/rest/api/3/user&accountId=1"},
+		{"reserved characters", "GET", "https://example.atlassian.net/rest/api/3/search?jql=a*b~c+d", "", "GET&/rest/api/3/search&jql=a%2Ab~c%20d"},
+		{"ampersand in path", "GET", "https://example.atlassian.net/rest/api/3/issue/a&b", "", "GET&/rest/api/3/issue/a%26b&"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			u, err := url.Parse(test.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tp := &JWTAuthTransport{ContextPath: test.contextPath}
+			if got := tp.canonicalizeRequest(test.method, u); got != test.want {
+				t.Errorf("canonicalizeRequest() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestJWTAuthTransport_Claims(t *testing.T) {
+	setup()
+	defer teardown()
+
+	sharedSecret := []byte("ssshh,it's a secret")
+	tp := &JWTAuthTransport{
+		Secret: sharedSecret,
+		Issuer: "add-on.key",
+		Expiry: 3 * time.Minute,
+	}
+
+	testMux.HandleFunc("/rest/api/2/issue/TEST-1", func(w http.ResponseWriter, r *http.Request) {
+		tokenString := strings.TrimPrefix(r.Header.Get("Authorization"), "JWT ")
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+			return sharedSecret, nil
+		})
+		if err != nil {
+			t.Fatalf("Error parsing JWT: %s", err)
+		}
+		if claims["iss"] != "add-on.key" {
+			t.Errorf("Unexpected iss claim %v", claims["iss"])
+		}
+		if exp, iat := claims["exp"].(float64), claims["iat"].(float64); exp-iat != 180 {
+			t.Errorf("Unexpected token lifetime %v", exp-iat)
+		}
+		if want := tp.createQueryStringHash(r.Method, r.URL); claims["qsh"] != want {
+			t.Errorf("Unexpected qsh claim %v, want %s", claims["qsh"], want)
+		}
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	if _, _, err := client.Issue.Get(context.Background(), "TEST-1", &GetQueryOptions{Expand: "names"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,309 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	oauth2AuthURL      = "https://auth.atlassian.com/authorize"
+	oauth2TokenURL     = "https://auth.atlassian.com/oauth/token"
+	oauth2ResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+	// OAuth2APIURL is the base URL of the Jira APIs for OAuth 2.0 apps.
+	// The cloud ID of the site is appended to it, see OAuth2BaseURL.
+	OAuth2APIURL = "https://api.atlassian.com/ex/jira/"
+
+	// oauth2ExpiryDelta is subtracted from the expiry of a token,
+	// so it is refreshed before it actually expires.
+	oauth2ExpiryDelta = 10 * time.Second
+)
+
+// OAuth2Config is the configuration of an OAuth 2.0 (3LO) app.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// AuthURL, TokenURL and ResourcesURL default to the Atlassian endpoints if empty.
+	AuthURL      string
+	TokenURL     string
+	ResourcesURL string
+
+	// HTTPClient is used for the token requests.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// OAuth2Token is an access token of an OAuth 2.0 (3LO) app.
+// RefreshToken is only set if the offline_access scope was requested.
+type OAuth2Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+	ExpiresIn    int       `json:"expires_in,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the token is set and not about to expire.
+func (t *OAuth2Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(t.Expiry)
+}
+
+// AccessibleResource is a site the user granted an OAuth 2.0 app access to.
+type AccessibleResource struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	AvatarURL string   `json:"avatarUrl"`
+}
+
+// AuthCodeURL returns the URL the user has to be sent to for granting access to the app.
+// state is returned unchanged to the RedirectURL and should be checked to prevent CSRF.
+func (c *OAuth2Config) AuthCodeURL(state string) string {
+	v := url.Values{
+		"audience":      {"api.atlassian.com"},
+		"client_id":     {c.ClientID},
+		"scope":         {strings.Join(c.Scopes, " ")},
+		"redirect_uri":  {c.RedirectURL},
+		"state":         {state},
+		"response_type": {"code"},
+		"prompt":        {"consent"},
+	}
+	return c.authURL() + "?" + v.Encode()
+}
+
+// Exchange exchanges the authorization code passed to the RedirectURL for an access token.
+func (c *OAuth2Config) Exchange(ctx context.Context, code string) (*OAuth2Token, error) {
+	return c.requestToken(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.RedirectURL,
+	})
+}
+
+// Refresh returns a new access token for the refresh token.
+// Refresh tokens are rotated, so the refresh token of the returned token has to be used next time.
+func (c *OAuth2Config) Refresh(ctx context.Context, refreshToken string) (*OAuth2Token, error) {
+	return c.requestToken(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+}
+
+// AccessibleResources returns the sites the token grants access to.
+func (c *OAuth2Config) AccessibleResources(ctx context.Context, token *OAuth2Token) ([]AccessibleResource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.resourcesURL(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	req.Header.Set("Accept", "application/json")
+
+	var resources []AccessibleResource
+	if err := c.do(req, &resources); err != nil {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// CloudID returns the cloud ID of the site with the given URL, e.g. https://your-domain.atlassian.net.
+// If siteURL is empty, the cloud ID of the only site the token grants access to is returned.
+func (c *OAuth2Config) CloudID(ctx context.Context, token *OAuth2Token, siteURL string) (string, error) {
+	resources, err := c.AccessibleResources(ctx, token)
+	if err != nil {
+		return "", err
+	}
+
+	siteURL = strings.TrimRight(siteURL, "/")
+	if siteURL == "" {
+		if len(resources) != 1 {
+			return "", fmt.Errorf("oauth2: token grants access to %d sites, a site URL is required", len(resources))
+		}
+		return resources[0].ID, nil
+	}
+	for _, r := range resources {
+		if strings.EqualFold(strings.TrimRight(r.URL, "/"), siteURL) {
+			return r.ID, nil
+		}
+	}
+	return "", fmt.Errorf("oauth2: token grants no access to site %s", siteURL)
+}
+
+// OAuth2BaseURL returns the base URL to use with NewClient for OAuth 2.0 apps.
+func OAuth2BaseURL(cloudID string) string {
+	return OAuth2APIURL + cloudID + "/"
+}
+
+func (c *OAuth2Config) requestToken(ctx context.Context, payload map[string]string) (*OAuth2Token, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.tokenURL(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	token := new(OAuth2Token)
+	if err := c.do(req, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("oauth2: server response is missing access_token")
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+func (c *OAuth2Config) do(req *http.Request, v interface{}) error {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("oauth2: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e struct {
+			Error       string `json:"error"`
+			Description string `json:"error_description"`
+		}
+		data, _ := io.ReadAll(resp.Body)
+		if json.Unmarshal(data, &e) == nil && e.Error != "" {
+			return fmt.Errorf("oauth2: %s: %s (status %d)", e.Error, e.Description, resp.StatusCode)
+		}
+		return fmt.Errorf("oauth2: request to %s failed with status %d", req.URL.Redacted(), resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("oauth2: error decoding response: %w", err)
+	}
+	return nil
+}
+
+func (c *OAuth2Config) authURL() string {
+	if c.AuthURL != "" {
+		return c.AuthURL
+	}
+	return oauth2AuthURL
+}
+
+func (c *OAuth2Config) tokenURL() string {
+	if c.TokenURL != "" {
+		return c.TokenURL
+	}
+	return oauth2TokenURL
+}
+
+func (c *OAuth2Config) resourcesURL() string {
+	if c.ResourcesURL != "" {
+		return c.ResourcesURL
+	}
+	return oauth2ResourcesURL
+}
+
+// OAuth2Transport is an http.RoundTripper that authenticates all requests
+// with the access token of an OAuth 2.0 (3LO) app.
+// The token is refreshed automatically before it expires, if it has a refresh token.
+//
+// Use OAuth2BaseURL as base URL of the client, as OAuth 2.0 apps can't call the site URL directly:
+//
+//	tp := &cloud.OAuth2Transport{Config: config, Token: token}
+//	cloudID, _ := config.CloudID(ctx, token, "https://your-domain.atlassian.net")
+//	client, _ := cloud.NewClient(cloud.OAuth2BaseURL(cloudID), tp.Client())
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/oauth-2-3lo-apps/
+type OAuth2Transport struct {
+	Config *OAuth2Config
+	Token  *OAuth2Token
+
+	// OnTokenRefresh is called with the new token after the token was refreshed.
+	// As refresh tokens are rotated, the new token should be persisted.
+	OnTokenRefresh func(token *OAuth2Token)
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu sync.Mutex
+}
+
+// RoundTrip implements the RoundTripper interface.  We add the
+// access token, refreshing it if needed, and return the RoundTripper for this transport type.
+func (t *OAuth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.token(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req2 := cloneRequest(req) // per RoundTripper contract
+	req2.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are authenticated
+// with the access token of an OAuth 2.0 (3LO) app.
+func (t *OAuth2Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth2Transport) token(ctx context.Context) (*OAuth2Token, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Token.Valid() {
+		return t.Token, nil
+	}
+	if t.Token == nil || t.Token.RefreshToken == "" || t.Config == nil {
+		return nil, errors.New("oauth2: token expired and can't be refreshed")
+	}
+
+	token, err := t.Config.Refresh(ctx, t.Token.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = t.Token.RefreshToken
+	}
+	t.Token = token
+	if t.OnTokenRefresh != nil {
+		t.OnTokenRefresh(token)
+	}
+	return token, nil
+}
+
+func (t *OAuth2Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
@@ -0,0 +1,175 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestOAuth2Config_AuthCodeURL(t *testing.T) {
+	c := &OAuth2Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []string{"read:jira-work", "offline_access"},
+	}
+
+	u, err := url.Parse(c.AuthCodeURL("xyz"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := u.Scheme + "://" + u.Host + u.Path; got != oauth2AuthURL {
+		t.Errorf("Unexpected auth URL %s", got)
+	}
+	want := map[string]string{
+		"audience":      "api.atlassian.com",
+		"client_id":     "client-id",
+		"scope":         "read:jira-work offline_access",
+		"redirect_uri":  "https://example.com/callback",
+		"state":         "xyz",
+		"response_type": "code",
+		"prompt":        "consent",
+	}
+	for k, v := range want {
+		if got := u.Query().Get(k); got != v {
+			t.Errorf("Unexpected %s %q, want %q", k, got, v)
+		}
+	}
+}
+
+func TestOAuth2Config_Exchange(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		if payload["grant_type"] != "authorization_code" || payload["code"] != "the-code" || payload["client_secret"] != "secret" {
+			t.Errorf("Unexpected payload %v", payload)
+		}
+		fmt.Fprint(w, `{"access_token":"access","refresh_token":"refresh","expires_in":3600,"scope":"read:jira-work","token_type":"Bearer"}`)
+	})
+
+	c := &OAuth2Config{ClientID: "client-id", ClientSecret: "secret", TokenURL: testServer.URL + "/oauth/token"}
+	token, err := c.Exchange(context.Background(), "the-code")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" || !token.Valid() {
+		t.Errorf("Unexpected token %+v", token)
+	}
+	if d := time.Until(token.Expiry); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("Unexpected token expiry %s", token.Expiry)
+	}
+}
+
+func TestOAuth2Config_Exchange_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error":"invalid_grant","error_description":"Invalid authorization code"}`)
+	})
+
+	c := &OAuth2Config{TokenURL: testServer.URL + "/oauth/token"}
+	_, err := c.Exchange(context.Background(), "the-code")
+	if err == nil || err.Error() != "oauth2: invalid_grant: Invalid authorization code (status 403)" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
+
+func TestOAuth2Config_CloudID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/oauth/token/accessible-resources", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.Header.Get("Authorization"); got != "Bearer access" {
+			t.Errorf("Unexpected Authorization header %q", got)
+		}
+		fmt.Fprint(w, `[{"id":"1324a887-45db-1bf4-1e99-ef0ff456d421","url":"https://your-domain.atlassian.net","name":"your-domain","scopes":["read:jira-work"]},{"id":"2b3c","url":"https://other.atlassian.net","name":"other"}]`)
+	})
+
+	c := &OAuth2Config{ResourcesURL: testServer.URL + "/oauth/token/accessible-resources"}
+	token := &OAuth2Token{AccessToken: "access"}
+
+	cloudID, err := c.CloudID(context.Background(), token, "https://your-domain.atlassian.net/")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if cloudID != "1324a887-45db-1bf4-1e99-ef0ff456d421" {
+		t.Errorf("Unexpected cloud ID %q", cloudID)
+	}
+	if got, want := OAuth2BaseURL(cloudID), "https://api.atlassian.com/ex/jira/1324a887-45db-1bf4-1e99-ef0ff456d421/"; got != want {
+		t.Errorf("Unexpected base URL %q, want %q", got, want)
+	}
+
+	if _, err := c.CloudID(context.Background(), token, ""); err == nil {
+		t.Error("Expected an error for several sites without a site URL")
+	}
+	if _, err := c.CloudID(context.Background(), token, "https://unknown.atlassian.net"); err == nil {
+		t.Error("Expected an error for an unknown site")
+	}
+}
+
+func TestOAuth2Transport_RefreshesToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	refreshed := 0
+	testMux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		if payload["grant_type"] != "refresh_token" || payload["refresh_token"] != "refresh-1" {
+			t.Errorf("Unexpected payload %v", payload)
+		}
+		refreshed++
+		fmt.Fprint(w, `{"access_token":"access-2","refresh_token":"refresh-2","expires_in":3600}`)
+	})
+	testMux.HandleFunc("/rest/api/3/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer access-2" {
+			t.Errorf("Unexpected Authorization header %q", got)
+		}
+		fmt.Fprint(w, `{"accountId":"1"}`)
+	})
+
+	var persisted *OAuth2Token
+	tp := &OAuth2Transport{
+		Config:         &OAuth2Config{TokenURL: testServer.URL + "/oauth/token"},
+		Token:          &OAuth2Token{AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(-time.Minute)},
+		OnTokenRefresh: func(token *OAuth2Token) { persisted = token },
+	}
+	client, _ := NewClient(testServer.URL, tp.Client())
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.User.GetCurrentUser(context.Background()); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+	}
+	if refreshed != 1 {
+		t.Errorf("Expected the token to be refreshed once, got %d", refreshed)
+	}
+	if persisted == nil || persisted.RefreshToken != "refresh-2" {
+		t.Errorf("Unexpected refreshed token %+v", persisted)
+	}
+}
+
+func TestOAuth2Transport_ExpiredWithoutRefreshToken(t *testing.T) {
+	tp := &OAuth2Transport{
+		Token: &OAuth2Token{AccessToken: "access", Expiry: time.Now().Add(-time.Minute)},
+	}
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if _, err := tp.RoundTrip(req); err == nil {
+		t.Error("Expected an error for an expired token")
+	}
+}
@@ -0,0 +1,54 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// BacklogService handles the backlog in Jira Agile API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-backlog/
+type BacklogService service
+
+// MoveIssues moves issues to the backlog.
+// This operation is equivalent to removing future and active sprints from a given set of issues.
+// At most 50 issues may be moved at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-backlog/#api-rest-agile-1-0-backlog-issue-post
+// Caller must close resp.Body
+func (s *BacklogService) MoveIssues(ctx context.Context, issueIDs []string) (*Response, error) {
+	apiEndpoint := "rest/agile/1.0/backlog/issue"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, &IssuesWrapper{Issues: issueIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// MoveIssuesForBoard moves issues to the backlog of a particular board (if they are already on that board).
+// The moved issues can be ranked before or after another issue.
+// At most 50 issues may be moved at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-backlog/#api-rest-agile-1-0-backlog-boardid-issue-post
+// Caller must close resp.Body
+func (s *BacklogService) MoveIssuesForBoard(ctx context.Context, boardID int64, issues *IssuesWrapper) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/backlog/%d/issue", boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issues)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
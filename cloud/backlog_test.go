@@ -0,0 +1,69 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestBacklogService_MoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/backlog/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload.Issues) != 2 || payload.Issues[0] != "PR-1" {
+			t.Errorf("Expected issues [PR-1 PR-2] in payload, got %v", payload.Issues)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Backlog.MoveIssues(context.Background(), []string{"PR-1", "PR-2"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBacklogService_MoveIssuesForBoard(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/backlog/84/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload.RankBeforeIssue != "PR-4" {
+			t.Errorf("Expected rankBeforeIssue PR-4, got %s", payload.RankBeforeIssue)
+		}
+		if payload.RankCustomFieldID != 10521 {
+			t.Errorf("Expected rankCustomFieldId 10521, got %d", payload.RankCustomFieldID)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Backlog.MoveIssuesForBoard(context.Background(), 84, &IssuesWrapper{
+		Issues:            []string{"PR-1"},
+		RankBeforeIssue:   "PR-4",
+		RankCustomFieldID: 10521,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
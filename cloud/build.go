@@ -0,0 +1,212 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// BuildService handles build information of the development panel for the Jira instance / API.
+// The build provider endpoints can only be used by Connect or OAuth 2.0 apps with the build module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-builds/
+type BuildService service
+
+const (
+	BuildStatePending    = "pending"
+	BuildStateInProgress = "in_progress"
+	BuildStateSuccessful = "successful"
+	BuildStateFailed     = "failed"
+	BuildStateCancelled  = "cancelled"
+	BuildStateUnknown    = "unknown"
+)
+
+const (
+	DevInfoAssociationTypeIssueIDOrKeys   = "issueIdOrKeys"
+	DevInfoAssociationTypeServiceIDOrKeys = "serviceIdOrKeys"
+)
+
+// DevInfoAssociation associates development information, like builds, with Jira entities.
+// AssociationType is one of the DevInfoAssociationType* constants.
+type DevInfoAssociation struct {
+	AssociationType string   `json:"associationType" structs:"associationType"`
+	Values          []string `json:"values" structs:"values"`
+}
+
+// DevInfoProviderMetadata describes the product that submits development information.
+type DevInfoProviderMetadata struct {
+	Product string `json:"product,omitempty" structs:"product,omitempty"`
+}
+
+// DevInfoError is an error that caused the rejection of submitted development information.
+type DevInfoError struct {
+	Message      string `json:"message,omitempty" structs:"message,omitempty"`
+	ErrorTraceID string `json:"errorTraceId,omitempty" structs:"errorTraceId,omitempty"`
+}
+
+// Build represents a build of a CI pipeline shown in the development panel of issues.
+type Build struct {
+	SchemaVersion        string               `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	PipelineID           string               `json:"pipelineId" structs:"pipelineId"`
+	BuildNumber          int64                `json:"buildNumber" structs:"buildNumber"`
+	UpdateSequenceNumber int64                `json:"updateSequenceNumber" structs:"updateSequenceNumber"`
+	DisplayName          string               `json:"displayName" structs:"displayName"`
+	Description          string               `json:"description,omitempty" structs:"description,omitempty"`
+	Label                string               `json:"label,omitempty" structs:"label,omitempty"`
+	URL                  string               `json:"url" structs:"url"`
+	State                string               `json:"state" structs:"state"`
+	LastUpdated          *time.Time           `json:"lastUpdated,omitempty" structs:"lastUpdated,omitempty"`
+	IssueKeys            []string             `json:"issueKeys,omitempty" structs:"issueKeys,omitempty"`
+	Associations         []DevInfoAssociation `json:"associations,omitempty" structs:"associations,omitempty"`
+	TestInfo             *BuildTestInfo       `json:"testInfo,omitempty" structs:"testInfo,omitempty"`
+	References           []BuildReference     `json:"references,omitempty" structs:"references,omitempty"`
+}
+
+// BuildTestInfo summarizes the test results of a build.
+type BuildTestInfo struct {
+	TotalNumber   int `json:"totalNumber" structs:"totalNumber"`
+	NumberPassed  int `json:"numberPassed" structs:"numberPassed"`
+	NumberFailed  int `json:"numberFailed" structs:"numberFailed"`
+	NumberSkipped int `json:"numberSkipped,omitempty" structs:"numberSkipped,omitempty"`
+}
+
+// BuildReference links a build to the commit and branch (ref) it was built from.
+type BuildReference struct {
+	Commit *BuildCommit `json:"commit,omitempty" structs:"commit,omitempty"`
+	Ref    *BuildRef    `json:"ref,omitempty" structs:"ref,omitempty"`
+}
+
+// BuildCommit is the commit a build was built from.
+type BuildCommit struct {
+	ID            string `json:"id" structs:"id"`
+	RepositoryURI string `json:"repositoryUri" structs:"repositoryUri"`
+}
+
+// BuildRef is the branch or tag a build was built from.
+type BuildRef struct {
+	Name string `json:"name" structs:"name"`
+	URI  string `json:"uri" structs:"uri"`
+}
+
+// BuildKey identifies a build.
+type BuildKey struct {
+	PipelineID  string `json:"pipelineId" structs:"pipelineId"`
+	BuildNumber int64  `json:"buildNumber" structs:"buildNumber"`
+}
+
+// BuildsSubmission is the payload of BuildService.Submit.
+// Properties can be used to delete builds with BuildService.DeleteByProperties later on.
+type BuildsSubmission struct {
+	Properties       map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	Builds           []Build                  `json:"builds" structs:"builds"`
+	ProviderMetadata *DevInfoProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// BuildsSubmissionResult is the result of BuildService.Submit.
+type BuildsSubmissionResult struct {
+	AcceptedBuilds      []BuildKey           `json:"acceptedBuilds,omitempty" structs:"acceptedBuilds,omitempty"`
+	RejectedBuilds      []RejectedBuild      `json:"rejectedBuilds,omitempty" structs:"rejectedBuilds,omitempty"`
+	UnknownIssueKeys    []string             `json:"unknownIssueKeys,omitempty" structs:"unknownIssueKeys,omitempty"`
+	UnknownAssociations []DevInfoAssociation `json:"unknownAssociations,omitempty" structs:"unknownAssociations,omitempty"`
+}
+
+// RejectedBuild is a submitted build that was rejected, along with the reasons.
+type RejectedBuild struct {
+	Key    BuildKey       `json:"key" structs:"key"`
+	Errors []DevInfoError `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// Submit updates or inserts builds.
+// Builds are identified by the combination of PipelineID and BuildNumber,
+// and are only updated if the UpdateSequenceNumber is higher than the stored one.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-builds/#api-rest-builds-0-1-bulk-post
+func (s *BuildService) Submit(ctx context.Context, builds *BuildsSubmission) (*BuildsSubmissionResult, *Response, error) {
+	apiEndpoint := "rest/builds/0.1/bulk"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, builds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BuildsSubmissionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// Get returns the build identified by pipelineID and buildNumber.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-builds/#api-rest-builds-0-1-pipelines-pipelineid-builds-buildnumber-get
+func (s *BuildService) Get(ctx context.Context, pipelineID string, buildNumber int64) (*Build, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/builds/0.1/pipelines/%s/builds/%d", url.PathEscape(pipelineID), buildNumber)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	build := new(Build)
+	resp, err := s.client.Do(req, build)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return build, resp, nil
+}
+
+// Delete deletes the build identified by pipelineID and buildNumber.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-builds/#api-rest-builds-0-1-pipelines-pipelineid-builds-buildnumber-delete
+// Caller must close resp.Body
+func (s *BuildService) Delete(ctx context.Context, pipelineID string, buildNumber int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/builds/0.1/pipelines/%s/builds/%d", url.PathEscape(pipelineID), buildNumber)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteByProperties deletes all builds that were submitted with all of the given properties.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-builds/#api-rest-builds-0-1-bulkbyproperties-delete
+// Caller must close resp.Body
+func (s *BuildService) DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	return deleteDevInfoByProperties(ctx, s.client, "rest/builds/0.1/bulkByProperties", properties)
+}
+
+// deleteDevInfoByProperties deletes development information of the given endpoint,
+// filtered by the properties it was submitted with.
+func deleteDevInfoByProperties(ctx context.Context, client *Client, apiEndpoint string, properties map[string]string) (*Response, error) {
+	query := url.Values{}
+	for key, value := range properties {
+		query.Set(key, value)
+	}
+	if len(query) > 0 {
+		apiEndpoint += "?" + query.Encode()
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
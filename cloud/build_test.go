@@ -0,0 +1,125 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBuildService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/bulk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload BuildsSubmission
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Properties["accountId"] != "account-234" {
+			t.Errorf("Unexpected properties: %+v", payload.Properties)
+		}
+		if len(payload.Builds) != 1 || payload.Builds[0].State != BuildStateSuccessful || payload.Builds[0].TestInfo.NumberPassed != 10 {
+			t.Errorf("Unexpected builds: %+v", payload.Builds)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedBuilds":[{"pipelineId":"my-pipeline","buildNumber":16}],"rejectedBuilds":[{"key":{"pipelineId":"my-pipeline","buildNumber":17},"errors":[{"message":"Invalid state"}]}],"unknownIssueKeys":["ISSUE-404"]}`)
+	})
+
+	result, _, err := testClient.Build.Submit(context.Background(), &BuildsSubmission{
+		Properties: map[string]string{"accountId": "account-234"},
+		Builds: []Build{{
+			PipelineID:           "my-pipeline",
+			BuildNumber:          16,
+			UpdateSequenceNumber: 1,
+			DisplayName:          "Build #16",
+			URL:                  "https://ci.example.com/my-pipeline/16",
+			State:                BuildStateSuccessful,
+			IssueKeys:            []string{"ISSUE-123"},
+			TestInfo:             &BuildTestInfo{TotalNumber: 10, NumberPassed: 10},
+		}},
+		ProviderMetadata: &DevInfoProviderMetadata{Product: "CI 1.0"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil {
+		t.Fatal("Expected result. Result is nil")
+	}
+	if len(result.AcceptedBuilds) != 1 || result.AcceptedBuilds[0].BuildNumber != 16 {
+		t.Errorf("Unexpected accepted builds: %+v", result.AcceptedBuilds)
+	}
+	if len(result.RejectedBuilds) != 1 || result.RejectedBuilds[0].Errors[0].Message != "Invalid state" {
+		t.Errorf("Unexpected rejected builds: %+v", result.RejectedBuilds)
+	}
+	if len(result.UnknownIssueKeys) != 1 {
+		t.Errorf("Unexpected unknown issue keys: %+v", result.UnknownIssueKeys)
+	}
+}
+
+func TestBuildService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/pipelines/my-pipeline/builds/16"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"schemaVersion":"1.0","pipelineId":"my-pipeline","buildNumber":16,"updateSequenceNumber":1523494301448,"displayName":"Build #16","url":"https://ci.example.com/my-pipeline/16","state":"failed","lastUpdated":"2018-01-20T23:27:25.000Z","issueKeys":["ISSUE-123"],"references":[{"commit":{"id":"08cd9c26b2b8d7cf6e6af6b49da8895d065c259f","repositoryUri":"https://bitbucket.org/atlassian/biij-vendor-api"},"ref":{"name":"feature/ISSUE-123-some-work","uri":"https://bitbucket.org/atlassian/biij-vendor-api/refs/feature/ISSUE-123-some-work"}}]}`)
+	})
+
+	build, _, err := testClient.Build.Get(context.Background(), "my-pipeline", 16)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if build == nil {
+		t.Fatal("Expected build. Build is nil")
+	}
+	if build.State != BuildStateFailed || build.LastUpdated == nil {
+		t.Errorf("Unexpected build: %+v", build)
+	}
+	if len(build.References) != 1 || build.References[0].Commit.ID != "08cd9c26b2b8d7cf6e6af6b49da8895d065c259f" {
+		t.Errorf("Unexpected references: %+v", build.References)
+	}
+}
+
+func TestBuildService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/pipelines/my-pipeline/builds/16"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Build.Delete(context.Background(), "my-pipeline", 16)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBuildService_DeleteByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/builds/0.1/bulkByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234", "repositoryId": "repo-345"})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Build.DeleteByProperties(context.Background(), map[string]string{"accountId": "account-234", "repositoryId": "repo-345"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
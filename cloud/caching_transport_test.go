@@ -0,0 +1,165 @@
+package cloud
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCachingTransport_ETag(t *testing.T) {
+	requests, notModified := 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `[{"id":"summary"}]`)
+	}))
+	defer ts.Close()
+
+	tp := &CachingTransport{}
+	client := tp.Client()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(ts.URL + "/rest/api/2/field")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK || string(body) != `[{"id":"summary"}]` {
+			t.Errorf("Request %d: unexpected response %d %s", i, resp.StatusCode, body)
+		}
+		if fromCache := resp.Header.Get(CachedResponseHeader) == "1"; fromCache != (i > 0) {
+			t.Errorf("Request %d: expected from cache %v, got %v", i, i > 0, fromCache)
+		}
+	}
+
+	if requests != 3 || notModified != 2 {
+		t.Errorf("Expected 3 requests with 2 validated by the server, got %d and %d", requests, notModified)
+	}
+}
+
+func TestCachingTransport_LastModified(t *testing.T) {
+	const lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Last-Modified", lastModified)
+		fmt.Fprint(w, `[]`)
+	}))
+	defer ts.Close()
+
+	client := (&CachingTransport{}).Client()
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(ts.URL + "/rest/api/2/status")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		resp.Body.Close()
+		if i == 1 && resp.Header.Get(CachedResponseHeader) != "1" {
+			t.Errorf("Expected the cached response")
+		}
+	}
+}
+
+func TestCachingTransport_Invalidate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer ts.Close()
+
+	cache := NewMemoryCache(0)
+	client := (&CachingTransport{Cache: cache}).Client()
+
+	resp, _ := client.Get(ts.URL + "/rest/api/2/project/EX")
+	resp.Body.Close()
+	if cache.Len() != 1 {
+		t.Fatalf("Expected 1 cached response, got %d", cache.Len())
+	}
+
+	req, _ := http.NewRequest(http.MethodPut, ts.URL+"/rest/api/2/project/EX", nil)
+	resp, _ = client.Do(req)
+	resp.Body.Close()
+	if cache.Len() != 0 {
+		t.Errorf("Expected the cached response to be invalidated, got %d", cache.Len())
+	}
+}
+
+func TestCachingTransport_CacheKeyCredentials(t *testing.T) {
+	a, _ := http.NewRequest(http.MethodGet, "https://example.com/rest/api/2/field", nil)
+	b, _ := http.NewRequest(http.MethodGet, "https://example.com/rest/api/2/field", nil)
+	a.SetBasicAuth("alice", "secret")
+	b.SetBasicAuth("bob", "secret")
+
+	if cacheKey(a) == cacheKey(b) {
+		t.Error("Expected different cache keys for different credentials")
+	}
+}
+
+func TestCachingTransport_SharedCacheCredentials(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		user, _, _ := r.BasicAuth()
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprintf(w, `{"name":%q}`, user)
+	}))
+	defer ts.Close()
+
+	cache := NewMemoryCache(10)
+	get := func(user string) (string, bool) {
+		tp := &BasicAuthTransport{Username: user, APIToken: "secret", Transport: &CachingTransport{Cache: cache}}
+		resp, err := tp.Client().Get(ts.URL + "/rest/api/2/myself")
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body), resp.Header.Get(CachedResponseHeader) == "1"
+	}
+
+	for i, want := range []struct {
+		user, body string
+		fromCache  bool
+	}{
+		{"alice", `{"name":"alice"}`, false},
+		{"bob", `{"name":"bob"}`, false},
+		{"alice", `{"name":"alice"}`, true},
+		{"bob", `{"name":"bob"}`, true},
+	} {
+		body, fromCache := get(want.user)
+		if body != want.body || fromCache != want.fromCache {
+			t.Errorf("Request %d of %s: expected %s from cache %v, got %s from cache %v", i, want.user, want.body, want.fromCache, body, fromCache)
+		}
+	}
+}
+
+func TestMemoryCache_MaxEntries(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("a"))
+	c.Set("b", []byte("b"))
+	c.Get("a")
+	c.Set("c", []byte("c"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("Expected the least recently used entry to be removed")
+	}
+	if _, ok := c.Get("a"); !ok {
+		t.Error("Expected the recently used entry to be kept")
+	}
+	if c.Len() != 2 {
+		t.Errorf("Expected 2 entries, got %d", c.Len())
+	}
+}
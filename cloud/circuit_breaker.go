@@ -0,0 +1,170 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Client.Do without sending the request while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("jira: circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all requests pass.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects all requests with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests pass to check if Jira recovered.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreaker makes requests fail fast while Jira is down or rate limiting hard.
+//
+// After FailureThreshold consecutive failures the circuit opens and requests are rejected
+// with ErrCircuitOpen. After OpenDuration, HalfOpenProbes requests are let through.
+// If they all succeed, the circuit closes, otherwise it opens again.
+//
+// A CircuitBreaker can be shared between clients, it is safe for concurrent use.
+type CircuitBreaker struct {
+	// FailureThreshold is the number of consecutive failures that open the circuit.
+	// It will default to 5 if 0.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before probe requests are let through.
+	// It will default to 30s if 0.
+	OpenDuration time.Duration
+	// HalfOpenProbes is the number of probe requests that have to succeed to close the circuit.
+	// It will default to 1 if 0.
+	HalfOpenProbes int
+	// IsFailure classifies whether the response or error of a request counts as failure.
+	// If nil, errors of the HTTP client (except canceled requests),
+	// 429 Too Many Requests and 5xx responses are failures.
+	IsFailure func(resp *http.Response, err error) bool
+
+	mu        sync.Mutex
+	state     CircuitState
+	failures  int
+	openedAt  time.Time
+	probes    int
+	successes int
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == CircuitOpen && time.Since(b.openedAt) >= b.openDuration() {
+		return CircuitHalfOpen
+	}
+	return b.state
+}
+
+// allow reports with ErrCircuitOpen if a request has to be rejected.
+func (b *CircuitBreaker) allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == CircuitOpen {
+		if time.Since(b.openedAt) < b.openDuration() {
+			return ErrCircuitOpen
+		}
+		b.state = CircuitHalfOpen
+		b.probes = 0
+		b.successes = 0
+	}
+	if b.state == CircuitHalfOpen {
+		if b.probes >= b.halfOpenProbes() {
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+	return nil
+}
+
+// record records the outcome of a request that was allowed.
+func (b *CircuitBreaker) record(resp *http.Response, err error) {
+	if b == nil {
+		return
+	}
+	failure := b.isFailure(resp, err)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case CircuitHalfOpen:
+		if failure {
+			b.open()
+			return
+		}
+		b.successes++
+		if b.successes >= b.halfOpenProbes() {
+			b.state = CircuitClosed
+			b.failures = 0
+		}
+	case CircuitClosed:
+		if !failure {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.failureThreshold() {
+			b.open()
+		}
+	}
+}
+
+func (b *CircuitBreaker) open() {
+	b.state = CircuitOpen
+	b.openedAt = time.Now()
+	b.failures = 0
+}
+
+func (b *CircuitBreaker) isFailure(resp *http.Response, err error) bool {
+	if b.IsFailure != nil {
+		return b.IsFailure(resp, err)
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+func (b *CircuitBreaker) failureThreshold() int {
+	if b.FailureThreshold > 0 {
+		return b.FailureThreshold
+	}
+	return 5
+}
+
+func (b *CircuitBreaker) openDuration() time.Duration {
+	if b.OpenDuration > 0 {
+		return b.OpenDuration
+	}
+	return 30 * time.Second
+}
+
+func (b *CircuitBreaker) halfOpenProbes() int {
+	if b.HalfOpenProbes > 0 {
+		return b.HalfOpenProbes
+	}
+	return 1
+}
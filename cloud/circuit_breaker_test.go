@@ -0,0 +1,92 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	b := &CircuitBreaker{FailureThreshold: 2, OpenDuration: 20 * time.Millisecond, HalfOpenProbes: 1}
+	failed := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	ok := &http.Response{StatusCode: http.StatusOK}
+
+	for i := 0; i < 2; i++ {
+		if err := b.allow(); err != nil {
+			t.Fatalf("Expected request %d to pass, got %v", i, err)
+		}
+		b.record(failed, nil)
+	}
+	if b.State() != CircuitOpen {
+		t.Fatalf("Expected the circuit to be open, got %s", b.State())
+	}
+	if err := b.allow(); err != ErrCircuitOpen {
+		t.Errorf("Expected ErrCircuitOpen, got %v", err)
+	}
+
+	time.Sleep(25 * time.Millisecond)
+	if b.State() != CircuitHalfOpen {
+		t.Fatalf("Expected the circuit to be half-open, got %s", b.State())
+	}
+	if err := b.allow(); err != nil {
+		t.Fatalf("Expected the probe to pass, got %v", err)
+	}
+	if err := b.allow(); err != ErrCircuitOpen {
+		t.Errorf("Expected only one probe, got %v", err)
+	}
+	b.record(ok, nil)
+	if b.State() != CircuitClosed {
+		t.Errorf("Expected the circuit to be closed, got %s", b.State())
+	}
+}
+
+func TestCircuitBreaker_ProbeFails(t *testing.T) {
+	b := &CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Millisecond}
+	_ = b.allow()
+	b.record(nil, errors.New("connection refused"))
+	time.Sleep(2 * time.Millisecond)
+
+	if err := b.allow(); err != nil {
+		t.Fatalf("Expected the probe to pass, got %v", err)
+	}
+	b.record(&http.Response{StatusCode: http.StatusTooManyRequests}, nil)
+	if b.State() != CircuitOpen {
+		t.Errorf("Expected the circuit to open again, got %s", b.State())
+	}
+}
+
+func TestCircuitBreaker_SuccessResetsFailures(t *testing.T) {
+	b := &CircuitBreaker{FailureThreshold: 2}
+	b.record(nil, errors.New("timeout"))
+	b.record(&http.Response{StatusCode: http.StatusNotFound}, nil)
+	b.record(nil, errors.New("timeout"))
+	b.record(nil, context.Canceled)
+	if b.State() != CircuitClosed {
+		t.Errorf("Expected the circuit to stay closed, got %s", b.State())
+	}
+}
+
+func TestClient_Do_CircuitOpen(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.CircuitBreaker = &CircuitBreaker{FailureThreshold: 1, OpenDuration: time.Minute}
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	for i := 0; i < 3; i++ {
+		req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+		_, err := testClient.Do(req, nil)
+		if i > 0 && !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("Expected ErrCircuitOpen for request %d, got %v", i, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 request to reach Jira, got %d", calls)
+	}
+}
@@ -0,0 +1,66 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// ClassificationLevelService handles data classification levels for the Jira instance / API.
+// Data classification levels are defined in Atlassian Guard and can be assigned to projects and fields.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-classification-levels/#api-group-classification-levels
+type ClassificationLevelService service
+
+const (
+	ClassificationLevelStatusPublished = "PUBLISHED"
+	ClassificationLevelStatusArchived  = "ARCHIVED"
+	ClassificationLevelStatusDraft     = "DRAFT"
+)
+
+// ClassificationLevel represents a data classification level.
+type ClassificationLevel struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Guideline   string `json:"guideline,omitempty" structs:"guideline,omitempty"`
+	Status      string `json:"status,omitempty" structs:"status,omitempty"`
+	Rank        int    `json:"rank,omitempty" structs:"rank,omitempty"`
+	Color       string `json:"color,omitempty" structs:"color,omitempty"`
+}
+
+// ClassificationLevelListOptions specifies the optional parameters to the ClassificationLevelService.GetList
+type ClassificationLevelListOptions struct {
+	// Status filters the classification levels by status.
+	// Valid values: PUBLISHED, ARCHIVED, DRAFT.
+	Status []string `url:"status,omitempty"`
+	// OrderBy orders the results. Valid values: rank, -rank, +rank.
+	OrderBy string `url:"orderBy,omitempty"`
+}
+
+// classificationLevelsResult is only a small wrapper around the ClassificationLevelService.GetList
+// to be able to parse the results
+type classificationLevelsResult struct {
+	Classifications []ClassificationLevel `json:"classifications"`
+}
+
+// GetList returns all data classification levels.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-classification-levels/#api-rest-api-3-classification-levels-get
+func (s *ClassificationLevelService) GetList(ctx context.Context, options *ClassificationLevelListOptions) ([]ClassificationLevel, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "classification-levels"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(classificationLevelsResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result.Classifications, resp, nil
+}
@@ -0,0 +1,35 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClassificationLevelService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/classification-levels"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"status": "PUBLISHED", "orderBy": "rank"})
+		fmt.Fprint(w, `{"classifications":[{"id":"ari:cloud:platform::classification-tag/5bfa70f7-4af1-44f5-9e12-1ce185f15a38","status":"PUBLISHED","name":"Restricted","rank":1,"description":"Data we hold that would be very damaging.","guideline":"Access to data must be restricted to only individuals who need access.","color":"RED"}]}`)
+	})
+
+	levels, _, err := testClient.ClassificationLevel.GetList(context.Background(), &ClassificationLevelListOptions{
+		Status:  []string{ClassificationLevelStatusPublished},
+		OrderBy: "rank",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(levels) != 1 {
+		t.Fatalf("Expected 1 classification level. Got %d", len(levels))
+	}
+	if levels[0].Name != "Restricted" || levels[0].Color != "RED" {
+		t.Errorf("Unexpected classification level: %+v", levels[0])
+	}
+}
@@ -0,0 +1,154 @@
+package cloud
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// ClientOption configures a Client created with New.
+type ClientOption func(*clientOptions) error
+
+// clientOptions collects the options of New, so they can be given in any order.
+type clientOptions struct {
+	baseURL    string
+	httpClient *http.Client
+	transport  http.RoundTripper
+	timeout    time.Duration
+	userAgent  string
+	headers    http.Header
+
+	experimentalAPI bool
+	debug           io.Writer
+}
+
+// WithBaseURL sets the base URL of the Jira instance, e.g. "https://your-domain.atlassian.net".
+// It is required.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) error {
+		o.baseURL = baseURL
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to send the requests.
+// The client is copied, so options like WithTransport or WithTimeout don't modify it.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) error {
+		if httpClient == nil {
+			return errors.New("jira: http client is nil")
+		}
+		o.httpClient = httpClient
+		return nil
+	}
+}
+
+// WithTransport sets the transport of the http.Client, e.g. an auth transport like BasicAuthTransport.
+func WithTransport(transport http.RoundTripper) ClientOption {
+	return func(o *clientOptions) error {
+		o.transport = transport
+		return nil
+	}
+}
+
+// WithTimeout sets the timeout of each request sent with the http.Client,
+// including reading the response body.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) error {
+		o.timeout = timeout
+		return nil
+	}
+}
+
+// WithUserAgent sets the User-Agent sent with each request.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) error {
+		o.userAgent = userAgent
+		return nil
+	}
+}
+
+// WithHeader sets a header on every request, e.g. WithHeader("X-Force-Accept-Language", "true").
+// It can be given multiple times to set multiple headers.
+func WithHeader(key, value string) ClientOption {
+	return func(o *clientOptions) error {
+		if o.headers == nil {
+			o.headers = make(http.Header)
+		}
+		o.headers.Set(key, value)
+		return nil
+	}
+}
+
+// WithExperimentalAPIOptIn opts in to experimental APIs for all requests, see Client.ExperimentalAPI.
+func WithExperimentalAPIOptIn() ClientOption {
+	return func(o *clientOptions) error {
+		o.experimentalAPI = true
+		return nil
+	}
+}
+
+// WithDebug logs all requests and responses including the first 4 KB of their bodies to w,
+// os.Stderr if w is nil. Credentials are masked.
+func WithDebug(w io.Writer) ClientOption {
+	return func(o *clientOptions) error {
+		if w == nil {
+			w = os.Stderr
+		}
+		o.debug = w
+		return nil
+	}
+}
+
+// New returns a new Jira API client configured with opts.
+// WithBaseURL is required, all other options are optional.
+//
+// Example:
+//
+//	tp := cloud.BasicAuthTransport{Username: "<username>", APIToken: "<api-token>"}
+//	client, err := cloud.New(
+//		cloud.WithBaseURL("https://your-domain.atlassian.net"),
+//		cloud.WithTransport(&tp),
+//		cloud.WithTimeout(30*time.Second),
+//	)
+func New(opts ...ClientOption) (*Client, error) {
+	var o clientOptions
+	for _, opt := range opts {
+		if err := opt(&o); err != nil {
+			return nil, err
+		}
+	}
+	if o.baseURL == "" {
+		return nil, errors.New("jira: base URL is required, use WithBaseURL")
+	}
+
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		clientCopy := *o.httpClient
+		httpClient = &clientCopy
+	}
+	if o.transport != nil {
+		httpClient.Transport = o.transport
+	}
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	c, err := NewClient(o.baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	if o.userAgent != "" {
+		c.UserAgent = o.userAgent
+	}
+	c.DefaultHeaders = o.headers
+	c.ExperimentalAPI = o.experimentalAPI
+	if o.debug != nil {
+		c.Logger = slog.New(slog.NewTextHandler(o.debug, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		c.LogBodyLimit = 4 << 10
+	}
+	return c, nil
+}
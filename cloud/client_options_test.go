@@ -0,0 +1,77 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	httpClient := &http.Client{}
+	transport := &BasicAuthTransport{Username: "user", APIToken: "token"}
+
+	c, err := New(
+		WithTransport(transport),
+		WithBaseURL(testJiraInstanceURL),
+		WithHTTPClient(httpClient),
+		WithTimeout(10*time.Second),
+		WithUserAgent("my-app/1.0"),
+		WithHeader("X-Force-Accept-Language", "true"),
+	)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	if c.BaseURL.String() != testJiraInstanceURL {
+		t.Errorf("BaseURL = %s, want %s", c.BaseURL, testJiraInstanceURL)
+	}
+	if c.UserAgent != "my-app/1.0" {
+		t.Errorf("UserAgent = %s, want my-app/1.0", c.UserAgent)
+	}
+	if got := c.Client(); got.Transport != transport || got.Timeout != 10*time.Second {
+		t.Errorf("Expected the transport and timeout to be set, got %+v", got)
+	}
+	if httpClient.Transport != nil || httpClient.Timeout != 0 {
+		t.Error("Expected the given http client not to be modified")
+	}
+	if got := c.DefaultHeaders.Get("X-Force-Accept-Language"); got != "true" {
+		t.Errorf("Expected the default header, got %q", got)
+	}
+	if c.Issue == nil {
+		t.Error("Expected the services to be set up")
+	}
+}
+
+func TestNew_BaseURLRequired(t *testing.T) {
+	if _, err := New(WithUserAgent("my-app/1.0")); err == nil {
+		t.Error("Expected an error without base URL")
+	}
+	if _, err := New(WithBaseURL("://invalid")); err == nil {
+		t.Error("Expected an error for an invalid base URL")
+	}
+}
+
+func TestNew_WithDebug(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key":"EX-1"}`))
+	}))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	c, err := New(WithBaseURL(ts.URL), WithDebug(&buf))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1", nil)
+	if _, err := c.Do(req, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "rest/api/2/issue/EX-1") || !strings.Contains(out, "EX-1") {
+		t.Errorf("Expected the request to be logged, got %s", out)
+	}
+}
@@ -0,0 +1,116 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CoalescedResponseHeader is set on responses that a CoalescingTransport shared
+// from a request to the same URL that was already in flight.
+const CoalescedResponseHeader = "X-Coalesced"
+
+// CoalescingTransport is an http.RoundTripper that collapses concurrent identical GET requests
+// into one request to Jira, e.g. when many goroutines fetch the fields or the same project at once.
+// The requests waiting for the request in flight get copies of its response,
+// which saves the rate limit of fan-out workloads.
+//
+// Requests are identical if they have the same URL and the same Accept, Authorization and Cookie headers.
+// Requests with other methods than GET and Range requests are sent as they are.
+// The bodies of the shared responses are read into memory.
+//
+// The CoalescingTransport only tells users apart by the credentials the auth transport sets on the requests,
+// so it goes below the auth transport, as its Transport. Wrapped around the auth transport,
+// it would answer the concurrent requests of all users to a URL with the response of the first one:
+//
+//	tp := cloud.BasicAuthTransport{Username: "...", APIToken: "...", Transport: &cloud.CoalescingTransport{}}
+//	client, err := cloud.NewClient("https://your-domain.atlassian.net", tp.Client())
+type CoalescingTransport struct {
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+// coalescedCall is a request in flight and, once done is closed, its response.
+type coalescedCall struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *CoalescingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("Range") != "" {
+		return t.transport().RoundTrip(req)
+	}
+	key := cacheKey(req) + " " + req.Header.Get("Accept")
+
+	t.mu.Lock()
+	if call, ok := t.calls[key]; ok {
+		t.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		// The request in flight was canceled by its sender, not by this one.
+		if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+			return t.transport().RoundTrip(req)
+		}
+		return call.response(req, true)
+	}
+	call := &coalescedCall{done: make(chan struct{})}
+	if t.calls == nil {
+		t.calls = map[string]*coalescedCall{}
+	}
+	t.calls[key] = call
+	t.mu.Unlock()
+
+	call.resp, call.err = t.transport().RoundTrip(req)
+	if call.err == nil {
+		call.body, call.err = io.ReadAll(call.resp.Body)
+		call.resp.Body.Close()
+	}
+
+	t.mu.Lock()
+	delete(t.calls, key)
+	t.mu.Unlock()
+	close(call.done)
+
+	return call.response(req, false)
+}
+
+// response returns a copy of the response of the call for req.
+func (c *coalescedCall) response(req *http.Request, coalesced bool) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := *c.resp
+	resp.Header = c.resp.Header.Clone()
+	resp.Body = io.NopCloser(bytes.NewReader(c.body))
+	resp.ContentLength = int64(len(c.body))
+	resp.Request = req
+	if coalesced {
+		resp.Header.Set(CoalescedResponseHeader, "1")
+	}
+	return &resp, nil
+}
+
+// Client returns an *http.Client that collapses identical requests with this transport.
+func (t *CoalescingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *CoalescingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
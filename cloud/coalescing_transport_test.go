@@ -0,0 +1,124 @@
+package cloud
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCoalescingTransport(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		fmt.Fprint(w, `[{"id":"summary"}]`)
+	}))
+	defer server.Close()
+
+	client := (&CoalescingTransport{}).Client()
+	var wg sync.WaitGroup
+	bodies := make([]string, 10)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := client.Get(server.URL + "/rest/api/2/field")
+			if err != nil {
+				t.Errorf("Error given: %s", err)
+				return
+			}
+			defer resp.Body.Close()
+			b, _ := io.ReadAll(resp.Body)
+			bodies[i] = string(b)
+		}(i)
+	}
+	// Wait until the requests are in flight.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("Expected 1 request, got %d", n)
+	}
+	for i, body := range bodies {
+		if body != `[{"id":"summary"}]` {
+			t.Errorf("Unexpected body %d: %q", i, body)
+		}
+	}
+}
+
+func TestCoalescingTransport_NotCoalesced(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := (&CoalescingTransport{}).Client()
+	for _, method := range []string{http.MethodGet, http.MethodGet, http.MethodPost} {
+		req, _ := http.NewRequest(method, server.URL+"/rest/api/2/issue", nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		resp.Body.Close()
+		if resp.Header.Get(CoalescedResponseHeader) != "" {
+			t.Errorf("Expected sequential requests not to be coalesced")
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 3 {
+		t.Errorf("Expected 3 requests, got %d", n)
+	}
+}
+
+func TestCoalescingTransport_Credentials(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		user, _, _ := r.BasicAuth()
+		fmt.Fprintf(w, `{"name":%q}`, user)
+	}))
+	defer server.Close()
+
+	coalescing := &CoalescingTransport{}
+	users := []string{"alice", "bob", "alice", "bob"}
+	bodies := make([]string, len(users))
+	var wg sync.WaitGroup
+	for i, user := range users {
+		wg.Add(1)
+		go func(i int, user string) {
+			defer wg.Done()
+			client := (&BasicAuthTransport{Username: user, APIToken: "secret", Transport: coalescing}).Client()
+			resp, err := client.Get(server.URL + "/rest/api/2/myself")
+			if err != nil {
+				t.Errorf("Error given: %s", err)
+				return
+			}
+			defer resp.Body.Close()
+			b, _ := io.ReadAll(resp.Body)
+			bodies[i] = string(b)
+		}(i, user)
+	}
+	// Wait until the requests are in flight.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("Expected 1 request per user, got %d", n)
+	}
+	for i, user := range users {
+		if want := fmt.Sprintf(`{"name":%q}`, user); bodies[i] != want {
+			t.Errorf("Request %d of %s: expected %s, got %s", i, user, want, bodies[i])
+		}
+	}
+}
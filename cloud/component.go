@@ -2,7 +2,6 @@ package cloud
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -59,7 +58,7 @@ type ComponentCreateOptions struct {
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-post
 func (s *ComponentService) Create(ctx context.Context, options *ComponentCreateOptions) (*ProjectComponent, *Response, error) {
-	apiEndpoint := "rest/api/3/component"
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "component")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
@@ -78,7 +77,7 @@ func (s *ComponentService) Create(ctx context.Context, options *ComponentCreateO
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-get
 func (s *ComponentService) Get(ctx context.Context, componentID string) (*ProjectComponent, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/3/component/%s", componentID)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "component/%s", componentID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -93,12 +92,83 @@ func (s *ComponentService) Get(ctx context.Context, componentID string) (*Projec
 	return component, resp, nil
 }
 
+// ComponentListOptions specifies the optional parameters to the ComponentService.ListProjectComponents
+type ComponentListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the results by a field.
+	// Valid values: description, issueCount, lead, name (optionally prefixed with - or + to set the direction).
+	OrderBy string `url:"orderBy,omitempty"`
+	// ComponentSource can take the values jira, compass and auto.
+	ComponentSource string `url:"componentSource,omitempty"`
+	// Query filters the results using a literal string.
+	// Components with a matching name or description are returned (case insensitive).
+	Query string `url:"query,omitempty"`
+}
+
+// ComponentList is a page of project components.
+type ComponentList struct {
+	Self       string                      `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage   string                      `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	MaxResults int                         `json:"maxResults" structs:"maxResults"`
+	StartAt    int                         `json:"startAt" structs:"startAt"`
+	Total      int                         `json:"total" structs:"total"`
+	IsLast     bool                        `json:"isLast" structs:"isLast"`
+	Values     []ProjectComponentWithCount `json:"values" structs:"values"`
+}
+
+// ProjectComponentWithCount is a project component including the number of issues assigned to it.
+type ProjectComponentWithCount struct {
+	ProjectComponent
+	IssueCount int64 `json:"issueCount" structs:"issueCount"`
+}
+
+// ListProjectComponents returns a paginated list of all components in a project.
+// Use it instead of fetching the components of a project together with the project for projects with many components.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
+func (s *ComponentService) ListProjectComponents(ctx context.Context, projectIDOrKey string, options *ComponentListOptions) (*ComponentList, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "project/%s/component", projectIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	components := new(ComponentList)
+	resp, err := s.client.Do(req, components)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return components, resp, nil
+}
+
+// projectComponentsPager returns a Pager over the components of a project.
+func (s *ComponentService) projectComponentsPager(projectIDOrKey string, options *ComponentListOptions, pager *PagerOptions) *Pager[ProjectComponentWithCount] {
+	var opts ComponentListOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[ProjectComponentWithCount], *Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		list, resp, err := s.ListProjectComponents(ctx, projectIDOrKey, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[ProjectComponentWithCount]{Values: list.Values, Total: list.Total, IsLast: list.IsLast}, resp, nil
+	}, pager)
+}
+
 // TODO Add "Update component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-put
 
 // TODO Add "Delete component" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-delete
 
 // TODO Add "Get component issues count" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-component-id-relatedissuecounts-get
 
-// TODO Add "Get project components paginated" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-component-get
-
 // TODO Add "Get project components" method. See https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-components/#api-rest-api-3-project-projectidorkey-components-get
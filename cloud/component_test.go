@@ -79,3 +79,31 @@ func TestComponentService_Get_NoComponent(t *testing.T) {
 		t.Error("No error given. Expected one")
 	}
 }
+
+func TestComponentService_ListProjectComponents(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/HSP/component"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"query": "Component", "orderBy": "-issueCount", "maxResults": "2"})
+		fmt.Fprint(w, `{"isLast":false,"maxResults":2,"nextPage":"https://your-domain.atlassian.net/rest/api/3/project/HSP/component?startAt=2&maxResults=2","self":"https://your-domain.atlassian.net/rest/api/3/project/HSP/component?startAt=0&maxResults=2","startAt":0,"total":7,"values":[{"id":"10000","name":"Component 1","assigneeType":"PROJECT_LEAD","project":"HSP","projectId":10000,"issueCount":1},{"id":"10050","name":"PXA","assigneeType":"PROJECT_LEAD","project":"PROJECTKEY","projectId":10000,"issueCount":5}]}`)
+	})
+
+	components, _, err := testClient.Component.ListProjectComponents(context.Background(), "HSP", &ComponentListOptions{
+		Query:      "Component",
+		OrderBy:    "-issueCount",
+		MaxResults: 2,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if components == nil || components.Total != 7 || len(components.Values) != 2 {
+		t.Fatalf("Unexpected component list: %+v", components)
+	}
+	if c := components.Values[1]; c.Name != "PXA" || c.IssueCount != 5 {
+		t.Errorf("Unexpected component: %+v", c)
+	}
+}
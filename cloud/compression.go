@@ -0,0 +1,98 @@
+package cloud
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// compressRequest returns req prepared for compressed transfers:
+// It accepts gzip encoded responses and, if enabled with CompressRequestBodies,
+// gzips request bodies larger than CompressRequestBodies bytes.
+// req is cloned if it has to be changed.
+func (c *Client) compressRequest(req *http.Request) (*http.Request, error) {
+	if c.DisableCompression {
+		return req, nil
+	}
+
+	compressBody := c.CompressRequestBodies > 0 && req.ContentLength > int64(c.CompressRequestBodies) &&
+		req.GetBody != nil && req.Header.Get("Content-Encoding") == ""
+	if req.Header.Get("Accept-Encoding") != "" && !compressBody {
+		return req, nil
+	}
+
+	req = req.Clone(req.Context())
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+	if !compressBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.Copy(zw, body); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = io.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return req, nil
+}
+
+// decompressResponse replaces the body of a gzip encoded response with the decompressed body.
+// Responses without a body are left as they are, even if they have the gzip Content-Encoding,
+// e.g. responses to HEAD requests and 204 No Content responses.
+func decompressResponse(resp *http.Response) error {
+	if resp == nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") || !hasBody(resp) {
+		return nil
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return err
+	}
+	resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// hasBody reports whether resp can have a body.
+func hasBody(resp *http.Response) bool {
+	if resp.Body == nil || resp.Body == http.NoBody || resp.ContentLength == 0 {
+		return false
+	}
+	if resp.Request != nil && resp.Request.Method == http.MethodHead {
+		return false
+	}
+	return resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotModified
+}
+
+// gzipReadCloser closes the gzip reader and the underlying body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r *gzipReadCloser) Close() error {
+	r.Reader.Close()
+	return r.body.Close()
+}
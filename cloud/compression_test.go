@@ -0,0 +1,121 @@
+package cloud
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Do_GzipResponse(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		io.WriteString(zw, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"key":"EX-1"}]}`)
+		zw.Close()
+	})
+
+	issues, _, err := testClient.Issue.Search(context.Background(), "", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-1" {
+		t.Errorf("Unexpected issues %+v", issues)
+	}
+}
+
+func TestClient_Do_GzipEncodingWithoutBody(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	for _, method := range []string{http.MethodHead, http.MethodDelete} {
+		req, err := testClient.NewRequest(context.Background(), method, "rest/api/2/issue/EX-1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := testClient.Do(req, nil); err != nil {
+			t.Errorf("%s: Error given: %s", method, err)
+		}
+	}
+}
+
+func TestClient_compressRequest_Disabled(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.DisableCompression = true
+	testClient.CompressRequestBodies = 1
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/", map[string]string{"a": "b"})
+	got, err := testClient.compressRequest(req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got.Header.Get("Accept-Encoding") != "" || got.Header.Get("Content-Encoding") != "" {
+		t.Errorf("Expected no compression headers, got %v", got.Header)
+	}
+}
+
+func TestClient_Do_CompressRequestBodies(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.CompressRequestBodies = 10
+
+	description := strings.Repeat("a", 100)
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "gzip" {
+			t.Fatalf("Content-Encoding = %q, want gzip", got)
+		}
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		body, _ := io.ReadAll(zr)
+		if !bytes.Contains(body, []byte(description)) {
+			t.Errorf("Unexpected request body %s", body)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id":"10000","key":"EX-1"}`)
+	})
+
+	issue, _, err := testClient.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Description: description}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
+
+func TestClient_Do_CompressRequestBodies_Small(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.CompressRequestBodies = 1 << 10
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Content-Encoding"); got != "" {
+			t.Errorf("Expected an uncompressed body, got Content-Encoding %q", got)
+		}
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/", map[string]string{"a": "b"})
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
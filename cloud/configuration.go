@@ -0,0 +1,42 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// ConfigurationService handles the global settings of the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-jira-settings/#api-rest-api-2-configuration-get
+type ConfigurationService service
+
+// Configuration represents the global settings of the Jira instance.
+type Configuration struct {
+	VotingEnabled             bool                       `json:"votingEnabled" structs:"votingEnabled"`
+	WatchingEnabled           bool                       `json:"watchingEnabled" structs:"watchingEnabled"`
+	UnassignedIssuesAllowed   bool                       `json:"unassignedIssuesAllowed" structs:"unassignedIssuesAllowed"`
+	SubTasksEnabled           bool                       `json:"subTasksEnabled" structs:"subTasksEnabled"`
+	IssueLinkingEnabled       bool                       `json:"issueLinkingEnabled" structs:"issueLinkingEnabled"`
+	TimeTrackingEnabled       bool                       `json:"timeTrackingEnabled" structs:"timeTrackingEnabled"`
+	AttachmentsEnabled        bool                       `json:"attachmentsEnabled" structs:"attachmentsEnabled"`
+	TimeTrackingConfiguration *TimeTrackingConfiguration `json:"timeTrackingConfiguration,omitempty" structs:"timeTrackingConfiguration,omitempty"`
+}
+
+// Get returns the global settings of the Jira instance, e.g. the working hours used by time tracking.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-jira-settings/#api-rest-api-2-configuration-get
+func (s *ConfigurationService) Get(ctx context.Context) (*Configuration, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "configuration")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(Configuration)
+	resp, err := s.client.Do(req, configuration)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return configuration, resp, nil
+}
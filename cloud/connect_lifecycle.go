@@ -0,0 +1,189 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Event types of the lifecycle callbacks of Connect apps.
+const (
+	LifecycleInstalled   = "installed"
+	LifecycleUninstalled = "uninstalled"
+	LifecycleEnabled     = "enabled"
+	LifecycleDisabled    = "disabled"
+)
+
+// ErrInstallationNotFound is returned by an InstallationStore if no installation has the client key.
+var ErrInstallationNotFound = errors.New("jira: connect installation not found")
+
+// LifecyclePayload is the payload of a lifecycle callback of a Connect app.
+// Only the installed callback contains the shared secret.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/connect-app-descriptor/#lifecycle-http-request-payload
+type LifecyclePayload struct {
+	Key                      string `json:"key"`
+	ClientKey                string `json:"clientKey"`
+	OAuthClientID            string `json:"oauthClientId,omitempty"`
+	SharedSecret             string `json:"sharedSecret,omitempty"`
+	ServerVersion            string `json:"serverVersion,omitempty"`
+	PluginsVersion           string `json:"pluginsVersion,omitempty"`
+	BaseURL                  string `json:"baseUrl"`
+	DisplayURL               string `json:"displayUrl,omitempty"`
+	ProductType              string `json:"productType,omitempty"`
+	Description              string `json:"description,omitempty"`
+	ServiceEntitlementNumber string `json:"serviceEntitlementNumber,omitempty"`
+	EventType                string `json:"eventType"`
+	CloudID                  string `json:"cloudId,omitempty"`
+	InstallationID           string `json:"installationId,omitempty"`
+}
+
+// ParseLifecyclePayload parses the body of a lifecycle callback.
+//
+// The callbacks must be authenticated before, as they are sent to a public URL:
+// installed and uninstalled callbacks carry a JWT signed by Atlassian, the others
+// a JWT signed with the shared secret of the installation.
+func ParseLifecyclePayload(data []byte) (*LifecyclePayload, error) {
+	payload := new(LifecyclePayload)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return nil, fmt.Errorf("jira: invalid lifecycle payload: %w", err)
+	}
+	if payload.ClientKey == "" || payload.EventType == "" {
+		return nil, errors.New("jira: lifecycle payload has no clientKey or eventType")
+	}
+	if payload.EventType == LifecycleInstalled && payload.SharedSecret == "" {
+		return nil, errors.New("jira: installed lifecycle payload has no sharedSecret")
+	}
+	return payload, nil
+}
+
+// Installation is an installation of a Connect app in a Jira instance,
+// as received in the installed lifecycle callback.
+type Installation struct {
+	// Key is the key of the app.
+	Key string `json:"key"`
+	// ClientKey identifies the installation. It is the issuer of the JWTs Jira sends to the app.
+	ClientKey     string `json:"clientKey"`
+	SharedSecret  string `json:"sharedSecret"`
+	OAuthClientID string `json:"oauthClientId,omitempty"`
+	BaseURL       string `json:"baseUrl"`
+	DisplayURL    string `json:"displayUrl,omitempty"`
+	CloudID       string `json:"cloudId,omitempty"`
+	// Enabled is false after the disabled lifecycle callback.
+	Enabled     bool      `json:"enabled"`
+	InstalledAt time.Time `json:"installedAt"`
+}
+
+// InstallationStore stores the installations of a Connect app, e.g. in a database.
+// Implementations must be safe for concurrent use.
+type InstallationStore interface {
+	// Get returns the installation with the client key or an error wrapping ErrInstallationNotFound.
+	Get(ctx context.Context, clientKey string) (*Installation, error)
+	// Save creates or replaces the installation with the client key of installation.
+	Save(ctx context.Context, installation *Installation) error
+	// Delete deletes the installation with the client key. Deleting a missing installation is no error.
+	Delete(ctx context.Context, clientKey string) error
+}
+
+// HandleLifecycle applies the lifecycle callback payload to the store:
+// installed saves the installation with its shared secret, enabled and disabled update it
+// and uninstalled deletes it.
+func HandleLifecycle(ctx context.Context, store InstallationStore, payload *LifecyclePayload) error {
+	switch payload.EventType {
+	case LifecycleInstalled:
+		return store.Save(ctx, &Installation{
+			Key:           payload.Key,
+			ClientKey:     payload.ClientKey,
+			SharedSecret:  payload.SharedSecret,
+			OAuthClientID: payload.OAuthClientID,
+			BaseURL:       payload.BaseURL,
+			DisplayURL:    payload.DisplayURL,
+			CloudID:       payload.CloudID,
+			Enabled:       true,
+			InstalledAt:   time.Now(),
+		})
+	case LifecycleEnabled, LifecycleDisabled:
+		installation, err := store.Get(ctx, payload.ClientKey)
+		if err != nil {
+			return err
+		}
+		installation.Enabled = payload.EventType == LifecycleEnabled
+		if payload.BaseURL != "" {
+			installation.BaseURL = payload.BaseURL
+		}
+		return store.Save(ctx, installation)
+	case LifecycleUninstalled:
+		return store.Delete(ctx, payload.ClientKey)
+	default:
+		return fmt.Errorf("jira: unknown lifecycle event type %q", payload.EventType)
+	}
+}
+
+// InstallationSecret returns a TokenProvider of the shared secret of the installation with the client key,
+// e.g. for JWTAuthTransport.SecretProvider. The secret is read from the store for every request,
+// so a reinstallation with a new secret takes effect immediately.
+func InstallationSecret(store InstallationStore, clientKey string) TokenProvider {
+	return TokenProviderFunc(func(ctx context.Context) (string, error) {
+		installation, err := store.Get(ctx, clientKey)
+		if err != nil {
+			return "", err
+		}
+		return installation.SharedSecret, nil
+	})
+}
+
+// NewInstallationJWTAuthTransport returns a JWTAuthTransport for the requests of the app
+// to the installation with the client key, signed with its shared secret from the store.
+func NewInstallationJWTAuthTransport(store InstallationStore, appKey, clientKey string) *JWTAuthTransport {
+	return &JWTAuthTransport{
+		SecretProvider: InstallationSecret(store, clientKey),
+		Issuer:         appKey,
+	}
+}
+
+// MemoryInstallationStore is an InstallationStore keeping the installations in memory, e.g. for tests.
+type MemoryInstallationStore struct {
+	mu            sync.RWMutex
+	installations map[string]Installation
+}
+
+// NewMemoryInstallationStore returns an empty MemoryInstallationStore.
+func NewMemoryInstallationStore() *MemoryInstallationStore {
+	return &MemoryInstallationStore{installations: map[string]Installation{}}
+}
+
+// Get implements InstallationStore. It returns a copy of the installation.
+func (s *MemoryInstallationStore) Get(ctx context.Context, clientKey string) (*Installation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	installation, ok := s.installations[clientKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrInstallationNotFound, clientKey)
+	}
+	return &installation, nil
+}
+
+// Save implements InstallationStore.
+func (s *MemoryInstallationStore) Save(ctx context.Context, installation *Installation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.installations == nil {
+		s.installations = map[string]Installation{}
+	}
+	s.installations[installation.ClientKey] = *installation
+	return nil
+}
+
+// Delete implements InstallationStore.
+func (s *MemoryInstallationStore) Delete(ctx context.Context, clientKey string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.installations, clientKey)
+	return nil
+}
@@ -0,0 +1,102 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+const testInstalledPayload = `{"key":"installed-addon-key","clientKey":"unique-client-identifier","sharedSecret":"a-secret-key-not-to-be-lost",` +
+	`"serverVersion":"server-version","pluginsVersion":"version-of-connect","baseUrl":"https://example.atlassian.net","displayUrl":"https://issues.example.com",` +
+	`"productType":"jira","description":"Atlassian Jira at https://example.atlassian.net","eventType":"installed"}`
+
+func TestParseLifecyclePayload(t *testing.T) {
+	payload, err := ParseLifecyclePayload([]byte(testInstalledPayload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if payload.ClientKey != "unique-client-identifier" || payload.SharedSecret != "a-secret-key-not-to-be-lost" || payload.EventType != LifecycleInstalled {
+		t.Errorf("Unexpected payload %+v", payload)
+	}
+
+	for _, data := range []string{
+		`{`,
+		`{"eventType":"enabled"}`,
+		`{"clientKey":"unique-client-identifier","eventType":"installed"}`,
+	} {
+		if _, err := ParseLifecyclePayload([]byte(data)); err == nil {
+			t.Errorf("Expected an error for %s", data)
+		}
+	}
+}
+
+func TestHandleLifecycle(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryInstallationStore()
+
+	installed, _ := ParseLifecyclePayload([]byte(testInstalledPayload))
+	if err := HandleLifecycle(ctx, store, installed); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	installation, err := store.Get(ctx, "unique-client-identifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !installation.Enabled || installation.SharedSecret != "a-secret-key-not-to-be-lost" || installation.BaseURL != "https://example.atlassian.net" {
+		t.Errorf("Unexpected installation %+v", installation)
+	}
+
+	disabled := &LifecyclePayload{ClientKey: "unique-client-identifier", EventType: LifecycleDisabled}
+	if err := HandleLifecycle(ctx, store, disabled); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if installation, _ := store.Get(ctx, "unique-client-identifier"); installation.Enabled {
+		t.Error("Expected the installation to be disabled")
+	}
+
+	uninstalled := &LifecyclePayload{ClientKey: "unique-client-identifier", EventType: LifecycleUninstalled}
+	if err := HandleLifecycle(ctx, store, uninstalled); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := store.Get(ctx, "unique-client-identifier"); !errors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("Expected ErrInstallationNotFound, got %v", err)
+	}
+	if err := HandleLifecycle(ctx, store, disabled); !errors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("Expected ErrInstallationNotFound, got %v", err)
+	}
+}
+
+func TestNewInstallationJWTAuthTransport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	store := NewMemoryInstallationStore()
+	_ = store.Save(context.Background(), &Installation{ClientKey: "unique-client-identifier", SharedSecret: "a-secret-key-not-to-be-lost"})
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		tokenString := r.Header.Get("Authorization")[len("JWT "):]
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+			return []byte("a-secret-key-not-to-be-lost"), nil
+		})
+		if err != nil {
+			t.Errorf("Expected a JWT signed with the shared secret, got %s", err)
+		}
+		if claims["iss"] != "installed-addon-key" {
+			t.Errorf("Expected the issuer installed-addon-key, got %v", claims["iss"])
+		}
+	})
+
+	tp := NewInstallationJWTAuthTransport(store, "installed-addon-key", "unique-client-identifier")
+	if _, err := tp.Client().Get(testServer.URL + "/rest/api/2/myself"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	tp = NewInstallationJWTAuthTransport(store, "installed-addon-key", "unknown")
+	if _, err := tp.Client().Get(testServer.URL + "/rest/api/2/myself"); !errors.Is(err, ErrInstallationNotFound) {
+		t.Errorf("Expected ErrInstallationNotFound, got %v", err)
+	}
+}
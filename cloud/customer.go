@@ -28,20 +28,12 @@ type CustomerListOptions struct {
 }
 
 // CustomerList is a page of customers.
-type CustomerList struct {
-	Values  []Customer `json:"values,omitempty" structs:"values,omitempty"`
-	Start   int        `json:"start,omitempty" structs:"start,omitempty"`
-	Limit   int        `json:"limit,omitempty" structs:"limit,omitempty"`
-	IsLast  bool       `json:"isLastPage,omitempty" structs:"isLastPage,omitempty"`
-	Expands []string   `json:"_expands,omitempty" structs:"_expands,omitempty"`
-}
+type CustomerList = ServiceDeskPage[Customer]
 
 // Create creates a ServiceDesk customer.
+// Use ServiceDeskService.AddCustomers to add the customer to a service desk afterwards.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-customer/#api-rest-servicedeskapi-customer-post
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (c *CustomerService) Create(ctx context.Context, email, displayName string) (*Customer, *Response, error) {
 	const apiEndpoint = "rest/servicedeskapi/customer"
 
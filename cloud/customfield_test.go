@@ -0,0 +1,88 @@
+package cloud
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func testCustomFields(t *testing.T) *IssueFields {
+	fields := new(IssueFields)
+	err := json.Unmarshal([]byte(`{
+		"summary": "Custom fields",
+		"customfield_10001": "Some text",
+		"customfield_10002": 8,
+		"customfield_10003": {"accountId": "5b10ac8d82e05b22cc7d4ef5", "displayName": "Jane Doe"},
+		"customfield_10004": {"self": "https://example.atlassian.net/rest/api/2/customFieldOption/10100", "id": "10100", "value": "Red"},
+		"customfield_10005": {"id": "10200", "value": "Europe", "child": {"id": "10201", "value": "Germany"}},
+		"customfield_10006": [{"id": "10300", "value": "A"}, {"id": "10301", "value": "B"}],
+		"customfield_10007": "2023-06-30",
+		"customfield_10008": "2023-06-30T14:30:00.000+0200",
+		"customfield_10009": null,
+		"customfield_10010": "3.5"
+	}`), fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	return fields
+}
+
+func TestIssueFields_CustomAccessors(t *testing.T) {
+	fields := testCustomFields(t)
+
+	if s, err := fields.CustomString("customfield_10001"); err != nil || s != "Some text" {
+		t.Errorf("CustomString = %q, %v", s, err)
+	}
+	if s, err := fields.CustomString("customfield_10004"); err != nil || s != "Red" {
+		t.Errorf("CustomString of an option = %q, %v", s, err)
+	}
+	if n, err := fields.CustomNumber("customfield_10002"); err != nil || n != 8 {
+		t.Errorf("CustomNumber = %v, %v", n, err)
+	}
+	if n, err := fields.CustomNumber("customfield_10010"); err != nil || n != 3.5 {
+		t.Errorf("CustomNumber of a numeric string = %v, %v", n, err)
+	}
+	if u, err := fields.CustomUser("customfield_10003"); err != nil || u.AccountID != "5b10ac8d82e05b22cc7d4ef5" || u.DisplayName != "Jane Doe" {
+		t.Errorf("CustomUser = %+v, %v", u, err)
+	}
+	if o, err := fields.CustomOption("customfield_10004"); err != nil || o.ID != "10100" || o.Value != "Red" {
+		t.Errorf("CustomOption = %+v, %v", o, err)
+	}
+	if parent, child, err := fields.CustomCascading("customfield_10005"); err != nil || parent != "Europe" || child != "Germany" {
+		t.Errorf("CustomCascading = %q, %q, %v", parent, child, err)
+	}
+	if o, err := fields.CustomMultiSelect("customfield_10006"); err != nil || len(o) != 2 || o[1].Value != "B" {
+		t.Errorf("CustomMultiSelect = %+v, %v", o, err)
+	}
+	if d, err := fields.CustomDate("customfield_10007"); err != nil || !d.Equal(time.Date(2023, 6, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("CustomDate = %v, %v", d, err)
+	}
+	if d, err := fields.CustomDate("customfield_10008"); err != nil || !d.Equal(time.Date(2023, 6, 30, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("CustomDate of a date time = %v, %v", d, err)
+	}
+}
+
+func TestIssueFields_CustomAccessors_Errors(t *testing.T) {
+	fields := testCustomFields(t)
+
+	for _, id := range []string{"customfield_10009", "customfield_99999"} {
+		if _, err := fields.CustomString(id); !errors.Is(err, ErrCustomFieldNotSet) {
+			t.Errorf("Expected ErrCustomFieldNotSet for %s, got %v", id, err)
+		}
+	}
+
+	var typeErr *CustomFieldTypeError
+	if _, err := fields.CustomNumber("customfield_10001"); !errors.As(err, &typeErr) || typeErr.Field != "customfield_10001" || typeErr.Type != "number" {
+		t.Errorf("Expected a CustomFieldTypeError, got %v", err)
+	}
+	if _, err := fields.CustomUser("customfield_10006"); !errors.As(err, &typeErr) {
+		t.Errorf("Expected a CustomFieldTypeError, got %v", err)
+	}
+	if _, err := fields.CustomDate("customfield_10002"); !errors.As(err, &typeErr) {
+		t.Errorf("Expected a CustomFieldTypeError, got %v", err)
+	}
+	if _, err := fields.CustomMultiSelect("customfield_10004"); !errors.As(err, &typeErr) {
+		t.Errorf("Expected a CustomFieldTypeError, got %v", err)
+	}
+}
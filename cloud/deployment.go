@@ -0,0 +1,222 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DeploymentService handles deployment information of the development panel for the Jira instance / API.
+// The deployment provider endpoints can only be used by Connect or OAuth 2.0 apps with the deployment module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-deployments/
+type DeploymentService service
+
+const (
+	DeploymentStateUnknown    = "unknown"
+	DeploymentStatePending    = "pending"
+	DeploymentStateInProgress = "in_progress"
+	DeploymentStateCancelled  = "cancelled"
+	DeploymentStateFailed     = "failed"
+	DeploymentStateRolledBack = "rolled_back"
+	DeploymentStateSuccessful = "successful"
+)
+
+const (
+	DeploymentEnvironmentTypeUnmapped    = "unmapped"
+	DeploymentEnvironmentTypeDevelopment = "development"
+	DeploymentEnvironmentTypeTesting     = "testing"
+	DeploymentEnvironmentTypeStaging     = "staging"
+	DeploymentEnvironmentTypeProduction  = "production"
+)
+
+const (
+	DeploymentAssociationTypeIssueKeys       = "issueKeys"
+	DeploymentAssociationTypeIssueIDOrKeys   = "issueIdOrKeys"
+	DeploymentAssociationTypeServiceIDOrKeys = "serviceIdOrKeys"
+	DeploymentAssociationTypeRepository      = "repository"
+	DeploymentAssociationTypeCommit          = "commit"
+)
+
+// Deployment represents a deployment of a CD pipeline to an environment, shown in the development panel of issues.
+type Deployment struct {
+	SchemaVersion            string                  `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	DeploymentSequenceNumber int64                   `json:"deploymentSequenceNumber" structs:"deploymentSequenceNumber"`
+	UpdateSequenceNumber     int64                   `json:"updateSequenceNumber" structs:"updateSequenceNumber"`
+	Associations             []DeploymentAssociation `json:"associations,omitempty" structs:"associations,omitempty"`
+	DisplayName              string                  `json:"displayName" structs:"displayName"`
+	URL                      string                  `json:"url" structs:"url"`
+	Description              string                  `json:"description" structs:"description"`
+	LastUpdated              *time.Time              `json:"lastUpdated" structs:"lastUpdated"`
+	Label                    string                  `json:"label,omitempty" structs:"label,omitempty"`
+	State                    string                  `json:"state" structs:"state"`
+	Pipeline                 *DeploymentPipeline     `json:"pipeline" structs:"pipeline"`
+	Environment              *DeploymentEnvironment  `json:"environment" structs:"environment"`
+}
+
+// DeploymentPipeline is the pipeline that performed a deployment.
+type DeploymentPipeline struct {
+	ID          string `json:"id" structs:"id"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	URL         string `json:"url" structs:"url"`
+}
+
+// DeploymentEnvironment is the environment a deployment was made to.
+// Type is one of the DeploymentEnvironmentType* constants.
+type DeploymentEnvironment struct {
+	ID          string `json:"id" structs:"id"`
+	DisplayName string `json:"displayName" structs:"displayName"`
+	Type        string `json:"type" structs:"type"`
+}
+
+// DeploymentAssociation associates a deployment with Jira entities, repositories or commits.
+// AssociationType is one of the DeploymentAssociationType* constants.
+// Commits is used for the commit association type, Values for all other types.
+type DeploymentAssociation struct {
+	AssociationType string
+	Values          []string
+	Commits         []DeploymentCommit
+}
+
+// DeploymentCommit identifies a commit a deployment is associated with.
+type DeploymentCommit struct {
+	CommitHash   string `json:"commitHash" structs:"commitHash"`
+	RepositoryID string `json:"repositoryId" structs:"repositoryId"`
+}
+
+// deploymentAssociationJSON is the wire format of a DeploymentAssociation
+type deploymentAssociationJSON struct {
+	AssociationType string          `json:"associationType"`
+	Values          json.RawMessage `json:"values"`
+}
+
+// MarshalJSON encodes the association, taking the values from Commits for commit associations.
+func (a DeploymentAssociation) MarshalJSON() ([]byte, error) {
+	var values interface{} = a.Values
+	if a.AssociationType == DeploymentAssociationTypeCommit {
+		values = a.Commits
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(deploymentAssociationJSON{AssociationType: a.AssociationType, Values: raw})
+}
+
+// UnmarshalJSON decodes the association, putting the values into Commits for commit associations.
+func (a *DeploymentAssociation) UnmarshalJSON(data []byte) error {
+	var aux deploymentAssociationJSON
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	*a = DeploymentAssociation{AssociationType: aux.AssociationType}
+	if len(aux.Values) == 0 {
+		return nil
+	}
+	if aux.AssociationType == DeploymentAssociationTypeCommit {
+		return json.Unmarshal(aux.Values, &a.Commits)
+	}
+	return json.Unmarshal(aux.Values, &a.Values)
+}
+
+// DeploymentKey identifies a deployment.
+type DeploymentKey struct {
+	PipelineID               string `json:"pipelineId" structs:"pipelineId"`
+	EnvironmentID            string `json:"environmentId" structs:"environmentId"`
+	DeploymentSequenceNumber int64  `json:"deploymentSequenceNumber" structs:"deploymentSequenceNumber"`
+}
+
+// DeploymentsSubmission is the payload of DeploymentService.Submit.
+// Properties can be used to delete deployments with DeploymentService.DeleteByProperties later on.
+type DeploymentsSubmission struct {
+	Properties       map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	Deployments      []Deployment             `json:"deployments" structs:"deployments"`
+	ProviderMetadata *DevInfoProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// DeploymentsSubmissionResult is the result of DeploymentService.Submit.
+type DeploymentsSubmissionResult struct {
+	AcceptedDeployments []DeploymentKey         `json:"acceptedDeployments,omitempty" structs:"acceptedDeployments,omitempty"`
+	RejectedDeployments []RejectedDeployment    `json:"rejectedDeployments,omitempty" structs:"rejectedDeployments,omitempty"`
+	UnknownIssueKeys    []string                `json:"unknownIssueKeys,omitempty" structs:"unknownIssueKeys,omitempty"`
+	UnknownAssociations []DeploymentAssociation `json:"unknownAssociations,omitempty" structs:"unknownAssociations,omitempty"`
+}
+
+// RejectedDeployment is a submitted deployment that was rejected, along with the reasons.
+type RejectedDeployment struct {
+	Key    DeploymentKey  `json:"key" structs:"key"`
+	Errors []DevInfoError `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// Submit updates or inserts deployments.
+// Deployments are identified by the combination of pipeline ID, environment ID and DeploymentSequenceNumber,
+// and are only updated if the UpdateSequenceNumber is higher than the stored one.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-deployments/#api-rest-deployments-0-1-bulk-post
+func (s *DeploymentService) Submit(ctx context.Context, deployments *DeploymentsSubmission) (*DeploymentsSubmissionResult, *Response, error) {
+	apiEndpoint := "rest/deployments/0.1/bulk"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, deployments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(DeploymentsSubmissionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// Get returns the deployment identified by the given key.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-deployments/#api-rest-deployments-0-1-pipelines-pipelineid-environments-environmentid-deployments-deploymentsequencenumber-get
+func (s *DeploymentService) Get(ctx context.Context, key DeploymentKey) (*Deployment, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/deployments/0.1/pipelines/%s/environments/%s/deployments/%d", url.PathEscape(key.PipelineID), url.PathEscape(key.EnvironmentID), key.DeploymentSequenceNumber)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deployment := new(Deployment)
+	resp, err := s.client.Do(req, deployment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return deployment, resp, nil
+}
+
+// Delete deletes the deployment identified by the given key.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-deployments/#api-rest-deployments-0-1-pipelines-pipelineid-environments-environmentid-deployments-deploymentsequencenumber-delete
+// Caller must close resp.Body
+func (s *DeploymentService) Delete(ctx context.Context, key DeploymentKey) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/deployments/0.1/pipelines/%s/environments/%s/deployments/%d", url.PathEscape(key.PipelineID), url.PathEscape(key.EnvironmentID), key.DeploymentSequenceNumber)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteByProperties deletes all deployments that were submitted with all of the given properties.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-deployments/#api-rest-deployments-0-1-bulkbyproperties-delete
+// Caller must close resp.Body
+func (s *DeploymentService) DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	return deleteDevInfoByProperties(ctx, s.client, "rest/deployments/0.1/bulkByProperties", properties)
+}
@@ -0,0 +1,137 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDeploymentService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/bulk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload struct {
+			Deployments []struct {
+				Associations []map[string]interface{} `json:"associations"`
+				Environment  DeploymentEnvironment    `json:"environment"`
+			} `json:"deployments"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.Deployments) != 1 {
+			t.Fatalf("Expected 1 deployment, got %d", len(payload.Deployments))
+		}
+		deployment := payload.Deployments[0]
+		if deployment.Environment.Type != DeploymentEnvironmentTypeProduction {
+			t.Errorf("Unexpected environment: %+v", deployment.Environment)
+		}
+		if len(deployment.Associations) != 2 {
+			t.Fatalf("Expected 2 associations, got %+v", deployment.Associations)
+		}
+		if values := deployment.Associations[0]["values"].([]interface{}); values[0] != "ABC-123" {
+			t.Errorf("Unexpected issue association: %+v", deployment.Associations[0])
+		}
+		if values := deployment.Associations[1]["values"].([]interface{}); values[0].(map[string]interface{})["commitHash"] != "a7727ee6350c" {
+			t.Errorf("Unexpected commit association: %+v", deployment.Associations[1])
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedDeployments":[{"pipelineId":"e9c906a7-451f-4fa6-ae1a-c389e2e2d87c","environmentId":"8ec94d72-a4fc-4ac0-b31d-c5a595f373ba","deploymentSequenceNumber":100}],"unknownAssociations":[{"associationType":"issueIdOrKeys","values":["ABC-404"]}]}`)
+	})
+
+	result, _, err := testClient.Deployment.Submit(context.Background(), &DeploymentsSubmission{
+		Deployments: []Deployment{{
+			DeploymentSequenceNumber: 100,
+			UpdateSequenceNumber:     1,
+			Associations: []DeploymentAssociation{
+				{AssociationType: DeploymentAssociationTypeIssueIDOrKeys, Values: []string{"ABC-123"}},
+				{AssociationType: DeploymentAssociationTypeCommit, Commits: []DeploymentCommit{{CommitHash: "a7727ee6350c", RepositoryID: "5689"}}},
+			},
+			DisplayName: "Deployment number 16 of Data Depot",
+			State:       DeploymentStateSuccessful,
+			Pipeline:    &DeploymentPipeline{ID: "e9c906a7-451f-4fa6-ae1a-c389e2e2d87c", DisplayName: "Data Depot Deployment"},
+			Environment: &DeploymentEnvironment{ID: "8ec94d72-a4fc-4ac0-b31d-c5a595f373ba", DisplayName: "US East", Type: DeploymentEnvironmentTypeProduction},
+		}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil {
+		t.Fatal("Expected result. Result is nil")
+	}
+	if len(result.AcceptedDeployments) != 1 || result.AcceptedDeployments[0].DeploymentSequenceNumber != 100 {
+		t.Errorf("Unexpected accepted deployments: %+v", result.AcceptedDeployments)
+	}
+	if len(result.UnknownAssociations) != 1 || result.UnknownAssociations[0].Values[0] != "ABC-404" {
+		t.Errorf("Unexpected unknown associations: %+v", result.UnknownAssociations)
+	}
+}
+
+func TestDeploymentService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/pipelines/pipe/environments/env/deployments/100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"deploymentSequenceNumber":100,"updateSequenceNumber":1,"associations":[{"associationType":"commit","values":[{"commitHash":"a7727ee6350c","repositoryId":"5689"}]}],"displayName":"Deployment 16","url":"https://cd.example.com/16","description":"The bits are being transferred","lastUpdated":"2018-01-20T23:27:25.000Z","state":"in_progress","pipeline":{"id":"pipe","displayName":"Data Depot Deployment","url":"https://cd.example.com/pipe"},"environment":{"id":"env","displayName":"US East","type":"staging"}}`)
+	})
+
+	deployment, _, err := testClient.Deployment.Get(context.Background(), DeploymentKey{PipelineID: "pipe", EnvironmentID: "env", DeploymentSequenceNumber: 100})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if deployment == nil {
+		t.Fatal("Expected deployment. Deployment is nil")
+	}
+	if deployment.State != DeploymentStateInProgress || deployment.Environment.Type != DeploymentEnvironmentTypeStaging {
+		t.Errorf("Unexpected deployment: %+v", deployment)
+	}
+	if len(deployment.Associations) != 1 || len(deployment.Associations[0].Commits) != 1 || deployment.Associations[0].Commits[0].RepositoryID != "5689" {
+		t.Errorf("Unexpected associations: %+v", deployment.Associations)
+	}
+}
+
+func TestDeploymentService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/pipelines/pipe/environments/env/deployments/100"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Deployment.Delete(context.Background(), DeploymentKey{PipelineID: "pipe", EnvironmentID: "env", DeploymentSequenceNumber: 100})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDeploymentService_DeleteByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/deployments/0.1/bulkByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234"})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.Deployment.DeleteByProperties(context.Background(), map[string]string{"accountId": "account-234"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
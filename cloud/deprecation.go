@@ -0,0 +1,83 @@
+package cloud
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Deprecation is the deprecation information Jira sends with the responses of deprecated endpoints
+// in the Deprecation, Sunset, Link and Warning headers.
+type Deprecation struct {
+	// Deprecated reports whether the Deprecation header is set.
+	Deprecated bool
+	// Date is the date the endpoint is or was deprecated at, zero if Jira only marks it as deprecated.
+	Date time.Time
+	// Sunset is the date the endpoint will be removed at, zero if unknown.
+	Sunset time.Time
+	// Link is the link to the deprecation or sunset notice, if any.
+	Link string
+	// Warnings are the texts of the Warning headers.
+	Warnings []string
+}
+
+// parseDeprecation returns the deprecation information of the headers h, nil if there is none.
+func parseDeprecation(h http.Header) *Deprecation {
+	deprecation, sunset, warnings := h.Get("Deprecation"), h.Get("Sunset"), h.Values("Warning")
+	if deprecation == "" && sunset == "" && len(warnings) == 0 {
+		return nil
+	}
+
+	d := &Deprecation{Deprecated: deprecation != ""}
+	if deprecation != "" && !strings.EqualFold(deprecation, "true") {
+		d.Date = parseDeprecationDate(deprecation)
+	}
+	if sunset != "" {
+		d.Sunset, _ = http.ParseTime(sunset)
+	}
+	for _, w := range warnings {
+		d.Warnings = append(d.Warnings, warningText(w))
+	}
+	d.Link = deprecationLink(h.Values("Link"))
+	return d
+}
+
+// parseDeprecationDate parses the Deprecation header, an HTTP date or a Unix timestamp like "@1688169599".
+func parseDeprecationDate(s string) time.Time {
+	if sec, ok := strings.CutPrefix(s, "@"); ok {
+		if n, err := strconv.ParseInt(sec, 10, 64); err == nil {
+			return time.Unix(n, 0).UTC()
+		}
+		return time.Time{}
+	}
+	t, _ := http.ParseTime(s)
+	return t
+}
+
+// warningText returns the quoted text of a Warning header like `299 - "Deprecated API"`.
+func warningText(w string) string {
+	start := strings.IndexByte(w, '"')
+	end := strings.LastIndexByte(w, '"')
+	if start < 0 || end <= start {
+		return strings.TrimSpace(w)
+	}
+	return w[start+1 : end]
+}
+
+// deprecationLink returns the target of the Link header with the relation type "deprecation" or "sunset".
+func deprecationLink(links []string) string {
+	for _, header := range links {
+		for _, link := range strings.Split(header, ",") {
+			target, params, _ := strings.Cut(link, ";")
+			for _, p := range strings.Split(params, ";") {
+				key, value, _ := strings.Cut(strings.TrimSpace(p), "=")
+				value = strings.Trim(value, `"`)
+				if strings.EqualFold(key, "rel") && (value == "deprecation" || value == "sunset") {
+					return strings.Trim(strings.TrimSpace(target), "<>")
+				}
+			}
+		}
+	}
+	return ""
+}
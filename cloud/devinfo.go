@@ -0,0 +1,276 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DevInfoService handles development information (repositories, commits, branches and pull requests)
+// of the development panel for the Jira instance / API.
+// The development information endpoints can only be used by Connect or OAuth 2.0 apps with the development information module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/
+type DevInfoService service
+
+const (
+	DevInfoEntityTypeCommit      = "commit"
+	DevInfoEntityTypeBranch      = "branch"
+	DevInfoEntityTypePullRequest = "pull_request"
+)
+
+const (
+	DevInfoPullRequestStatusOpen     = "OPEN"
+	DevInfoPullRequestStatusMerged   = "MERGED"
+	DevInfoPullRequestStatusDeclined = "DECLINED"
+	DevInfoPullRequestStatusUnknown  = "UNKNOWN"
+)
+
+// DevInfoRepository represents a repository with the commits, branches and pull requests linked to issues.
+type DevInfoRepository struct {
+	ID                string               `json:"id" structs:"id"`
+	Name              string               `json:"name" structs:"name"`
+	Description       string               `json:"description,omitempty" structs:"description,omitempty"`
+	ForkOf            string               `json:"forkOf,omitempty" structs:"forkOf,omitempty"`
+	URL               string               `json:"url" structs:"url"`
+	Commits           []DevInfoCommit      `json:"commits,omitempty" structs:"commits,omitempty"`
+	Branches          []DevInfoBranch      `json:"branches,omitempty" structs:"branches,omitempty"`
+	PullRequests      []DevInfoPullRequest `json:"pullRequests,omitempty" structs:"pullRequests,omitempty"`
+	Avatar            string               `json:"avatar,omitempty" structs:"avatar,omitempty"`
+	AvatarDescription string               `json:"avatarDescription,omitempty" structs:"avatarDescription,omitempty"`
+	UpdateSequenceID  int64                `json:"updateSequenceId" structs:"updateSequenceId"`
+}
+
+// DevInfoCommit represents a commit linked to issues.
+// Flags can contain "MERGE_COMMIT".
+type DevInfoCommit struct {
+	ID               string         `json:"id" structs:"id"`
+	IssueKeys        []string       `json:"issueKeys" structs:"issueKeys"`
+	UpdateSequenceID int64          `json:"updateSequenceId" structs:"updateSequenceId"`
+	Hash             string         `json:"hash" structs:"hash"`
+	Flags            []string       `json:"flags,omitempty" structs:"flags,omitempty"`
+	Message          string         `json:"message" structs:"message"`
+	Author           *DevInfoAuthor `json:"author" structs:"author"`
+	FileCount        int            `json:"fileCount" structs:"fileCount"`
+	URL              string         `json:"url" structs:"url"`
+	Files            []DevInfoFile  `json:"files,omitempty" structs:"files,omitempty"`
+	AuthorTimestamp  *time.Time     `json:"authorTimestamp" structs:"authorTimestamp"`
+	DisplayID        string         `json:"displayId" structs:"displayId"`
+}
+
+// DevInfoBranch represents a branch linked to issues.
+type DevInfoBranch struct {
+	ID                   string         `json:"id" structs:"id"`
+	IssueKeys            []string       `json:"issueKeys" structs:"issueKeys"`
+	Name                 string         `json:"name" structs:"name"`
+	LastCommit           *DevInfoCommit `json:"lastCommit" structs:"lastCommit"`
+	CreatePullRequestURL string         `json:"createPullRequestUrl,omitempty" structs:"createPullRequestUrl,omitempty"`
+	URL                  string         `json:"url" structs:"url"`
+	UpdateSequenceID     int64          `json:"updateSequenceId" structs:"updateSequenceId"`
+}
+
+// DevInfoPullRequest represents a pull request linked to issues.
+// Status is one of the DevInfoPullRequestStatus* constants.
+type DevInfoPullRequest struct {
+	ID                   string            `json:"id" structs:"id"`
+	IssueKeys            []string          `json:"issueKeys" structs:"issueKeys"`
+	UpdateSequenceID     int64             `json:"updateSequenceId" structs:"updateSequenceId"`
+	Status               string            `json:"status" structs:"status"`
+	Title                string            `json:"title" structs:"title"`
+	Author               *DevInfoAuthor    `json:"author,omitempty" structs:"author,omitempty"`
+	CommentCount         int               `json:"commentCount" structs:"commentCount"`
+	SourceBranch         string            `json:"sourceBranch" structs:"sourceBranch"`
+	SourceBranchURL      string            `json:"sourceBranchUrl,omitempty" structs:"sourceBranchUrl,omitempty"`
+	LastUpdate           *time.Time        `json:"lastUpdate" structs:"lastUpdate"`
+	DestinationBranch    string            `json:"destinationBranch,omitempty" structs:"destinationBranch,omitempty"`
+	DestinationBranchURL string            `json:"destinationBranchUrl,omitempty" structs:"destinationBranchUrl,omitempty"`
+	Reviewers            []DevInfoReviewer `json:"reviewers,omitempty" structs:"reviewers,omitempty"`
+	URL                  string            `json:"url" structs:"url"`
+	DisplayID            string            `json:"displayId" structs:"displayId"`
+}
+
+// DevInfoAuthor is the author of a commit or pull request.
+type DevInfoAuthor struct {
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	Email    string `json:"email,omitempty" structs:"email,omitempty"`
+	Username string `json:"username,omitempty" structs:"username,omitempty"`
+	URL      string `json:"url,omitempty" structs:"url,omitempty"`
+	Avatar   string `json:"avatar,omitempty" structs:"avatar,omitempty"`
+}
+
+// DevInfoFile is a file changed in a commit.
+// ChangeType can take the values ADDED, COPIED, DELETED, MODIFIED, MOVED and UNKNOWN.
+type DevInfoFile struct {
+	Path         string `json:"path" structs:"path"`
+	URL          string `json:"url" structs:"url"`
+	ChangeType   string `json:"changeType" structs:"changeType"`
+	LinesAdded   int    `json:"linesAdded" structs:"linesAdded"`
+	LinesRemoved int    `json:"linesRemoved" structs:"linesRemoved"`
+}
+
+// DevInfoReviewer is a reviewer of a pull request.
+// ApprovalStatus can take the values APPROVED, NEEDSWORK and UNAPPROVED.
+type DevInfoReviewer struct {
+	Name           string `json:"name,omitempty" structs:"name,omitempty"`
+	ApprovalStatus string `json:"approvalStatus,omitempty" structs:"approvalStatus,omitempty"`
+	URL            string `json:"url,omitempty" structs:"url,omitempty"`
+	Avatar         string `json:"avatar,omitempty" structs:"avatar,omitempty"`
+	Email          string `json:"email,omitempty" structs:"email,omitempty"`
+	AccountID      string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+}
+
+// DevInfoSubmission is the payload of DevInfoService.Submit.
+// Properties can be used to delete development information with DevInfoService.DeleteByProperties later on.
+// If PreventTransitions is set, no issue transitions are triggered by the submitted data.
+type DevInfoSubmission struct {
+	Repositories       []DevInfoRepository      `json:"repositories" structs:"repositories"`
+	PreventTransitions bool                     `json:"preventTransitions,omitempty" structs:"preventTransitions,omitempty"`
+	OperationType      string                   `json:"operationType,omitempty" structs:"operationType,omitempty"`
+	Properties         map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	ProviderMetadata   *DevInfoProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// DevInfoSubmissionResult is the result of DevInfoService.Submit.
+// The maps are keyed by repository ID.
+type DevInfoSubmissionResult struct {
+	AcceptedDevinfoEntities map[string]DevInfoAcceptedEntities `json:"acceptedDevinfoEntities,omitempty" structs:"acceptedDevinfoEntities,omitempty"`
+	FailedDevinfoEntities   map[string]DevInfoFailedEntities   `json:"failedDevinfoEntities,omitempty" structs:"failedDevinfoEntities,omitempty"`
+	UnknownIssueKeys        []string                           `json:"unknownIssueKeys,omitempty" structs:"unknownIssueKeys,omitempty"`
+}
+
+// DevInfoAcceptedEntities lists the IDs of the accepted entities of a repository.
+type DevInfoAcceptedEntities struct {
+	Commits      []string `json:"commits,omitempty" structs:"commits,omitempty"`
+	Branches     []string `json:"branches,omitempty" structs:"branches,omitempty"`
+	PullRequests []string `json:"pullRequests,omitempty" structs:"pullRequests,omitempty"`
+}
+
+// DevInfoFailedEntities lists the errors of a repository and its rejected entities.
+type DevInfoFailedEntities struct {
+	Errors       []DevInfoError        `json:"errors,omitempty" structs:"errors,omitempty"`
+	Commits      []DevInfoFailedEntity `json:"commits,omitempty" structs:"commits,omitempty"`
+	Branches     []DevInfoFailedEntity `json:"branches,omitempty" structs:"branches,omitempty"`
+	PullRequests []DevInfoFailedEntity `json:"pullRequests,omitempty" structs:"pullRequests,omitempty"`
+}
+
+// DevInfoFailedEntity is a rejected commit, branch or pull request, along with the reasons.
+type DevInfoFailedEntity struct {
+	ID     string         `json:"id" structs:"id"`
+	Errors []DevInfoError `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// Submit stores development information (repositories, commits, branches, pull requests).
+// Entities are only updated if the UpdateSequenceID is higher than the stored one.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-bulk-post
+func (s *DevInfoService) Submit(ctx context.Context, devInfo *DevInfoSubmission) (*DevInfoSubmissionResult, *Response, error) {
+	apiEndpoint := "rest/devinfo/0.10/bulk"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, devInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(DevInfoSubmissionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// GetRepository returns the repository with the given ID, including its linked commits, branches and pull requests.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-repository-repositoryid-get
+func (s *DevInfoService) GetRepository(ctx context.Context, repositoryID string) (*DevInfoRepository, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/devinfo/0.10/repository/%s", url.PathEscape(repositoryID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repository := new(DevInfoRepository)
+	resp, err := s.client.Do(req, repository)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return repository, resp, nil
+}
+
+// DeleteRepository deletes the repository with the given ID and all its linked entities.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-repository-repositoryid-delete
+// Caller must close resp.Body
+func (s *DevInfoService) DeleteRepository(ctx context.Context, repositoryID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/devinfo/0.10/repository/%s", url.PathEscape(repositoryID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteEntity deletes a single commit, branch or pull request of a repository.
+// entityType is one of the DevInfoEntityType* constants.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-repository-repositoryid-entitytype-entityid-delete
+// Caller must close resp.Body
+func (s *DevInfoService) DeleteEntity(ctx context.Context, repositoryID, entityType, entityID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/devinfo/0.10/repository/%s/%s/%s", url.PathEscape(repositoryID), url.PathEscape(entityType), url.PathEscape(entityID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteByProperties deletes all development information that was submitted with all of the given properties.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-bulkbyproperties-delete
+// Caller must close resp.Body
+func (s *DevInfoService) DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	return deleteDevInfoByProperties(ctx, s.client, "rest/devinfo/0.10/bulkByProperties", properties)
+}
+
+// ExistsByProperties checks whether development information was submitted with all of the given properties.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-development-information/#api-rest-devinfo-0-10-existsbyproperties-get
+func (s *DevInfoService) ExistsByProperties(ctx context.Context, properties map[string]string) (bool, *Response, error) {
+	query := url.Values{}
+	for key, value := range properties {
+		query.Set(key, value)
+	}
+	apiEndpoint := "rest/devinfo/0.10/existsByProperties?" + query.Encode()
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	result := new(struct {
+		HasDataMatchingProperties bool `json:"hasDataMatchingProperties"`
+	})
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return false, resp, NewJiraError(resp, err)
+	}
+
+	return result.HasDataMatchingProperties, resp, nil
+}
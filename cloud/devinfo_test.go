@@ -0,0 +1,164 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestDevInfoService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/bulk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload DevInfoSubmission
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if !payload.PreventTransitions {
+			t.Errorf("Expected preventTransitions to be set")
+		}
+		if len(payload.Repositories) != 1 || len(payload.Repositories[0].Commits) != 1 || payload.Repositories[0].Commits[0].Hash != "a7727ee6350c" {
+			t.Fatalf("Unexpected repositories: %+v", payload.Repositories)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedDevinfoEntities":{"c6c7c8c9":{"commits":["a7727ee6350c"],"branches":[],"pullRequests":[]}},"failedDevinfoEntities":{"c6c7c8c9":{"pullRequests":[{"id":"pr-1","errors":[{"message":"Missing title"}]}]}},"unknownIssueKeys":["ISSUE-404"]}`)
+	})
+
+	result, _, err := testClient.DevInfo.Submit(context.Background(), &DevInfoSubmission{
+		Repositories: []DevInfoRepository{{
+			ID:               "c6c7c8c9",
+			Name:             "atlassian-connect-jira-example",
+			URL:              "https://bitbucket.org/atlassianlabs/atlassian-connect-jira-example",
+			UpdateSequenceID: 1,
+			Commits: []DevInfoCommit{{
+				ID:               "a7727ee6350c",
+				IssueKeys:        []string{"ISSUE-123"},
+				UpdateSequenceID: 1,
+				Hash:             "a7727ee6350c",
+				Message:          "ISSUE-123 fix the bug",
+				Author:           &DevInfoAuthor{Name: "Jane Doe"},
+				DisplayID:        "a7727ee",
+			}},
+		}},
+		PreventTransitions: true,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil {
+		t.Fatal("Expected result. Result is nil")
+	}
+	if accepted := result.AcceptedDevinfoEntities["c6c7c8c9"]; len(accepted.Commits) != 1 {
+		t.Errorf("Unexpected accepted entities: %+v", result.AcceptedDevinfoEntities)
+	}
+	if failed := result.FailedDevinfoEntities["c6c7c8c9"]; len(failed.PullRequests) != 1 || failed.PullRequests[0].Errors[0].Message != "Missing title" {
+		t.Errorf("Unexpected failed entities: %+v", result.FailedDevinfoEntities)
+	}
+}
+
+func TestDevInfoService_GetRepository(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/repository/c6c7c8c9"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"c6c7c8c9","name":"atlassian-connect-jira-example","url":"https://bitbucket.org/atlassianlabs/atlassian-connect-jira-example","updateSequenceId":1523494301448,"branches":[{"id":"c6c7c8c9ea","issueKeys":["ISSUE-123"],"name":"feature/ISSUE-123","url":"https://bitbucket.org/branch","updateSequenceId":1}],"pullRequests":[{"id":"pr-1","issueKeys":["ISSUE-123"],"updateSequenceId":1,"status":"MERGED","title":"ISSUE-123 fix","commentCount":3,"sourceBranch":"feature/ISSUE-123","lastUpdate":"2016-10-31T23:27:25.000Z","reviewers":[{"name":"Jane Doe","approvalStatus":"APPROVED"}],"url":"https://bitbucket.org/pr/1","displayId":"#1"}]}`)
+	})
+
+	repository, _, err := testClient.DevInfo.GetRepository(context.Background(), "c6c7c8c9")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if repository == nil {
+		t.Fatal("Expected repository. Repository is nil")
+	}
+	if len(repository.Branches) != 1 || repository.Branches[0].Name != "feature/ISSUE-123" {
+		t.Errorf("Unexpected branches: %+v", repository.Branches)
+	}
+	if len(repository.PullRequests) != 1 || repository.PullRequests[0].Status != DevInfoPullRequestStatusMerged || repository.PullRequests[0].Reviewers[0].ApprovalStatus != "APPROVED" {
+		t.Errorf("Unexpected pull requests: %+v", repository.PullRequests)
+	}
+}
+
+func TestDevInfoService_DeleteRepository(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/repository/c6c7c8c9"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.DevInfo.DeleteRepository(context.Background(), "c6c7c8c9")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDevInfoService_DeleteEntity(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/repository/c6c7c8c9/pull_request/pr-1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.DevInfo.DeleteEntity(context.Background(), "c6c7c8c9", DevInfoEntityTypePullRequest, "pr-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDevInfoService_DeleteByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/bulkByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234"})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.DevInfo.DeleteByProperties(context.Background(), map[string]string{"accountId": "account-234"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestDevInfoService_ExistsByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/devinfo/0.10/existsByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234"})
+		fmt.Fprint(w, `{"hasDataMatchingProperties":true}`)
+	})
+
+	exists, _, err := testClient.DevInfo.ExistsByProperties(context.Background(), map[string]string{"accountId": "account-234"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !exists {
+		t.Error("Expected data matching the properties to exist")
+	}
+}
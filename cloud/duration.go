@@ -0,0 +1,187 @@
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeTrackingConfiguration represents the time tracking settings of the Jira instance.
+// The working hours per day and days per week define the length of the "d" and "w" units of durations.
+type TimeTrackingConfiguration struct {
+	WorkingHoursPerDay float64 `json:"workingHoursPerDay" structs:"workingHoursPerDay"`
+	WorkingDaysPerWeek float64 `json:"workingDaysPerWeek" structs:"workingDaysPerWeek"`
+	// TimeFormat is the format of durations in the UI: "pretty", "days" or "hours".
+	TimeFormat string `json:"timeFormat,omitempty" structs:"timeFormat,omitempty"`
+	// DefaultUnit is the unit of durations entered without unit: "minute", "hour", "day" or "week".
+	DefaultUnit string `json:"defaultUnit,omitempty" structs:"defaultUnit,omitempty"`
+}
+
+// DefaultTimeTrackingConfiguration is the default time tracking configuration of Jira:
+// 8 working hours per day, 5 working days per week and minutes as default unit.
+var DefaultTimeTrackingConfiguration = TimeTrackingConfiguration{
+	WorkingHoursPerDay: 8,
+	WorkingDaysPerWeek: 5,
+	TimeFormat:         "pretty",
+	DefaultUnit:        "minute",
+}
+
+// durationUnits returns the lengths of the units of durations like "1w 2d 3h 4m".
+func (c *TimeTrackingConfiguration) durationUnits() map[string]time.Duration {
+	if c == nil {
+		c = &DefaultTimeTrackingConfiguration
+	}
+	hoursPerDay, daysPerWeek := c.WorkingHoursPerDay, c.WorkingDaysPerWeek
+	if hoursPerDay <= 0 {
+		hoursPerDay = DefaultTimeTrackingConfiguration.WorkingHoursPerDay
+	}
+	if daysPerWeek <= 0 {
+		daysPerWeek = DefaultTimeTrackingConfiguration.WorkingDaysPerWeek
+	}
+	day := time.Duration(hoursPerDay * float64(time.Hour))
+	return map[string]time.Duration{
+		"w": time.Duration(daysPerWeek * float64(day)),
+		"d": day,
+		"h": time.Hour,
+		"m": time.Minute,
+	}
+}
+
+// ParseDuration parses a Jira duration like "3d 4h 30m" or "1.5h".
+// The days and weeks are working days and weeks of the time tracking configuration,
+// the DefaultTimeTrackingConfiguration is used if config is nil.
+// Numbers without unit are in the default unit of the configuration.
+func ParseDuration(s string, config *TimeTrackingConfiguration) (time.Duration, error) {
+	if config == nil {
+		config = &DefaultTimeTrackingConfiguration
+	}
+	units := config.durationUnits()
+
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("jira: invalid duration %q", s)
+	}
+	var d time.Duration
+	for _, field := range fields {
+		number, unit := field, ""
+		if last := field[len(field)-1]; last < '0' || last > '9' {
+			number, unit = field[:len(field)-1], strings.ToLower(field[len(field)-1:])
+		} else {
+			unit = defaultDurationUnit(config.DefaultUnit)
+		}
+
+		length, ok := units[unit]
+		if !ok {
+			return 0, fmt.Errorf("jira: invalid unit %q in duration %q", unit, s)
+		}
+		n, err := strconv.ParseFloat(number, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("jira: invalid duration %q", s)
+		}
+		d += time.Duration(math.Round(n * float64(length)))
+	}
+	return d, nil
+}
+
+func defaultDurationUnit(unit string) string {
+	switch unit {
+	case "week":
+		return "w"
+	case "day":
+		return "d"
+	case "hour":
+		return "h"
+	default:
+		return "m"
+	}
+}
+
+// FormatDuration formats d as a Jira duration like "1w 2d 4h 30m".
+// The days and weeks are working days and weeks of the time tracking configuration,
+// the DefaultTimeTrackingConfiguration is used if config is nil.
+// Seconds are truncated, as Jira tracks minutes.
+func FormatDuration(d time.Duration, config *TimeTrackingConfiguration) string {
+	units := config.durationUnits()
+
+	var parts []string
+	for _, unit := range []string{"w", "d", "h", "m"} {
+		if n := d / units[unit]; n > 0 {
+			parts = append(parts, strconv.FormatInt(int64(n), 10)+unit)
+			d -= n * units[unit]
+		}
+	}
+	if len(parts) == 0 {
+		return "0m"
+	}
+	return strings.Join(parts, " ")
+}
+
+// Duration is a duration of time tracking, like the time spent of a worklog.
+// It is encoded as number of seconds, like the "timeSpentSeconds" of worklogs.
+// Jira duration strings like "3d 4h 30m" are decoded with the DefaultTimeTrackingConfiguration.
+type Duration time.Duration
+
+// String formats the duration like "1w 2d 4h 30m" with the DefaultTimeTrackingConfiguration.
+func (d Duration) String() string {
+	return FormatDuration(time.Duration(d), nil)
+}
+
+// MarshalJSON encodes the duration as number of seconds.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(time.Duration(d)/time.Second), 10)), nil
+}
+
+// UnmarshalJSON decodes a number of seconds or a Jira duration string.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		parsed, err := ParseDuration(s, nil)
+		if err != nil {
+			return err
+		}
+		*d = Duration(parsed)
+		return nil
+	}
+
+	var seconds float64
+	if err := json.Unmarshal(b, &seconds); err != nil {
+		return err
+	}
+	*d = Duration(time.Duration(seconds * float64(time.Second)))
+	return nil
+}
+
+// OriginalEstimateDuration returns the original estimate as time.Duration.
+func (t *TimeTracking) OriginalEstimateDuration() time.Duration {
+	return time.Duration(t.OriginalEstimateSeconds) * time.Second
+}
+
+// RemainingEstimateDuration returns the remaining estimate as time.Duration.
+func (t *TimeTracking) RemainingEstimateDuration() time.Duration {
+	return time.Duration(t.RemainingEstimateSeconds) * time.Second
+}
+
+// TimeSpentDuration returns the time spent as time.Duration.
+func (t *TimeTracking) TimeSpentDuration() time.Duration {
+	return time.Duration(t.TimeSpentSeconds) * time.Second
+}
+
+// TimeSpentDuration returns the time spent of the worklog as time.Duration.
+func (w *WorklogRecord) TimeSpentDuration() time.Duration {
+	return time.Duration(w.TimeSpentSeconds) * time.Second
+}
+
+// SetTimeSpent sets the time spent of the worklog to d, truncated to minutes, e.g. to add a worklog.
+func (w *WorklogRecord) SetTimeSpent(d time.Duration) {
+	w.TimeSpentSeconds = int(d.Truncate(time.Minute) / time.Second)
+	w.TimeSpent = ""
+}
@@ -0,0 +1,106 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"3d 4h 30m": 3*8*time.Hour + 4*time.Hour + 30*time.Minute,
+		"1w":        5 * 8 * time.Hour,
+		"1.5h":      90 * time.Minute,
+		"45":        45 * time.Minute,
+		"2H 5M":     2*time.Hour + 5*time.Minute,
+	} {
+		got, err := ParseDuration(in, nil)
+		if err != nil || got != want {
+			t.Errorf("ParseDuration(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+
+	for _, in := range []string{"", "3x", "h", "-1h"} {
+		if _, err := ParseDuration(in, nil); err == nil {
+			t.Errorf("Expected an error for %q", in)
+		}
+	}
+}
+
+func TestParseDuration_WorkingHours(t *testing.T) {
+	config := &TimeTrackingConfiguration{WorkingHoursPerDay: 6, WorkingDaysPerWeek: 4, DefaultUnit: "hour"}
+	got, err := ParseDuration("1w 1d 2", config)
+	if want := 4*6*time.Hour + 6*time.Hour + 2*time.Hour; err != nil || got != want {
+		t.Errorf("ParseDuration = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	d := 5*8*time.Hour + 2*8*time.Hour + 4*time.Hour + 30*time.Minute + 10*time.Second
+	if got := FormatDuration(d, nil); got != "1w 2d 4h 30m" {
+		t.Errorf("FormatDuration = %q", got)
+	}
+	if got := FormatDuration(24*time.Hour, &TimeTrackingConfiguration{WorkingHoursPerDay: 24, WorkingDaysPerWeek: 7}); got != "1d" {
+		t.Errorf("FormatDuration with 24h days = %q", got)
+	}
+	if got := FormatDuration(30*time.Second, nil); got != "0m" {
+		t.Errorf("FormatDuration of seconds = %q", got)
+	}
+}
+
+func TestDuration_JSON(t *testing.T) {
+	var v struct {
+		Seconds Duration `json:"seconds"`
+		String  Duration `json:"string"`
+	}
+	if err := json.Unmarshal([]byte(`{"seconds":12000,"string":"3h 20m"}`), &v); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if time.Duration(v.Seconds) != 200*time.Minute || v.String != v.Seconds {
+		t.Errorf("Unexpected durations %v and %v", v.Seconds, v.String)
+	}
+	if v.Seconds.String() != "3h 20m" {
+		t.Errorf("Unexpected string %q", v.Seconds.String())
+	}
+
+	data, _ := json.Marshal(v)
+	if string(data) != `{"seconds":12000,"string":12000}` {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+}
+
+func TestWorklogRecord_TimeSpentDuration(t *testing.T) {
+	w := &WorklogRecord{}
+	w.SetTimeSpent(90*time.Minute + 30*time.Second)
+	if w.TimeSpentSeconds != 5400 || w.TimeSpentDuration() != 90*time.Minute {
+		t.Errorf("Unexpected time spent %d", w.TimeSpentSeconds)
+	}
+
+	tt := &TimeTracking{OriginalEstimateSeconds: 600, RemainingEstimateSeconds: 200, TimeSpentSeconds: 400}
+	if tt.OriginalEstimateDuration() != 10*time.Minute || tt.RemainingEstimateDuration() != 200*time.Second || tt.TimeSpentDuration() != 400*time.Second {
+		t.Errorf("Unexpected durations of %+v", tt)
+	}
+}
+
+func TestConfigurationService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/configuration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"votingEnabled":true,"watchingEnabled":true,"timeTrackingEnabled":true,"timeTrackingConfiguration":{"workingHoursPerDay":7.5,"workingDaysPerWeek":5,"timeFormat":"pretty","defaultUnit":"hour"}}`)
+	})
+
+	config, _, err := testClient.Configuration.Get(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !config.TimeTrackingEnabled || config.TimeTrackingConfiguration == nil || config.TimeTrackingConfiguration.WorkingHoursPerDay != 7.5 {
+		t.Errorf("Unexpected configuration %+v", config)
+	}
+	if got := FormatDuration(15*time.Hour, config.TimeTrackingConfiguration); got != "2d" {
+		t.Errorf("Expected 2 working days, got %q", got)
+	}
+}
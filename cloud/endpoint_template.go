@@ -0,0 +1,36 @@
+package cloud
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	issueKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]+-[0-9]+$`)
+	numberPattern   = regexp.MustCompile(`^[0-9]+$`)
+	// opaqueIDPattern matches account IDs, UUIDs and other generated identifiers.
+	opaqueIDPattern = regexp.MustCompile(`^([0-9a-f]{24,}|[0-9]+:[0-9a-f-]{36}|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`)
+)
+
+// EndpointTemplate returns the path of u with issue keys and identifiers replaced by placeholders,
+// e.g. /rest/api/2/issue/{key}/comment/{id} for /rest/api/2/issue/PRJ-1/comment/10000.
+// The query is dropped.
+//
+// It has a low cardinality, so it can be used as span name or metric label.
+func EndpointTemplate(u *url.URL) string {
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		// Keep the API version, e.g. rest/api/2
+		if i > 0 && segments[i-1] == "api" {
+			continue
+		}
+		switch {
+		case issueKeyPattern.MatchString(segment):
+			segments[i] = "{key}"
+		case numberPattern.MatchString(segment), opaqueIDPattern.MatchString(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
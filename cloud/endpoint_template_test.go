@@ -0,0 +1,23 @@
+package cloud
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/2/issue/PRJ-123/comment/10000?expand=renderedBody": "/rest/api/2/issue/{key}/comment/{id}",
+		"/rest/agile/1.0/board/42/sprint":                             "/rest/agile/1.0/board/{id}/sprint",
+		"/rest/api/3/user?accountId=5b10ac8d82e05b22cc7d4ef5":         "/rest/api/3/user",
+		"/rest/api/3/user/5b10ac8d82e05b22cc7d4ef5/columns":           "/rest/api/3/user/{id}/columns",
+		"/rest/api/3/project/PRJ":                                     "/rest/api/3/project/PRJ",
+		"/rest/api/2/myself":                                          "/rest/api/2/myself",
+	}
+	for raw, want := range tests {
+		u, _ := url.Parse(raw)
+		if got := EndpointTemplate(u); got != want {
+			t.Errorf("Expected %s for %s, got %s", want, raw, got)
+		}
+	}
+}
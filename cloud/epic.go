@@ -0,0 +1,161 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EpicService handles epics in the Jira Agile API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/
+type EpicService service
+
+// EpicUpdate represents the fields of an epic that can be updated with EpicService.Update.
+// Fields that are not set are left unchanged.
+type EpicUpdate struct {
+	Name    string     `json:"name,omitempty" structs:"name,omitempty"`
+	Summary string     `json:"summary,omitempty" structs:"summary,omitempty"`
+	Color   *EpicColor `json:"color,omitempty" structs:"color,omitempty"`
+	Done    *bool      `json:"done,omitempty" structs:"done,omitempty"`
+}
+
+// EpicRank represents the position an epic is ranked to with EpicService.Rank.
+// Either RankBeforeEpic or RankAfterEpic must be set.
+type EpicRank struct {
+	RankBeforeEpic    string `json:"rankBeforeEpic,omitempty" structs:"rankBeforeEpic,omitempty"`
+	RankAfterEpic     string `json:"rankAfterEpic,omitempty" structs:"rankAfterEpic,omitempty"`
+	RankCustomFieldID int64  `json:"rankCustomFieldId,omitempty" structs:"rankCustomFieldId,omitempty"`
+}
+
+// Get returns the epic for a given epic ID or key.
+// This epic will only be returned if the user has permission to view it.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-epicidorkey-get
+func (s *EpicService) Get(ctx context.Context, epicIDOrKey string) (*Epic, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s", url.PathEscape(epicIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epic := new(Epic)
+	resp, err := s.client.Do(req, epic)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return epic, resp, nil
+}
+
+// Update performs a partial update of the epic.
+// Only the fields set in update are changed.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-epicidorkey-post
+func (s *EpicService) Update(ctx context.Context, epicIDOrKey string, update *EpicUpdate) (*Epic, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s", url.PathEscape(epicIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epic := new(Epic)
+	resp, err := s.client.Do(req, epic)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return epic, resp, nil
+}
+
+// Rank moves (ranks) an epic before or after a given epic.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-epicidorkey-rank-put
+// Caller must close resp.Body
+func (s *EpicService) Rank(ctx context.Context, epicIDOrKey string, rank *EpicRank) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/rank", url.PathEscape(epicIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, rank)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetIssues returns all issues that belong to the epic, for the given epic ID or key.
+// This only includes issues that the user has permission to view.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-epicidorkey-issue-get
+func (s *EpicService) GetIssues(ctx context.Context, epicIDOrKey string, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/epic/%s/issue", url.PathEscape(epicIDOrKey)), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := new(AgileIssueList)
+	resp, err := s.client.Do(req, issues)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return issues, resp, nil
+}
+
+// issuesPager returns a Pager over the issues of an epic.
+func (s *EpicService) issuesPager(epicIDOrKey string, options *AgileIssueListOptions, pager *PagerOptions) *Pager[Issue] {
+	return agileIssuesPager(options, pager, func(ctx context.Context, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+		return s.GetIssues(ctx, epicIDOrKey, options)
+	})
+}
+
+// MoveIssues moves issues to an epic, for a given epic ID or key.
+// Issues can be only in a single epic at the same time, so the issues are removed from their previous epic.
+// At most 50 issues may be moved at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-epicidorkey-issue-post
+// Caller must close resp.Body
+func (s *EpicService) MoveIssues(ctx context.Context, epicIDOrKey string, issueIDs []string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/epic/%s/issue", url.PathEscape(epicIDOrKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, &IssuesWrapper{Issues: issueIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RemoveIssues removes issues from the epics they belong to.
+// At most 50 issues may be moved at once.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-epic/#api-rest-agile-1-0-epic-none-issue-post
+// Caller must close resp.Body
+func (s *EpicService) RemoveIssues(ctx context.Context, issueIDs []string) (*Response, error) {
+	apiEndpoint := "rest/agile/1.0/epic/none/issue"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, &IssuesWrapper{Issues: issueIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
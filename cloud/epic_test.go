@@ -0,0 +1,159 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestEpicService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EX-7"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":37,"key":"EX-7","self":"https://your-domain.atlassian.net/rest/agile/1.0/epic/23","name":"epic 1","summary":"epic 1 summary","color":{"key":"color_4"},"done":true}`)
+	})
+
+	epic, _, err := testClient.Epic.Get(context.Background(), "EX-7")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epic == nil {
+		t.Fatal("Expected epic. Epic is nil")
+	}
+	if epic.ID != 37 || !epic.Done {
+		t.Errorf("Unexpected epic: %+v", epic)
+	}
+	if epic.Color == nil || epic.Color.Key != "color_4" {
+		t.Errorf("Expected color color_4, got %+v", epic.Color)
+	}
+}
+
+func TestEpicService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload) != 2 || payload["done"] != false || payload["name"] != "epic 2" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"id":37,"key":"EX-7","name":"epic 2","summary":"epic 1 summary","done":false}`)
+	})
+
+	epic, _, err := testClient.Epic.Update(context.Background(), "37", &EpicUpdate{Name: "epic 2", Done: Bool(false)})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epic == nil || epic.Name != "epic 2" {
+		t.Errorf("Unexpected epic: %+v", epic)
+	}
+}
+
+func TestEpicService_Rank(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EX-7/rank"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload EpicRank
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.RankBeforeEpic != "EX-3" {
+			t.Errorf("Expected rankBeforeEpic EX-3, got %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Epic.Rank(context.Background(), "EX-7", &EpicRank{RankBeforeEpic: "EX-3"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EX-7/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"jql": "status = Open"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"id":"10001","key":"EX-8","fields":{"summary":"In epic"}}]}`)
+	})
+
+	issues, _, err := testClient.Epic.GetIssues(context.Background(), "EX-7", &AgileIssueListOptions{JQL: "status = Open"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "EX-8" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestEpicService_MoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/EX-7/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.Issues) != 2 || payload.Issues[1] != "EX-9" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Epic.MoveIssues(context.Background(), "EX-7", []string{"EX-8", "EX-9"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestEpicService_RemoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/epic/none/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.Issues) != 1 || payload.Issues[0] != "EX-8" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Epic.RemoveIssues(context.Background(), []string{"EX-8"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestError_NewJiraError(t *testing.T) {
@@ -204,3 +205,200 @@ func TestError_LongMessage(t *testing.T) {
 		t.Errorf("Expected the error map: Got\n%s\n", msg)
 	}
 }
+
+func TestError_Is(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tests := []struct {
+		status int
+		kind   error
+	}{
+		{http.StatusNotFound, ErrNotFound},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusTooManyRequests, ErrRateLimited},
+		{http.StatusBadRequest, ErrValidation},
+	}
+	for _, tt := range tests {
+		endpoint := fmt.Sprintf("/status/%d", tt.status)
+		status := tt.status
+		testMux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(status)
+			fmt.Fprint(w, `Something went wrong`)
+		})
+
+		req, _ := testClient.NewRequest(context.Background(), http.MethodGet, endpoint, nil)
+		resp, err := testClient.Do(req, nil)
+		err = NewJiraError(resp, err)
+		if !errors.Is(err, tt.kind) {
+			t.Errorf("Expected %v for status %d, got %v", tt.kind, tt.status, err)
+		}
+		if tt.kind != ErrNotFound && errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected status %d not to be ErrNotFound", tt.status)
+		}
+	}
+}
+
+func TestError_Validation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"summary":"You must specify a summary of the issue."}}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/rest/api/2/issue", nil)
+	resp, err := testClient.Do(req, nil)
+	err = NewJiraError(resp, err)
+
+	var jerr *Error
+	if !errors.Is(err, ErrValidation) || !errors.As(err, &jerr) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+	if jerr.Errors["summary"] != "You must specify a summary of the issue." {
+		t.Errorf("Expected the field error of summary, got %v", jerr.Errors)
+	}
+}
+
+func TestError_RateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, err := testClient.Do(req, nil)
+	err = NewJiraError(resp, err)
+
+	var jerr *Error
+	if !errors.Is(err, ErrRateLimited) || !errors.As(err, &jerr) {
+		t.Fatalf("Expected a rate limit error, got %v", err)
+	}
+	if jerr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected RetryAfter 30s, got %v", jerr.RetryAfter)
+	}
+}
+
+func TestClient_Do_ResponseError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":[],"errors":{"project":"project is required"}}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/rest/api/2/issue?os_password=secret", nil)
+	resp, err := testClient.Do(req, nil)
+
+	var respErr *ResponseError
+	if !errors.As(err, &respErr) {
+		t.Fatalf("Expected a *ResponseError, got %T", err)
+	}
+	if respErr.StatusCode != http.StatusBadRequest || respErr.Method != http.MethodPost {
+		t.Errorf("Expected POST with status 400, got %s with %d", respErr.Method, respErr.StatusCode)
+	}
+	if !strings.Contains(respErr.URL, "/rest/api/2/issue") || strings.Contains(respErr.URL, "secret") {
+		t.Errorf("Expected the redacted URL of the request, got %s", respErr.URL)
+	}
+	if !strings.Contains(string(respErr.Body), "project is required") {
+		t.Errorf("Expected the response body, got %s", respErr.Body)
+	}
+	if !strings.Contains(err.Error(), "POST") {
+		t.Errorf("Expected the method in the error message, got %s", err)
+	}
+
+	// The body can still be parsed by NewJiraError.
+	err = NewJiraError(resp, err)
+	var jerr *Error
+	if !errors.As(err, &jerr) || jerr.Errors["project"] != "project is required" {
+		t.Errorf("Expected the field errors to be parsed, got %v", err)
+	}
+	if !errors.As(err, &respErr) {
+		t.Errorf("Expected the *ResponseError to be wrapped, got %v", err)
+	}
+}
+
+func TestError_BulkElementErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"issues":[],"errors":[{"status":400,"elementErrors":{"errorMessages":[],"errors":{"issuetype":"The issue type selected is invalid."}},"failedElementNumber":1}]}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "/rest/api/2/issue/bulk", nil)
+	resp, err := testClient.Do(req, nil)
+	err = NewJiraError(resp, err)
+
+	var jerr *Error
+	if !errors.As(err, &jerr) {
+		t.Fatalf("Expected jira Error, got %v", err)
+	}
+	if len(jerr.ElementErrors) != 1 {
+		t.Fatalf("Expected 1 element error, got %d", len(jerr.ElementErrors))
+	}
+	el := jerr.ElementErrors[0]
+	if el.Status != http.StatusBadRequest || el.FailedElementNumber != 1 || el.Errors["issuetype"] != "The issue type selected is invalid." {
+		t.Errorf("Unexpected element error %+v", el)
+	}
+	if !strings.Contains(err.Error(), "element 1: issuetype - The issue type selected is invalid.") {
+		t.Errorf("Expected the element error in the message, got %s", err)
+	}
+}
+
+func TestError_WarningMessages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":["The value 'XYZ' does not exist for the field 'project'."],"warningMessages":["The field 'foo' is deprecated."],"errors":{"count":1}}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, err := testClient.Do(req, nil)
+	err = NewJiraError(resp, err)
+
+	var jerr *Error
+	if !errors.As(err, &jerr) {
+		t.Fatalf("Expected jira Error, got %v", err)
+	}
+	if len(jerr.WarningMessages) != 1 || jerr.Errors["count"] != "1" {
+		t.Errorf("Unexpected warnings %v or errors %v", jerr.WarningMessages, jerr.Errors)
+	}
+	if !strings.Contains(jerr.LongError(), "Warnings:\n - The field 'foo' is deprecated.") {
+		t.Errorf("Expected the warnings in the long error, got %s", jerr.LongError())
+	}
+}
+
+func TestError_HTMLErrorPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>\n<head><title>502 Bad Gateway</title></head>\n<body><center><h1>502 Bad Gateway</h1></center><hr><center>nginx</center></body>\n</html>")
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, err := testClient.Do(req, nil)
+	err = NewJiraError(resp, err)
+
+	msg := err.Error()
+	if !strings.Contains(msg, "502 Bad Gateway: 502 Bad Gateway:") || strings.Contains(msg, "<") {
+		t.Errorf("Expected the title of the HTML page, got %s", msg)
+	}
+}
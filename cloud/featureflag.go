@@ -0,0 +1,149 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// FeatureFlagService handles feature flag information of the development panel for the Jira instance / API.
+// The feature flag provider endpoints can only be used by Connect or OAuth 2.0 apps with the feature flag module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-feature-flags/
+type FeatureFlagService service
+
+// FeatureFlag represents a feature flag shown in the development panel of issues.
+type FeatureFlag struct {
+	SchemaVersion    string               `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	ID               string               `json:"id" structs:"id"`
+	Key              string               `json:"key" structs:"key"`
+	UpdateSequenceID int64                `json:"updateSequenceId" structs:"updateSequenceId"`
+	DisplayName      string               `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	IssueKeys        []string             `json:"issueKeys" structs:"issueKeys"`
+	Summary          *FeatureFlagSummary  `json:"summary" structs:"summary"`
+	Details          []FeatureFlagDetails `json:"details" structs:"details"`
+}
+
+// FeatureFlagSummary summarizes the state of a feature flag across all environments.
+type FeatureFlagSummary struct {
+	URL         string             `json:"url,omitempty" structs:"url,omitempty"`
+	Status      *FeatureFlagStatus `json:"status" structs:"status"`
+	LastUpdated *time.Time         `json:"lastUpdated" structs:"lastUpdated"`
+}
+
+// FeatureFlagDetails is the state of a feature flag in a single environment.
+type FeatureFlagDetails struct {
+	URL         string                  `json:"url" structs:"url"`
+	LastUpdated *time.Time              `json:"lastUpdated" structs:"lastUpdated"`
+	Environment *FeatureFlagEnvironment `json:"environment" structs:"environment"`
+	Status      *FeatureFlagStatus      `json:"status" structs:"status"`
+}
+
+// FeatureFlagEnvironment is an environment a feature flag is configured for.
+// Type can take the same values as DeploymentEnvironment.Type.
+type FeatureFlagEnvironment struct {
+	Name string `json:"name" structs:"name"`
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// FeatureFlagStatus is the status of a feature flag.
+type FeatureFlagStatus struct {
+	Enabled      bool                `json:"enabled" structs:"enabled"`
+	DefaultValue string              `json:"defaultValue,omitempty" structs:"defaultValue,omitempty"`
+	Rollout      *FeatureFlagRollout `json:"rollout,omitempty" structs:"rollout,omitempty"`
+}
+
+// FeatureFlagRollout describes to whom a feature flag is rolled out.
+// Only one of Percentage, Text and Rules should be set.
+type FeatureFlagRollout struct {
+	Percentage *float64 `json:"percentage,omitempty" structs:"percentage,omitempty"`
+	Text       string   `json:"text,omitempty" structs:"text,omitempty"`
+	Rules      *int     `json:"rules,omitempty" structs:"rules,omitempty"`
+}
+
+// FeatureFlagsSubmission is the payload of FeatureFlagService.Submit.
+// Properties can be used to delete feature flags with FeatureFlagService.DeleteByProperties later on.
+type FeatureFlagsSubmission struct {
+	Properties       map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	Flags            []FeatureFlag            `json:"flags" structs:"flags"`
+	ProviderMetadata *DevInfoProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// FeatureFlagsSubmissionResult is the result of FeatureFlagService.Submit.
+// FailedFlags maps the IDs of rejected feature flags to the reasons.
+type FeatureFlagsSubmissionResult struct {
+	AcceptedFlags    []string                  `json:"acceptedFlags,omitempty" structs:"acceptedFlags,omitempty"`
+	FailedFlags      map[string][]DevInfoError `json:"failedFlags,omitempty" structs:"failedFlags,omitempty"`
+	UnknownIssueKeys []string                  `json:"unknownIssueKeys,omitempty" structs:"unknownIssueKeys,omitempty"`
+}
+
+// Submit updates or inserts feature flags.
+// Feature flags are identified by their ID,
+// and are only updated if the UpdateSequenceID is higher than the stored one.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-feature-flags/#api-rest-featureflags-0-1-bulk-post
+func (s *FeatureFlagService) Submit(ctx context.Context, flags *FeatureFlagsSubmission) (*FeatureFlagsSubmissionResult, *Response, error) {
+	apiEndpoint := "rest/featureflags/0.1/bulk"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, flags)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(FeatureFlagsSubmissionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// Get returns the feature flag with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-feature-flags/#api-rest-featureflags-0-1-flag-featureflagid-get
+func (s *FeatureFlagService) Get(ctx context.Context, featureFlagID string) (*FeatureFlag, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/featureflags/0.1/flag/%s", url.PathEscape(featureFlagID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flag := new(FeatureFlag)
+	resp, err := s.client.Do(req, flag)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return flag, resp, nil
+}
+
+// Delete deletes the feature flag with the given ID.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-feature-flags/#api-rest-featureflags-0-1-flag-featureflagid-delete
+// Caller must close resp.Body
+func (s *FeatureFlagService) Delete(ctx context.Context, featureFlagID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/featureflags/0.1/flag/%s", url.PathEscape(featureFlagID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteByProperties deletes all feature flags that were submitted with all of the given properties.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-feature-flags/#api-rest-featureflags-0-1-bulkbyproperties-delete
+// Caller must close resp.Body
+func (s *FeatureFlagService) DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	return deleteDevInfoByProperties(ctx, s.client, "rest/featureflags/0.1/bulkByProperties", properties)
+}
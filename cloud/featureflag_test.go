@@ -0,0 +1,120 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestFeatureFlagService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/featureflags/0.1/bulk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload FeatureFlagsSubmission
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.Flags) != 1 || payload.Flags[0].Key != "STAR-123" {
+			t.Fatalf("Unexpected flags: %+v", payload.Flags)
+		}
+		if rollout := payload.Flags[0].Summary.Status.Rollout; rollout == nil || *rollout.Percentage != 80 {
+			t.Errorf("Unexpected rollout: %+v", rollout)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedFlags":["111-222-333"],"failedFlags":{"444-555-666":[{"message":"Missing key"}]},"unknownIssueKeys":["ISSUE-404"]}`)
+	})
+
+	percentage := 80.0
+	result, _, err := testClient.FeatureFlag.Submit(context.Background(), &FeatureFlagsSubmission{
+		Flags: []FeatureFlag{{
+			ID:               "111-222-333",
+			Key:              "STAR-123",
+			UpdateSequenceID: 1,
+			IssueKeys:        []string{"ISSUE-123"},
+			Summary: &FeatureFlagSummary{
+				Status: &FeatureFlagStatus{Enabled: true, Rollout: &FeatureFlagRollout{Percentage: &percentage}},
+			},
+		}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil {
+		t.Fatal("Expected result. Result is nil")
+	}
+	if len(result.AcceptedFlags) != 1 || result.AcceptedFlags[0] != "111-222-333" {
+		t.Errorf("Unexpected accepted flags: %+v", result.AcceptedFlags)
+	}
+	if errs := result.FailedFlags["444-555-666"]; len(errs) != 1 || errs[0].Message != "Missing key" {
+		t.Errorf("Unexpected failed flags: %+v", result.FailedFlags)
+	}
+}
+
+func TestFeatureFlagService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/featureflags/0.1/flag/111-222-333"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"schemaVersion":"1.0","id":"111-222-333","key":"my-awesome-feature","updateSequenceId":1523494301448,"displayName":"Enable awesome feature","issueKeys":["ISSUE-123"],"summary":{"url":"https://example.com/project/feature-123/summary","status":{"enabled":true,"defaultValue":"Disabled","rollout":{"percentage":80}},"lastUpdated":"2018-01-20T23:27:25.000Z"},"details":[{"url":"https://example.com/project/feature-123/production","lastUpdated":"2018-01-20T23:27:25.000Z","environment":{"name":"prod-us-west","type":"production"},"status":{"enabled":true,"defaultValue":"Disabled","rollout":{"text":"Enabled for internal users"}}}]}`)
+	})
+
+	flag, _, err := testClient.FeatureFlag.Get(context.Background(), "111-222-333")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if flag == nil {
+		t.Fatal("Expected feature flag. Feature flag is nil")
+	}
+	if flag.Key != "my-awesome-feature" || !flag.Summary.Status.Enabled {
+		t.Errorf("Unexpected feature flag: %+v", flag)
+	}
+	if len(flag.Details) != 1 || flag.Details[0].Environment.Type != DeploymentEnvironmentTypeProduction || flag.Details[0].Status.Rollout.Text != "Enabled for internal users" {
+		t.Errorf("Unexpected details: %+v", flag.Details)
+	}
+}
+
+func TestFeatureFlagService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/featureflags/0.1/flag/111-222-333"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.FeatureFlag.Delete(context.Background(), "111-222-333")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestFeatureFlagService_DeleteByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/featureflags/0.1/bulkByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234"})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.FeatureFlag.DeleteByProperties(context.Background(), map[string]string{"accountId": "account-234"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -2,7 +2,6 @@ package cloud
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -40,7 +39,7 @@ type FieldSchema struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *FieldService) GetList(ctx context.Context) ([]Field, *Response, error) {
-	apiEndpoint := "rest/api/2/field"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "field")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -115,7 +114,7 @@ type FieldCreateOptions struct {
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-fields/#api-rest-api-2-field-post
 func (s *FieldService) CreateCustom(ctx context.Context, options *FieldCreateOptions) (*Field, *Response, error) {
-	apiEndpoint := "rest/api/2/field"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "field")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, options)
 	if err != nil {
 		return nil, nil, err
@@ -134,7 +133,7 @@ func (s *FieldService) CreateCustom(ctx context.Context, options *FieldCreateOpt
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-fields/#api-rest-api-2-field-fieldid-put
 func (s *FieldService) UpdateCustom(ctx context.Context, fieldId string, options *FieldCreateOptions) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s", fieldId)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "field/%s", fieldId)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, options)
 	if err != nil {
 		return nil, err
@@ -149,7 +148,7 @@ func (s *FieldService) UpdateCustom(ctx context.Context, fieldId string, options
 }
 
 func (s *FieldService) DeleteCustom(ctx context.Context, fieldId string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/field/%s", fieldId)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "field/%s", fieldId)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,157 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrFieldNotFound is returned by the FieldRegistry if no field has the given name or ID.
+	ErrFieldNotFound = errors.New("jira: field not found")
+	// ErrFieldAmbiguous is returned by the FieldRegistry if several fields have the given name.
+	// Use the ID of the field instead.
+	ErrFieldAmbiguous = errors.New("jira: field name is ambiguous")
+)
+
+// FieldRegistry resolves fields by their display name, so custom fields can be referenced
+// by name across Jira instances where their IDs, like "customfield_10010", differ.
+// The fields are fetched once with FieldService.GetList and cached for the TTL of the registry.
+// Names are compared case-insensitively, IDs are resolved to themselves.
+//
+// A FieldRegistry is safe for concurrent use.
+type FieldRegistry struct {
+	client *Client
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	fetched time.Time
+	byID    map[string]*Field
+	byName  map[string][]*Field
+}
+
+// NewFieldRegistry returns a FieldRegistry for the fields of the Jira instance of client.
+// The fields are fetched again after ttl, or only on Refresh if ttl is 0.
+func NewFieldRegistry(client *Client, ttl time.Duration) *FieldRegistry {
+	return &FieldRegistry{client: client, ttl: ttl}
+}
+
+// Refresh fetches the fields from Jira, e.g. after custom fields were created.
+func (r *FieldRegistry) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.refresh(ctx)
+}
+
+func (r *FieldRegistry) refresh(ctx context.Context) error {
+	fields, _, err := r.client.Field.GetList(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.byID = make(map[string]*Field, len(fields))
+	r.byName = make(map[string][]*Field, len(fields))
+	for i := range fields {
+		f := &fields[i]
+		r.byID[f.ID] = f
+		name := strings.ToLower(f.Name)
+		r.byName[name] = append(r.byName[name], f)
+	}
+	r.fetched = time.Now()
+	return nil
+}
+
+// Field returns the field with the name or ID.
+// It returns an error wrapping ErrFieldNotFound or ErrFieldAmbiguous if the field can not be resolved.
+func (r *FieldRegistry) Field(ctx context.Context, nameOrID string) (*Field, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.byID == nil || (r.ttl > 0 && time.Since(r.fetched) > r.ttl) {
+		if err := r.refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if f, ok := r.byID[nameOrID]; ok {
+		return f, nil
+	}
+	switch fields := r.byName[strings.ToLower(nameOrID)]; len(fields) {
+	case 0:
+		return nil, fmt.Errorf("%w: %q", ErrFieldNotFound, nameOrID)
+	case 1:
+		return fields[0], nil
+	default:
+		ids := make([]string, len(fields))
+		for i, f := range fields {
+			ids[i] = f.ID
+		}
+		return nil, fmt.Errorf("%w: %q is the name of %s", ErrFieldAmbiguous, nameOrID, strings.Join(ids, ", "))
+	}
+}
+
+// ID returns the ID of the field with the name or ID, e.g. "customfield_10010" for "Story Points".
+func (r *FieldRegistry) ID(ctx context.Context, nameOrID string) (string, error) {
+	f, err := r.Field(ctx, nameOrID)
+	if err != nil {
+		return "", err
+	}
+	return f.ID, nil
+}
+
+// JQLField returns the unambiguous JQL clause name of the field with the name or ID,
+// e.g. "cf[10010]" for the custom field "Story Points" and "summary" for "Summary".
+//
+// Example:
+//
+//	field, _ := registry.JQLField(ctx, "Story Points")
+//	issues, _, err := client.Issue.Search(ctx, fmt.Sprintf("%s > 5", field), nil)
+func (r *FieldRegistry) JQLField(ctx context.Context, nameOrID string) (string, error) {
+	f, err := r.Field(ctx, nameOrID)
+	if err != nil {
+		return "", err
+	}
+	if f.Custom && f.Schema.CustomID != 0 {
+		return fmt.Sprintf("cf[%d]", f.Schema.CustomID), nil
+	}
+	return f.ID, nil
+}
+
+// TranslateFields returns values with the field names replaced by their IDs,
+// e.g. for the "fields" of IssueService.UpdateIssue.
+func (r *FieldRegistry) TranslateFields(ctx context.Context, values map[string]interface{}) (map[string]interface{}, error) {
+	translated := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		id, err := r.ID(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		translated[id] = value
+	}
+	return translated, nil
+}
+
+// SetCustomFields sets the custom fields of fields by their name or ID, e.g. to create or update an issue.
+//
+// Example:
+//
+//	err := registry.SetCustomFields(ctx, issue.Fields, map[string]interface{}{
+//		"Story Points": 5,
+//		"Team":         map[string]string{"value": "Platform"},
+//	})
+func (r *FieldRegistry) SetCustomFields(ctx context.Context, fields *IssueFields, values map[string]interface{}) error {
+	translated, err := r.TranslateFields(ctx, values)
+	if err != nil {
+		return err
+	}
+	if fields.Unknowns == nil {
+		fields.Unknowns = map[string]interface{}{}
+	}
+	for id, value := range translated {
+		fields.Unknowns[id] = value
+	}
+	return nil
+}
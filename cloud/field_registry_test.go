@@ -0,0 +1,108 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func setupFieldRegistry(t *testing.T) *int {
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		fmt.Fprint(w, `[
+			{"id":"summary","name":"Summary","custom":false,"clauseNames":["summary"]},
+			{"id":"customfield_10010","name":"Story Points","custom":true,"clauseNames":["cf[10010]","Story Points"],"schema":{"type":"number","customId":10010}},
+			{"id":"customfield_10020","name":"Team","custom":true,"schema":{"type":"option","customId":10020}},
+			{"id":"customfield_10021","name":"Team","custom":true,"schema":{"type":"string","customId":10021}}
+		]`)
+	})
+	return &requests
+}
+
+func TestFieldRegistry_ID(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := setupFieldRegistry(t)
+
+	registry := NewFieldRegistry(testClient, time.Hour)
+	for nameOrID, want := range map[string]string{
+		"Story Points":      "customfield_10010",
+		"story points":      "customfield_10010",
+		"customfield_10010": "customfield_10010",
+		"Summary":           "summary",
+	} {
+		id, err := registry.ID(context.Background(), nameOrID)
+		if err != nil || id != want {
+			t.Errorf("ID(%q) = %q, %v, want %q", nameOrID, id, err, want)
+		}
+	}
+	if *requests != 1 {
+		t.Errorf("Expected the fields to be fetched once, got %d requests", *requests)
+	}
+
+	if _, err := registry.ID(context.Background(), "Unknown"); !errors.Is(err, ErrFieldNotFound) {
+		t.Errorf("Expected ErrFieldNotFound, got %v", err)
+	}
+	if _, err := registry.ID(context.Background(), "Team"); !errors.Is(err, ErrFieldAmbiguous) {
+		t.Errorf("Expected ErrFieldAmbiguous, got %v", err)
+	}
+}
+
+func TestFieldRegistry_TTL(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := setupFieldRegistry(t)
+
+	registry := NewFieldRegistry(testClient, time.Nanosecond)
+	registry.ID(context.Background(), "Summary")
+	time.Sleep(time.Millisecond)
+	registry.ID(context.Background(), "Summary")
+	if *requests != 2 {
+		t.Errorf("Expected the expired fields to be fetched again, got %d requests", *requests)
+	}
+
+	if err := registry.Refresh(context.Background()); err != nil || *requests != 3 {
+		t.Errorf("Expected Refresh to fetch the fields, got %d requests and %v", *requests, err)
+	}
+}
+
+func TestFieldRegistry_JQLField(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFieldRegistry(t)
+
+	registry := NewFieldRegistry(testClient, 0)
+	if field, err := registry.JQLField(context.Background(), "Story Points"); err != nil || field != "cf[10010]" {
+		t.Errorf("JQLField = %q, %v", field, err)
+	}
+	if field, err := registry.JQLField(context.Background(), "Summary"); err != nil || field != "summary" {
+		t.Errorf("JQLField = %q, %v", field, err)
+	}
+}
+
+func TestFieldRegistry_SetCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+	setupFieldRegistry(t)
+
+	registry := NewFieldRegistry(testClient, 0)
+	fields := &IssueFields{Summary: "Test"}
+	err := registry.SetCustomFields(context.Background(), fields, map[string]interface{}{
+		"Story Points":      5,
+		"customfield_10020": map[string]string{"value": "Platform"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n, err := fields.CustomNumber("customfield_10010"); err != nil || n != 5 {
+		t.Errorf("Expected story points 5, got %v, %v", n, err)
+	}
+	if _, ok := fields.Unknowns["customfield_10020"]; !ok {
+		t.Error("Expected the field set by ID")
+	}
+}
@@ -2,7 +2,6 @@ package cloud
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
@@ -126,7 +125,7 @@ type FilterSearchOptions struct {
 func (fs *FilterService) GetList(ctx context.Context) ([]*Filter, *Response, error) {
 
 	options := &GetQueryOptions{}
-	apiEndpoint := "rest/api/2/filter"
+	apiEndpoint := fs.client.restAPIPath(APIVersion2, "filter")
 	req, err := fs.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -152,7 +151,7 @@ func (fs *FilterService) GetList(ctx context.Context) ([]*Filter, *Response, err
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (fs *FilterService) GetFavouriteList(ctx context.Context) ([]*Filter, *Response, error) {
-	apiEndpoint := "rest/api/2/filter/favourite"
+	apiEndpoint := fs.client.restAPIPath(APIVersion2, "filter/favourite")
 	req, err := fs.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -171,7 +170,7 @@ func (fs *FilterService) GetFavouriteList(ctx context.Context) ([]*Filter, *Resp
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (fs *FilterService) Get(ctx context.Context, filterID int) (*Filter, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/filter/%d", filterID)
+	apiEndpoint := fs.client.restAPIPath(APIVersion2, "filter/%d", filterID)
 	req, err := fs.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -193,7 +192,7 @@ func (fs *FilterService) Get(ctx context.Context, filterID int) (*Filter, *Respo
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (fs *FilterService) GetMyFilters(ctx context.Context, opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error) {
-	apiEndpoint := "rest/api/3/filter/my"
+	apiEndpoint := fs.client.restAPIPath(APIVersion3, "filter/my")
 	url, err := addOptions(apiEndpoint, opts)
 	if err != nil {
 		return nil, nil, err
@@ -219,7 +218,7 @@ func (fs *FilterService) GetMyFilters(ctx context.Context, opts *GetMyFiltersQue
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (fs *FilterService) Search(ctx context.Context, opt *FilterSearchOptions) (*FiltersList, *Response, error) {
-	apiEndpoint := "rest/api/3/filter/search"
+	apiEndpoint := fs.client.restAPIPath(APIVersion3, "filter/search")
 	url, err := addOptions(apiEndpoint, opt)
 	if err != nil {
 		return nil, nil, err
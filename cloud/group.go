@@ -2,7 +2,6 @@ package cloud
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"net/url"
 )
@@ -71,11 +70,12 @@ type GroupSearchOptions struct {
 func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error) {
 	var apiEndpoint string
 	if options == nil {
-		apiEndpoint = fmt.Sprintf("/rest/api/2/group/member?groupname=%s", url.QueryEscape(name))
+		apiEndpoint = s.client.restAPIPath(APIVersion2, "group/member?groupname=%s", url.QueryEscape(name))
 	} else {
 		// TODO use addOptions
-		apiEndpoint = fmt.Sprintf(
-			"/rest/api/2/group/member?groupname=%s&startAt=%d&maxResults=%d&includeInactiveUsers=%t",
+		apiEndpoint = s.client.restAPIPath(
+			APIVersion2,
+			"group/member?groupname=%s&startAt=%d&maxResults=%d&includeInactiveUsers=%t",
 			url.QueryEscape(name),
 			options.StartAt,
 			options.MaxResults,
@@ -95,6 +95,34 @@ func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearc
 	return group.Members, resp, nil
 }
 
+// GetAll returns the members of all pages of the specified group and its subgroups, see Get.
+// options.StartAt and options.MaxResults are ignored, the page size and a limit of members can be set with pager.
+//
+// Jira API docs: https://docs.atlassian.com/jira/REST/server/#api/2/group-getUsersFromGroup
+func (s *GroupService) GetAll(ctx context.Context, name string, options *GroupSearchOptions, pager *PagerOptions) ([]GroupMember, error) {
+	return s.membersPager(name, options, pager).All(ctx)
+}
+
+// membersPager returns a Pager over the members of a group.
+func (s *GroupService) membersPager(name string, options *GroupSearchOptions, pager *PagerOptions) *Pager[GroupMember] {
+	var opts GroupSearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[GroupMember], *Response, error) {
+		opts.StartAt = page.StartAt
+		opts.MaxResults = page.MaxResults
+		if opts.MaxResults == 0 {
+			opts.MaxResults = 50
+		}
+		members, resp, err := s.Get(ctx, name, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[GroupMember]{Values: members, Total: resp.Total}, resp, nil
+	}, pager)
+}
+
 // Add adds a user to a group.
 //
 // The account ID of the user, which uniquely identifies the user across all Atlassian products.
@@ -102,7 +130,7 @@ func (s *GroupService) Get(ctx context.Context, name string, options *GroupSearc
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-post
 func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string, accountID string) (*Group, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s", groupName)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "group/user?groupname=%s", groupName)
 	var user struct {
 		AccountID string `json:"accountId"`
 	}
@@ -130,7 +158,7 @@ func (s *GroupService) AddUserByGroupName(ctx context.Context, groupName string,
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-groups/#api-rest-api-3-group-user-delete
 // Caller must close resp.Body
 func (s *GroupService) RemoveUserByGroupName(ctx context.Context, groupName string, accountID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/group/user?groupname=%s&accountId=%s", groupName, accountID)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "group/user?groupname=%s&accountId=%s", groupName, accountID)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
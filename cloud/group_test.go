@@ -95,3 +95,27 @@ func TestGroupService_Remove(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestGroupService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":3,"isLast":false,"values":[{"name":"michael"},{"name":"dave"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults":50,"startAt":2,"total":3,"isLast":true,"values":[{"name":"gina"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	members, err := testClient.Group.GetAll(context.Background(), "default", &GroupSearchOptions{IncludeInactiveUsers: true}, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(members) != 3 {
+		t.Errorf("Expected 3 members, got %d", len(members))
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fatih/structs"
@@ -47,6 +48,29 @@ type Issue struct {
 	Changelog      *Changelog           `json:"changelog,omitempty" structs:"changelog,omitempty"`
 	Transitions    []Transition         `json:"transitions,omitempty" structs:"transitions,omitempty"`
 	Names          map[string]string    `json:"names,omitempty" structs:"names,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Issue struct.
+// It adds the Unknowns to the encoded fields.
+func (i Issue) MarshalJSON() ([]byte, error) {
+	type Alias Issue
+	return marshalWithUnknowns(Alias(i), i.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Issue struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	type Alias Issue
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(i))
+	if err != nil {
+		return err
+	}
+	i.Unknowns = unknowns
+	return nil
 }
 
 // ChangelogItems reflects one single changelog item of a history item
@@ -63,7 +87,7 @@ type ChangelogItems struct {
 type ChangelogHistory struct {
 	Id      string           `json:"id" structs:"id"`
 	Author  User             `json:"author" structs:"author"`
-	Created string           `json:"created" structs:"created"`
+	Created Time             `json:"created" structs:"created"`
 	Items   []ChangelogItems `json:"items" structs:"items"`
 }
 
@@ -78,7 +102,7 @@ type Attachment struct {
 	ID        string `json:"id,omitempty" structs:"id,omitempty"`
 	Filename  string `json:"filename,omitempty" structs:"filename,omitempty"`
 	Author    *User  `json:"author,omitempty" structs:"author,omitempty"`
-	Created   string `json:"created,omitempty" structs:"created,omitempty"`
+	Created   *Time  `json:"created,omitempty" structs:"created,omitempty"`
 	Size      int    `json:"size,omitempty" structs:"size,omitempty"`
 	MimeType  string `json:"mimeType,omitempty" structs:"mimeType,omitempty"`
 	Content   string `json:"content,omitempty" structs:"content,omitempty"`
@@ -86,14 +110,19 @@ type Attachment struct {
 }
 
 // Epic represents the epic to which an issue is associated
-// Not that this struct does not process the returned "color" value
 type Epic struct {
-	ID      int    `json:"id" structs:"id"`
-	Key     string `json:"key" structs:"key"`
-	Self    string `json:"self" structs:"self"`
-	Name    string `json:"name" structs:"name"`
-	Summary string `json:"summary" structs:"summary"`
-	Done    bool   `json:"done" structs:"done"`
+	ID      int        `json:"id" structs:"id"`
+	Key     string     `json:"key" structs:"key"`
+	Self    string     `json:"self" structs:"self"`
+	Name    string     `json:"name" structs:"name"`
+	Summary string     `json:"summary" structs:"summary"`
+	Color   *EpicColor `json:"color,omitempty" structs:"color,omitempty"`
+	Done    bool       `json:"done" structs:"done"`
+}
+
+// EpicColor is the color of an epic, e.g. "color_1" up to "color_14"
+type EpicColor struct {
+	Key string `json:"key" structs:"key"`
 }
 
 // IssueFields represents single fields of a Jira issue.
@@ -210,21 +239,90 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 }
 
 // IssueRenderedFields represents rendered fields of a Jira issue.
+// They are returned if the issue is requested with WithExpand("renderedFields").
+// Rich text fields, like the description, environment and comments, are rendered as HTML
+// and dates and durations as human readable strings, e.g. "3 days ago" or "1h 30m".
 // Not all IssueFields are rendered.
 type IssueRenderedFields struct {
-	// TODO Missing fields
-	//      * "aggregatetimespent": null,
-	//      * "workratio": -1,
-	//      * "lastViewed": null,
-	//      * "aggregatetimeoriginalestimate": null,
-	//      * "aggregatetimeestimate": null,
-	//      * "environment": null,
-	Resolutiondate string    `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created        string    `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate        string    `json:"duedate,omitempty" structs:"duedate,omitempty"`
-	Updated        string    `json:"updated,omitempty" structs:"updated,omitempty"`
-	Comments       *Comments `json:"comment,omitempty" structs:"comment,omitempty"`
-	Description    string    `json:"description,omitempty" structs:"description,omitempty"`
+	Resolutiondate                string        `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created                       string        `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate                       string        `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Updated                       string        `json:"updated,omitempty" structs:"updated,omitempty"`
+	LastViewed                    string        `json:"lastViewed,omitempty" structs:"lastViewed,omitempty"`
+	Comments                      *Comments     `json:"comment,omitempty" structs:"comment,omitempty"`
+	Description                   string        `json:"description,omitempty" structs:"description,omitempty"`
+	Environment                   string        `json:"environment,omitempty" structs:"environment,omitempty"`
+	TimeTracking                  *TimeTracking `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
+	TimeSpent                     string        `json:"timespent,omitempty" structs:"timespent,omitempty"`
+	TimeEstimate                  string        `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
+	TimeOriginalEstimate          string        `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
+	AggregateTimeSpent            string        `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         string        `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate string        `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
+	Worklog                       *Worklog      `json:"worklog,omitempty" structs:"worklog,omitempty"`
+
+	// CustomFields are the rendered custom fields by their ID, e.g. "customfield_10010".
+	// Custom fields without rendered value, like select lists and user pickers, are omitted.
+	CustomFields map[string]string `json:"-" structs:"-"`
+}
+
+// CustomField returns the rendered value of the custom field with the ID, e.g. "customfield_10010".
+// ok is false if the field is not rendered.
+func (r *IssueRenderedFields) CustomField(id string) (value string, ok bool) {
+	if r == nil {
+		return "", false
+	}
+	value, ok = r.CustomFields[id]
+	return value, ok
+}
+
+// MarshalJSON is a custom JSON marshal function for the IssueRenderedFields struct.
+// It adds the CustomFields next to the other fields.
+func (r *IssueRenderedFields) MarshalJSON() ([]byte, error) {
+	type Alias IssueRenderedFields
+	data, err := json.Marshal((*Alias)(r))
+	if err != nil || len(r.CustomFields) == 0 {
+		return data, err
+	}
+
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for id, value := range r.CustomFields {
+		m[id] = value
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the IssueRenderedFields struct.
+// It decodes the rendered custom fields into CustomFields.
+func (r *IssueRenderedFields) UnmarshalJSON(data []byte) error {
+	type Alias IssueRenderedFields
+	if err := json.Unmarshal(data, (*Alias)(r)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	r.CustomFields = nil
+	for id, raw := range fields {
+		if !strings.HasPrefix(id, "customfield_") {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil || value == "" {
+			// null and values which are not rendered as text
+			continue
+		}
+		if r.CustomFields == nil {
+			r.CustomFields = map[string]string{}
+		}
+		r.CustomFields[id] = value
+	}
+	return nil
 }
 
 // IssueType represents a type of a Jira issue.
@@ -237,6 +335,10 @@ type IssueType struct {
 	Name        string `json:"name,omitempty" structs:"name,omitempty"`
 	Subtask     bool   `json:"subtask,omitempty" structs:"subtask,omitempty"`
 	AvatarID    int    `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+	// HierarchyLevel is the level of the issue type in the issue type hierarchy, e.g. HierarchyLevelEpic.
+	HierarchyLevel int `json:"hierarchyLevel,omitempty" structs:"hierarchyLevel,omitempty"`
+	// EntityID is the UUID of the issue type of a team-managed project.
+	EntityID string `json:"entityId,omitempty" structs:"entityId,omitempty"`
 }
 
 // Watches represents a type of how many and which user are "observing" a Jira issue to track the status / updates.
@@ -286,16 +388,6 @@ type Parent struct {
 	Key string `json:"key,omitempty" structs:"key,omitempty"`
 }
 
-// Time represents the Time definition of Jira as a time.Time of go
-type Time time.Time
-
-func (t Time) Equal(u Time) bool {
-	return time.Time(t).Equal(time.Time(u))
-}
-
-// Date represents the Date definition of Jira as a time.Time of go
-type Date time.Time
-
 // Wrapper struct for search result
 type transitionResult struct {
 	Transitions []Transition `json:"transitions" structs:"transitions"`
@@ -352,50 +444,6 @@ type Option struct {
 	Value string `json:"value" structs:"value"`
 }
 
-// UnmarshalJSON will transform the Jira time into a time.Time
-// during the transformation of the Jira JSON response
-func (t *Time) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02T15:04:05.999-0700\"", string(b))
-	if err != nil {
-		return err
-	}
-	*t = Time(ti)
-	return nil
-}
-
-// MarshalJSON will transform the time.Time into a Jira time
-// during the creation of a Jira request
-func (t Time) MarshalJSON() ([]byte, error) {
-	return []byte(time.Time(t).Format("\"2006-01-02T15:04:05.000-0700\"")), nil
-}
-
-// UnmarshalJSON will transform the Jira date into a time.Time
-// during the transformation of the Jira JSON response
-func (t *Date) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02\"", string(b))
-	if err != nil {
-		return err
-	}
-	*t = Date(ti)
-	return nil
-}
-
-// MarshalJSON will transform the Date object into a short
-// date string as Jira expects during the creation of a
-// Jira request
-func (t Date) MarshalJSON() ([]byte, error) {
-	time := time.Time(t)
-	return []byte(time.Format("\"2006-01-02\"")), nil
-}
-
 // Worklog represents the work log of a Jira issue.
 // One Worklog contains zero or n WorklogRecords
 // Jira Wiki: https://confluence.atlassian.com/jira/logging-work-on-an-issue-185729605.html
@@ -419,6 +467,7 @@ type WorklogRecord struct {
 	TimeSpentSeconds int              `json:"timeSpentSeconds,omitempty" structs:"timeSpentSeconds,omitempty"`
 	ID               string           `json:"id,omitempty" structs:"id,omitempty"`
 	IssueID          string           `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	Visibility       *Visibility      `json:"visibility,omitempty" structs:"visibility,omitempty"`
 	Properties       []EntityProperty `json:"properties,omitempty"`
 }
 
@@ -427,6 +476,17 @@ type EntityProperty struct {
 	Value interface{} `json:"value"`
 }
 
+// EntityPropertyKeys is the list of property keys of an entity, like a board or a sprint
+type EntityPropertyKeys struct {
+	Keys []EntityPropertyKey `json:"keys" structs:"keys"`
+}
+
+// EntityPropertyKey is the reference to a single property of an entity
+type EntityPropertyKey struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Key  string `json:"key" structs:"key"`
+}
+
 // TimeTracking represents the timetracking fields of a Jira issue.
 type TimeTracking struct {
 	OriginalEstimate         string `json:"originalEstimate,omitempty" structs:"originalEstimate,omitempty"`
@@ -467,7 +527,10 @@ type IssueLinkType struct {
 
 // Comments represents a list of Comment.
 type Comments struct {
-	Comments []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
+	StartAt    int        `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total      int        `json:"total,omitempty" structs:"total,omitempty"`
+	Comments   []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
 }
 
 // Comment represents a comment by a person to an issue in Jira.
@@ -478,12 +541,37 @@ type Comment struct {
 	Author       User              `json:"author,omitempty" structs:"author,omitempty"`
 	Body         string            `json:"body,omitempty" structs:"body,omitempty"`
 	UpdateAuthor User              `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
-	Updated      string            `json:"updated,omitempty" structs:"updated,omitempty"`
-	Created      string            `json:"created,omitempty" structs:"created,omitempty"`
+	Updated      *Time             `json:"updated,omitempty" structs:"updated,omitempty"`
+	Created      *Time             `json:"created,omitempty" structs:"created,omitempty"`
 	Visibility   CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+	// RenderedBody is the body rendered as HTML, if the comment is requested with WithExpand("renderedBody").
+	RenderedBody string `json:"renderedBody,omitempty" structs:"renderedBody,omitempty"`
 
 	// A list of comment properties. Optional on create and update.
 	Properties []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Comment struct.
+// It adds the Unknowns to the encoded fields.
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type Alias Comment
+	return marshalWithUnknowns(Alias(c), c.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Comment struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type Alias Comment
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Unknowns = unknowns
+	return nil
 }
 
 // FixVersion represents a software release in which an issue is fixed.
@@ -494,10 +582,10 @@ type FixVersion struct {
 	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty" structs:"startDate,omitempty"`
 }
 
 // AffectsVersion represents a software release which is affected by an issue.
@@ -505,10 +593,7 @@ type AffectsVersion Version
 
 // CommentVisibility represents he visibility of a comment.
 // E.g. Type could be "role" and Value "Administrators"
-type CommentVisibility struct {
-	Type  string `json:"type,omitempty" structs:"type,omitempty"`
-	Value string `json:"value,omitempty" structs:"value,omitempty"`
-}
+type CommentVisibility = Visibility
 
 // SearchOptions specifies the optional parameters to various List methods that
 // support pagination.
@@ -616,12 +701,14 @@ type RemoteLinkStatus struct {
 //
 // # The given options will be appended to the query string
 //
+// opts like WithFields or WithExpand are applied after options.
+//
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQueryOptions, opts ...RequestOption) (*Issue, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -634,6 +721,9 @@ func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQuer
 		}
 		req.URL.RawQuery = q.Encode()
 	}
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, nil, err
+	}
 
 	issue := new(Issue)
 	resp, err := s.client.Do(req, issue)
@@ -645,6 +735,70 @@ func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQuer
 	return issue, resp, nil
 }
 
+// BulkGetIssues fetches the issues with the given keys or IDs concurrently with up to concurrency requests at a time.
+// concurrency will default to 5 if 0 or less.
+// options and opts are passed to Get for every issue.
+//
+// The fetched issues are returned by key and the errors of the failed keys in a second map,
+// a failed key doesn't stop fetching the other keys.
+// Rate limited requests are retried according to the RetryPolicy of the client,
+// so a RetryPolicy should be set for large numbers of keys.
+// If ctx is done, the keys that were not fetched yet fail with the error of ctx.
+func (s *IssueService) BulkGetIssues(ctx context.Context, keys []string, options *GetQueryOptions, concurrency int, opts ...RequestOption) (map[string]*Issue, map[string]error) {
+	if concurrency <= 0 {
+		concurrency = 5
+	}
+
+	issues := make(map[string]*Issue, len(keys))
+	errs := make(map[string]error)
+	var mu sync.Mutex
+
+	work := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(keys); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				issue, _, err := s.Get(ctx, key, options, opts...)
+				mu.Lock()
+				if err != nil {
+					errs[key] = err
+				} else {
+					issues[key] = issue
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if ctx.Err() != nil {
+			mu.Lock()
+			errs[key] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+		select {
+		case work <- key:
+		case <-ctx.Done():
+			mu.Lock()
+			errs[key] = ctx.Err()
+			mu.Unlock()
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	return issues, errs
+}
+
 // DownloadAttachment returns a Response of an attachment for a given attachmentID.
 // The attachment is in the Response.Body of the response.
 // This is an io.ReadCloser.
@@ -673,7 +827,7 @@ func (s *IssueService) DownloadAttachment(ctx context.Context, attachmentID stri
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/attachments", issueID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/attachments", issueID)
 
 	b := new(bytes.Buffer)
 	writer := multipart.NewWriter(b)
@@ -715,7 +869,7 @@ func (s *IssueService) PostAttachment(ctx context.Context, issueID string, r io.
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) DeleteAttachment(ctx context.Context, attachmentID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/attachment/%s", attachmentID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "attachment/%s", attachmentID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
@@ -737,7 +891,7 @@ func (s *IssueService) DeleteAttachment(ctx context.Context, attachmentID string
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) DeleteLink(ctx context.Context, linkID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLink/%s", linkID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLink/%s", linkID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
@@ -760,19 +914,16 @@ func (s *IssueService) DeleteLink(ctx context.Context, linkID string) (*Response
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
+func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...RequestOption) (*Worklog, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/worklog", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	for _, option := range options {
-		err = option(req)
-		if err != nil {
-			return nil, nil, err
-		}
+	if err := applyRequestOptions(req, options); err != nil {
+		return nil, nil, err
 	}
 
 	v := new(Worklog)
@@ -780,6 +931,17 @@ func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options
 	return v, resp, err
 }
 
+// worklogsPager returns a Pager over the worklogs of an issue.
+func (s *IssueService) worklogsPager(issueID string, pager *PagerOptions, options ...RequestOption) *Pager[WorklogRecord] {
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[WorklogRecord], *Response, error) {
+		worklogs, resp, err := s.GetWorklogs(ctx, issueID, append(append([]RequestOption(nil), options...), pageRequestOptions(page)...)...)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+		return &Page[WorklogRecord]{Values: worklogs.Worklogs, Total: worklogs.Total}, resp, nil
+	}, pager)
+}
+
 // Applies query options to http request.
 // This helper is meant to be used with all "QueryOptions" structs.
 //
@@ -808,7 +970,7 @@ func WithQueryOptions(options interface{}) func(*http.Request) error {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Create(ctx context.Context, issue *Issue) (*Issue, *Response, error) {
-	apiEndpoint := "rest/api/2/issue"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issue)
 	if err != nil {
 		return nil, nil, err
@@ -839,7 +1001,7 @@ func (s *IssueService) Create(ctx context.Context, issue *Issue) (*Issue, *Respo
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Update(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", issue.Key)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%v", issue.Key)
 	url, err := addOptions(apiEndpoint, opts)
 	if err != nil {
 		return nil, nil, err
@@ -868,7 +1030,7 @@ func (s *IssueService) Update(ctx context.Context, issue *Issue, opts *UpdateQue
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%v", jiraID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%v", jiraID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, data)
 	if err != nil {
 		return nil, err
@@ -883,14 +1045,52 @@ func (s *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[
 	return resp, nil
 }
 
+// GetComments returns a page of the comments of issueID.
+// opts like WithExpand("renderedBody") are added to the query.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-comments/#api-rest-api-2-issue-issueidorkey-comment-get
+func (s *IssueService) GetComments(ctx context.Context, issueID string, opts ...RequestOption) (*Comments, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/comment", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(Comments)
+	resp, err := s.client.Do(req, comments)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return comments, resp, nil
+}
+
+// commentsPager returns a Pager over the comments of an issue.
+func (s *IssueService) commentsPager(issueID string, pager *PagerOptions, opts ...RequestOption) *Pager[*Comment] {
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[*Comment], *Response, error) {
+		comments, resp, err := s.GetComments(ctx, issueID, append(append([]RequestOption(nil), opts...), pageRequestOptions(page)...)...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[*Comment]{Values: comments.Comments, Total: comments.Total}, resp, nil
+	}, pager)
+}
+
 // AddComment adds a new comment to issueID.
+// An invalid comment.Visibility is rejected before the request, see Visibility.Validate.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
+	if err := comment.Visibility.Validate(); err != nil {
+		return nil, nil, err
+	}
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/comment", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, comment)
 	if err != nil {
 		return nil, nil, err
@@ -907,18 +1107,26 @@ func (s *IssueService) AddComment(ctx context.Context, issueID string, comment *
 }
 
 // UpdateComment updates the body of a comment, identified by comment.ID, on the issueID.
+// A restricted comment.Visibility is updated as well, an empty one keeps the visibility of the comment.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/issue/{issueIdOrKey}/comment-updateComment
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error) {
+	if err := comment.Visibility.Validate(); err != nil {
+		return nil, nil, err
+	}
 	reqBody := struct {
-		Body string `json:"body"`
+		Body       string      `json:"body"`
+		Visibility *Visibility `json:"visibility,omitempty"`
 	}{
 		Body: comment.Body,
 	}
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, comment.ID)
+	if comment.Visibility.IsRestricted() {
+		reqBody.Visibility = &comment.Visibility
+	}
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/comment/%s", issueID, comment.ID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, reqBody)
 	if err != nil {
 		return nil, nil, err
@@ -940,7 +1148,7 @@ func (s *IssueService) UpdateComment(ctx context.Context, issueID string, commen
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) DeleteComment(ctx context.Context, issueID, commentID string) error {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment/%s", issueID, commentID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/comment/%s", issueID, commentID)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return err
@@ -957,13 +1165,19 @@ func (s *IssueService) DeleteComment(ctx context.Context, issueID, commentID str
 }
 
 // AddWorklogRecord adds a new worklog record to issueID.
+// An invalid record.Visibility is rejected before the request, see Visibility.Validate.
 //
 // https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-issue-issueIdOrKey-worklog-post
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddWorklogRecord(ctx context.Context, issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
+	if record.Visibility != nil {
+		if err := record.Visibility.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/worklog", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, record)
 	if err != nil {
 		return nil, nil, err
@@ -987,13 +1201,19 @@ func (s *IssueService) AddWorklogRecord(ctx context.Context, issueID string, rec
 }
 
 // UpdateWorklogRecord updates a worklog record.
+// An invalid record.Visibility is rejected before the request, see Visibility.Validate.
 //
 // https://docs.atlassian.com/software/jira/docs/api/REST/7.1.2/#api/2/issue-updateWorklog
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateWorklogRecord(ctx context.Context, issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog/%s", issueID, worklogID)
+	if record.Visibility != nil {
+		if err := record.Visibility.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/worklog/%s", issueID, worklogID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, record)
 	if err != nil {
 		return nil, nil, err
@@ -1024,7 +1244,7 @@ func (s *IssueService) UpdateWorklogRecord(ctx context.Context, issueID, worklog
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddLink(ctx context.Context, issueLink *IssueLink) (*Response, error) {
-	apiEndpoint := "rest/api/2/issueLink"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLink")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issueLink)
 	if err != nil {
 		return nil, err
@@ -1039,14 +1259,116 @@ func (s *IssueService) AddLink(ctx context.Context, issueLink *IssueLink) (*Resp
 }
 
 // Search will search for tickets according to the jql
+// opts like WithFields or WithValidateQuery are applied after options.
 //
 // Jira API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOptions, opts ...RequestOption) ([]Issue, *Response, error) {
+	req, err := s.newSearchRequest(ctx, jql, options, opts)
+	if err != nil {
+		return []Issue{}, nil, err
+	}
+
+	v := new(searchResult)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		err = NewJiraError(resp, err)
+	}
+	return v.Issues, resp, err
+}
+
+// SearchStream searches like Search, but decodes the issues of the response one by one and calls f for each issue,
+// instead of decoding all issues into memory at once. This keeps the memory usage low for large pages.
+// It stops at the first error returned by f.
+// The paging values of the response are set once the response is decoded completely.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssueService) SearchStream(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error, opts ...RequestOption) (*Response, error) {
+	req, err := s.newSearchRequest(ctx, jql, options, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	return resp, decodeSearchStream(json.NewDecoder(resp.Body), resp, f)
+}
+
+// decodeSearchStream decodes a search result token by token and calls f for each issue.
+// The paging values are set on resp.
+func decodeSearchStream(dec *json.Decoder, resp *Response, f func(Issue) error) error {
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := t.(string)
+
+		switch key {
+		case "issues":
+			if err := expectDelim(dec, '['); err != nil {
+				return err
+			}
+			resp.pageSize = 0
+			for dec.More() {
+				resp.pageSize++
+				var issue Issue
+				if err := dec.Decode(&issue); err != nil {
+					return err
+				}
+				if err := f(issue); err != nil {
+					return err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return err
+			}
+		case "startAt":
+			err = dec.Decode(&resp.StartAt)
+		case "maxResults":
+			err = dec.Decode(&resp.MaxResults)
+		case "total":
+			err = dec.Decode(&resp.Total)
+		case "isLast":
+			err = dec.Decode(&resp.IsLast)
+		case "nextPageToken":
+			err = dec.Decode(&resp.NextPageToken)
+		default:
+			var skip json.RawMessage
+			err = dec.Decode(&skip)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+// expectDelim reads the next token of dec and returns an error if it is not delim.
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("unexpected JSON token %v, expected %v", t, delim)
+	}
+	return nil
+}
+
+// newSearchRequest creates the request of a search with jql.
+func (s *IssueService) newSearchRequest(ctx context.Context, jql string, options *SearchOptions, opts []RequestOption) (*http.Request, error) {
 	u := url.URL{
-		Path: "rest/api/2/search",
+		Path: s.client.restAPIPath(APIVersion2, "search"),
 	}
 	uv := url.Values{}
 	if jql != "" {
@@ -1075,15 +1397,12 @@ func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOp
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
-		return []Issue{}, nil, err
+		return nil, err
 	}
-
-	v := new(searchResult)
-	resp, err := s.client.Do(req, v)
-	if err != nil {
-		err = NewJiraError(resp, err)
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, err
 	}
-	return v.Issues, resp, err
+	return req, nil
 }
 
 // SearchPages will get issues from all pages in a search
@@ -1133,12 +1452,39 @@ func (s *IssueService) SearchPages(ctx context.Context, jql string, options *Sea
 	}
 }
 
+// SearchAll returns the issues of all pages of a search.
+// options.StartAt is ignored, the page size and a limit of issues can be set with pager.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-search/#api-rest-api-2-search-get
+func (s *IssueService) SearchAll(ctx context.Context, jql string, options *SearchOptions, pager *PagerOptions) ([]Issue, error) {
+	return s.searchPager(jql, options, pager).All(ctx)
+}
+
+// searchPager returns a Pager over the issues of a search.
+func (s *IssueService) searchPager(jql string, options *SearchOptions, pager *PagerOptions) *Pager[Issue] {
+	var opts SearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[Issue], *Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		issues, resp, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[Issue]{Values: issues, Total: resp.Total}, resp, nil
+	}, pager)
+}
+
 // GetCustomFields returns a map of customfield_* keys with string values
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) GetCustomFields(ctx context.Context, issueID string) (CustomFields, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1181,7 +1527,7 @@ func (s *IssueService) GetCustomFields(ctx context.Context, issueID string) (Cus
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) GetTransitions(ctx context.Context, id string) ([]Transition, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions?expand=transitions.fields", id)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/transitions?expand=transitions.fields", id)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1221,7 +1567,7 @@ func (s *IssueService) DoTransition(ctx context.Context, ticketID, transitionID
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) DoTransitionWithPayload(ctx context.Context, ticketID, payload interface{}) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/transitions", ticketID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/transitions", ticketID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
 	if err != nil {
@@ -1326,7 +1672,7 @@ func InitIssueWithMetaAndFields(metaProject *MetaProject, metaIssuetype *MetaIss
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) Delete(ctx context.Context, issueID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s", issueID)
 
 	// to enable deletion of subtasks; without this, the request will fail if the issue has subtasks
 	deletePayload := make(map[string]interface{})
@@ -1349,7 +1695,7 @@ func (s *IssueService) Delete(ctx context.Context, issueID string) (*Response, e
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error) {
-	watchesAPIEndpoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	watchesAPIEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/watchers", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, watchesAPIEndpoint, nil)
 	if err != nil {
@@ -1385,7 +1731,7 @@ func (s *IssueService) GetWatchers(ctx context.Context, issueID string) (*[]User
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	apiEndPoint := s.client.restAPIPath(APIVersion2, "issue/%s/watchers", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndPoint, userName)
 	if err != nil {
@@ -1408,7 +1754,7 @@ func (s *IssueService) AddWatcher(ctx context.Context, issueID string, userName
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/watchers", issueID)
+	apiEndPoint := s.client.restAPIPath(APIVersion2, "issue/%s/watchers", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndPoint, userName)
 	if err != nil {
@@ -1431,7 +1777,7 @@ func (s *IssueService) RemoveWatcher(ctx context.Context, issueID string, userNa
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateAssignee(ctx context.Context, issueID string, assignee *User) (*Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issue/%s/assignee", issueID)
+	apiEndPoint := s.client.restAPIPath(APIVersion2, "issue/%s/assignee", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndPoint, assignee)
 	if err != nil {
@@ -1449,13 +1795,7 @@ func (s *IssueService) UpdateAssignee(ctx context.Context, issueID string, assig
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (c ChangelogHistory) CreatedTime() (time.Time, error) {
-	var t time.Time
-	// Ignore null
-	if string(c.Created) == "null" {
-		return t, nil
-	}
-	t, err := time.Parse("2006-01-02T15:04:05.999-0700", c.Created)
-	return t, err
+	return time.Time(c.Created), nil
 }
 
 // GetRemoteLinks gets remote issue links on the issue.
@@ -1465,7 +1805,7 @@ func (c ChangelogHistory) CreatedTime() (time.Time, error) {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) GetRemoteLinks(ctx context.Context, id string) (*[]RemoteLink, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", id)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/remotelink", id)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -1486,7 +1826,7 @@ func (s *IssueService) GetRemoteLinks(ctx context.Context, id string) (*[]Remote
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) AddRemoteLink(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink", issueID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/remotelink", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, remotelink)
 	if err != nil {
 		return nil, nil, err
@@ -1510,7 +1850,7 @@ func (s *IssueService) AddRemoteLink(ctx context.Context, issueID string, remote
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueService) UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *RemoteLink) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/remotelink/%d", issueID, linkID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/remotelink/%d", issueID, linkID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, remotelink)
 	if err != nil {
 		return nil, err
@@ -1524,3 +1864,189 @@ func (s *IssueService) UpdateRemoteLink(ctx context.Context, issueID string, lin
 
 	return resp, nil
 }
+
+// IssuePickerOptions specifies the optional parameters to the IssueService.GetPickerSuggestions
+type IssuePickerOptions struct {
+	// Query is the text to match against issue keys and summaries.
+	Query string `url:"query,omitempty"`
+	// CurrentJQL limits the "current search" section to issues matching this JQL query.
+	CurrentJQL string `url:"currentJQL,omitempty"`
+	// CurrentIssueKey excludes the given issue from the suggestions.
+	CurrentIssueKey  string `url:"currentIssueKey,omitempty"`
+	CurrentProjectID string `url:"currentProjectId,omitempty"`
+	// ShowSubTasks includes sub-tasks in the suggestions.
+	ShowSubTasks *bool `url:"showSubTasks,omitempty"`
+	// ShowSubTaskParent includes the parent of the CurrentIssueKey in the suggestions.
+	ShowSubTaskParent *bool `url:"showSubTaskParent,omitempty"`
+}
+
+// IssuePickerSuggestions is the result of the issue picker.
+// Suggestions are grouped in sections like "History Search" and "Current Search".
+type IssuePickerSuggestions struct {
+	Sections []IssuePickerSection `json:"sections" structs:"sections"`
+}
+
+// IssuePickerSection is a group of issue picker suggestions.
+type IssuePickerSection struct {
+	ID     string                  `json:"id,omitempty" structs:"id,omitempty"`
+	Label  string                  `json:"label,omitempty" structs:"label,omitempty"`
+	Sub    string                  `json:"sub,omitempty" structs:"sub,omitempty"`
+	Msg    string                  `json:"msg,omitempty" structs:"msg,omitempty"`
+	Issues []IssuePickerSuggestion `json:"issues,omitempty" structs:"issues,omitempty"`
+}
+
+// IssuePickerSuggestion is a single issue suggested by the issue picker.
+// KeyHTML and SummaryHTML contain the matched text highlighted with HTML tags.
+type IssuePickerSuggestion struct {
+	ID          int64  `json:"id,omitempty" structs:"id,omitempty"`
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	KeyHTML     string `json:"keyHtml,omitempty" structs:"keyHtml,omitempty"`
+	Img         string `json:"img,omitempty" structs:"img,omitempty"`
+	SummaryHTML string `json:"summary,omitempty" structs:"summary,omitempty"`
+	SummaryText string `json:"summaryText,omitempty" structs:"summaryText,omitempty"`
+}
+
+// GetPickerSuggestions returns lists of issues matching a query string.
+// Use this resource to provide auto-completion suggestions when the user is looking for an issue using a word or string.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-search/#api-rest-api-3-issue-picker-get
+func (s *IssueService) GetPickerSuggestions(ctx context.Context, options *IssuePickerOptions) (*IssuePickerSuggestions, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "issue/picker"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	suggestions := new(IssuePickerSuggestions)
+	resp, err := s.client.Do(req, suggestions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return suggestions, resp, nil
+}
+
+// maxRankIssues is the maximum number of issues Jira accepts in a single rank operation.
+const maxRankIssues = 50
+
+// IssueRankResult is the result of IssueService.Rank.
+// It only contains entries if ranking failed for at least one issue.
+type IssueRankResult struct {
+	Entries []IssueRankEntry `json:"entries,omitempty" structs:"entries,omitempty"`
+}
+
+// IssueRankEntry reflects the outcome of ranking a single issue
+type IssueRankEntry struct {
+	IssueID  int64    `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	IssueKey string   `json:"issueKey,omitempty" structs:"issueKey,omitempty"`
+	Status   int      `json:"status,omitempty" structs:"status,omitempty"`
+	Errors   []string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// Rank moves (ranks) issues before or after a given issue.
+// If RankCustomFieldID is not set, the default rank field is used.
+//
+// Jira only accepts 50 issues per request, so larger sets are split into batches.
+// The relative order of the given issues is kept across batches.
+// The returned result collects the entries of all batches, and resp is the response of the last batch.
+// If a batch fails, the result has the entries of the previous batches.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-issue/#api-rest-agile-1-0-issue-rank-put
+func (s *IssueService) Rank(ctx context.Context, rank *IssuesWrapper) (*IssueRankResult, *Response, error) {
+	result := new(IssueRankResult)
+	var resp *Response
+
+	batch := *rank
+	for issues := rank.Issues; len(issues) > 0; {
+		n := len(issues)
+		if n > maxRankIssues {
+			n = maxRankIssues
+		}
+		batch.Issues = issues[:n]
+
+		req, err := s.client.NewRequest(ctx, http.MethodPut, "rest/agile/1.0/issue/rank", &batch)
+		if err != nil {
+			return result, resp, err
+		}
+
+		resp, err = s.client.Do(req, nil)
+		if err != nil {
+			return result, resp, NewJiraError(resp, err)
+		}
+
+		// Jira responds with 207 Multi-Status and a list of entries if some issues could not be ranked
+		if resp.StatusCode == http.StatusMultiStatus {
+			entries := new(IssueRankResult)
+			err = json.NewDecoder(resp.Body).Decode(entries)
+			if err != nil {
+				resp.Body.Close()
+				return result, resp, err
+			}
+			result.Entries = append(result.Entries, entries.Entries...)
+		}
+		// The body is drained, so the connection can be reused for the next batch.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		// Ranking every batch after the same issue would reverse the batch order,
+		// so further batches are ranked after the last issue of the previous batch.
+		if batch.RankAfterIssue != "" {
+			batch.RankAfterIssue = batch.Issues[n-1]
+		}
+		issues = issues[n:]
+	}
+
+	return result, resp, nil
+}
+
+// IssueEstimation represents the estimation of an issue on a board.
+// FieldID is the estimation field configured for the board, Value is its current value.
+type IssueEstimation struct {
+	FieldID string      `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	Value   interface{} `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// GetEstimation returns the estimation of the issue and the field ID of the estimation field, using the board's configured estimation field.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-issue/#api-rest-agile-1-0-issue-issueidorkey-estimation-get
+func (s *IssueService) GetEstimation(ctx context.Context, issueIDOrKey string, boardID int64) (*IssueEstimation, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/issue/%s/estimation?boardId=%d", issueIDOrKey, boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimation := new(IssueEstimation)
+	resp, err := s.client.Do(req, estimation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return estimation, resp, nil
+}
+
+// SetEstimation updates the estimation of the issue, using the board's configured estimation field.
+// The value is a number for numeric fields such as story points, or a duration like "1w 2d" for time tracking.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-issue/#api-rest-agile-1-0-issue-issueidorkey-estimation-put
+func (s *IssueService) SetEstimation(ctx context.Context, issueIDOrKey string, boardID int64, value string) (*IssueEstimation, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/issue/%s/estimation?boardId=%d", issueIDOrKey, boardID)
+	payload := struct {
+		Value string `json:"value"`
+	}{Value: value}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	estimation := new(IssueEstimation)
+	resp, err := s.client.Do(req, estimation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return estimation, resp, nil
+}
@@ -0,0 +1,265 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// IssuePayload is the body to create or edit an issue with "fields" to set
+// and "update" operations, like adding a label, as built by an IssueBuilder.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+type IssuePayload struct {
+	Fields map[string]interface{}            `json:"fields,omitempty"`
+	Update map[string][]IssueUpdateOperation `json:"update,omitempty"`
+	// Properties are set on the issue when it is created.
+	Properties []EntityProperty `json:"properties,omitempty"`
+}
+
+// IssueUpdateOperation is an operation of the "update" of an IssuePayload,
+// like {"add": "urgent"} for the labels or {"remove": {"name": "Backend"}} for the components.
+type IssueUpdateOperation map[string]interface{}
+
+// IssueBuilder builds the IssuePayload to create or edit an issue.
+// A field is either set with the methods named after it, like Labels, or changed with
+// update operations, like AddLabels, as Jira rejects a field in "fields" and "update".
+// The last call for a field wins.
+//
+// Example:
+//
+//	payload := cloud.NewIssue("EX", "Bug").
+//		Summary("Login fails").
+//		Assignee("5b10ac8d82e05b22cc7d4ef5").
+//		Labels("auth").
+//		CustomField("customfield_10010", 5).
+//		Build()
+//	issue, _, err := client.Issue.CreatePayload(ctx, payload)
+type IssueBuilder struct {
+	fields map[string]interface{}
+	update map[string][]IssueUpdateOperation
+}
+
+// NewIssue returns an IssueBuilder for a new issue of the issue type in the project with the key.
+func NewIssue(projectKey, issueType string) *IssueBuilder {
+	return NewIssueUpdate().
+		Field("project", map[string]interface{}{"key": projectKey}).
+		Field("issuetype", map[string]interface{}{"name": issueType})
+}
+
+// NewIssueUpdate returns an empty IssueBuilder, e.g. to edit an existing issue.
+func NewIssueUpdate() *IssueBuilder {
+	return &IssueBuilder{
+		fields: map[string]interface{}{},
+		update: map[string][]IssueUpdateOperation{},
+	}
+}
+
+// Field sets the field with the ID to value. value must marshal to the JSON Jira expects for the field.
+func (b *IssueBuilder) Field(id string, value interface{}) *IssueBuilder {
+	delete(b.update, id)
+	b.fields[id] = value
+	return b
+}
+
+// CustomField sets the custom field with the ID, e.g. "customfield_10010", to value.
+func (b *IssueBuilder) CustomField(id string, value interface{}) *IssueBuilder {
+	return b.Field(id, value)
+}
+
+// Summary sets the summary.
+func (b *IssueBuilder) Summary(summary string) *IssueBuilder {
+	return b.Field("summary", summary)
+}
+
+// Description sets the description.
+func (b *IssueBuilder) Description(description string) *IssueBuilder {
+	return b.Field("description", description)
+}
+
+// Assignee sets the assignee to the user with the account ID.
+// An empty account ID unassigns the issue.
+func (b *IssueBuilder) Assignee(accountID string) *IssueBuilder {
+	return b.Field("assignee", userRef(accountID))
+}
+
+// Reporter sets the reporter to the user with the account ID.
+func (b *IssueBuilder) Reporter(accountID string) *IssueBuilder {
+	return b.Field("reporter", userRef(accountID))
+}
+
+// userRef returns the reference to the user with the account ID, or null.
+func userRef(accountID string) interface{} {
+	if accountID == "" {
+		return nil
+	}
+	return map[string]interface{}{"accountId": accountID}
+}
+
+// Priority sets the priority by its name, e.g. "High".
+func (b *IssueBuilder) Priority(name string) *IssueBuilder {
+	return b.Field("priority", map[string]interface{}{"name": name})
+}
+
+// Parent sets the parent issue by its key, e.g. of a sub-task.
+func (b *IssueBuilder) Parent(key string) *IssueBuilder {
+	return b.Field("parent", map[string]interface{}{"key": key})
+}
+
+// DueDate sets the due date to the day of t.
+func (b *IssueBuilder) DueDate(t time.Time) *IssueBuilder {
+	return b.Field("duedate", t.Format(DateLayout))
+}
+
+// Labels replaces the labels.
+func (b *IssueBuilder) Labels(labels ...string) *IssueBuilder {
+	if labels == nil {
+		labels = []string{}
+	}
+	return b.Field("labels", labels)
+}
+
+// AddLabels adds the labels, keeping the existing labels of the issue.
+func (b *IssueBuilder) AddLabels(labels ...string) *IssueBuilder {
+	for _, label := range labels {
+		b.operation("labels", "add", label)
+	}
+	return b
+}
+
+// RemoveLabels removes the labels from the issue.
+func (b *IssueBuilder) RemoveLabels(labels ...string) *IssueBuilder {
+	for _, label := range labels {
+		b.operation("labels", "remove", label)
+	}
+	return b
+}
+
+// Components replaces the components by their names.
+func (b *IssueBuilder) Components(names ...string) *IssueBuilder {
+	return b.Field("components", nameRefs(names))
+}
+
+// AddComponents adds the components by their names, keeping the existing components of the issue.
+func (b *IssueBuilder) AddComponents(names ...string) *IssueBuilder {
+	for _, name := range names {
+		b.operation("components", "add", map[string]interface{}{"name": name})
+	}
+	return b
+}
+
+// RemoveComponents removes the components by their names from the issue.
+func (b *IssueBuilder) RemoveComponents(names ...string) *IssueBuilder {
+	for _, name := range names {
+		b.operation("components", "remove", map[string]interface{}{"name": name})
+	}
+	return b
+}
+
+// FixVersions replaces the fix versions by their names.
+func (b *IssueBuilder) FixVersions(names ...string) *IssueBuilder {
+	return b.Field("fixVersions", nameRefs(names))
+}
+
+// nameRefs returns the references to the components or versions with the names.
+func nameRefs(names []string) []map[string]interface{} {
+	refs := make([]map[string]interface{}, len(names))
+	for i, name := range names {
+		refs[i] = map[string]interface{}{"name": name}
+	}
+	return refs
+}
+
+// LinkTo adds an outward link of the link type, e.g. "Blocks", to the issue with the key.
+// The issue built "blocks" the issue with the key.
+func (b *IssueBuilder) LinkTo(linkType, key string) *IssueBuilder {
+	return b.operation("issuelinks", "add", map[string]interface{}{
+		"type":         map[string]interface{}{"name": linkType},
+		"outwardIssue": map[string]interface{}{"key": key},
+	})
+}
+
+// LinkFrom adds an inward link of the link type, e.g. "Blocks", from the issue with the key.
+// The issue built "is blocked by" the issue with the key.
+func (b *IssueBuilder) LinkFrom(linkType, key string) *IssueBuilder {
+	return b.operation("issuelinks", "add", map[string]interface{}{
+		"type":        map[string]interface{}{"name": linkType},
+		"inwardIssue": map[string]interface{}{"key": key},
+	})
+}
+
+// AddComment adds a comment with the body. It is only supported when editing an issue.
+func (b *IssueBuilder) AddComment(body string) *IssueBuilder {
+	return b.operation("comment", "add", map[string]interface{}{"body": body})
+}
+
+// operation appends the update operation for the field and drops the value set for it in fields.
+func (b *IssueBuilder) operation(field, verb string, value interface{}) *IssueBuilder {
+	delete(b.fields, field)
+	b.update[field] = append(b.update[field], IssueUpdateOperation{verb: value})
+	return b
+}
+
+// Build returns the payload for IssueService.CreatePayload or IssueService.UpdatePayload.
+func (b *IssueBuilder) Build() *IssuePayload {
+	payload := &IssuePayload{}
+	if len(b.fields) > 0 {
+		payload.Fields = make(map[string]interface{}, len(b.fields))
+		for id, value := range b.fields {
+			payload.Fields[id] = value
+		}
+	}
+	if len(b.update) > 0 {
+		payload.Update = make(map[string][]IssueUpdateOperation, len(b.update))
+		for id, ops := range b.update {
+			payload.Update[id] = append([]IssueUpdateOperation(nil), ops...)
+		}
+	}
+	return payload
+}
+
+// CreatePayload creates an issue from the payload, e.g. built by NewIssue.
+// The returned issue only contains the ID, key and self link.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-post
+func (s *IssueService) CreatePayload(ctx context.Context, payload *IssuePayload) (*Issue, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	issue := new(Issue)
+	if err := json.NewDecoder(resp.Body).Decode(issue); err != nil {
+		return nil, resp, err
+	}
+	return issue, resp, nil
+}
+
+// UpdatePayload edits the issue with the ID or key with the payload, e.g. built by NewIssueUpdate.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-put
+func (s *IssueService) UpdatePayload(ctx context.Context, issueID string, payload *IssuePayload, opts *UpdateQueryOptions) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s", issueID)
+	url, err := addOptions(apiEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, url, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,115 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIssueBuilder_Build(t *testing.T) {
+	payload := NewIssue("EX", "Bug").
+		Summary("Login fails").
+		Assignee("5b10ac8d82e05b22cc7d4ef5").
+		Priority("High").
+		DueDate(time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)).
+		Labels("auth", "login").
+		Components("Backend").
+		CustomField("customfield_10010", 5).
+		LinkTo("Blocks", "EX-2").
+		Build()
+
+	got, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"fields":{"assignee":{"accountId":"5b10ac8d82e05b22cc7d4ef5"},"components":[{"name":"Backend"}],"customfield_10010":5,` +
+		`"duedate":"2024-03-01","issuetype":{"name":"Bug"},"labels":["auth","login"],"priority":{"name":"High"},"project":{"key":"EX"},"summary":"Login fails"},` +
+		`"update":{"issuelinks":[{"add":{"outwardIssue":{"key":"EX-2"},"type":{"name":"Blocks"}}}]}}`
+	if string(got) != want {
+		t.Errorf("Unexpected JSON\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestIssueBuilder_Operations(t *testing.T) {
+	payload := NewIssueUpdate().
+		Labels("stale").
+		AddLabels("urgent").
+		RemoveLabels("triage").
+		AddComponents("Frontend").
+		RemoveComponents("Backend").
+		LinkFrom("Blocks", "EX-3").
+		AddComment("Escalated").
+		Assignee("").
+		Build()
+
+	got, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `{"fields":{"assignee":null},"update":{"comment":[{"add":{"body":"Escalated"}}],` +
+		`"components":[{"add":{"name":"Frontend"}},{"remove":{"name":"Backend"}}],` +
+		`"issuelinks":[{"add":{"inwardIssue":{"key":"EX-3"},"type":{"name":"Blocks"}}}],` +
+		`"labels":[{"add":"urgent"},{"remove":"triage"}]}}`
+	if string(got) != want {
+		t.Errorf("Unexpected JSON\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestIssueBuilder_FieldReplacesOperations(t *testing.T) {
+	payload := NewIssueUpdate().AddLabels("urgent").Labels().Build()
+
+	if payload.Update != nil {
+		t.Errorf("Expected no update operations, got %v", payload.Update)
+	}
+	if labels, ok := payload.Fields["labels"].([]string); !ok || len(labels) != 0 {
+		t.Errorf("Expected empty labels, got %v", payload.Fields["labels"])
+	}
+}
+
+func TestIssueService_CreatePayload(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, "/rest/api/2/issue")
+
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"fields":{"issuetype":{"name":"Task"},"project":{"key":"EX"},"summary":"Write docs"}}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected body\n got: %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","self":"http://www.example.com/jira/rest/api/2/issue/10002"}`)
+	})
+
+	issue, _, err := testClient.Issue.CreatePayload(context.Background(), NewIssue("EX", "Task").Summary("Write docs").Build())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, got %s", issue.Key)
+	}
+}
+
+func TestIssueService_UpdatePayload(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?overrideEditableFlag=true")
+
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"update":{"labels":[{"add":"urgent"}]}}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected body\n got: %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &UpdateQueryOptions{OverrideEditableFlag: true}
+	if _, err := testClient.Issue.UpdatePayload(context.Background(), "EX-1", NewIssueUpdate().AddLabels("urgent").Build(), opts); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
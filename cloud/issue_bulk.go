@@ -0,0 +1,99 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// MaxBulkCreateIssues is the maximum number of issues Jira creates with one request of IssueService.CreateBulk.
+const MaxBulkCreateIssues = 50
+
+// BulkCreateResult is the result of creating issues in bulk.
+type BulkCreateResult struct {
+	// Issues are the created issues, in the order of their payloads.
+	// Only the successfully created issues are listed, so if payloads failed, the index of an issue
+	// is not the index of its payload. The failed payloads are identified by ElementError.FailedElementNumber.
+	// The issues only contain the ID, key and self link.
+	Issues []*Issue `json:"issues"`
+	// Errors are the errors of the payloads that failed.
+	// ElementError.FailedElementNumber is the index of the payload that failed.
+	Errors []ElementError `json:"-"`
+}
+
+// UnmarshalJSON decodes the created issues and the element errors of the response.
+func (r *BulkCreateResult) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Issues []*Issue `json:"issues"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var jerr Error
+	if err := json.Unmarshal(data, &jerr); err != nil {
+		return err
+	}
+	r.Issues = raw.Issues
+	r.Errors = jerr.ElementErrors
+	return nil
+}
+
+// CreateBulk creates up to MaxBulkCreateIssues issues from the payloads, e.g. built by NewIssue, with one request.
+// Jira creates the valid payloads even if others fail, their errors are in BulkCreateResult.Errors.
+// If all payloads fail, the error is an *Error with the ElementErrors.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-bulk-post
+func (s *IssueService) CreateBulk(ctx context.Context, payloads []*IssuePayload) (*BulkCreateResult, *Response, error) {
+	if len(payloads) > MaxBulkCreateIssues {
+		return nil, nil, fmt.Errorf("jira: can't create more than %d issues in bulk, got %d", MaxBulkCreateIssues, len(payloads))
+	}
+
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/bulk")
+	body := struct {
+		IssueUpdates []*IssuePayload `json:"issueUpdates"`
+	}{IssueUpdates: payloads}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(BulkCreateResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// CreateBulkAll creates the issues from any number of payloads with CreateBulk, MaxBulkCreateIssues at a time.
+// The failed payloads of a request don't stop the following requests,
+// their errors are returned in BulkCreateResult.Errors with the index of the payload in payloads.
+// Any other error stops CreateBulkAll and is returned with the result of the issues created so far.
+func (s *IssueService) CreateBulkAll(ctx context.Context, payloads []*IssuePayload) (*BulkCreateResult, error) {
+	all := &BulkCreateResult{}
+	for start := 0; start < len(payloads); start += MaxBulkCreateIssues {
+		end := start + MaxBulkCreateIssues
+		if end > len(payloads) {
+			end = len(payloads)
+		}
+
+		result, _, err := s.CreateBulk(ctx, payloads[start:end])
+		if err != nil {
+			var jerr *Error
+			if !errors.As(err, &jerr) || len(jerr.ElementErrors) == 0 {
+				return all, err
+			}
+			result = &BulkCreateResult{Errors: jerr.ElementErrors}
+		}
+
+		all.Issues = append(all.Issues, result.Issues...)
+		for _, e := range result.Errors {
+			e.FailedElementNumber += start
+			all.Errors = append(all.Errors, e)
+		}
+	}
+	return all, nil
+}
@@ -0,0 +1,110 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_CreateBulk(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			IssueUpdates []IssuePayload `json:"issueUpdates"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if len(body.IssueUpdates) != 2 || body.IssueUpdates[0].Fields["summary"] != "Write docs" {
+			t.Errorf("Unexpected payloads %+v", body.IssueUpdates)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issues":[{"id":"10002","key":"EX-1"}],"errors":[{"status":400,"failedElementNumber":1,"elementErrors":{"errors":{"summary":"You must specify a summary of the issue."}}}]}`)
+	})
+
+	payloads := []*IssuePayload{NewIssue("EX", "Task").Summary("Write docs").Build(), NewIssue("EX", "Task").Build()}
+	result, _, err := testClient.Issue.CreateBulk(context.Background(), payloads)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Key != "EX-1" {
+		t.Errorf("Unexpected issues %+v", result.Issues)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].FailedElementNumber != 1 || result.Errors[0].Errors["summary"] == "" {
+		t.Errorf("Unexpected errors %+v", result.Errors)
+	}
+}
+
+func TestIssueService_CreateBulk_TooManyPayloads(t *testing.T) {
+	setup()
+	defer teardown()
+	payloads := make([]*IssuePayload, MaxBulkCreateIssues+1)
+	if _, _, err := testClient.Issue.CreateBulk(context.Background(), payloads); err == nil {
+		t.Error("Expected an error for more than MaxBulkCreateIssues payloads")
+	}
+}
+
+func TestIssueService_CreateBulkAll(t *testing.T) {
+	setup()
+	defer teardown()
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body struct {
+			IssueUpdates []IssuePayload `json:"issueUpdates"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		requests++
+		switch requests {
+		case 1:
+			if len(body.IssueUpdates) != MaxBulkCreateIssues {
+				t.Errorf("Expected %d payloads, got %d", MaxBulkCreateIssues, len(body.IssueUpdates))
+			}
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"issues":[{"id":"10001","key":"EX-1"}],"errors":[]}`)
+		case 2:
+			if len(body.IssueUpdates) != 2 {
+				t.Errorf("Expected 2 payloads, got %d", len(body.IssueUpdates))
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"issues":[],"errors":[{"status":400,"failedElementNumber":0,"elementErrors":{"errors":{"project":"project is required"}}},{"status":400,"failedElementNumber":1,"elementErrors":{"errors":{"project":"project is required"}}}]}`)
+		}
+	})
+
+	payloads := make([]*IssuePayload, MaxBulkCreateIssues+2)
+	for i := range payloads {
+		payloads[i] = NewIssue("EX", "Task").Summary(fmt.Sprint("Task ", i)).Build()
+	}
+	result, err := testClient.Issue.CreateBulkAll(context.Background(), payloads)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+	if len(result.Issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(result.Issues))
+	}
+	if len(result.Errors) != 2 || result.Errors[0].FailedElementNumber != MaxBulkCreateIssues || result.Errors[1].FailedElementNumber != MaxBulkCreateIssues+1 {
+		t.Errorf("Unexpected errors %+v", result.Errors)
+	}
+}
+
+func TestIssueService_CreateBulkAll_RequestError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := testClient.Issue.CreateBulkAll(context.Background(), []*IssuePayload{NewIssue("EX", "Task").Build()})
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
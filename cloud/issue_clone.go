@@ -0,0 +1,327 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// CloneLinkType is the issue link type CloneIssue links a clone to its source issue with.
+const CloneLinkType = "Cloners"
+
+// CloneOptions specifies what IssueService.CloneIssue copies from the source issue.
+type CloneOptions struct {
+	// Project is the key of the project to create the clone in. Defaults to the project of the source issue.
+	Project string
+	// IssueType is the name of the issue type of the clone. Defaults to the issue type of the source issue.
+	IssueType string
+	// SummaryPrefix is prepended to the summary of the clone, e.g. "CLONE - ".
+	SummaryPrefix string
+	// Fields are the IDs of the fields to copy. By default, all fields of the source issue
+	// that can be set on the create screen of the target project and issue type are copied.
+	Fields []string
+	// Attachments copies the attachments of the source issue.
+	Attachments bool
+	// Links copies the issue links of the source issue.
+	Links bool
+	// Watchers adds the watchers of the source issue to the clone.
+	Watchers bool
+	// Subtasks clones the sub-tasks of the source issue with the same options as sub-tasks of the clone.
+	Subtasks bool
+	// LinkType is the issue link type the clone "clones" the source issue with. Defaults to CloneLinkType.
+	LinkType string
+}
+
+// cloneSkippedFields are the fields CloneIssue sets itself or copies with separate requests.
+var cloneSkippedFields = map[string]bool{
+	"project":    true,
+	"issuetype":  true,
+	"parent":     true,
+	"attachment": true,
+	"issuelinks": true,
+	"subtasks":   true,
+	"watches":    true,
+	// Comments and worklogs are returned as lists with the issue, but can't be set on create.
+	"comment": true,
+	"worklog": true,
+}
+
+// sprintFieldType is the custom field type of the sprint field of Jira Software.
+const sprintFieldType = "com.pyxis.greenhopper.jira:gh-sprint"
+
+// cloneSource is the source issue of CloneIssue with the raw values of its fields.
+type cloneSource struct {
+	ID     string                     `json:"id"`
+	Key    string                     `json:"key"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// CloneIssue creates a copy of the issue with the ID or key and links it to the source issue
+// with a "clones" link. The summary, description and other fields are copied, as long as the
+// create screen of the target project and issue type has them, so an issue can be cloned
+// to a project with different fields.
+// Values are converted by the schema of the create screen where Jira returns them differently,
+// e.g. the sprint is set to the open sprint of the source issue and only the original estimate is copied;
+// comments and worklogs are not copied.
+// The attachments, links, watchers and sub-tasks are copied if options asks for them.
+//
+// The returned issue only contains the ID, key and self link of the clone.
+// If copying the attachments, links, watchers or sub-tasks fails, the clone is returned with the error,
+// it is not deleted.
+func (s *IssueService) CloneIssue(ctx context.Context, issueIDOrKey string, options *CloneOptions) (*Issue, *Response, error) {
+	if options == nil {
+		options = &CloneOptions{}
+	}
+
+	source, resp, err := s.getCloneSource(ctx, issueIDOrKey)
+	if err != nil {
+		return nil, resp, err
+	}
+	return s.cloneIssue(ctx, source, options, "")
+}
+
+func (s *IssueService) cloneIssue(ctx context.Context, source *cloneSource, options *CloneOptions, parentKey string) (*Issue, *Response, error) {
+	project := options.Project
+	if project == "" {
+		var p Project
+		if err := source.field("project", &p); err != nil {
+			return nil, nil, err
+		}
+		project = p.Key
+	}
+	issueType := options.IssueType
+	if issueType == "" || parentKey != "" {
+		var t IssueType
+		if err := source.field("issuetype", &t); err != nil {
+			return nil, nil, err
+		}
+		issueType = t.Name
+	}
+
+	meta, resp, err := s.GetCreateMeta(ctx, &GetQueryOptions{ProjectKeys: project, Expand: "projects.issuetypes.fields"})
+	if err != nil {
+		return nil, resp, err
+	}
+	metaProject := meta.GetProjectWithKey(project)
+	if metaProject == nil {
+		return nil, resp, fmt.Errorf("jira: can't create issues in project %s", project)
+	}
+	metaIssueType := metaProject.GetIssueTypeWithName(issueType)
+	if metaIssueType == nil {
+		return nil, resp, fmt.Errorf("jira: can't create issues of type %s in project %s", issueType, project)
+	}
+
+	linkType := options.LinkType
+	if linkType == "" {
+		linkType = CloneLinkType
+	}
+	builder := NewIssue(project, issueType).LinkTo(linkType, source.Key)
+	if parentKey != "" {
+		builder.Field("parent", map[string]interface{}{"key": parentKey})
+	}
+	for id, value := range source.copyFields(options.Fields, metaIssueType) {
+		builder.Field(id, value)
+	}
+	if options.SummaryPrefix != "" {
+		var summary string
+		if err := source.field("summary", &summary); err != nil {
+			return nil, nil, err
+		}
+		builder.Summary(options.SummaryPrefix + summary)
+	}
+
+	clone, resp, err := s.CreatePayload(ctx, builder.Build())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if options.Attachments {
+		if resp, err := s.cloneAttachments(ctx, source, clone.Key); err != nil {
+			return clone, resp, err
+		}
+	}
+	if options.Links {
+		if resp, err := s.cloneLinks(ctx, source, clone.Key); err != nil {
+			return clone, resp, err
+		}
+	}
+	if options.Watchers {
+		if resp, err := s.cloneWatchers(ctx, source, clone.Key); err != nil {
+			return clone, resp, err
+		}
+	}
+	if options.Subtasks && parentKey == "" {
+		var subtasks []*Subtasks
+		if err := source.field("subtasks", &subtasks); err != nil {
+			return clone, nil, err
+		}
+		subtaskOptions := *options
+		subtaskOptions.Project = project
+		for _, subtask := range subtasks {
+			subtaskSource, resp, err := s.getCloneSource(ctx, subtask.Key)
+			if err != nil {
+				return clone, resp, err
+			}
+			if _, resp, err := s.cloneIssue(ctx, subtaskSource, &subtaskOptions, clone.Key); err != nil {
+				return clone, resp, err
+			}
+		}
+	}
+
+	return clone, resp, nil
+}
+
+// getCloneSource fetches the issue with the ID or key with the raw values of its fields.
+func (s *IssueService) getCloneSource(ctx context.Context, issueIDOrKey string) (*cloneSource, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s", issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source := new(cloneSource)
+	resp, err := s.client.Do(req, source)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return source, resp, nil
+}
+
+// cloneAttachments downloads the attachments of the source issue and uploads them to the clone.
+func (s *IssueService) cloneAttachments(ctx context.Context, source *cloneSource, cloneKey string) (*Response, error) {
+	var attachments []*Attachment
+	if err := source.field("attachment", &attachments); err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		download, err := s.DownloadAttachment(ctx, attachment.ID)
+		if err != nil {
+			return download, err
+		}
+		_, resp, err := s.PostAttachment(ctx, cloneKey, download.Body, attachment.Filename)
+		download.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+	}
+	return nil, nil
+}
+
+// cloneLinks links the clone to the issues the source issue is linked to, with the same link type and direction.
+func (s *IssueService) cloneLinks(ctx context.Context, source *cloneSource, cloneKey string) (*Response, error) {
+	var links []*IssueLink
+	if err := source.field("issuelinks", &links); err != nil {
+		return nil, err
+	}
+	for _, link := range links {
+		newLink := &IssueLink{Type: IssueLinkType{Name: link.Type.Name}}
+		switch {
+		case link.OutwardIssue != nil:
+			newLink.InwardIssue = &Issue{Key: cloneKey}
+			newLink.OutwardIssue = &Issue{Key: link.OutwardIssue.Key}
+		case link.InwardIssue != nil:
+			newLink.InwardIssue = &Issue{Key: link.InwardIssue.Key}
+			newLink.OutwardIssue = &Issue{Key: cloneKey}
+		default:
+			continue
+		}
+		resp, err := s.AddLink(ctx, newLink)
+		if err != nil {
+			return resp, err
+		}
+	}
+	return nil, nil
+}
+
+// cloneWatchers adds the watchers of the source issue to the clone.
+func (s *IssueService) cloneWatchers(ctx context.Context, source *cloneSource, cloneKey string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/watchers", source.Key)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	watches := new(Watches)
+	resp, err := s.client.Do(req, watches)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	for _, watcher := range watches.Watchers {
+		resp, err := s.AddWatcher(ctx, cloneKey, watcher.AccountID)
+		if err != nil {
+			return resp, err
+		}
+	}
+	return nil, nil
+}
+
+// field decodes the raw value of the field with the ID into v. A missing field leaves v unchanged.
+func (c *cloneSource) field(id string, v interface{}) error {
+	raw, ok := c.Fields[id]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("jira: error decoding field %s of issue %s: %w", id, c.Key, err)
+	}
+	return nil
+}
+
+// copyFields returns the values of the fields to copy to a clone that can be set on the create screen of the issue type.
+func (c *cloneSource) copyFields(ids []string, issueType *MetaIssueType) map[string]interface{} {
+	if ids == nil {
+		for id := range c.Fields {
+			ids = append(ids, id)
+		}
+	}
+
+	fields := make(map[string]interface{}, len(ids))
+	for _, id := range ids {
+		raw, ok := c.Fields[id]
+		if !ok || string(raw) == "null" || cloneSkippedFields[id] {
+			continue
+		}
+		if _, ok := issueType.Fields[id]; !ok {
+			continue
+		}
+		if value, ok := createValue(id, raw, issueType); ok {
+			fields[id] = value
+		}
+	}
+	return fields
+}
+
+// createValue converts the value of the field with the ID, as returned with an issue, to the value of the field
+// on create, by the schema of the field on the create screen of the issue type.
+// It returns false if the value can't be set on create.
+func createValue(id string, raw json.RawMessage, issueType *MetaIssueType) (interface{}, bool) {
+	custom, _ := issueType.Fields.String(id + "/schema/custom")
+	system, _ := issueType.Fields.String(id + "/schema/system")
+	switch {
+	case custom == sprintFieldType:
+		// The sprints are returned as objects, but an issue is created in a single sprint by its ID.
+		// Closed sprints can't be set, so the clone is added to the open sprint of the source issue.
+		var sprints []struct {
+			ID    int    `json:"id"`
+			State string `json:"state"`
+		}
+		if err := json.Unmarshal(raw, &sprints); err != nil {
+			return nil, false
+		}
+		for i := len(sprints) - 1; i >= 0; i-- {
+			if sprints[i].State != "closed" {
+				return sprints[i].ID, true
+			}
+		}
+		return nil, false
+	case system == "timetracking" || id == "timetracking":
+		// The time spent and the computed values are returned too, only the estimate can be set on create.
+		var tracking TimeTracking
+		if err := json.Unmarshal(raw, &tracking); err != nil || tracking.OriginalEstimate == "" {
+			return nil, false
+		}
+		return map[string]string{"originalEstimate": tracking.OriginalEstimate}, true
+	}
+	return raw, true
+}
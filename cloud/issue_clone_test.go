@@ -0,0 +1,211 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestIssueService_CloneIssue(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{
+			"project":{"key":"EX"},
+			"issuetype":{"name":"Story"},
+			"summary":"Login fails",
+			"description":"Steps to reproduce",
+			"customfield_10010":5,
+			"customfield_10020":{"value":"High"},
+			"attachment":[{"id":"20001","filename":"trace.log"}],
+			"issuelinks":[{"type":{"name":"Blocks"},"outwardIssue":{"key":"EX-9"}}],
+			"subtasks":[{"id":"10002","key":"EX-2"}]
+		}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10002","key":"EX-2","fields":{"project":{"key":"EX"},"issuetype":{"name":"Sub-task"},"summary":"Fix session","parent":{"key":"EX-1"}}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"projectKeys": "OPS", "expand": "projects.issuetypes.fields"})
+		fmt.Fprint(w, `{"projects":[{"key":"OPS","issuetypes":[
+			{"name":"Story","fields":{"summary":{},"description":{},"customfield_10020":{},"issuelinks":{}}},
+			{"name":"Sub-task","subtask":true,"fields":{"summary":{},"parent":{}}}
+		]}]}`)
+	})
+
+	var mu sync.Mutex
+	var created []map[string]interface{}
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		mu.Lock()
+		created = append(created, payload)
+		key := fmt.Sprintf("OPS-%d", len(created))
+		mu.Unlock()
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"3000%d","key":%q}`, len(created), key)
+	})
+	testMux.HandleFunc("/secure/attachment/20001/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, "panic: nil session")
+	})
+	testMux.HandleFunc("/rest/api/2/issue/OPS-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "trace.log" || string(content) != "panic: nil session" {
+			t.Errorf("Unexpected attachment %s: %s", header.Filename, content)
+		}
+		fmt.Fprint(w, `[{"id":"20002","filename":"trace.log"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"type":{"name":"Blocks","inward":"","outward":""},"outwardIssue":{"key":"EX-9"},"inwardIssue":{"key":"OPS-1"}}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected link\n got: %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"watchCount":1,"watchers":[{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-2/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"watchCount":0,"watchers":[]}`)
+	})
+	var watcher string
+	testMux.HandleFunc("/rest/api/2/issue/OPS-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		json.NewDecoder(r.Body).Decode(&watcher)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	options := &CloneOptions{Project: "OPS", SummaryPrefix: "CLONE - ", Attachments: true, Links: true, Watchers: true, Subtasks: true}
+	clone, _, err := testClient.Issue.CloneIssue(context.Background(), "EX-1", options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if clone.Key != "OPS-1" {
+		t.Errorf("Expected clone OPS-1, got %s", clone.Key)
+	}
+	if watcher != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Expected watcher 5b10ac8d82e05b22cc7d4ef5, got %q", watcher)
+	}
+
+	want := []map[string]interface{}{
+		{
+			"fields": map[string]interface{}{
+				"project":           map[string]interface{}{"key": "OPS"},
+				"issuetype":         map[string]interface{}{"name": "Story"},
+				"summary":           "CLONE - Login fails",
+				"description":       "Steps to reproduce",
+				"customfield_10020": map[string]interface{}{"value": "High"},
+			},
+			"update": map[string]interface{}{
+				"issuelinks": []interface{}{map[string]interface{}{"add": map[string]interface{}{
+					"type":         map[string]interface{}{"name": "Cloners"},
+					"outwardIssue": map[string]interface{}{"key": "EX-1"},
+				}}},
+			},
+		},
+		{
+			"fields": map[string]interface{}{
+				"project":   map[string]interface{}{"key": "OPS"},
+				"issuetype": map[string]interface{}{"name": "Sub-task"},
+				"summary":   "CLONE - Fix session",
+				"parent":    map[string]interface{}{"key": "OPS-1"},
+			},
+			"update": map[string]interface{}{
+				"issuelinks": []interface{}{map[string]interface{}{"add": map[string]interface{}{
+					"type":         map[string]interface{}{"name": "Cloners"},
+					"outwardIssue": map[string]interface{}{"key": "EX-2"},
+				}}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("Unexpected issues created\n got: %v\nwant: %v", created, want)
+	}
+}
+
+func TestIssueService_CloneIssue_CreateMetaSchema(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{
+			"project":{"key":"EX"},
+			"issuetype":{"name":"Story"},
+			"summary":"Login fails",
+			"customfield_10020":[
+				{"id":36,"name":"Sprint 1","state":"closed","boardId":1},
+				{"id":37,"name":"Sprint 2","state":"active","boardId":1}
+			],
+			"timetracking":{"originalEstimate":"1d","remainingEstimate":"2h","timeSpent":"6h","originalEstimateSeconds":28800},
+			"comment":{"comments":[{"id":"1","body":"Confirmed"}],"total":1},
+			"worklog":{"worklogs":[{"id":"100","timeSpent":"6h"}],"total":1}
+		}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"projects":[{"key":"EX","issuetypes":[{"name":"Story","fields":{
+			"summary":{"schema":{"type":"string","system":"summary"}},
+			"customfield_10020":{"schema":{"type":"array","items":"json","custom":"com.pyxis.greenhopper.jira:gh-sprint","customId":10020}},
+			"timetracking":{"schema":{"type":"timetracking","system":"timetracking"}},
+			"comment":{"schema":{"type":"comments-page","system":"comment"}},
+			"worklog":{"schema":{"type":"array","items":"worklog","system":"worklog"}}
+		}}]}]}`)
+	})
+	var created map[string]interface{}
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		json.NewDecoder(r.Body).Decode(&created)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"30001","key":"EX-2"}`)
+	})
+
+	if _, _, err := testClient.Issue.CloneIssue(context.Background(), "EX-1", nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := map[string]interface{}{
+		"project":           map[string]interface{}{"key": "EX"},
+		"issuetype":         map[string]interface{}{"name": "Story"},
+		"summary":           "Login fails",
+		"customfield_10020": float64(37),
+		"timetracking":      map[string]interface{}{"originalEstimate": "1d"},
+	}
+	if got := created["fields"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected fields\n got: %v\nwant: %v", got, want)
+	}
+}
+
+func TestIssueService_CloneIssue_IssueTypeNotInProject(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{"project":{"key":"EX"},"issuetype":{"name":"Epic"},"summary":"Login"}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"projects":[{"key":"EX","issuetypes":[{"name":"Story","fields":{"summary":{}}}]}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No issue should be created")
+	})
+
+	if _, _, err := testClient.Issue.CloneIssue(context.Background(), "EX-1", nil); err == nil {
+		t.Error("Expected an error for an issue type the project doesn't have")
+	}
+}
@@ -0,0 +1,103 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// IssueFull is an issue with all of its comments, worklogs, changelog, remote links and attachments,
+// as returned by IssueService.GetIssueFull.
+type IssueFull struct {
+	Issue       *Issue             `json:"issue"`
+	Comments    []*Comment         `json:"comments"`
+	Worklogs    []WorklogRecord    `json:"worklogs"`
+	Changelog   []ChangelogHistory `json:"changelog"`
+	RemoteLinks []RemoteLink       `json:"remoteLinks"`
+	Attachments []*Attachment      `json:"attachments"`
+}
+
+// changelogPage is a page of the changelog of an issue.
+type changelogPage struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Values     []ChangelogHistory `json:"values"`
+}
+
+// GetIssueFull fetches the issue with the ID or key with all of its comments, worklogs, changelog,
+// remote links and attachments, following the pagination of each, e.g. to back up or sync an issue.
+// The comments, worklogs and changelog embedded in the fields of the issue only hold their first page,
+// IssueFull holds all of them.
+//
+// The pages are fetched one after another. The first failing request stops GetIssueFull with its error.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-issueidorkey-changelog-get
+func (s *IssueService) GetIssueFull(ctx context.Context, issueIDOrKey string) (*IssueFull, error) {
+	issue, _, err := s.Get(ctx, issueIDOrKey, nil)
+	if err != nil {
+		return nil, err
+	}
+	full := &IssueFull{Issue: issue}
+	if issue.Fields != nil {
+		full.Attachments = issue.Fields.Attachments
+	}
+
+	full.Comments, err = s.commentsPager(issue.Key, nil).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	full.Worklogs, err = s.worklogsPager(issue.Key, nil).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	full.Changelog, err = NewPager(func(ctx context.Context, page PageRequest) (*Page[ChangelogHistory], *Response, error) {
+		changelog, resp, err := s.getChangelogPage(ctx, issue.Key, page)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[ChangelogHistory]{Values: changelog.Values, Total: changelog.Total, IsLast: changelog.IsLast}, resp, nil
+	}, nil).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteLinks, _, err := s.GetRemoteLinks(ctx, issue.Key)
+	if err != nil {
+		return nil, err
+	}
+	full.RemoteLinks = *remoteLinks
+
+	return full, nil
+}
+
+// getChangelogPage fetches a page of the changelog of the issue.
+func (s *IssueService) getChangelogPage(ctx context.Context, issueIDOrKey string, page PageRequest) (*changelogPage, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue/%s/changelog", issueIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := applyRequestOptions(req, pageRequestOptions(page)); err != nil {
+		return nil, nil, err
+	}
+
+	changelog := new(changelogPage)
+	resp, err := s.client.Do(req, changelog)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return changelog, resp, nil
+}
+
+// pageRequestOptions returns the request options setting the startAt and maxResults of the page.
+func pageRequestOptions(page PageRequest) []RequestOption {
+	opts := []RequestOption{WithQueryParameter("startAt", strconv.Itoa(page.StartAt))}
+	if page.MaxResults != 0 {
+		opts = append(opts, WithQueryParameter("maxResults", strconv.Itoa(page.MaxResults)))
+	}
+	return opts
+}
@@ -0,0 +1,86 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_GetIssueFull(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{"summary":"Login fails","attachment":[{"id":"20001","filename":"trace.log"}]}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"comments":[{"id":"1","body":"first"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"comments":[{"id":"2","body":"second"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"startAt": "0"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":20,"total":1,"worklogs":[{"id":"3","timeSpentSeconds":3600}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"4"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"5"}]}`)
+		default:
+			t.Errorf("Unexpected page %s", r.URL.RawQuery)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":6,"object":{"url":"https://example.com/runbook","title":"Runbook"}}]`)
+	})
+
+	full, err := testClient.Issue.GetIssueFull(context.Background(), "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if full.Issue.Key != "EX-1" {
+		t.Errorf("Expected issue EX-1, got %s", full.Issue.Key)
+	}
+	if len(full.Comments) != 2 || full.Comments[1].Body != "second" {
+		t.Errorf("Expected 2 comments, got %+v", full.Comments)
+	}
+	if len(full.Worklogs) != 1 || full.Worklogs[0].TimeSpentSeconds != 3600 {
+		t.Errorf("Expected 1 worklog, got %+v", full.Worklogs)
+	}
+	if len(full.Changelog) != 2 || full.Changelog[1].Id != "5" {
+		t.Errorf("Expected 2 changelog histories, got %+v", full.Changelog)
+	}
+	if len(full.RemoteLinks) != 1 || full.RemoteLinks[0].Object.Title != "Runbook" {
+		t.Errorf("Expected 1 remote link, got %+v", full.RemoteLinks)
+	}
+	if len(full.Attachments) != 1 || full.Attachments[0].Filename != "trace.log" {
+		t.Errorf("Expected 1 attachment, got %+v", full.Attachments)
+	}
+}
+
+func TestIssueService_GetIssueFull_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"10001","key":"EX-1","fields":{}}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	if _, err := testClient.Issue.GetIssueFull(context.Background(), "EX-1"); err == nil {
+		t.Error("Expected an error for the failing comments request")
+	}
+}
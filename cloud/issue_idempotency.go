@@ -0,0 +1,195 @@
+package cloud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/andygrunwald/go-jira/v2/jql"
+)
+
+// IdempotencyKeyProperty is the issue property holding the idempotency key of issues created with
+// IssueService.CreateIdempotent, as {"key": "<idempotency key>"}.
+const IdempotencyKeyProperty = "go-jira.idempotency"
+
+// IdempotencyLabelPrefix is the prefix of the label added to the issues created with IssueService.CreateIdempotent.
+// Issue properties are only searchable with JQL if an app indexes them, so the issues are found by the label,
+// which is followed by a hash of the idempotency key.
+const IdempotencyLabelPrefix = "go-jira-idempotency-"
+
+// idempotencySearchJQL finds the issues of a project with a label, oldest first.
+const idempotencySearchJQL = `project = %s AND labels = %s ORDER BY created ASC`
+
+// idempotencySearchPageSize is the number of issues requested per page when searching for an idempotency key.
+const idempotencySearchPageSize = 100
+
+// idempotencyKeyValue is the value of the IdempotencyKeyProperty.
+type idempotencyKeyValue struct {
+	Key string `json:"key"`
+}
+
+// CreateIdempotent creates an issue from the payload, e.g. built by NewIssue, at most once for the idempotency key,
+// so jobs creating issues can be retried and rerun without duplicating them.
+// The key is stored in the IdempotencyKeyProperty of the issue and a hash of it in the IdempotencyLabel,
+// which must not be removed from the issue for the key to be found again.
+//
+// If an issue with the key exists already, it is returned without creating another one.
+// Otherwise the issue is created and the request is retried by the RetryPolicy of the client,
+// as long as FindByIdempotencyKey doesn't find the issue, e.g. when the response was lost after Jira created it.
+// The existing issue is returned with the failed response in this case.
+//
+// As the search index of Jira lags behind, an issue created a moment ago might not be found yet.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issues/#api-rest-api-2-issue-post
+func (s *IssueService) CreateIdempotent(ctx context.Context, payload *IssuePayload, key string) (*Issue, *Response, error) {
+	if key == "" {
+		return nil, nil, errors.New("jira: idempotency key is empty")
+	}
+	project, err := payloadProject(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existing, resp, err := s.FindByIdempotencyKey(ctx, project, key)
+	if err != nil || existing != nil {
+		return existing, resp, err
+	}
+
+	withKey := withIdempotencyLabel(payload, IdempotencyLabel(key))
+	withKey.Properties = append(append([]EntityProperty(nil), payload.Properties...),
+		EntityProperty{Key: IdempotencyKeyProperty, Value: idempotencyKeyValue{Key: key}})
+
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issue")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, withKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	check := func(ctx context.Context) (bool, error) {
+		var err error
+		existing, _, err = s.FindByIdempotencyKey(ctx, project, key)
+		return existing != nil, err
+	}
+	if err := WithIdempotencyCheck(check)(req); err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Issue)
+	resp, err = s.client.Do(req, created)
+	if err != nil {
+		if existing != nil {
+			return existing, resp, nil
+		}
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return created, resp, nil
+}
+
+// FindByIdempotencyKey returns the issue of the project created by CreateIdempotent with the idempotency key,
+// nil if there is none.
+// The issues with the IdempotencyLabel of the key are searched and the oldest one with the key is returned.
+func (s *IssueService) FindByIdempotencyKey(ctx context.Context, projectKeyOrID, key string) (*Issue, *Response, error) {
+	query := url.Values{
+		"jql":        {fmt.Sprintf(idempotencySearchJQL, jql.Quote(projectKeyOrID), jql.Quote(IdempotencyLabel(key)))},
+		"fields":     {"summary"},
+		"properties": {IdempotencyKeyProperty},
+		"maxResults": {fmt.Sprint(idempotencySearchPageSize)},
+	}
+	for startAt := 0; ; {
+		query.Set("startAt", fmt.Sprint(startAt))
+		apiEndpoint := s.client.restAPIPath(APIVersion2, "search") + "?" + query.Encode()
+		req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The issues are decoded twice, as Issue doesn't keep the properties.
+		var result struct {
+			Total  int               `json:"total"`
+			Issues []json.RawMessage `json:"issues"`
+		}
+		resp, err := s.client.Do(req, &result)
+		if err != nil {
+			return nil, resp, NewJiraError(resp, err)
+		}
+
+		for _, raw := range result.Issues {
+			var properties struct {
+				Properties struct {
+					Value *idempotencyKeyValue `json:"go-jira.idempotency"`
+				} `json:"properties"`
+			}
+			if err := json.Unmarshal(raw, &properties); err != nil || properties.Properties.Value == nil || properties.Properties.Value.Key != key {
+				continue
+			}
+			issue := new(Issue)
+			if err := json.Unmarshal(raw, issue); err != nil {
+				return nil, resp, err
+			}
+			return issue, resp, nil
+		}
+
+		startAt += len(result.Issues)
+		if len(result.Issues) == 0 || startAt >= result.Total {
+			return nil, resp, nil
+		}
+	}
+}
+
+// IdempotencyLabel returns the label CreateIdempotent adds to the issue with the idempotency key.
+func IdempotencyLabel(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return IdempotencyLabelPrefix + hex.EncodeToString(sum[:16])
+}
+
+// withIdempotencyLabel returns a copy of the payload with the label added to the labels of the issue.
+func withIdempotencyLabel(payload *IssuePayload, label string) *IssuePayload {
+	withLabel := *payload
+	withLabel.Fields = make(map[string]interface{}, len(payload.Fields)+1)
+	for id, value := range payload.Fields {
+		withLabel.Fields[id] = value
+	}
+	if ops, ok := payload.Update["labels"]; ok {
+		withLabel.Update = make(map[string][]IssueUpdateOperation, len(payload.Update))
+		for id, ops := range payload.Update {
+			withLabel.Update[id] = ops
+		}
+		withLabel.Update["labels"] = append(append([]IssueUpdateOperation(nil), ops...), IssueUpdateOperation{"add": label})
+		return &withLabel
+	}
+	switch labels := payload.Fields["labels"].(type) {
+	case []string:
+		withLabel.Fields["labels"] = append(append([]string(nil), labels...), label)
+	case []interface{}:
+		withLabel.Fields["labels"] = append(append([]interface{}(nil), labels...), label)
+	default:
+		withLabel.Fields["labels"] = []string{label}
+	}
+	return &withLabel
+}
+
+// payloadProject returns the key or ID of the project of the payload.
+func payloadProject(payload *IssuePayload) (string, error) {
+	if payload != nil {
+		switch project := payload.Fields["project"].(type) {
+		case map[string]interface{}:
+			for _, name := range []string{"key", "id"} {
+				if v, ok := project[name].(string); ok && v != "" {
+					return v, nil
+				}
+			}
+		case map[string]string:
+			for _, name := range []string{"key", "id"} {
+				if v := project[name]; v != "" {
+					return v, nil
+				}
+			}
+		}
+	}
+	return "", errors.New("jira: the payload has no project key or ID")
+}
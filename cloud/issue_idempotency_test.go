@@ -0,0 +1,114 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIssueService_CreateIdempotent(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
+
+	created := false
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{
+			"jql":        `project = "EX" AND labels = "` + IdempotencyLabel("job-1/row-7") + `" ORDER BY created ASC`,
+			"fields":     "summary",
+			"properties": IdempotencyKeyProperty,
+			"maxResults": "100",
+			"startAt":    "0",
+		})
+		if !created {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"id":"10001","key":"EX-1","properties":{"go-jira.idempotency":{"key":"other"}}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"id":"10002","key":"EX-2","properties":{"go-jira.idempotency":{"key":"job-1/row-7"}}}]}`)
+	})
+	posts := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		posts++
+		var payload IssuePayload
+		json.NewDecoder(r.Body).Decode(&payload)
+		if len(payload.Properties) != 1 || payload.Properties[0].Key != IdempotencyKeyProperty {
+			t.Errorf("Expected the idempotency key property, got %+v", payload.Properties)
+		}
+		if labels, _ := payload.Fields["labels"].([]interface{}); len(labels) != 2 || labels[1] != IdempotencyLabel("job-1/row-7") {
+			t.Errorf("Expected the idempotency label after the labels of the payload, got %v", payload.Fields["labels"])
+		}
+		// Jira creates the issue, but the response doesn't make it back.
+		created = true
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	issue, _, err := testClient.Issue.CreateIdempotent(context.Background(), NewIssue("EX", "Task").Summary("Import row 7").Labels("import").Build(), "job-1/row-7")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-2" {
+		t.Errorf("Expected the created issue EX-2, got %s", issue.Key)
+	}
+	if posts != 1 {
+		t.Errorf("Expected 1 create request, got %d", posts)
+	}
+}
+
+func TestIssueService_CreateIdempotent_Existing(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issues":[{"id":"10002","key":"EX-2","properties":{"go-jira.idempotency":{"key":"job-1/row-7"}}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no issue to be created")
+	})
+
+	issue, _, err := testClient.Issue.CreateIdempotent(context.Background(), NewIssue("EX", "Task").Build(), "job-1/row-7")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-2" {
+		t.Errorf("Expected the existing issue EX-2, got %s", issue.Key)
+	}
+}
+
+func TestIssueService_CreateIdempotent_NoProject(t *testing.T) {
+	setup()
+	defer teardown()
+	if _, _, err := testClient.Issue.CreateIdempotent(context.Background(), NewIssueUpdate().Summary("No project").Build(), "key"); err == nil {
+		t.Error("Expected an error for a payload without project")
+	}
+}
+
+func TestIssueService_FindByIdempotencyKey_Pages(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "0" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","properties":{"go-jira.idempotency":{"key":"colliding"}}}]}`)
+			return
+		}
+		testRequestParams(t, r, map[string]string{
+			"jql":        `project = "EX \"A\"" AND labels = "` + IdempotencyLabel("job-1/row-7") + `" ORDER BY created ASC`,
+			"fields":     "summary",
+			"properties": IdempotencyKeyProperty,
+			"maxResults": "100",
+			"startAt":    "1",
+		})
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","properties":{"go-jira.idempotency":{"key":"job-1/row-7"}}}]}`)
+	})
+
+	issue, _, err := testClient.Issue.FindByIdempotencyKey(context.Background(), `EX "A"`, "job-1/row-7")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue == nil || issue.Key != "EX-2" {
+		t.Errorf("Expected the issue EX-2 of the second page, got %+v", issue)
+	}
+}
@@ -0,0 +1,318 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// SyncChangeKind is the kind of entity of a SyncChange.
+type SyncChangeKind string
+
+const (
+	// SyncIssueChanged is an issue created or updated since the checkpoint, with its changelog.
+	SyncIssueChanged SyncChangeKind = "issue"
+	// SyncCommentChanged is a comment created or updated since the checkpoint.
+	SyncCommentChanged SyncChangeKind = "comment"
+	// SyncWorklogChanged is a worklog created or updated since the checkpoint.
+	SyncWorklogChanged SyncChangeKind = "worklog"
+	// SyncWorklogDeleted is a worklog deleted since the checkpoint, only its ID is known.
+	SyncWorklogDeleted SyncChangeKind = "worklogDeleted"
+)
+
+// SyncChange is an entity changed since the checkpoint of IssueService.SyncIssues.
+type SyncChange struct {
+	Kind SyncChangeKind
+	// Issue is the changed issue of SyncIssueChanged.
+	Issue *Issue
+	// IssueKey is the key of the issue of a SyncCommentChanged.
+	IssueKey string
+	// Comment is the changed comment of SyncCommentChanged.
+	Comment *Comment
+	// Worklog is the changed worklog of SyncWorklogChanged.
+	Worklog *WorklogRecord
+	// WorklogID is the ID of the worklog of SyncWorklogDeleted.
+	WorklogID int64
+}
+
+// SyncOptions specifies the optional parameters of IssueService.SyncIssues.
+type SyncOptions struct {
+	// Fields are the fields of the changed issues. All fields are returned if empty.
+	Fields []string
+	// Comments yields the comments of the changed issues that were created or updated since the checkpoint.
+	Comments bool
+	// Worklogs yields the worklogs of the changed issues that were created or updated since the checkpoint,
+	// and the IDs of all worklogs deleted since the checkpoint.
+	Worklogs bool
+	// PageSize is the number of issues fetched per request. It will default to 100 if 0.
+	PageSize int
+	// Location is the time zone of the user in Jira, which JQL dates are interpreted in. It will default to UTC.
+	Location *time.Location
+}
+
+// worklogChanges is a page of the updated or deleted worklog feed.
+type worklogChanges struct {
+	Values []struct {
+		WorklogID   int64 `json:"worklogId"`
+		UpdatedTime int64 `json:"updatedTime"`
+	} `json:"values"`
+	Since    int64 `json:"since"`
+	Until    int64 `json:"until"`
+	LastPage bool  `json:"lastPage"`
+}
+
+// SyncIssues calls f for each issue matching jql that changed since the checkpoint since, ordered by the update time,
+// and for its comments and worklogs that changed since the checkpoint, if options asks for them.
+// The issues are fetched with their changelog.
+// A zero since yields all issues matching jql.
+//
+// It returns the checkpoint token of the next sync, which is parsed with ParseSyncToken.
+// JQL matches the update time of issues with a precision of minutes, so changes near the checkpoint can be yielded
+// by two syncs. Entities should be upserted by their ID.
+// jql must not have an ORDER BY clause.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-worklogs/#api-rest-api-2-worklog-updated-get
+func (s *IssueService) SyncIssues(ctx context.Context, jql string, since time.Time, options *SyncOptions, f func(SyncChange) error) (string, error) {
+	if options == nil {
+		options = &SyncOptions{}
+	}
+	location := options.Location
+	if location == nil {
+		location = time.UTC
+	}
+	pageSize := options.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+	// The next sync starts at the start of this sync, so changes made while syncing are not missed.
+	next := time.Now()
+
+	searchOptions := &SearchOptions{MaxResults: pageSize, Fields: syncFields(options.Fields), Expand: "changelog"}
+	issueIDs := map[string]bool{}
+	err := s.syncIssuePages(ctx, jql, since, location, searchOptions, func(issue Issue) error {
+		issueIDs[issue.ID] = true
+		if err := f(SyncChange{Kind: SyncIssueChanged, Issue: &issue}); err != nil {
+			return err
+		}
+		if options.Comments {
+			return s.syncComments(ctx, issue.Key, since, f)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if options.Worklogs && len(issueIDs) > 0 {
+		if err := s.syncWorklogs(ctx, since, issueIDs, f); err != nil {
+			return "", err
+		}
+	}
+	if options.Worklogs {
+		if err := s.syncDeletedWorklogs(ctx, since, f); err != nil {
+			return "", err
+		}
+	}
+
+	return strconv.FormatInt(next.UnixMilli(), 10), nil
+}
+
+// syncIssuePages calls f once for each issue matching jql updated since the checkpoint, ordered by the update time.
+//
+// The pages are fetched by keyset instead of by offset: each page queries the issues updated since the last issue
+// of the previous page. An issue updated during the sync moves to the end of the results, which would shift the
+// later issues back by one and skip an issue with offsets. The issues of the minute of the last issue are fetched
+// again, as JQL compares minutes, and dropped by ID.
+// Only if more than a page of issues was updated in the same minute, that minute is paged by offset.
+func (s *IssueService) syncIssuePages(ctx context.Context, jql string, since time.Time, location *time.Location, options *SearchOptions, f func(Issue) error) error {
+	seen := map[string]bool{}
+	cursor := since
+	startAt := 0
+	for {
+		query := jql
+		if !cursor.IsZero() {
+			updated := fmt.Sprintf(`updated >= "%s"`, syncMinute(cursor, location))
+			if query == "" {
+				query = updated
+			} else {
+				query = "(" + query + ") AND " + updated
+			}
+		}
+		query += " ORDER BY updated ASC, key ASC"
+
+		page := *options
+		page.StartAt = startAt
+		issues, resp, err := s.Search(ctx, query, &page)
+		if err != nil {
+			return err
+		}
+		for _, issue := range issues {
+			if seen[issue.ID] {
+				continue
+			}
+			seen[issue.ID] = true
+			if err := f(issue); err != nil {
+				return err
+			}
+		}
+		if len(issues) == 0 || startAt+len(issues) >= resp.Total {
+			return nil
+		}
+
+		last := issues[len(issues)-1]
+		var next time.Time
+		if last.Fields != nil {
+			next = time.Time(last.Fields.Updated)
+		}
+		if syncMinute(next, location) == syncMinute(cursor, location) {
+			startAt += len(issues)
+			continue
+		}
+		cursor = next
+		startAt = 0
+	}
+}
+
+// syncMinute returns the minute of t as compared by JQL, "" for a zero t.
+func syncMinute(t time.Time, location *time.Location) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.In(location).Format("2006/01/02 15:04")
+}
+
+// syncFields returns the fields of the issues of a sync, which include the update time the issues are paged by.
+func syncFields(fields []string) []string {
+	if len(fields) == 0 {
+		return []string{"*all"}
+	}
+	for _, field := range fields {
+		if field == "updated" || field == "*all" || field == "*navigable" {
+			return fields
+		}
+	}
+	return append(append([]string(nil), fields...), "updated")
+}
+
+// ParseSyncToken returns the checkpoint of a token returned by IssueService.SyncIssues.
+func ParseSyncToken(token string) (time.Time, error) {
+	ms, err := strconv.ParseInt(token, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("jira: invalid sync token %q", token)
+	}
+	return time.UnixMilli(ms), nil
+}
+
+// syncComments calls f for the comments of the issue created or updated since the checkpoint.
+func (s *IssueService) syncComments(ctx context.Context, issueKey string, since time.Time, f func(SyncChange) error) error {
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[*Comment], *Response, error) {
+		comments, resp, err := s.GetComments(ctx, issueKey, pageRequestOptions(page)...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[*Comment]{Values: comments.Comments, Total: comments.Total}, resp, nil
+	}, nil).Each(ctx, func(comment *Comment) error {
+		if comment.Updated != nil && time.Time(*comment.Updated).Before(since) {
+			return nil
+		}
+		return f(SyncChange{Kind: SyncCommentChanged, IssueKey: issueKey, Comment: comment})
+	})
+}
+
+// syncWorklogs calls f for the worklogs of the issues created or updated since the checkpoint.
+// An issue is updated by changes of its worklogs, so the worklogs of the other issues didn't change.
+func (s *IssueService) syncWorklogs(ctx context.Context, since time.Time, issueIDs map[string]bool, f func(SyncChange) error) error {
+	return s.worklogFeed(ctx, "worklog/updated", since, func(ids []int64) error {
+		worklogs, err := s.listWorklogs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for i := range worklogs {
+			if !issueIDs[worklogs[i].IssueID] {
+				continue
+			}
+			if err := f(SyncChange{Kind: SyncWorklogChanged, Worklog: &worklogs[i]}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// syncDeletedWorklogs calls f for the IDs of the worklogs deleted since the checkpoint.
+func (s *IssueService) syncDeletedWorklogs(ctx context.Context, since time.Time, f func(SyncChange) error) error {
+	return s.worklogFeed(ctx, "worklog/deleted", since, func(ids []int64) error {
+		for _, id := range ids {
+			if err := f(SyncChange{Kind: SyncWorklogDeleted, WorklogID: id}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// worklogFeed calls f with the worklog IDs of each page of the updated or deleted worklog feed since the checkpoint.
+func (s *IssueService) worklogFeed(ctx context.Context, feed string, since time.Time, f func(ids []int64) error) error {
+	var sinceMs int64
+	if !since.IsZero() {
+		sinceMs = since.UnixMilli()
+	}
+	for {
+		apiEndpoint := s.client.restAPIPath(APIVersion2, feed) + "?since=" + strconv.FormatInt(sinceMs, 10)
+		req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+		if err != nil {
+			return err
+		}
+
+		page := new(worklogChanges)
+		resp, err := s.client.Do(req, page)
+		if err != nil {
+			return NewJiraError(resp, err)
+		}
+
+		ids := make([]int64, len(page.Values))
+		for i, v := range page.Values {
+			ids[i] = v.WorklogID
+		}
+		if len(ids) > 0 {
+			if err := f(ids); err != nil {
+				return err
+			}
+		}
+		if page.LastPage || page.Until <= sinceMs {
+			return nil
+		}
+		sinceMs = page.Until
+	}
+}
+
+// listWorklogs fetches the worklogs with the IDs, at most 1000 per request.
+func (s *IssueService) listWorklogs(ctx context.Context, ids []int64) ([]WorklogRecord, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "worklog/list")
+	var worklogs []WorklogRecord
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > 1000 {
+			n = 1000
+		}
+		payload := struct {
+			IDs []int64 `json:"ids"`
+		}{IDs: ids[:n]}
+		ids = ids[n:]
+
+		req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+		if err != nil {
+			return nil, err
+		}
+		allowRetry(req)
+		var page []WorklogRecord
+		resp, err := s.client.Do(req, &page)
+		if err != nil {
+			return nil, NewJiraError(resp, err)
+		}
+		worklogs = append(worklogs, page...)
+	}
+	return worklogs, nil
+}
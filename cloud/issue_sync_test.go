@@ -0,0 +1,155 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIssueService_SyncIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	since := time.Date(2024, 3, 1, 9, 30, 15, 0, time.UTC)
+	sinceMs := fmt.Sprint(since.UnixMilli())
+
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got, want := r.URL.Query().Get("jql"), `(project = EX) AND updated >= "2024/03/01 09:30" ORDER BY updated ASC, key ASC`; got != want {
+			t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, want)
+		}
+		if got := r.URL.Query().Get("expand"); got != "changelog" {
+			t.Errorf("Expected the changelog to be expanded, got %q", got)
+		}
+		fmt.Fprint(w, `{"startAt":0,"maxResults":100,"total":1,"issues":[{"id":"10001","key":"EX-1","fields":{"summary":"Login fails"}}]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"comments":[
+			{"id":"1","body":"old","updated":"2024-02-01T10:00:00.000+0000"},
+			{"id":"2","body":"new","updated":"2024-03-01T10:00:00.000+0000"}
+		]}`)
+	})
+	testMux.HandleFunc("/rest/api/2/worklog/updated", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("since") {
+		case sinceMs:
+			fmt.Fprintf(w, `{"values":[{"worklogId":100}],"since":%s,"until":%d,"lastPage":false}`, sinceMs, since.UnixMilli()+1000)
+		case fmt.Sprint(since.UnixMilli() + 1000):
+			fmt.Fprintf(w, `{"values":[{"worklogId":101}],"since":%d,"until":%d,"lastPage":true}`, since.UnixMilli()+1000, since.UnixMilli()+2000)
+		default:
+			t.Errorf("Unexpected since %s", r.URL.RawQuery)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/worklog/list", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var payload struct {
+			IDs []int64 `json:"ids"`
+		}
+		json.NewDecoder(r.Body).Decode(&payload)
+		switch payload.IDs[0] {
+		case 100:
+			fmt.Fprint(w, `[{"id":"100","issueId":"10001","timeSpentSeconds":60}]`)
+		case 101:
+			fmt.Fprint(w, `[{"id":"101","issueId":"10099","timeSpentSeconds":60}]`)
+		}
+	})
+	testMux.HandleFunc("/rest/api/2/worklog/deleted", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"since": sinceMs})
+		fmt.Fprintf(w, `{"values":[{"worklogId":90}],"since":%s,"until":%s,"lastPage":true}`, sinceMs, sinceMs)
+	})
+
+	var changes []string
+	options := &SyncOptions{Comments: true, Worklogs: true}
+	before := time.Now()
+	token, err := testClient.Issue.SyncIssues(context.Background(), "project = EX", since, options, func(c SyncChange) error {
+		switch c.Kind {
+		case SyncIssueChanged:
+			changes = append(changes, "issue "+c.Issue.Key)
+		case SyncCommentChanged:
+			changes = append(changes, "comment "+c.IssueKey+" "+c.Comment.ID)
+		case SyncWorklogChanged:
+			changes = append(changes, "worklog "+c.Worklog.ID)
+		case SyncWorklogDeleted:
+			changes = append(changes, fmt.Sprint("deleted ", c.WorklogID))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := []string{"issue EX-1", "comment EX-1 2", "worklog 100", "deleted 90"}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Unexpected changes\n got: %v\nwant: %v", changes, want)
+	}
+
+	checkpoint, err := ParseSyncToken(token)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if checkpoint.Before(before.Truncate(time.Millisecond)) || checkpoint.After(time.Now()) {
+		t.Errorf("Expected the checkpoint to be the start of the sync, got %s", checkpoint)
+	}
+}
+
+func TestIssueService_SyncIssues_UpdatedDuringSync(t *testing.T) {
+	setup()
+	defer teardown()
+	issues := []struct {
+		key     string
+		updated string
+	}{{"EX-1", "09:31"}, {"EX-2", "09:32"}, {"EX-3", "09:33"}}
+	var queries []string
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		queries = append(queries, jql)
+		var from string
+		fmt.Sscanf(jql[strings.Index(jql, "2024/03/01 ")+len("2024/03/01 "):], "%5s", &from)
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+
+		sort.Slice(issues, func(i, j int) bool {
+			if issues[i].updated != issues[j].updated {
+				return issues[i].updated < issues[j].updated
+			}
+			return issues[i].key < issues[j].key
+		})
+		var matches []string
+		for _, issue := range issues {
+			if issue.updated >= from {
+				matches = append(matches, fmt.Sprintf(`{"id":%q,"key":%q,"fields":{"updated":"2024-03-01T%s:00.000+0000"}}`, issue.key, issue.key, issue.updated))
+			}
+		}
+		page := matches[min(startAt, len(matches)):min(startAt+2, len(matches))]
+		fmt.Fprintf(w, `{"startAt":%d,"maxResults":2,"total":%d,"issues":[%s]}`, startAt, len(matches), strings.Join(page, ","))
+
+		// EX-1 is updated after the first page, which moves it behind EX-3.
+		issues[0].updated = "09:40"
+	})
+
+	var keys []string
+	since := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	_, err := testClient.Issue.SyncIssues(context.Background(), "project = EX", since, &SyncOptions{PageSize: 2}, func(c SyncChange) error {
+		keys = append(keys, c.Issue.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"EX-1", "EX-2", "EX-3"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Expected the issues %v, got %v (queries %q)", want, keys, queries)
+	}
+}
+
+func TestParseSyncToken_Invalid(t *testing.T) {
+	if _, err := ParseSyncToken("yesterday"); err == nil {
+		t.Error("Expected an error for an invalid token")
+	}
+}
@@ -3,12 +3,14 @@ package cloud
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -396,6 +398,50 @@ func TestIssueService_Get_RenderedFields(t *testing.T) {
 	}
 }
 
+func TestIssueService_Get_RenderedCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"expand": "renderedFields"})
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"customfield_10010":"*bold*"},"renderedFields":{"description":"<p>Hello</p>","environment":"<p>Linux</p>","timespent":"1h 30m","customfield_10010":"<p><b>bold</b></p>","customfield_10011":null,"customfield_10012":{"value":"red"}}}`)
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "10002", nil, WithExpand("renderedFields"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	rendered := issue.RenderedFields
+	if rendered.Description != "<p>Hello</p>" || rendered.Environment != "<p>Linux</p>" || rendered.TimeSpent != "1h 30m" {
+		t.Errorf("Unexpected rendered fields %+v", rendered)
+	}
+	if value, ok := rendered.CustomField("customfield_10010"); !ok || value != "<p><b>bold</b></p>" {
+		t.Errorf("Expected the rendered custom field, got %q", value)
+	}
+	if len(rendered.CustomFields) != 1 {
+		t.Errorf("Expected only the rendered text custom field, got %v", rendered.CustomFields)
+	}
+	if raw, _ := issue.Fields.Unknowns.String("customfield_10010"); raw != "*bold*" {
+		t.Errorf("Expected the raw custom field to be kept, got %q", raw)
+	}
+}
+
+func TestIssueRenderedFields_MarshalJSON(t *testing.T) {
+	rendered := &IssueRenderedFields{Description: "<p>Hello</p>", CustomFields: map[string]string{"customfield_10010": "<p>x</p>"}}
+	data, err := json.Marshal(rendered)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	got := new(IssueRenderedFields)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(got, rendered) {
+		t.Errorf("Expected %+v after a round trip, got %+v", rendered, got)
+	}
+}
+
 func TestIssueService_DownloadAttachment(t *testing.T) {
 	var testAttachment = "Here is an attachment"
 
@@ -774,6 +820,36 @@ func TestIssueService_SearchPages_EmptyResult(t *testing.T) {
 
 }
 
+func TestIssueService_SearchAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"issues": [{"id": "10001","key": "BULK-1"},{"id": "10002","key": "BULK-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"total": 3,"issues": [{"id": "10003","key": "BULK-3"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+		if got := r.URL.Query().Get("maxResults"); got != "2" {
+			t.Errorf("Expected maxResults 2, got %s", got)
+		}
+	})
+
+	issues, err := testClient.Issue.SearchAll(context.Background(), "something", &SearchOptions{StartAt: 5}, &PagerOptions{PageSize: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("Expected 3 issues, %v given", len(issues))
+	}
+	if issues[2].Key != "BULK-3" {
+		t.Errorf("Expected BULK-3, %s given", issues[2].Key)
+	}
+}
+
 func TestIssueService_GetCustomFields(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1084,7 +1160,7 @@ func TestIssueFields_MarshalJSON_Success(t *testing.T) {
 				ID:          "10705",
 				Name:        "2.1.0-rc3",
 				Self:        "http://www.example.com/jira/rest/api/2/version/10705",
-				ReleaseDate: "2018-09-30",
+				ReleaseDate: NewDate(2018, time.September, 30),
 			},
 		},
 	}
@@ -1706,7 +1782,7 @@ func TestIssueService_Get_Fields_Changelog(t *testing.T) {
 		t.Errorf("Expected one history item, %v found", len(issue.Changelog.Histories))
 	}
 
-	if issue.Changelog.Histories[0].Created != "2018-06-20T16:50:35.000+0300" {
+	if issue.Changelog.Histories[0].Created.String() != "2018-06-20T16:50:35.000+0300" {
 		t.Errorf("Expected created time of history item 2018-06-20T16:50:35.000+0300, %v got", issue.Changelog.Histories[0].Created)
 	}
 
@@ -1771,7 +1847,7 @@ func TestIssueService_Get_Fields_AffectsVersions(t *testing.T) {
 			ID:          "10705",
 			Name:        "2.1.0-rc3",
 			Self:        "http://www.example.com/jira/rest/api/2/version/10705",
-			ReleaseDate: "2018-09-30",
+			ReleaseDate: NewDate(2018, time.September, 30),
 			Released:    Bool(false),
 			Archived:    Bool(false),
 			Description: "test description",
@@ -1931,3 +2007,422 @@ func TestTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueService_GetPickerSuggestions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/issue/picker"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{
+			"query":           "login",
+			"currentJQL":      "project = EX",
+			"currentIssueKey": "EX-2",
+			"showSubTasks":    "false",
+		})
+		fmt.Fprint(w, `{"sections":[{"label":"History Search","sub":"Showing 1 of 1 matching issues","id":"hs","issues":[{"key":"EX-1","keyHtml":"EX-1","img":"/images/icons/issuetypes/task.png","summary":"Bug report application crashes on <b>login</b>","summaryText":"Bug report application crashes on login","id":10000}]}]}`)
+	})
+
+	suggestions, _, err := testClient.Issue.GetPickerSuggestions(context.Background(), &IssuePickerOptions{
+		Query:           "login",
+		CurrentJQL:      "project = EX",
+		CurrentIssueKey: "EX-2",
+		ShowSubTasks:    Bool(false),
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if suggestions == nil || len(suggestions.Sections) != 1 {
+		t.Fatalf("Unexpected suggestions: %+v", suggestions)
+	}
+	section := suggestions.Sections[0]
+	if section.ID != "hs" || len(section.Issues) != 1 {
+		t.Fatalf("Unexpected section: %+v", section)
+	}
+	if got := section.Issues[0].SummaryText; got != "Bug report application crashes on login" {
+		t.Errorf("Unexpected summary text: %s", got)
+	}
+}
+
+func TestIssueService_Rank(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/issue/rank"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.RankBeforeIssue != "PR-1" || payload.RankCustomFieldID != 10521 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		if len(payload.Issues) != 2 {
+			t.Errorf("Expected 2 issues, got %d", len(payload.Issues))
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := testClient.Issue.Rank(context.Background(), &IssuesWrapper{
+		Issues:            []string{"PR-2", "PR-3"},
+		RankBeforeIssue:   "PR-1",
+		RankCustomFieldID: 10521,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil || len(result.Entries) != 0 {
+		t.Errorf("Expected empty result, got %+v", result)
+	}
+}
+
+func TestIssueService_Rank_Batches(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/issue/rank"
+
+	issueKeys := make([]string, 120)
+	for i := range issueKeys {
+		issueKeys[i] = fmt.Sprintf("PR-%d", i+2)
+	}
+
+	var batches []IssuesWrapper
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload IssuesWrapper
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		batches = append(batches, payload)
+
+		w.WriteHeader(http.StatusMultiStatus)
+		fmt.Fprintf(w, `{"entries":[{"issueId":1,"issueKey":%q,"status":403,"errors":["rank failed"]}]}`, payload.Issues[0])
+	})
+
+	result, _, err := testClient.Issue.Rank(context.Background(), &IssuesWrapper{
+		Issues:         issueKeys,
+		RankAfterIssue: "PR-1",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	if len(batches) != 3 {
+		t.Fatalf("Expected 3 batches, got %d", len(batches))
+	}
+	wantSizes := []int{50, 50, 20}
+	wantAfter := []string{"PR-1", "PR-51", "PR-101"}
+	for i, batch := range batches {
+		if len(batch.Issues) != wantSizes[i] {
+			t.Errorf("Batch %d: expected %d issues, got %d", i, wantSizes[i], len(batch.Issues))
+		}
+		if batch.RankAfterIssue != wantAfter[i] {
+			t.Errorf("Batch %d: expected rankAfterIssue %s, got %s", i, wantAfter[i], batch.RankAfterIssue)
+		}
+	}
+
+	if result == nil || len(result.Entries) != 3 || result.Entries[2].IssueKey != "PR-102" {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+}
+
+// closeTrackingTransport records whether the bodies of its responses are closed.
+type closeTrackingTransport struct {
+	bodies []*trackedBody
+}
+
+type trackedBody struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return b.ReadCloser.Close()
+}
+
+func (t *closeTrackingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body := &trackedBody{ReadCloser: resp.Body}
+	t.bodies = append(t.bodies, body)
+	resp.Body = body
+	return resp, nil
+}
+
+func TestIssueService_Rank_FailedBatch(t *testing.T) {
+	setup()
+	defer teardown()
+
+	issueKeys := make([]string, 120)
+	for i := range issueKeys {
+		issueKeys[i] = fmt.Sprintf("PR-%d", i+2)
+	}
+	calls := 0
+	testMux.HandleFunc("/rest/agile/1.0/issue/rank", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch calls {
+		case 1:
+			w.WriteHeader(http.StatusMultiStatus)
+			fmt.Fprint(w, `{"entries":[{"issueId":1,"issueKey":"PR-2","status":403,"errors":["rank failed"]}]}`)
+		case 2:
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{"unexpected":"body"}`)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorMessages":["rank field missing"]}`)
+		}
+	})
+	transport := &closeTrackingTransport{}
+	client, err := NewClient(testServer.URL, &http.Client{Transport: transport})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, _, err := client.Issue.Rank(context.Background(), &IssuesWrapper{Issues: issueKeys, RankAfterIssue: "PR-1"})
+	if err == nil {
+		t.Error("Expected an error for the failed batch")
+	}
+	if result == nil || len(result.Entries) != 1 || result.Entries[0].IssueKey != "PR-2" {
+		t.Errorf("Expected the entries of the previous batches, got %+v", result)
+	}
+	for i, body := range transport.bodies {
+		if !body.closed {
+			t.Errorf("The body of batch %d was not closed", i+1)
+		}
+	}
+}
+
+func TestIssueService_GetEstimation(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/issue/EX-1/estimation"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"boardId": "5"})
+		fmt.Fprint(w, `{"fieldId":"customfield_12532","value":8}`)
+	})
+
+	estimation, _, err := testClient.Issue.GetEstimation(context.Background(), "EX-1", 5)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if estimation == nil || estimation.FieldID != "customfield_12532" || estimation.Value != float64(8) {
+		t.Errorf("Unexpected estimation: %+v", estimation)
+	}
+}
+
+func TestIssueService_SetEstimation(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/issue/EX-1/estimation"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"boardId": "5"})
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["value"] != "13" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"fieldId":"customfield_12532","value":13}`)
+	})
+
+	estimation, _, err := testClient.Issue.SetEstimation(context.Background(), "EX-1", 5, "13")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if estimation == nil || estimation.Value != float64(13) {
+		t.Errorf("Unexpected estimation: %+v", estimation)
+	}
+}
+
+func TestIssueService_Get_WithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?expand=changelog&fields=summary%2Cstatus")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "EX-1", &GetQueryOptions{Expand: "foo"}, WithFields("summary", "status"), WithExpand("changelog"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
+
+func TestIssueService_Search_WithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"jql": "project = EX", "fields": "summary", "validateQuery": "none"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"id":"10002","key":"EX-1"}]}`)
+	})
+
+	issues, _, err := testClient.Issue.Search(context.Background(), "project = EX", nil, WithFields("summary"), WithValidateQuery("none"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues))
+	}
+}
+
+func TestIssueService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/comment?expand=renderedBody")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"comments":[{"id":"10000","body":"Lorem ipsum","renderedBody":"<p>Lorem ipsum</p>"}]}`)
+	})
+
+	comments, _, err := testClient.Issue.GetComments(context.Background(), "EX-1", WithExpand("renderedBody"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if comments.Total != 1 || len(comments.Comments) != 1 || comments.Comments[0].ID != "10000" {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+}
+
+func TestIssueService_BulkGetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	testMux.HandleFunc("/rest/api/2/issue/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")
+		if key == "EX-3" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errorMessages":["Issue does not exist or you do not have permission to see it."],"errors":{}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"id":"1","key":"%s"}`, key)
+	})
+
+	keys := []string{"EX-1", "EX-2", "EX-3", "EX-4", "EX-5", "EX-1"}
+	issues, errs := testClient.Issue.BulkGetIssues(context.Background(), keys, nil, 2)
+
+	if len(issues) != 4 {
+		t.Errorf("Expected 4 issues, got %d", len(issues))
+	}
+	if issue := issues["EX-5"]; issue == nil || issue.Key != "EX-5" {
+		t.Errorf("Unexpected issue EX-5: %+v", issue)
+	}
+	if len(errs) != 1 || !errors.Is(errs["EX-3"], ErrNotFound) {
+		t.Errorf("Expected EX-3 to fail with ErrNotFound, got %v", errs)
+	}
+	if maxInFlight > 2 {
+		t.Errorf("Expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestIssueService_BulkGetIssues_Canceled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	issues, errs := testClient.Issue.BulkGetIssues(ctx, []string{"EX-1", "EX-2"}, nil, 1)
+	if len(issues) != 0 || len(errs) != 2 {
+		t.Fatalf("Expected all keys to fail, got %d issues and %d errors", len(issues), len(errs))
+	}
+	for key, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected %s to fail with context.Canceled, got %v", key, err)
+		}
+	}
+}
+
+func TestIssueService_SearchStream(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"jql": "project = EX", "maxResults": "1000"})
+		fmt.Fprint(w, `{"expand":"schema,names","startAt":0,"maxResults":1000,"issues":[{"id":"1","key":"EX-1","fields":{"summary":"first"}},{"id":"2","key":"EX-2","fields":{"summary":"second"}}],"total":2,"names":{"summary":"Summary"}}`)
+	})
+
+	var keys []string
+	resp, err := testClient.Issue.SearchStream(context.Background(), "project = EX", &SearchOptions{MaxResults: 1000}, func(issue Issue) error {
+		keys = append(keys, issue.Key+":"+issue.Fields.Summary)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"EX-1:first", "EX-2:second"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Issues = %v, want %v", keys, want)
+	}
+	if resp.StartAt != 0 || resp.MaxResults != 1000 || resp.Total != 2 {
+		t.Errorf("Unexpected paging values %d, %d, %d", resp.StartAt, resp.MaxResults, resp.Total)
+	}
+}
+
+func TestIssueService_SearchStream_CallbackError(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":2,"issues":[{"key":"EX-1"},{"key":"EX-2"}]}`)
+	})
+
+	stop := errors.New("stop")
+	calls := 0
+	_, err := testClient.Issue.SearchStream(context.Background(), "", nil, func(issue Issue) error {
+		calls++
+		return stop
+	})
+	if !errors.Is(err, stop) || calls != 1 {
+		t.Errorf("Expected to stop after the first issue with the error of the callback, got %v after %d calls", err, calls)
+	}
+}
+
+func TestIssueService_SearchStream_InvalidJSON(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issues":{}}`)
+	})
+
+	_, err := testClient.Issue.SearchStream(context.Background(), "", nil, func(issue Issue) error { return nil })
+	if err == nil {
+		t.Error("Expected an error for an unexpected JSON structure")
+	}
+}
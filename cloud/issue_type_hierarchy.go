@@ -0,0 +1,104 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"sort"
+)
+
+// The levels of the issue type hierarchy every site has.
+// Sites with Advanced Roadmaps can have levels above HierarchyLevelEpic, e.g. 2 for Initiative.
+const (
+	HierarchyLevelSubtask = -1
+	HierarchyLevelBase    = 0
+	HierarchyLevelEpic    = 1
+)
+
+// IssueTypeHierarchy is the issue type hierarchy of the site, as returned by IssueService.GetIssueTypeHierarchy.
+type IssueTypeHierarchy struct {
+	// Levels are ordered from the highest level, e.g. epics, to the sub-tasks.
+	Levels []IssueTypeHierarchyLevel
+}
+
+// IssueTypeHierarchyLevel is a level of the IssueTypeHierarchy with its issue types.
+type IssueTypeHierarchyLevel struct {
+	Level      int
+	IssueTypes []IssueType
+}
+
+// Level returns the level with the number, e.g. HierarchyLevelEpic, or nil if no issue type has the level.
+func (h *IssueTypeHierarchy) Level(level int) *IssueTypeHierarchyLevel {
+	for i := range h.Levels {
+		if h.Levels[i].Level == level {
+			return &h.Levels[i]
+		}
+	}
+	return nil
+}
+
+// LevelOf returns the level of the issue type with the ID and whether the hierarchy has the issue type.
+func (h *IssueTypeHierarchy) LevelOf(issueTypeID string) (int, bool) {
+	for _, level := range h.Levels {
+		for _, issueType := range level.IssueTypes {
+			if issueType.ID == issueTypeID {
+				return level.Level, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// GetIssueTypeHierarchy returns the issue type hierarchy of the site, built from the hierarchy level of all issue types
+// visible to the user. It includes the issue types of company-managed and team-managed projects,
+// use ProjectService.GetHierarchy for the hierarchy of a single team-managed project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-types/#api-rest-api-2-issuetype-get
+func (s *IssueService) GetIssueTypeHierarchy(ctx context.Context) (*IssueTypeHierarchy, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issuetype")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []IssueType
+	resp, err := s.client.Do(req, &issueTypes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	byLevel := map[int][]IssueType{}
+	for _, issueType := range issueTypes {
+		byLevel[issueType.HierarchyLevel] = append(byLevel[issueType.HierarchyLevel], issueType)
+	}
+	hierarchy := &IssueTypeHierarchy{}
+	for level, types := range byLevel {
+		hierarchy.Levels = append(hierarchy.Levels, IssueTypeHierarchyLevel{Level: level, IssueTypes: types})
+	}
+	sort.Slice(hierarchy.Levels, func(i, j int) bool {
+		return hierarchy.Levels[i].Level > hierarchy.Levels[j].Level
+	})
+
+	return hierarchy, resp, nil
+}
+
+// Level returns the level with the number, e.g. HierarchyLevelEpic, or nil if the project doesn't have the level.
+func (h *ProjectIssueTypeHierarchy) Level(level int) *ProjectIssueTypeLevel {
+	for i := range h.Hierarchy {
+		if h.Hierarchy[i].Level == level {
+			return &h.Hierarchy[i]
+		}
+	}
+	return nil
+}
+
+// LevelOf returns the level of the issue type with the ID and whether the project has the issue type.
+func (h *ProjectIssueTypeHierarchy) LevelOf(issueTypeID int64) (int, bool) {
+	for _, level := range h.Hierarchy {
+		for _, issueType := range level.IssueTypes {
+			if issueType.ID == issueTypeID {
+				return level.Level, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,61 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_GetIssueTypeHierarchy(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issuetype")
+		fmt.Fprint(w, `[
+			{"id":"10001","name":"Story","hierarchyLevel":0},
+			{"id":"10002","name":"Sub-task","subtask":true,"hierarchyLevel":-1},
+			{"id":"10000","name":"Epic","hierarchyLevel":1},
+			{"id":"10003","name":"Bug","hierarchyLevel":0},
+			{"id":"10010","name":"Task","hierarchyLevel":0,"entityId":"9d7dd6f7-e8b6-4247-954b-7b2c9b2a5ba2"}
+		]`)
+	})
+
+	hierarchy, _, err := testClient.Issue.GetIssueTypeHierarchy(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(hierarchy.Levels) != 3 || hierarchy.Levels[0].Level != HierarchyLevelEpic || hierarchy.Levels[2].Level != HierarchyLevelSubtask {
+		t.Fatalf("Unexpected levels %+v", hierarchy.Levels)
+	}
+	if base := hierarchy.Level(HierarchyLevelBase); base == nil || len(base.IssueTypes) != 3 {
+		t.Errorf("Expected 3 base level issue types, got %+v", base)
+	}
+	if level, ok := hierarchy.LevelOf("10002"); !ok || level != HierarchyLevelSubtask {
+		t.Errorf("Expected Sub-task on the sub-task level, got %d %v", level, ok)
+	}
+	if _, ok := hierarchy.LevelOf("99999"); ok {
+		t.Error("Expected an unknown issue type not to be found")
+	}
+	if level := hierarchy.Level(2); level != nil {
+		t.Errorf("Expected no level 2, got %+v", level)
+	}
+}
+
+func TestProjectIssueTypeHierarchy_Level(t *testing.T) {
+	hierarchy := &ProjectIssueTypeHierarchy{Hierarchy: []ProjectIssueTypeLevel{
+		{Level: 1, Name: "Epic", IssueTypes: []ProjectIssueTypeLevelType{{ID: 10008, Name: "Epic"}}},
+		{Level: 0, Name: "Base", IssueTypes: []ProjectIssueTypeLevelType{{ID: 10001, Name: "Story"}}},
+	}}
+
+	if epic := hierarchy.Level(HierarchyLevelEpic); epic == nil || epic.Name != "Epic" {
+		t.Errorf("Unexpected epic level %+v", epic)
+	}
+	if level, ok := hierarchy.LevelOf(10001); !ok || level != HierarchyLevelBase {
+		t.Errorf("Expected Story on the base level, got %d %v", level, ok)
+	}
+	if subtask := hierarchy.Level(HierarchyLevelSubtask); subtask != nil {
+		t.Errorf("Expected no sub-task level, got %+v", subtask)
+	}
+}
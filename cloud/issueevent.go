@@ -0,0 +1,39 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// IssueEventService handles issue event types for the Jira instance / API.
+// Issue events are referenced by notification schemes and workflow post functions.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-events/#api-group-issue-events
+type IssueEventService service
+
+// IssueEvent represents a type of event fired by Jira, e.g. "Issue Created" or "Issue Updated".
+type IssueEvent struct {
+	ID          int64  `json:"id,omitempty" structs:"id,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+}
+
+// GetList returns all issue event types.
+// Only issue event types that the user has permission to view are returned.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-events/#api-rest-api-3-events-get
+func (s *IssueEventService) GetList(ctx context.Context) ([]IssueEvent, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "events")
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := []IssueEvent{}
+	resp, err := s.client.Do(req, &events)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return events, resp, nil
+}
@@ -0,0 +1,31 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueEventService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/events"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":1,"name":"Issue Created","description":"This is the 'issue created' event."},{"id":2,"name":"Issue Updated","description":"This is the 'issue updated' event."}]`)
+	})
+
+	events, _, err := testClient.IssueEvent.GetList(context.Background())
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("Expected 2 events. Got %d", len(events))
+	}
+	if events[1].ID != 2 || events[1].Name != "Issue Updated" {
+		t.Errorf("Unexpected event: %+v", events[1])
+	}
+}
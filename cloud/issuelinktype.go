@@ -3,7 +3,6 @@ package cloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 )
 
@@ -19,7 +18,7 @@ type IssueLinkTypeService service
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) GetList(ctx context.Context) ([]IssueLinkType, *Response, error) {
-	apiEndpoint := "rest/api/2/issueLinkType"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLinkType")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -40,7 +39,7 @@ func (s *IssueLinkTypeService) GetList(ctx context.Context) ([]IssueLinkType, *R
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Get(ctx context.Context, ID string) (*IssueLinkType, *Response, error) {
-	apiEndPoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", ID)
+	apiEndPoint := s.client.restAPIPath(APIVersion2, "issueLinkType/%s", ID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndPoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -61,7 +60,7 @@ func (s *IssueLinkTypeService) Get(ctx context.Context, ID string) (*IssueLinkTy
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Create(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
-	apiEndpoint := "/rest/api/2/issueLinkType"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLinkType")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, linkType)
 	if err != nil {
 		return nil, nil, err
@@ -90,7 +89,7 @@ func (s *IssueLinkTypeService) Create(ctx context.Context, linkType *IssueLinkTy
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Update(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", linkType.ID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLinkType/%s", linkType.ID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, linkType)
 	if err != nil {
 		return nil, nil, err
@@ -111,7 +110,7 @@ func (s *IssueLinkTypeService) Update(ctx context.Context, linkType *IssueLinkTy
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *IssueLinkTypeService) Delete(ctx context.Context, ID string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/issueLinkType/%s", ID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "issueLinkType/%s", ID)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,169 @@
+//go:build go1.23
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// errStopIteration stops the pagination when the caller breaks out of the loop.
+var errStopIteration = errors.New("iteration stopped")
+
+// Iterate returns an iterator over the items of all pages.
+// Pages are fetched lazily while iterating, so not all items are held in memory.
+// The iteration stops after yielding the first error of the endpoint or OnPage.
+//
+// Example:
+//
+//	for issue, err := range client.Issue.Iterate(ctx, jql, nil, nil) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(issue.Key)
+//	}
+func (p *Pager[T]) Iterate(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stopped := false
+		err := p.Each(ctx, func(v T) error {
+			if !yield(v, nil) {
+				stopped = true
+				return errStopIteration
+			}
+			return nil
+		})
+		if err != nil && !stopped {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+// Iterate returns an iterator over the issues of all pages of a search, see SearchAll.
+func (s *IssueService) Iterate(ctx context.Context, jql string, options *SearchOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.searchPager(jql, options, pager).Iterate(ctx)
+}
+
+// Iterate returns an iterator over the projects of all pages visible to the user, see SearchAll.
+func (s *ProjectService) Iterate(ctx context.Context, options *ProjectSearchOptions, pager *PagerOptions) iter.Seq2[Project, error] {
+	return s.searchPager(options, pager).Iterate(ctx)
+}
+
+// Iterate returns an iterator over the users of all pages of a search, see FindAll.
+func (s *UserService) Iterate(ctx context.Context, property string, pager *PagerOptions, tweaks ...UserSearchF) iter.Seq2[User, error] {
+	return s.findPager(property, pager, tweaks...).Iterate(ctx)
+}
+
+// Iterate returns an iterator over the members of all pages of a group, see GetAll.
+func (s *GroupService) Iterate(ctx context.Context, name string, options *GroupSearchOptions, pager *PagerOptions) iter.Seq2[GroupMember, error] {
+	return s.membersPager(name, options, pager).Iterate(ctx)
+}
+
+// IterateBoards returns an iterator over the boards of all pages, see ListAllBoards.
+func (s *BoardService) IterateBoards(ctx context.Context, opt *BoardListOptions, pager *PagerOptions) iter.Seq2[Board, error] {
+	return s.boardsPager(opt, pager).Iterate(ctx)
+}
+
+// IterateSprints returns an iterator over the sprints of all pages from a board, see ListAllSprints.
+func (s *BoardService) IterateSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions, pager *PagerOptions) iter.Seq2[Sprint, error] {
+	return s.sprintsPager(boardID, options, pager).Iterate(ctx)
+}
+
+// IterateBacklogIssues returns an iterator over the issues of all pages of the backlog of a board, see GetBacklogIssues.
+func (s *BoardService) IterateBacklogIssues(ctx context.Context, boardID int64, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.boardIssuesPager(fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID), options, pager).Iterate(ctx)
+}
+
+// IterateSprintIssues returns an iterator over the issues of all pages of a sprint of a board, see GetSprintIssues.
+func (s *BoardService) IterateSprintIssues(ctx context.Context, boardID, sprintID int64, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.boardIssuesPager(fmt.Sprintf("rest/agile/1.0/board/%d/sprint/%d/issue", boardID, sprintID), options, pager).Iterate(ctx)
+}
+
+// IterateEpics returns an iterator over the epics of all pages of a board, see GetEpics.
+func (s *BoardService) IterateEpics(ctx context.Context, boardID int64, options *GetEpicsOptions, pager *PagerOptions) iter.Seq2[Epic, error] {
+	return s.epicsPager(boardID, options, pager).Iterate(ctx)
+}
+
+// IterateEpicIssues returns an iterator over the issues of all pages of an epic of a board, see GetEpicIssues.
+func (s *BoardService) IterateEpicIssues(ctx context.Context, boardID, epicID int64, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.boardIssuesPager(fmt.Sprintf("rest/agile/1.0/board/%d/epic/%d/issue", boardID, epicID), options, pager).Iterate(ctx)
+}
+
+// IterateIssuesWithoutEpic returns an iterator over the issues of all pages without epic of a board, see GetIssuesWithoutEpic.
+func (s *BoardService) IterateIssuesWithoutEpic(ctx context.Context, boardID int64, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.boardIssuesPager(fmt.Sprintf("rest/agile/1.0/board/%d/epic/none/issue", boardID), options, pager).Iterate(ctx)
+}
+
+// IterateIssues returns an iterator over the issues of all pages of a sprint, see GetIssues.
+func (s *SprintService) IterateIssues(ctx context.Context, sprintID int, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.issuesPager(sprintID, options, pager).Iterate(ctx)
+}
+
+// IterateIssues returns an iterator over the issues of all pages of an epic, see GetIssues.
+func (s *EpicService) IterateIssues(ctx context.Context, epicIDOrKey string, options *AgileIssueListOptions, pager *PagerOptions) iter.Seq2[Issue, error] {
+	return s.issuesPager(epicIDOrKey, options, pager).Iterate(ctx)
+}
+
+// IterateProjectComponents returns an iterator over the components of all pages of a project, see ListProjectComponents.
+func (s *ComponentService) IterateProjectComponents(ctx context.Context, projectIDOrKey string, options *ComponentListOptions, pager *PagerOptions) iter.Seq2[ProjectComponentWithCount, error] {
+	return s.projectComponentsPager(projectIDOrKey, options, pager).Iterate(ctx)
+}
+
+// IterateComments returns an iterator over the comments of all pages of an issue, see GetComments.
+func (s *IssueService) IterateComments(ctx context.Context, issueID string, pager *PagerOptions, opts ...RequestOption) iter.Seq2[*Comment, error] {
+	return s.commentsPager(issueID, pager, opts...).Iterate(ctx)
+}
+
+// IterateWorklogs returns an iterator over the worklogs of all pages of an issue, see GetWorklogs.
+func (s *IssueService) IterateWorklogs(ctx context.Context, issueID string, pager *PagerOptions, options ...RequestOption) iter.Seq2[WorklogRecord, error] {
+	return s.worklogsPager(issueID, pager, options...).Iterate(ctx)
+}
+
+// IterateObjects returns an iterator over the objects of all pages matching an AQL query, see SearchObjects.
+func (s *AssetsService) IterateObjects(ctx context.Context, workspaceID, aql string, options *AssetAQLOptions, pager *PagerOptions) iter.Seq2[AssetObject, error] {
+	return s.objectsPager(workspaceID, aql, options, pager).Iterate(ctx)
+}
+
+// Iterate returns an iterator over the plans of all pages, see GetList.
+func (s *PlanService) Iterate(ctx context.Context, options *PlanListOptions, pager *PagerOptions) iter.Seq2[Plan, error] {
+	return s.listPager(options, pager).Iterate(ctx)
+}
+
+// ServiceDeskIterate returns an iterator over the values of all pages returned by list, see ServiceDeskAllPages.
+//
+// Example:
+//
+//	for queue, err := range ServiceDeskIterate(ctx, func(ctx context.Context, start int) (*QueueList, *Response, error) {
+//		return client.ServiceDesk.GetQueues(ctx, serviceDeskID, &QueueListOptions{Start: start})
+//	}) {
+//		...
+//	}
+func ServiceDeskIterate[T any](ctx context.Context, list func(ctx context.Context, start int) (*ServiceDeskPage[T], *Response, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		start := 0
+		for {
+			page, _, err := list(ctx, start)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, v := range page.Values {
+				if !yield(v, nil) {
+					return
+				}
+			}
+			if page.IsLast || page.Size == 0 {
+				return
+			}
+			start = page.NextStart()
+		}
+	}
+}
+
+// Iterate returns an iterator over the workflows of all pages, see SearchAll.
+func (s *WorkflowService) Iterate(ctx context.Context, options *WorkflowSearchOptions, pager *PagerOptions) iter.Seq2[Workflow, error] {
+	return s.searchPager(options, pager).Iterate(ctx)
+}
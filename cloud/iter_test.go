@@ -0,0 +1,213 @@
+//go:build go1.23
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPager_Iterate_Break(t *testing.T) {
+	calls := 0
+	fetch := offsetPages([]int{1, 2, 3, 4, 5}, true)
+	pager := NewPager(func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+		calls++
+		return fetch(ctx, page)
+	}, nil)
+
+	var got []int
+	for v, err := range pager.Iterate(context.Background()) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got = append(got, v)
+		if v == 3 {
+			break
+		}
+	}
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", calls)
+	}
+}
+
+func TestPager_Iterate_Error(t *testing.T) {
+	fail := errors.New("fail")
+	pager := NewPager(func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+		return nil, nil, fail
+	}, nil)
+
+	var errs []error
+	for _, err := range pager.Iterate(context.Background()) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || errs[0] != fail {
+		t.Errorf("Expected the error %v once, got %v", fail, errs)
+	}
+}
+
+func TestIssueService_Iterate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"issues": [{"key": "BULK-1"},{"key": "BULK-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"total": 3,"issues": [{"key": "BULK-3"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var keys []string
+	for issue, err := range testClient.Issue.Iterate(context.Background(), "project = BULK", nil, nil) {
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		keys = append(keys, issue.Key)
+	}
+	if want := []string{"BULK-1", "BULK-2", "BULK-3"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Expected %v, got %v", want, keys)
+	}
+}
+
+func TestServiceDeskIterate(t *testing.T) {
+	pages := map[int]*ServiceDeskPage[string]{
+		0: {Values: []string{"a", "b"}, Start: 0, Size: 2},
+		2: {Values: []string{"c"}, Start: 2, Size: 1, IsLast: true},
+	}
+	var got []string
+	for v, err := range ServiceDeskIterate(context.Background(), func(ctx context.Context, start int) (*ServiceDeskPage[string], *Response, error) {
+		return pages[start], nil, nil
+	}) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		got = append(got, v)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestSprintService_IterateIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/agile/1.0/sprint/12/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("jql"); got != "assignee = currentUser()" {
+			t.Errorf("Expected the JQL of the options, got %q", got)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":2,"total":3,"issues":[{"key":"EX-1"},{"key":"EX-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt":2,"maxResults":2,"total":3,"issues":[{"key":"EX-3"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var keys []string
+	for issue, err := range testClient.Sprint.IterateIssues(context.Background(), 12, &AgileIssueListOptions{JQL: "assignee = currentUser()"}, nil) {
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		keys = append(keys, issue.Key)
+	}
+	if want := []string{"EX-1", "EX-2", "EX-3"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("Expected %v, got %v", want, keys)
+	}
+}
+
+func TestIssueService_IterateComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if got := r.URL.Query().Get("expand"); got != "renderedBody" {
+			t.Errorf("Expected the expand of the options, got %q", got)
+		}
+		switch r.URL.Query().Get("startAt") {
+		case "0":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"comments":[{"id":"1"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"comments":[{"id":"2"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var ids []string
+	for comment, err := range testClient.Issue.IterateComments(context.Background(), "EX-1", &PagerOptions{PageSize: 1}, WithExpand("renderedBody")) {
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		ids = append(ids, comment.ID)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Expected %v, got %v", want, ids)
+	}
+}
+
+func TestAssetsService_IterateObjects(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/gateway/api/jsm/assets/workspace/ws-1/v1/object/aql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"isLast":false,"values":[{"id":"1"}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"isLast":true,"values":[{"id":"2"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var ids []string
+	for object, err := range testClient.Assets.IterateObjects(context.Background(), "ws-1", "objectType = Laptop", nil, nil) {
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		ids = append(ids, object.ID)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Expected %v, got %v", want, ids)
+	}
+}
+
+func TestPlanService_Iterate(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/3/plans/plan", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{"nextPageCursor":"c2","last":false,"size":1,"total":2,"values":[{"id":1}]}`)
+		case "c2":
+			fmt.Fprint(w, `{"cursor":"c2","last":true,"size":1,"total":2,"values":[{"id":2}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	var ids []int64
+	for plan, err := range testClient.Plan.Iterate(context.Background(), nil, nil) {
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		ids = append(ids, plan.ID)
+	}
+	if want := []int64{1, 2}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Expected %v, got %v", want, ids)
+	}
+}
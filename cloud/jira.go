@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -34,31 +35,96 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// DefaultHeaders are set on every request created by the client, e.g. "X-Force-Accept-Language".
+	// Headers required by a request, like Content-Type, take precedence.
+	DefaultHeaders http.Header
+
+	// ExperimentalAPI opts in to experimental APIs for every request created by the client,
+	// by setting the "X-ExperimentalApi: opt-in" header. Use WithExperimentalAPI to opt in for single requests.
+	ExperimentalAPI bool
+
+	// Redactor masks credentials in errors returned by the client.
+	// Authorization headers, URL passwords and common credential query parameters are always masked.
+	// Configure it to also mask custom secrets.
+	Redactor *Redactor
+
+	// OnDeprecation is called with every response of a deprecated endpoint,
+	// i.e. a response with a Deprecation, Sunset or Warning header.
+	// Use it to learn about endpoints Atlassian is sunsetting, e.g. by logging or counting them.
+	OnDeprecation func(resp *Response)
+
+	// RetryPolicy configures the retry of rate limited requests.
+	// Requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+
+	// CircuitBreaker makes requests fail fast with ErrCircuitOpen while Jira fails repeatedly.
+	// It is disabled if nil.
+	CircuitBreaker *CircuitBreaker
+
+	// RateLimiter limits the rate of the requests sent with Do, including retries.
+	// Requests are not limited if nil.
+	RateLimiter *RateLimiter
+
+	// MetricsRecorder is called after every request sent with Do.
+	MetricsRecorder MetricsRecorder
+
+	// Logger logs requests and responses at debug level and warns about retries and deprecated endpoints.
+	// Credentials are masked with the Redactor. Nothing is logged if nil.
+	Logger *slog.Logger
+	// LogBodyLimit is the number of bytes of request and response bodies that are logged.
+	// Bodies are not logged if 0.
+	LogBodyLimit int
+
+	// DisableCompression stops the client from requesting gzip encoded responses.
+	// Responses are requested with "Accept-Encoding: gzip" and decompressed in Do by default.
+	DisableCompression bool
+	// CompressRequestBodies gzips request bodies larger than this many bytes, e.g. big bulk or search requests.
+	// Request bodies are not compressed if 0.
+	CompressRequestBodies int
+
+	middlewares []Middleware
+
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
 	// Services used for talking to different parts of the Jira API.
-	Issue            *IssueService
-	Project          *ProjectService
-	Board            *BoardService
-	Sprint           *SprintService
-	User             *UserService
-	Group            *GroupService
-	Version          *VersionService
-	Priority         *PriorityService
-	Field            *FieldService
-	Component        *ComponentService
-	Resolution       *ResolutionService
-	StatusCategory   *StatusCategoryService
-	Filter           *FilterService
-	Role             *RoleService
-	PermissionScheme *PermissionSchemeService
-	Status           *StatusService
-	IssueLinkType    *IssueLinkTypeService
-	Organization     *OrganizationService
-	ServiceDesk      *ServiceDeskService
-	Customer         *CustomerService
-	Request          *RequestService
+	Issue               *IssueService
+	Project             *ProjectService
+	Board               *BoardService
+	Sprint              *SprintService
+	User                *UserService
+	Group               *GroupService
+	Version             *VersionService
+	Priority            *PriorityService
+	Field               *FieldService
+	Component           *ComponentService
+	Resolution          *ResolutionService
+	StatusCategory      *StatusCategoryService
+	Filter              *FilterService
+	Role                *RoleService
+	PermissionScheme    *PermissionSchemeService
+	Status              *StatusService
+	IssueLinkType       *IssueLinkTypeService
+	Organization        *OrganizationService
+	ServiceDesk         *ServiceDeskService
+	Customer            *CustomerService
+	Request             *RequestService
+	IssueEvent          *IssueEventService
+	UIModification      *UIModificationService
+	AddonProperty       *AddonPropertyService
+	ClassificationLevel *ClassificationLevelService
+	Plan                *PlanService
+	Backlog             *BacklogService
+	Epic                *EpicService
+	Build               *BuildService
+	Deployment          *DeploymentService
+	FeatureFlag         *FeatureFlagService
+	RemoteLink          *RemoteLinkService
+	DevInfo             *DevInfoService
+	Assets              *AssetsService
+	Task                *TaskService
+	Configuration       *ConfigurationService
+	Workflow            *WorkflowService
 }
 
 // service is the base structure to bundle API services
@@ -121,6 +187,22 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.ServiceDesk = (*ServiceDeskService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
+	c.IssueEvent = (*IssueEventService)(&c.common)
+	c.UIModification = (*UIModificationService)(&c.common)
+	c.AddonProperty = (*AddonPropertyService)(&c.common)
+	c.ClassificationLevel = (*ClassificationLevelService)(&c.common)
+	c.Plan = (*PlanService)(&c.common)
+	c.Backlog = (*BacklogService)(&c.common)
+	c.Epic = (*EpicService)(&c.common)
+	c.Build = (*BuildService)(&c.common)
+	c.Deployment = (*DeploymentService)(&c.common)
+	c.FeatureFlag = (*FeatureFlagService)(&c.common)
+	c.RemoteLink = (*RemoteLinkService)(&c.common)
+	c.DevInfo = (*DevInfoService)(&c.common)
+	c.Assets = (*AssetsService)(&c.common)
+	c.Task = (*TaskService)(&c.common)
+	c.Configuration = (*ConfigurationService)(&c.common)
+	c.Workflow = (*WorkflowService)(&c.common)
 
 	return c, nil
 }
@@ -143,6 +225,10 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 
@@ -177,12 +263,54 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 
 	return req, nil
 }
 
+// setDefaultHeaders sets the User-Agent, the DefaultHeaders and the experimental API opt-in of the client on req.
+func (c *Client) setDefaultHeaders(req *http.Request) {
+	for key, values := range c.DefaultHeaders {
+		req.Header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if c.ExperimentalAPI {
+		req.Header.Set(experimentalAPIHeader, "opt-in")
+	}
+}
+
+// APIVersion is a version of the Jira platform REST API.
+//
+// Each endpoint uses the version it is implemented against, mostly version 2.
+// The endpoints with rich text fields, like the description of issues and the body of comments,
+// use version 2, as their structs hold rich text as strings.
+// Documents in the Atlassian Document Format of version 3, e.g. built with the adf package,
+// are sent and received with Client.Call or NewRequest and Do on the rest/api/3 paths.
+type APIVersion string
+
+const (
+	// APIVersion2 is version 2 of the platform REST API, which formats rich text fields as wiki markup.
+	APIVersion2 APIVersion = "2"
+	// APIVersion3 is version 3 of the platform REST API, which formats rich text fields in the Atlassian Document Format.
+	APIVersion3 APIVersion = "3"
+)
+
+// restAPIPath returns the path of an endpoint of the version of the platform REST API, e.g. rest/api/2/issue/PRJ-1.
+// The path is formatted with a, like fmt.Sprintf, if a is not empty.
+func (c *Client) restAPIPath(version APIVersion, path string, a ...interface{}) string {
+	if len(a) > 0 {
+		path = fmt.Sprintf(path, a...)
+	}
+	return "rest/api/" + string(version) + "/" + path
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -222,6 +350,10 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	// Set required headers
 	req.Header.Set("X-Atlassian-Token", "nocheck")
@@ -231,17 +363,28 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
+// Rate limited requests are retried according to the RetryPolicy of the client.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
-	httpResp, err := c.client.Do(req)
+	req, cancel := applyRequestTimeout(req)
+	httpResp, err := c.doer().Do(req)
+	if cancel != nil {
+		if err != nil {
+			cancel()
+		} else {
+			httpResp.Body = &cancelOnClose{ReadCloser: httpResp.Body, cancel: cancel}
+		}
+	}
 	if err != nil {
-		return nil, err
+		return nil, c.Redactor.redactError(err)
 	}
+	applyResponseProgress(req, httpResp)
 
 	err = CheckResponse(httpResp)
 	if err != nil {
+		c.readErrorBody(httpResp, err.(*ResponseError))
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
-		return newResponse(httpResp, nil), err
+		return c.newResponse(httpResp, nil), err
 	}
 
 	if v != nil {
@@ -250,10 +393,67 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		err = json.NewDecoder(httpResp.Body).Decode(v)
 	}
 
-	resp := newResponse(httpResp, v)
+	resp := c.newResponse(httpResp, v)
 	return resp, err
 }
 
+// Call sends a request to an arbitrary REST path of Jira, e.g. of an app like "rest/scriptrunner/latest/custom/myEndpoint",
+// and decodes the JSON response into v.
+// The request is sent like the requests of all API methods: authenticated by the HTTP client and with the error handling of Do.
+// query is added to the path and may be nil.
+// body is JSON encoded, an io.Reader is sent as it is. No body is sent if nil.
+// The response is not decoded if v is nil.
+func (c *Client) Call(ctx context.Context, method, path string, query url.Values, body, v interface{}) (*Response, error) {
+	if len(query) > 0 {
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+		path += sep + query.Encode()
+	}
+
+	var req *http.Request
+	var err error
+	if r, ok := body.(io.Reader); ok {
+		req, err = c.NewRawRequest(ctx, method, path, r)
+	} else {
+		req, err = c.NewRequest(ctx, method, path, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Do sends an API request with client and returns the JSON decoded response as *T.
+// It is meant for endpoints that are not wrapped by this library yet.
+// The request is sent with ctx and errors are returned as the Error of NewJiraError,
+// like for all API methods.
+//
+// Example:
+//
+//	req, _ := client.NewRequest(ctx, http.MethodGet, "rest/api/2/serverInfo", nil)
+//	info, _, err := Do[ServerInfo](ctx, client, req)
+func Do[T any](ctx context.Context, client *Client, req *http.Request) (*T, *Response, error) {
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	v := new(T)
+	resp, err := client.Do(req, v)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return v, resp, nil
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
 // The caller is responsible to analyze the response body.
@@ -263,37 +463,176 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
+	err := &ResponseError{StatusCode: r.StatusCode}
+	if r.Request != nil {
+		err.Method = r.Request.Method
+		err.URL = r.Request.URL.Redacted()
+	}
 	return err
 }
 
 // Response represents Jira API response. It wraps http.Response returned from
 // API and provides information about paging.
+//
+// The paging values are set for all paginated endpoints, whether they use offset based
+// (startAt, maxResults and total or start, limit and isLastPage) or token based (nextPageToken) pagination.
 type Response struct {
 	*http.Response
 
 	StartAt    int
 	MaxResults int
 	Total      int
+	// IsLast reports whether this is the last page, if the endpoint returns it.
+	IsLast bool
+	// NextPageToken is the token of the next page of endpoints using token based pagination.
+	NextPageToken string
+
+	// pageSize is the number of values of the page, -1 if unknown.
+	pageSize int
+
+	// Deprecation is the deprecation information of the endpoint, nil if the endpoint is not deprecated.
+	Deprecation *Deprecation
+
+	redactor *Redactor
 }
 
 func newResponse(r *http.Response, v interface{}) *Response {
-	resp := &Response{Response: r}
+	resp := &Response{Response: r, pageSize: -1}
 	resp.populatePageValues(v)
+	if r != nil {
+		resp.Deprecation = parseDeprecation(r.Header)
+	}
 	return resp
 }
 
-// Sets paging values if response json was parsed to searchResult type
-// (can be extended with other types if they also need paging info)
+func (c *Client) newResponse(r *http.Response, v interface{}) *Response {
+	resp := newResponse(r, v)
+	resp.redactor = c.Redactor
+	if resp.Deprecation != nil && c.OnDeprecation != nil {
+		c.OnDeprecation(resp)
+	}
+	return resp
+}
+
+// populatePageValues sets the paging values if v is a page of a paginated endpoint.
+// The values are looked up by the JSON names of the fields of v.
 func (r *Response) populatePageValues(v interface{}) {
-	switch value := v.(type) {
-	case *searchResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
-	case *groupMembersResult:
-		r.StartAt = value.StartAt
-		r.MaxResults = value.MaxResults
-		r.Total = value.Total
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		value := rv.Field(i)
+
+		switch {
+		case (name == "startAt" || name == "start") && value.CanInt():
+			r.StartAt = int(value.Int())
+		case (name == "maxResults" || name == "limit") && value.CanInt():
+			r.MaxResults = int(value.Int())
+		case name == "total" && value.CanInt():
+			r.Total = int(value.Int())
+		case (name == "isLast" || name == "isLastPage") && value.Kind() == reflect.Bool:
+			r.IsLast = value.Bool()
+		case name == "nextPageToken" && value.Kind() == reflect.String:
+			r.NextPageToken = value.String()
+		case (name == "values" || name == "issues") && value.Kind() == reflect.Slice:
+			r.pageSize = value.Len()
+		}
+	}
+}
+
+// HasNextPage reports whether there is a page after this one.
+func (r *Response) HasNextPage() bool {
+	switch {
+	case r.NextPageToken != "":
+		return true
+	case r.IsLast || r.pageSize == 0:
+		return false
+	case r.Total > 0:
+		return r.StartAt+r.pageSizeOrMax() < r.Total
+	default:
+		// Without total, a full page may be followed by more values.
+		return r.pageSize > 0 && r.pageSize >= r.MaxResults
+	}
+}
+
+// NextPage sets the options of the request for the next page: the start (startAt or start) or the nextPageToken.
+// options must be a pointer to the options struct the page was requested with, e.g. *SearchOptions.
+// It returns false and leaves options unchanged if there is no next page.
+//
+// Example:
+//
+//	opts := &BoardListOptions{}
+//	for {
+//		boards, resp, err := client.Board.GetAllBoards(ctx, opts)
+//		if err != nil {
+//			return err
+//		}
+//		// process boards.Values
+//		if !resp.NextPage(opts) {
+//			break
+//		}
+//	}
+func (r *Response) NextPage(options interface{}) bool {
+	if !r.HasNextPage() {
+		return false
+	}
+	rv := reflect.ValueOf(options)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	if r.NextPageToken != "" {
+		return setOption(rv.Elem(), []string{"nextPageToken"}, reflect.ValueOf(r.NextPageToken))
+	}
+	return setOption(rv.Elem(), []string{"startAt", "start"}, reflect.ValueOf(r.StartAt+r.pageSizeOrMax()))
+}
+
+func (r *Response) pageSizeOrMax() int {
+	if r.pageSize >= 0 {
+		return r.pageSize
+	}
+	return r.MaxResults
+}
+
+// setOption sets the field of the options struct v with one of the given url tag names to value.
+// Embedded structs, like SearchOptions, are searched too.
+func setOption(v reflect.Value, names []string, value reflect.Value) bool {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if setOption(v.Field(i), names, value) {
+				return true
+			}
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("url"), ",")
+		for _, n := range names {
+			if name != n {
+				continue
+			}
+			f := v.Field(i)
+			switch {
+			case f.CanInt() && value.CanInt():
+				f.SetInt(value.Int())
+			case f.Kind() == reflect.String && value.Kind() == reflect.String:
+				f.SetString(value.String())
+			default:
+				return false
+			}
+			return true
+		}
 	}
+	return false
 }
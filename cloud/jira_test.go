@@ -3,6 +3,8 @@ package cloud
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +14,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/andygrunwald/go-jira/v2/cloud/adf"
 )
 
 const (
@@ -209,6 +213,87 @@ func testURLParseError(t *testing.T, err error) {
 	}
 }
 
+func TestClient_NewRequest_DefaultHeaders(t *testing.T) {
+	c, err := NewClient(testJiraInstanceURL, nil)
+	if err != nil {
+		t.Fatalf("An error occurred: %s", err)
+	}
+	c.UserAgent = "my-app/1.0"
+	c.DefaultHeaders = http.Header{
+		"x-force-accept-language": []string{"true"},
+		"Content-Type":            []string{"text/plain"},
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1", nil)
+	if got := req.Header.Get("User-Agent"); got != "my-app/1.0" {
+		t.Errorf("User-Agent = %s, want my-app/1.0", got)
+	}
+	if got := req.Header.Get("X-Force-Accept-Language"); got != "true" {
+		t.Errorf("X-Force-Accept-Language = %s, want true", got)
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %s, want application/json", got)
+	}
+
+	req, _ = c.NewMultiPartRequest(context.Background(), http.MethodPost, "rest/api/2/issue/EX-1/attachments", new(bytes.Buffer))
+	if got := req.Header.Get("User-Agent"); got != "my-app/1.0" {
+		t.Errorf("User-Agent of multipart request = %s, want my-app/1.0", got)
+	}
+}
+
+func TestClient_restAPIPath(t *testing.T) {
+	c, _ := NewClient(testJiraInstanceURL, nil)
+
+	if got, want := c.restAPIPath(APIVersion2, "issue/%s/comment", "EX-1"), "rest/api/2/issue/EX-1/comment"; got != want {
+		t.Errorf("restAPIPath = %s, want %s", got, want)
+	}
+	if got, want := c.restAPIPath(APIVersion3, "field"), "rest/api/3/field"; got != want {
+		t.Errorf("restAPIPath = %s, want %s", got, want)
+	}
+}
+
+func TestClient_Call_ADF(t *testing.T) {
+	setup()
+	defer teardown()
+
+	// Version 3 takes and returns the body of comments as ADF document.
+	var stored json.RawMessage
+	testMux.HandleFunc("/rest/api/3/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var comment struct {
+			Body json.RawMessage `json:"body"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+			t.Errorf("Error decoding the comment: %s", err)
+			return
+		}
+		stored = comment.Body
+		fmt.Fprintf(w, `{"id":"10000","body":%s}`, stored)
+	})
+	testMux.HandleFunc("/rest/api/3/issue/EX-1/comment/10000", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"id":"10000","body":%s}`, stored)
+	})
+
+	doc := adf.Doc(adf.Paragraph(adf.Text("Fixed in "), adf.Text("v2", adf.Code())))
+	var created, comment struct {
+		ID   string    `json:"id"`
+		Body *adf.Node `json:"body"`
+	}
+	if _, err := testClient.Call(context.Background(), http.MethodPost, "rest/api/3/issue/EX-1/comment", nil, map[string]interface{}{"body": doc}, &created); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := testClient.Call(context.Background(), http.MethodGet, "rest/api/3/issue/EX-1/comment/"+created.ID, nil, nil, &comment); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(comment.Body, doc) {
+		t.Errorf("Expected the document %+v, got %+v", doc, comment.Body)
+	}
+	if got, want := adf.PlainText(comment.Body), "Fixed in v2"; got != want {
+		t.Errorf("PlainText = %q, want %q", got, want)
+	}
+}
+
 func TestClient_NewRequest_BadURL(t *testing.T) {
 	c, err := NewClient(testJiraInstanceURL, nil)
 	if err != nil {
@@ -280,6 +365,100 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestClient_Call(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/scriptrunner/latest/custom/report", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"project": "EX", "format": "json"})
+		b, _ := io.ReadAll(r.Body)
+		if want := `{"name":"report"}` + "\n"; string(b) != want {
+			t.Errorf("Request body = %q, want %q", b, want)
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	var result map[string]string
+	query := url.Values{"project": []string{"EX"}}
+	resp, err := testClient.Call(context.Background(), http.MethodPost, "rest/scriptrunner/latest/custom/report?format=json", query, map[string]string{"name": "report"}, &result)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp == nil {
+		t.Fatal("Expected the response")
+	}
+	if result["id"] != "1" {
+		t.Errorf("Unexpected result %v", result)
+	}
+}
+
+func TestClient_Call_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/plugin/1.0/thing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["Forbidden."]}`)
+	})
+
+	_, err := testClient.Call(context.Background(), http.MethodDelete, "/rest/plugin/1.0/thing", nil, nil, nil)
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected a forbidden error, got %v", err)
+	}
+}
+
+func TestDo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[foo](context.Background(), testClient, req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected the response, got %v", resp)
+	}
+	if want := (&foo{"a"}); !reflect.DeepEqual(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+}
+
+func TestDo_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Not found."],"errors":{}}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[map[string]string](context.Background(), testClient, req)
+	if body != nil {
+		t.Errorf("Expected no body, got %v", body)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected the response, got %v", resp)
+	}
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "Not found.") {
+		t.Errorf("Expected the Jira error, got %v", err)
+	}
+}
+
 func TestClient_Do_HTTPResponse(t *testing.T) {
 	setup()
 	defer teardown()
@@ -338,3 +517,56 @@ func TestClient_Do_RedirectLoop(t *testing.T) {
 		t.Errorf("Expected a URL error; got %+v.", err)
 	}
 }
+
+func TestResponse_NextPage_Offset(t *testing.T) {
+	list := &BoardsList{StartAt: 0, MaxResults: 2, Total: 3, Values: make([]Board, 2)}
+	resp := newResponse(&http.Response{}, list)
+	if resp.StartAt != 0 || resp.MaxResults != 2 || resp.Total != 3 {
+		t.Errorf("Unexpected paging values %+v", resp)
+	}
+
+	opts := &BoardListOptions{}
+	if !resp.NextPage(opts) {
+		t.Fatal("Expected a next page")
+	}
+	if opts.StartAt != 2 {
+		t.Errorf("Expected startAt 2, got %d", opts.StartAt)
+	}
+
+	list = &BoardsList{StartAt: 2, MaxResults: 2, Total: 3, Values: make([]Board, 1)}
+	if newResponse(&http.Response{}, list).NextPage(opts) {
+		t.Error("Expected no next page")
+	}
+	if opts.StartAt != 2 {
+		t.Errorf("Expected the options to be unchanged, got startAt %d", opts.StartAt)
+	}
+}
+
+func TestResponse_NextPage_IsLast(t *testing.T) {
+	list := &BoardsList{StartAt: 0, MaxResults: 2, IsLast: true, Values: make([]Board, 2)}
+	resp := newResponse(&http.Response{}, list)
+	if !resp.IsLast {
+		t.Error("Expected IsLast to be set")
+	}
+	if resp.NextPage(&BoardListOptions{}) {
+		t.Error("Expected no next page")
+	}
+}
+
+func TestResponse_NextPage_Token(t *testing.T) {
+	page := &struct {
+		Values        []string `json:"values"`
+		NextPageToken string   `json:"nextPageToken"`
+	}{Values: []string{"a"}, NextPageToken: "next"}
+	resp := newResponse(&http.Response{}, page)
+	if resp.NextPageToken != "next" {
+		t.Errorf("Expected NextPageToken next, got %q", resp.NextPageToken)
+	}
+
+	opts := &struct {
+		NextPageToken string `url:"nextPageToken,omitempty"`
+	}{}
+	if !resp.NextPage(opts) || opts.NextPageToken != "next" {
+		t.Errorf("Expected the token to be set, got %q", opts.NextPageToken)
+	}
+}
@@ -0,0 +1,87 @@
+package cloud
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// logRequest logs req at debug level.
+func (c *Client) logRequest(req *http.Request) {
+	if c.Logger == nil || !c.Logger.Enabled(req.Context(), slog.LevelDebug) {
+		return
+	}
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", c.Redactor.URL(req.URL)),
+	}
+	if c.LogBodyLimit > 0 && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(io.LimitReader(body, int64(c.LogBodyLimit)))
+			body.Close()
+			attrs = append(attrs, slog.String("body", c.Redactor.String(string(data))))
+		}
+	}
+	c.Logger.DebugContext(req.Context(), "jira: sending request", attrs...)
+}
+
+// logResponse logs the response or error of req at debug level
+// and warns if the endpoint is deprecated.
+func (c *Client) logResponse(req *http.Request, resp *http.Response, err error, duration time.Duration) {
+	if c.Logger == nil {
+		return
+	}
+	ctx := req.Context()
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", c.Redactor.URL(req.URL)),
+		slog.Duration("duration", duration),
+	}
+	if err != nil {
+		c.Logger.DebugContext(ctx, "jira: request failed", append(attrs, slog.String("error", c.Redactor.redactError(err).Error()))...)
+		return
+	}
+
+	if deprecation := resp.Header.Get("Deprecation"); deprecation != "" {
+		c.Logger.WarnContext(ctx, "jira: endpoint is deprecated", append(attrs,
+			slog.String("deprecation", deprecation),
+			slog.String("sunset", resp.Header.Get("Sunset")),
+		)...)
+	}
+
+	if !c.Logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	if c.LogBodyLimit > 0 && resp.Body != nil {
+		// Only the logged prefix is read, the body is still streamed to the caller.
+		prefix, _ := io.ReadAll(io.LimitReader(resp.Body, int64(c.LogBodyLimit)))
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+		attrs = append(attrs, slog.String("body", c.Redactor.String(string(prefix))))
+	}
+	c.Logger.DebugContext(ctx, "jira: received response", attrs...)
+}
+
+// logRetry warns that req is retried after delay.
+func (c *Client) logRetry(req *http.Request, resp *http.Response, err error, attempt int, delay time.Duration) {
+	if c.Logger == nil {
+		return
+	}
+	attrs := []any{
+		slog.String("method", req.Method),
+		slog.String("url", c.Redactor.URL(req.URL)),
+		slog.Int("retry", attempt+1),
+		slog.Duration("delay", delay),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", c.Redactor.redactError(err).Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", resp.StatusCode))
+	}
+	c.Logger.WarnContext(req.Context(), "jira: retrying request", attrs...)
+}
@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_Do_Logger(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Deprecation", "true")
+		fmt.Fprint(w, `{"id":"10000","key":"TEST-1","secret":"s3cr3t"}`)
+	})
+
+	var buf bytes.Buffer
+	testClient.Logger = slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	testClient.LogBodyLimit = 16
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+	testClient.Redactor = &Redactor{Secrets: []string{"s3cr3t"}}
+
+	ctx := ContextWithRequestOptions(context.Background(), WithRetryNonIdempotent())
+	req, _ := testClient.NewRequest(ctx, http.MethodPost, "rest/api/2/issue?jwt=abc.def", map[string]string{"password": "s3cr3t"})
+	resp, err := testClient.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if !strings.HasPrefix(string(body), `{"id":"10000"`) || !strings.HasSuffix(string(body), `"s3cr3t"}`) {
+		t.Errorf("Expected the complete body after logging, got %s", body)
+	}
+
+	logs := buf.String()
+	for _, msg := range []string{"jira: sending request", "jira: retrying request", "jira: endpoint is deprecated", "jira: received response", `body="{\"id\":\"10000\",\"k"`} {
+		if !strings.Contains(logs, msg) {
+			t.Errorf("Expected %q to be logged, got:\n%s", msg, logs)
+		}
+	}
+	for _, secret := range []string{"s3cr3t", "abc.def"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("Expected %q to be redacted in:\n%s", secret, logs)
+		}
+	}
+}
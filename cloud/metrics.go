@@ -0,0 +1,58 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RequestMetrics describes a request sent with Client.Do.
+type RequestMetrics struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint template of the request, see EndpointTemplate.
+	Endpoint string
+	// StatusCode is the status code of the response, 0 if no response was received.
+	StatusCode int
+	// Duration is the time until the response was received, including retries.
+	Duration time.Duration
+	// Retries is the number of retries of the request.
+	Retries int
+	// Err is the error of the HTTP client if no response was received.
+	Err error
+	// RateLimitRemaining is the value of the X-RateLimit-Remaining header, -1 if it was missing.
+	RateLimitRemaining int
+}
+
+// MetricsRecorder records metrics of requests, e.g. for monitoring the error rate of the Jira API.
+// It is called once per request, after the response headers were received.
+type MetricsRecorder interface {
+	RecordRequest(ctx context.Context, m RequestMetrics)
+}
+
+// MetricsRecorderFunc is an adapter to use an ordinary function as MetricsRecorder.
+type MetricsRecorderFunc func(ctx context.Context, m RequestMetrics)
+
+// RecordRequest calls f(ctx, m).
+func (f MetricsRecorderFunc) RecordRequest(ctx context.Context, m RequestMetrics) {
+	f(ctx, m)
+}
+
+func newRequestMetrics(req *http.Request, resp *http.Response, err error, retries int, duration time.Duration) RequestMetrics {
+	m := RequestMetrics{
+		Method:             req.Method,
+		Endpoint:           EndpointTemplate(req.URL),
+		Duration:           duration,
+		Retries:            retries,
+		Err:                err,
+		RateLimitRemaining: -1,
+	}
+	if resp != nil {
+		m.StatusCode = resp.StatusCode
+		if remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+			m.RateLimitRemaining = remaining
+		}
+	}
+	return m
+}
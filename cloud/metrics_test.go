@@ -0,0 +1,48 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_MetricsRecorder(t *testing.T) {
+	setup()
+	defer teardown()
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/issue/PRJ-1", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var recorded []RequestMetrics
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+	testClient.MetricsRecorder = MetricsRecorderFunc(func(ctx context.Context, m RequestMetrics) {
+		recorded = append(recorded, m)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/PRJ-1", nil)
+	_, _ = testClient.Do(req, nil)
+
+	if len(recorded) != 1 {
+		t.Fatalf("Expected 1 recorded request, got %d", len(recorded))
+	}
+	m := recorded[0]
+	if m.Method != http.MethodGet || m.Endpoint != "/rest/api/2/issue/{key}" {
+		t.Errorf("Unexpected request %s %s", m.Method, m.Endpoint)
+	}
+	if m.StatusCode != http.StatusNotFound || m.Retries != 1 || m.RateLimitRemaining != 99 {
+		t.Errorf("Expected status 404, 1 retry and 99 remaining, got %d, %d and %d", m.StatusCode, m.Retries, m.RateLimitRemaining)
+	}
+	if m.Duration <= 0 || m.Duration > time.Minute {
+		t.Errorf("Unexpected duration %v", m.Duration)
+	}
+}
@@ -0,0 +1,53 @@
+package cloud
+
+import (
+	"net/http"
+)
+
+// Doer sends an HTTP request and returns the HTTP response.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use an ordinary function as Doer.
+type DoerFunc func(req *http.Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps the Doer that sends the requests of Client.Do.
+// It can mutate the request before calling next and inspect the response afterwards.
+//
+// Example:
+//
+//	client.Use(func(next cloud.Doer) cloud.Doer {
+//		return cloud.DoerFunc(func(req *http.Request) (*http.Response, error) {
+//			req.Header.Set("X-Request-Id", requestID(req.Context()))
+//			return next.Do(req)
+//		})
+//	})
+type Middleware func(next Doer) Doer
+
+// Use adds middlewares to the client. They are called in the order they are added
+// for every request sent with Do, the first one being the outermost.
+// Middlewares wrap the whole call, including retries.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.clientMu.Lock()
+	defer c.clientMu.Unlock()
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// doer returns the Doer that sends requests through all middlewares.
+func (c *Client) doer() Doer {
+	c.clientMu.Lock()
+	middlewares := c.middlewares
+	c.clientMu.Unlock()
+
+	var d Doer = DoerFunc(c.send)
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		d = middlewares[i](d)
+	}
+	return d
+}
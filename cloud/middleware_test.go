@@ -0,0 +1,47 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestClient_Use(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Request-Id"); got != "abc" {
+			t.Errorf("Expected header X-Request-Id abc, got %q", got)
+		}
+		w.Header().Set("X-Arequestid", "123")
+	})
+
+	var calls []string
+	testClient.Use(
+		func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, "first")
+				req.Header.Set("X-Request-Id", "abc")
+				resp, err := next.Do(req)
+				calls = append(calls, "first:"+resp.Header.Get("X-Arequestid"))
+				return resp, err
+			})
+		},
+		func(next Doer) Doer {
+			return DoerFunc(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, "second")
+				return next.Do(req)
+			})
+		},
+	)
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if _, err := testClient.Do(req, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"first", "second", "first:123"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected calls %v, got %v", want, calls)
+	}
+}
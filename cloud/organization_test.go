@@ -170,11 +170,19 @@ func TestOrganizationService_SetProperty(t *testing.T) {
 		testMethod(t, r, http.MethodPut)
 		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/property/organization.attributes")
 
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["phone"] != "0800-1233456789" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+
 		w.WriteHeader(http.StatusOK)
 	})
 
 	key := "organization.attributes"
-	_, err := testClient.Organization.SetProperty(context.Background(), 1, key)
+	_, err := testClient.Organization.SetProperty(context.Background(), 1, key, map[string]string{"phone": "0800-1233456789"})
 
 	if err != nil {
 		t.Errorf("Error given: %s", err)
@@ -309,6 +317,14 @@ func TestOrganizationService_RemoveUsers(t *testing.T) {
 		testMethod(t, r, http.MethodDelete)
 		testRequestURL(t, r, "/rest/servicedeskapi/organization/1/user")
 
+		var payload OrganizationUsersDTO
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.AccountIds) != 2 {
+			t.Errorf("Expected 2 account IDs in payload, got %+v", payload.AccountIds)
+		}
+
 		w.WriteHeader(http.StatusNoContent)
 	})
 
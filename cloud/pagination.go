@@ -0,0 +1,131 @@
+package cloud
+
+import (
+	"context"
+)
+
+// PageRequest is the position of a page requested by a Pager.
+type PageRequest struct {
+	// StartAt is the index of the first item of the page.
+	StartAt int
+	// MaxResults is the page size, 0 requests the default page size of the endpoint.
+	MaxResults int
+	// NextPageToken is the token returned with the previous page by endpoints
+	// using token based pagination. It is empty for the first page.
+	NextPageToken string
+}
+
+// Page is a single page of a paginated list, as returned to a Pager.
+type Page[T any] struct {
+	Values []T
+	// Total is the total number of items, 0 if the endpoint doesn't return it.
+	Total int
+	// IsLast reports whether this is the last page.
+	IsLast bool
+	// NextPageToken is the token of the next page for endpoints using token based pagination.
+	NextPageToken string
+}
+
+// PagerOptions specifies the optional parameters of a Pager.
+type PagerOptions struct {
+	// PageSize is the number of items requested per page.
+	// The default page size of the endpoint is used if 0.
+	PageSize int
+	// MaxItems stops the pagination once this many items are fetched.
+	// All items are fetched if 0.
+	MaxItems int
+	// OnPage is called after each page with the response and the number of items fetched so far.
+	// Returning an error stops the pagination with this error.
+	OnPage func(resp *Response, fetched int) error
+}
+
+// Pager follows the pagination of a list endpoint until all items are fetched.
+// It supports offset based (startAt/maxResults) as well as token based (nextPageToken) pagination.
+//
+// Example:
+//
+//	pager := NewPager(func(ctx context.Context, page PageRequest) (*Page[Board], *Response, error) {
+//		list, resp, err := client.Board.GetAllBoards(ctx, &BoardListOptions{SearchOptions: SearchOptions{StartAt: page.StartAt, MaxResults: page.MaxResults}})
+//		if err != nil {
+//			return nil, resp, err
+//		}
+//		return &Page[Board]{Values: list.Values, Total: list.Total, IsLast: list.IsLast}, resp, nil
+//	}, nil)
+//	boards, err := pager.All(ctx)
+type Pager[T any] struct {
+	fetch   func(ctx context.Context, page PageRequest) (*Page[T], *Response, error)
+	options PagerOptions
+}
+
+// NewPager returns a Pager that fetches the pages with fetch.
+// options may be nil.
+func NewPager[T any](fetch func(ctx context.Context, page PageRequest) (*Page[T], *Response, error), options *PagerOptions) *Pager[T] {
+	p := &Pager[T]{fetch: fetch}
+	if options != nil {
+		p.options = *options
+	}
+	return p
+}
+
+// Each calls f for each item of all pages.
+// It stops at the first error returned by the endpoint, OnPage or f.
+func (p *Pager[T]) Each(ctx context.Context, f func(T) error) error {
+	next := PageRequest{MaxResults: p.options.PageSize}
+	fetched := 0
+	tokenBased := false
+
+	for {
+		if max := p.options.MaxItems; max > 0 && next.MaxResults > max-fetched {
+			next.MaxResults = max - fetched
+		}
+
+		page, resp, err := p.fetch(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range page.Values {
+			if err := f(v); err != nil {
+				return err
+			}
+			fetched++
+			if p.options.MaxItems > 0 && fetched >= p.options.MaxItems {
+				break
+			}
+		}
+
+		if p.options.OnPage != nil {
+			if err := p.options.OnPage(resp, fetched); err != nil {
+				return err
+			}
+		}
+
+		if p.options.MaxItems > 0 && fetched >= p.options.MaxItems {
+			return nil
+		}
+
+		if page.NextPageToken != "" {
+			tokenBased = true
+			next.NextPageToken = page.NextPageToken
+			continue
+		}
+		if tokenBased || page.IsLast || len(page.Values) == 0 {
+			return nil
+		}
+		next.StartAt += len(page.Values)
+		if page.Total > 0 && next.StartAt >= page.Total {
+			return nil
+		}
+	}
+}
+
+// All returns the items of all pages.
+// It stops at the first error and returns the items fetched so far with the error.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var values []T
+	err := p.Each(ctx, func(v T) error {
+		values = append(values, v)
+		return nil
+	})
+	return values, err
+}
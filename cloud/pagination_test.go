@@ -0,0 +1,123 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func offsetPages(values []int, total bool) func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+	return func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+		size := page.MaxResults
+		if size == 0 {
+			size = 2
+		}
+		end := page.StartAt + size
+		if end > len(values) {
+			end = len(values)
+		}
+		p := &Page[int]{Values: values[page.StartAt:end]}
+		if total {
+			p.Total = len(values)
+		}
+		return p, &Response{}, nil
+	}
+}
+
+func TestPager_All_Offset(t *testing.T) {
+	values := []int{1, 2, 3, 4, 5}
+	for _, total := range []bool{true, false} {
+		calls := 0
+		fetch := offsetPages(values, total)
+		got, err := NewPager(func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+			calls++
+			return fetch(ctx, page)
+		}, nil).All(context.Background())
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("Expected %v, got %v", values, got)
+		}
+		// Without a total an empty page terminates the pagination.
+		if want := map[bool]int{true: 3, false: 4}[total]; calls != want {
+			t.Errorf("Expected %d requests with total=%t, got %d", want, total, calls)
+		}
+	}
+}
+
+func TestPager_All_Token(t *testing.T) {
+	pages := map[string]*Page[string]{
+		"":   {Values: []string{"a", "b"}, NextPageToken: "t1"},
+		"t1": {Values: []string{"c"}, NextPageToken: "t2"},
+		"t2": {Values: []string{"d"}},
+	}
+	got, err := NewPager(func(ctx context.Context, page PageRequest) (*Page[string], *Response, error) {
+		return pages[page.NextPageToken], &Response{}, nil
+	}, nil).All(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []string{"a", "b", "c", "d"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestPager_All_MaxItems(t *testing.T) {
+	var requested []int
+	fetch := offsetPages([]int{1, 2, 3, 4, 5, 6, 7}, true)
+	got, err := NewPager(func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+		requested = append(requested, page.MaxResults)
+		return fetch(ctx, page)
+	}, &PagerOptions{PageSize: 3, MaxItems: 4}).All(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if want := []int{3, 1}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("Expected page sizes %v, got %v", want, requested)
+	}
+}
+
+func TestPager_All_OnPage(t *testing.T) {
+	stop := errors.New("stop")
+	var fetched []int
+	got, err := NewPager(offsetPages([]int{1, 2, 3, 4, 5}, true), &PagerOptions{
+		OnPage: func(resp *Response, n int) error {
+			fetched = append(fetched, n)
+			if n >= 4 {
+				return stop
+			}
+			return nil
+		},
+	}).All(context.Background())
+	if err != stop {
+		t.Errorf("Expected error %v, got %v", stop, err)
+	}
+	if want := []int{1, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	if want := []int{2, 4}; !reflect.DeepEqual(fetched, want) {
+		t.Errorf("Expected OnPage with %v, got %v", want, fetched)
+	}
+}
+
+func TestPager_All_Error(t *testing.T) {
+	fail := errors.New("fail")
+	fetch := offsetPages([]int{1, 2, 3}, true)
+	got, err := NewPager(func(ctx context.Context, page PageRequest) (*Page[int], *Response, error) {
+		if page.StartAt > 0 {
+			return nil, nil, fail
+		}
+		return fetch(ctx, page)
+	}, nil).All(context.Background())
+	if err != fail {
+		t.Errorf("Expected error %v, got %v", fail, err)
+	}
+	if want := []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -35,7 +35,7 @@ type Holder struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *PermissionSchemeService) GetList(ctx context.Context) (*PermissionSchemes, *Response, error) {
-	apiEndpoint := "/rest/api/3/permissionscheme"
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "permissionscheme")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -58,7 +58,7 @@ func (s *PermissionSchemeService) GetList(ctx context.Context) (*PermissionSchem
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *PermissionSchemeService) Get(ctx context.Context, schemeID int) (*PermissionScheme, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/3/permissionscheme/%d", schemeID)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "permissionscheme/%d", schemeID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,542 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// PlanService handles plans of Advanced Roadmaps (Jira Premium) for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-group-plans
+type PlanService service
+
+const (
+	PlanStatusActive   = "Active"
+	PlanStatusArchived = "Archived"
+	PlanStatusTrashed  = "Trashed"
+)
+
+// Plan represents a plan of Advanced Roadmaps.
+type Plan struct {
+	ID                   int64                     `json:"id,omitempty" structs:"id,omitempty"`
+	Name                 string                    `json:"name,omitempty" structs:"name,omitempty"`
+	LeadAccountID        string                    `json:"leadAccountId,omitempty" structs:"leadAccountId,omitempty"`
+	Status               string                    `json:"status,omitempty" structs:"status,omitempty"`
+	LastSaved            string                    `json:"lastSaved,omitempty" structs:"lastSaved,omitempty"`
+	IssueSources         []PlanIssueSource         `json:"issueSources,omitempty" structs:"issueSources,omitempty"`
+	Scheduling           *PlanScheduling           `json:"scheduling,omitempty" structs:"scheduling,omitempty"`
+	ExclusionRules       *PlanExclusionRules       `json:"exclusionRules,omitempty" structs:"exclusionRules,omitempty"`
+	CrossProjectReleases []PlanCrossProjectRelease `json:"crossProjectReleases,omitempty" structs:"crossProjectReleases,omitempty"`
+	CustomFields         []PlanCustomField         `json:"customFields,omitempty" structs:"customFields,omitempty"`
+	Permissions          []PlanPermission          `json:"permissions,omitempty" structs:"permissions,omitempty"`
+}
+
+// PlanIssueSource is a source of issues of a plan.
+// Type can take the values Board, Project and Filter.
+type PlanIssueSource struct {
+	Type  string `json:"type,omitempty" structs:"type,omitempty"`
+	Value int64  `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// PlanScheduling contains the scheduling settings of a plan.
+type PlanScheduling struct {
+	// Estimation can take the values StoryPoints, Days and Hours.
+	Estimation string              `json:"estimation,omitempty" structs:"estimation,omitempty"`
+	StartDate  *PlanSchedulingDate `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	EndDate    *PlanSchedulingDate `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	// InferredDates can take the values None, SprintDates and ReleaseDates.
+	InferredDates string `json:"inferredDates,omitempty" structs:"inferredDates,omitempty"`
+	// Dependencies can take the values Sequential and Concurrent.
+	Dependencies string `json:"dependencies,omitempty" structs:"dependencies,omitempty"`
+}
+
+// PlanSchedulingDate describes where the start or end date of issues in a plan is taken from.
+// Type can take the values DueDate, TargetStartDate, TargetEndDate and DateCustomField.
+type PlanSchedulingDate struct {
+	Type              string `json:"type,omitempty" structs:"type,omitempty"`
+	DateCustomFieldID int64  `json:"dateCustomFieldId,omitempty" structs:"dateCustomFieldId,omitempty"`
+}
+
+// PlanExclusionRules describes which issues are excluded from a plan.
+type PlanExclusionRules struct {
+	NumberOfDaysToShowCompletedIssues int     `json:"numberOfDaysToShowCompletedIssues,omitempty" structs:"numberOfDaysToShowCompletedIssues,omitempty"`
+	IssueIDs                          []int64 `json:"issueIds,omitempty" structs:"issueIds,omitempty"`
+	WorkStatusIDs                     []int64 `json:"workStatusIds,omitempty" structs:"workStatusIds,omitempty"`
+	WorkStatusCategoryIDs             []int64 `json:"workStatusCategoryIds,omitempty" structs:"workStatusCategoryIds,omitempty"`
+	IssueTypeIDs                      []int64 `json:"issueTypeIds,omitempty" structs:"issueTypeIds,omitempty"`
+	ReleaseIDs                        []int64 `json:"releaseIds,omitempty" structs:"releaseIds,omitempty"`
+}
+
+// PlanCrossProjectRelease is a release that spans multiple projects of a plan.
+type PlanCrossProjectRelease struct {
+	Name       string  `json:"name,omitempty" structs:"name,omitempty"`
+	ReleaseIDs []int64 `json:"releaseIds,omitempty" structs:"releaseIds,omitempty"`
+}
+
+// PlanCustomField is a custom field that is shown in a plan.
+type PlanCustomField struct {
+	CustomFieldID int64 `json:"customFieldId,omitempty" structs:"customFieldId,omitempty"`
+	Filter        bool  `json:"filter,omitempty" structs:"filter,omitempty"`
+}
+
+// PlanPermission grants view or edit access to a plan.
+// Type can take the values View and Edit.
+type PlanPermission struct {
+	Type   string                `json:"type,omitempty" structs:"type,omitempty"`
+	Holder *PlanPermissionHolder `json:"holder,omitempty" structs:"holder,omitempty"`
+}
+
+// PlanPermissionHolder is the holder of a plan permission.
+// Type can take the values Group and AccountId.
+type PlanPermissionHolder struct {
+	Type  string `json:"type,omitempty" structs:"type,omitempty"`
+	Value string `json:"value,omitempty" structs:"value,omitempty"`
+}
+
+// PlanList is a cursor based page of plans.
+type PlanList struct {
+	Cursor         string `json:"cursor,omitempty" structs:"cursor,omitempty"`
+	NextPageCursor string `json:"nextPageCursor,omitempty" structs:"nextPageCursor,omitempty"`
+	Last           bool   `json:"last" structs:"last"`
+	Size           int    `json:"size" structs:"size"`
+	Total          int    `json:"total" structs:"total"`
+	Values         []Plan `json:"values" structs:"values"`
+}
+
+// PlanListOptions specifies the optional parameters to the PlanService.GetList
+type PlanListOptions struct {
+	IncludeTrashed  bool   `url:"includeTrashed,omitempty"`
+	IncludeArchived bool   `url:"includeArchived,omitempty"`
+	Cursor          string `url:"cursor,omitempty"`
+	MaxResults      int    `url:"maxResults,omitempty"`
+}
+
+// PlanGetOptions specifies the optional parameters to PlanService.Get and PlanService.Create
+type PlanGetOptions struct {
+	// UseGroupID makes Jira use group IDs instead of group names in permission holders.
+	UseGroupID bool `url:"useGroupId,omitempty"`
+}
+
+// PlanPatchOperation is a single JSON Patch (RFC 6902) operation used to update a plan.
+type PlanPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// GetList returns a cursor based page of plans.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-get
+func (s *PlanService) GetList(ctx context.Context, options *PlanListOptions) (*PlanList, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "plans/plan"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(PlanList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return list, resp, nil
+}
+
+// listPager returns a Pager over the plans, following the cursors of the pages.
+func (s *PlanService) listPager(options *PlanListOptions, pager *PagerOptions) *Pager[Plan] {
+	var opts PlanListOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[Plan], *Response, error) {
+		if page.NextPageToken != "" {
+			opts.Cursor = page.NextPageToken
+		}
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		list, resp, err := s.GetList(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		next := list.NextPageCursor
+		if list.Last {
+			next = ""
+		}
+		return &Page[Plan]{Values: list.Values, Total: list.Total, IsLast: list.Last, NextPageToken: next}, resp, nil
+	}, pager)
+}
+
+// Get returns the plan for the given plan ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-planid-get
+func (s *PlanService) Get(ctx context.Context, planID int64, options *PlanGetOptions) (*Plan, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "plans/plan/%d", planID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plan := new(Plan)
+	resp, err := s.client.Do(req, plan)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return plan, resp, nil
+}
+
+// Create creates a plan and returns the ID of the new plan.
+// Name, IssueSources and Scheduling are required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-post
+func (s *PlanService) Create(ctx context.Context, plan *Plan, options *PlanGetOptions) (int64, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "plans/plan"), options)
+	if err != nil {
+		return 0, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, plan)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var planID int64
+	resp, err := s.client.Do(req, &planID)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	return planID, resp, nil
+}
+
+// Update updates the plan with the given ID by applying JSON Patch operations.
+// Example: []PlanPatchOperation{{Op: "replace", Path: "/name", Value: "Updated plan"}}
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-planid-put
+// Caller must close resp.Body
+func (s *PlanService) Update(ctx context.Context, planID int64, operations []PlanPatchOperation, options *PlanGetOptions) (*Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "plans/plan/%d", planID), options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, operations)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Archive archives the plan with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-planid-archive-put
+// Caller must close resp.Body
+func (s *PlanService) Archive(ctx context.Context, planID int64) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/archive", planID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Trash moves the plan with the given ID to the trash.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-planid-trash-put
+// Caller must close resp.Body
+func (s *PlanService) Trash(ctx context.Context, planID int64) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/trash", planID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Duplicate duplicates the plan with the given ID and returns the ID of the new plan.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-plans/#api-rest-api-3-plans-plan-planid-duplicate-post
+func (s *PlanService) Duplicate(ctx context.Context, planID int64, name string) (int64, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/duplicate", planID)
+
+	payload := struct {
+		Name string `json:"name"`
+	}{
+		Name: name,
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var newPlanID int64
+	resp, err := s.client.Do(req, &newPlanID)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	return newPlanID, resp, nil
+}
+
+// PlanTeam is a team that is part of a plan.
+// Type can take the values Atlassian and PlanOnly.
+type PlanTeam struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// PlanTeamList is a cursor based page of teams in a plan.
+type PlanTeamList struct {
+	Cursor         string     `json:"cursor,omitempty" structs:"cursor,omitempty"`
+	NextPageCursor string     `json:"nextPageCursor,omitempty" structs:"nextPageCursor,omitempty"`
+	Last           bool       `json:"last" structs:"last"`
+	Size           int        `json:"size" structs:"size"`
+	Total          int        `json:"total" structs:"total"`
+	Values         []PlanTeam `json:"values" structs:"values"`
+}
+
+// PlanTeamListOptions specifies the optional parameters to the PlanService.GetTeams
+type PlanTeamListOptions struct {
+	Cursor     string `url:"cursor,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// GetTeams returns a cursor based page of the Atlassian teams and plan-only teams in a plan.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-get
+func (s *PlanService) GetTeams(ctx context.Context, planID int64, options *PlanTeamListOptions) (*PlanTeamList, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "plans/plan/%d/team", planID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(PlanTeamList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return list, resp, nil
+}
+
+const (
+	PlanningStyleScrum  = "Scrum"
+	PlanningStyleKanban = "Kanban"
+)
+
+// PlanAtlassianTeam holds the planning settings of an Atlassian team in a plan.
+// The ID is the ID of the Atlassian team.
+type PlanAtlassianTeam struct {
+	ID string `json:"id,omitempty" structs:"id,omitempty"`
+	// PlanningStyle can take the values Scrum and Kanban.
+	PlanningStyle string `json:"planningStyle,omitempty" structs:"planningStyle,omitempty"`
+	// Capacity is the capacity of the team per sprint (Scrum) or per week (Kanban),
+	// in the estimation unit of the plan.
+	Capacity      *float64 `json:"capacity,omitempty" structs:"capacity,omitempty"`
+	IssueSourceID int64    `json:"issueSourceId,omitempty" structs:"issueSourceId,omitempty"`
+	// SprintLength is the sprint length in weeks.
+	SprintLength int64 `json:"sprintLength,omitempty" structs:"sprintLength,omitempty"`
+}
+
+// PlanOnlyTeam is a team that only exists in a plan.
+type PlanOnlyTeam struct {
+	ID   int64  `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	// PlanningStyle can take the values Scrum and Kanban.
+	PlanningStyle string `json:"planningStyle,omitempty" structs:"planningStyle,omitempty"`
+	// Capacity is the capacity of the team per sprint (Scrum) or per week (Kanban),
+	// in the estimation unit of the plan.
+	Capacity      *float64 `json:"capacity,omitempty" structs:"capacity,omitempty"`
+	IssueSourceID int64    `json:"issueSourceId,omitempty" structs:"issueSourceId,omitempty"`
+	// SprintLength is the sprint length in weeks.
+	SprintLength     int64    `json:"sprintLength,omitempty" structs:"sprintLength,omitempty"`
+	MemberAccountIDs []string `json:"memberAccountIds,omitempty" structs:"memberAccountIds,omitempty"`
+}
+
+// AddAtlassianTeam adds an existing Atlassian team to a plan and configures its planning settings.
+// ID and PlanningStyle are required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-atlassian-post
+// Caller must close resp.Body
+func (s *PlanService) AddAtlassianTeam(ctx context.Context, planID int64, team *PlanAtlassianTeam) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/atlassian", planID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, team)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetAtlassianTeam returns the planning settings of an Atlassian team in a plan.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-atlassian-atlassianteamid-get
+func (s *PlanService) GetAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string) (*PlanAtlassianTeam, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/atlassian/%s", planID, atlassianTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(PlanAtlassianTeam)
+	resp, err := s.client.Do(req, team)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return team, resp, nil
+}
+
+// UpdateAtlassianTeam updates the planning settings of an Atlassian team in a plan by applying JSON Patch operations.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-atlassian-atlassianteamid-put
+// Caller must close resp.Body
+func (s *PlanService) UpdateAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string, operations []PlanPatchOperation) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/atlassian/%s", planID, atlassianTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, operations)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RemoveAtlassianTeam removes an Atlassian team from a plan.
+// The Atlassian team itself is not deleted.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-atlassian-atlassianteamid-delete
+// Caller must close resp.Body
+func (s *PlanService) RemoveAtlassianTeam(ctx context.Context, planID int64, atlassianTeamID string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/atlassian/%s", planID, atlassianTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// CreatePlanOnlyTeam creates a plan-only team and returns the ID of the new team.
+// Name and PlanningStyle are required.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-planonly-post
+func (s *PlanService) CreatePlanOnlyTeam(ctx context.Context, planID int64, team *PlanOnlyTeam) (int64, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/planonly", planID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, team)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var teamID int64
+	resp, err := s.client.Do(req, &teamID)
+	if err != nil {
+		return 0, resp, NewJiraError(resp, err)
+	}
+
+	return teamID, resp, nil
+}
+
+// GetPlanOnlyTeam returns a plan-only team.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-planonly-planonlyteamid-get
+func (s *PlanService) GetPlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64) (*PlanOnlyTeam, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/planonly/%d", planID, planOnlyTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team := new(PlanOnlyTeam)
+	resp, err := s.client.Do(req, team)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return team, resp, nil
+}
+
+// UpdatePlanOnlyTeam updates a plan-only team by applying JSON Patch operations.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-planonly-planonlyteamid-put
+// Caller must close resp.Body
+func (s *PlanService) UpdatePlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64, operations []PlanPatchOperation) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/planonly/%d", planID, planOnlyTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, operations)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeletePlanOnlyTeam deletes a plan-only team.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-teams-in-plan/#api-rest-api-3-plans-plan-planid-team-planonly-planonlyteamid-delete
+// Caller must close resp.Body
+func (s *PlanService) DeletePlanOnlyTeam(ctx context.Context, planID, planOnlyTeamID int64) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "plans/plan/%d/team/planonly/%d", planID, planOnlyTeamID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
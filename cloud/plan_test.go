@@ -0,0 +1,384 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPlanService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"includeArchived": "true", "maxResults": "2"})
+		fmt.Fprint(w, `{"cursor":"","last":true,"nextPageCursor":"2","size":2,"total":10,"values":[{"id":100,"issueSources":[{"type":"Project","value":10000}],"name":"Plan 1","scenarioId":200,"status":"Active"},{"id":200,"issueSources":[{"type":"Board","value":20000}],"name":"Plan 2","scenarioId":300,"status":"Archived"}]}`)
+	})
+
+	list, _, err := testClient.Plan.GetList(context.Background(), &PlanListOptions{IncludeArchived: true, MaxResults: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if list == nil || len(list.Values) != 2 {
+		t.Fatalf("Unexpected plan list: %+v", list)
+	}
+	if list.Values[1].Status != PlanStatusArchived {
+		t.Errorf("Expected status %s. Got %s", PlanStatusArchived, list.Values[1].Status)
+	}
+}
+
+func TestPlanService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":23,"issueSources":[{"type":"Project","value":12}],"lastSaved":"2024-10-03T10:15:30Z","leadAccountId":"628f5e86d5ec1f006ne7363x2s","name":"Onset TBJ Plan","permissions":[{"holder":{"type":"AccountId","value":"04jekw86d5jjje006ne7363x2s"},"type":"Edit"}],"scheduling":{"dependencies":"Concurrent","endDate":{"type":"DueDate"},"estimation":"Hours","inferredDates":"ReleaseDates","startDate":{"type":"TargetStartDate"}},"status":"Active"}`)
+	})
+
+	plan, _, err := testClient.Plan.Get(context.Background(), 23, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if plan == nil {
+		t.Fatal("Expected plan. Plan is nil")
+	}
+	if plan.Scheduling == nil || plan.Scheduling.Estimation != "Hours" {
+		t.Errorf("Unexpected scheduling: %+v", plan.Scheduling)
+	}
+	if len(plan.Permissions) != 1 || plan.Permissions[0].Holder.Type != "AccountId" {
+		t.Errorf("Unexpected permissions: %+v", plan.Permissions)
+	}
+}
+
+func TestPlanService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload Plan
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Name != "ATP Plan" || len(payload.IssueSources) != 1 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `100`)
+	})
+
+	planID, _, err := testClient.Plan.Create(context.Background(), &Plan{
+		Name:         "ATP Plan",
+		IssueSources: []PlanIssueSource{{Type: "Project", Value: 12}},
+		Scheduling:   &PlanScheduling{Estimation: "Days"},
+	}, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if planID != 100 {
+		t.Errorf("Expected plan ID 100. Got %d", planID)
+	}
+}
+
+func TestPlanService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		if got := r.Header.Get("Content-Type"); got != "application/json-patch+json" {
+			t.Errorf("Expected Content-Type application/json-patch+json. Got %s", got)
+		}
+
+		var payload []PlanPatchOperation
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload) != 1 || payload[0].Op != "replace" || payload[0].Path != "/name" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.Update(context.Background(), 23, []PlanPatchOperation{
+		{Op: "replace", Path: "/name", Value: "Updated plan"},
+	}, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_Archive(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/archive"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.Archive(context.Background(), 23)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_Trash(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/trash"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.Trash(context.Background(), 23)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_Duplicate(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/duplicate"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["name"] != "Copied plan" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `24`)
+	})
+
+	planID, _, err := testClient.Plan.Duplicate(context.Background(), 23, "Copied plan")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if planID != 24 {
+		t.Errorf("Expected plan ID 24. Got %d", planID)
+	}
+}
+
+func TestPlanService_GetTeams(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"cursor":"","last":true,"nextPageCursor":"2","size":2,"total":2,"values":[{"id":"1","name":"PlanOnly Team","type":"PlanOnly"},{"id":"2","name":"Atlassian Team","type":"Atlassian"}]}`)
+	})
+
+	teams, _, err := testClient.Plan.GetTeams(context.Background(), 23, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if teams == nil || len(teams.Values) != 2 || teams.Values[1].Type != "Atlassian" {
+		t.Errorf("Unexpected teams: %+v", teams)
+	}
+}
+
+func TestPlanService_AddAtlassianTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/atlassian"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload PlanAtlassianTeam
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.ID != "AtlassianTeamId" || payload.PlanningStyle != PlanningStyleScrum || payload.Capacity == nil || *payload.Capacity != 30 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	capacity := 30.0
+	_, err := testClient.Plan.AddAtlassianTeam(context.Background(), 23, &PlanAtlassianTeam{
+		ID:            "AtlassianTeamId",
+		PlanningStyle: PlanningStyleScrum,
+		Capacity:      &capacity,
+		SprintLength:  2,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_GetAtlassianTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/atlassian/AtlassianTeamId"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"capacity":30,"id":"AtlassianTeamId","issueSourceId":1,"planningStyle":"Scrum","sprintLength":2}`)
+	})
+
+	team, _, err := testClient.Plan.GetAtlassianTeam(context.Background(), 23, "AtlassianTeamId")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if team == nil || team.PlanningStyle != PlanningStyleScrum || team.SprintLength != 2 {
+		t.Errorf("Unexpected team: %+v", team)
+	}
+}
+
+func TestPlanService_UpdateAtlassianTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/atlassian/AtlassianTeamId"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		if got := r.Header.Get("Content-Type"); got != "application/json-patch+json" {
+			t.Errorf("Expected Content-Type application/json-patch+json. Got %s", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.UpdateAtlassianTeam(context.Background(), 23, "AtlassianTeamId", []PlanPatchOperation{
+		{Op: "replace", Path: "/planningStyle", Value: PlanningStyleKanban},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_RemoveAtlassianTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/atlassian/AtlassianTeamId"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.RemoveAtlassianTeam(context.Background(), 23, "AtlassianTeamId")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_CreatePlanOnlyTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/planonly"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload PlanOnlyTeam
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Name != "Team1" || len(payload.MemberAccountIDs) != 2 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `1`)
+	})
+
+	teamID, _, err := testClient.Plan.CreatePlanOnlyTeam(context.Background(), 23, &PlanOnlyTeam{
+		Name:             "Team1",
+		PlanningStyle:    PlanningStyleKanban,
+		MemberAccountIDs: []string{"member1AccountId", "member2AccountId"},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if teamID != 1 {
+		t.Errorf("Expected team ID 1. Got %d", teamID)
+	}
+}
+
+func TestPlanService_GetPlanOnlyTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/planonly/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"capacity":30,"id":1,"issueSourceId":1,"memberAccountIds":["member1AccountId","member2AccountId"],"name":"Team1","planningStyle":"Scrum","sprintLength":2}`)
+	})
+
+	team, _, err := testClient.Plan.GetPlanOnlyTeam(context.Background(), 23, 1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if team == nil || team.Name != "Team1" || team.Capacity == nil || *team.Capacity != 30 {
+		t.Errorf("Unexpected team: %+v", team)
+	}
+}
+
+func TestPlanService_UpdatePlanOnlyTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/planonly/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.UpdatePlanOnlyTeam(context.Background(), 23, 1, []PlanPatchOperation{
+		{Op: "replace", Path: "/capacity", Value: 40},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPlanService_DeletePlanOnlyTeam(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/plans/plan/23/team/planonly/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Plan.DeletePlanOnlyTeam(context.Background(), 23, 1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -28,7 +28,7 @@ type Priority struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *PriorityService) GetList(ctx context.Context) ([]Priority, *Response, error) {
-	apiEndpoint := "rest/api/2/priority"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "priority")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
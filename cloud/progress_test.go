@@ -0,0 +1,74 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWithProgress_Upload(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/attachments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `[{"id":"228924","filename":"report.txt"}]`)
+	})
+
+	var transferred, total int64
+	ctx := ContextWithRequestOptions(context.Background(), WithProgress(func(n, size int64) error {
+		transferred, total = n, size
+		return nil
+	}))
+	if _, _, err := testClient.Issue.PostAttachment(ctx, "10000", strings.NewReader(strings.Repeat("x", 1<<16)), "report.txt"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if transferred < 1<<16 || transferred != total {
+		t.Errorf("Expected the whole body to be reported, got %d of %d", transferred, total)
+	}
+}
+
+func TestWithProgress_Download(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/secure/attachment/10000/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", "1024")
+		fmt.Fprint(w, strings.Repeat("x", 1024))
+	})
+
+	var calls int
+	var transferred int64
+	ctx := ContextWithRequestOptions(context.Background(), WithProgress(func(n, total int64) error {
+		calls++
+		transferred = n
+		return nil
+	}))
+	resp, err := testClient.Issue.DownloadAttachment(ctx, "10000")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if calls == 0 || transferred != 1024 {
+		t.Errorf("Expected 1024 bytes to be reported, got %d", transferred)
+	}
+}
+
+func TestProgressReader_Abort(t *testing.T) {
+	errSlow := errors.New("transfer too slow")
+	r := NewProgressReader(strings.NewReader(strings.Repeat("x", 100)), 100, func(transferred, total int64) error {
+		if transferred >= 10 {
+			return errSlow
+		}
+		return nil
+	})
+
+	buf := make([]byte, 10)
+	if _, err := r.Read(buf); !errors.Is(err, errSlow) {
+		t.Errorf("Expected the transfer to be aborted, got %v", err)
+	}
+}
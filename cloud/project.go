@@ -2,10 +2,10 @@ package cloud
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 
 	"github.com/google/go-querystring/query"
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // ProjectService handles projects for the Jira instance / API.
@@ -52,6 +52,29 @@ type Project struct {
 	Roles           map[string]string  `json:"roles,omitempty" structs:"roles,omitempty"`
 	AvatarUrls      AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Project struct.
+// It adds the Unknowns to the encoded fields.
+func (p Project) MarshalJSON() ([]byte, error) {
+	type Alias Project
+	return marshalWithUnknowns(Alias(p), p.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Project struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type Alias Project
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Unknowns = unknowns
+	return nil
 }
 
 // ProjectComponent represents a single component of a project
@@ -80,15 +103,67 @@ type PermissionScheme struct {
 	Permissions []Permission `json:"permissions" structs:"permissions,omitempty"`
 }
 
+// ProjectSearchOptions specifies the optional parameters for ProjectService.Search
+type ProjectSearchOptions struct {
+	// StartAt: The starting index of the returned projects. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of projects to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// OrderBy orders the results by a field, e.g. key, name or -lastIssueUpdatedTime.
+	OrderBy string `url:"orderBy,omitempty"`
+	// Query filters the results by a literal string that matches the key or name of the project.
+	Query string `url:"query,omitempty"`
+	// TypeKey filters the results by project type, e.g. business, service_desk or software.
+	TypeKey string `url:"typeKey,omitempty"`
+	// CategoryID filters the results by the project category ID.
+	CategoryID int `url:"categoryId,omitempty"`
+	// IDs filters the results by project IDs.
+	IDs []int `url:"id,omitempty"`
+	// Keys filters the results by project keys.
+	Keys []string `url:"keys,omitempty"`
+	// Action filters the results by the permission of the user for the projects: view, browse or edit.
+	Action string `url:"action,omitempty"`
+	// Status filters the results by the status of the projects. Only live projects are returned by default.
+	Status []ProjectStatus `url:"status,omitempty"`
+	// Properties are the keys of the project properties to return with the projects.
+	Properties []string `url:"properties,omitempty,comma"`
+	// Expand can be used to include additional information like description, projectKeys, lead, issueTypes, url or insight.
+	Expand string `url:"expand,omitempty"`
+}
+
+// ProjectStatus is the status of a project, used to filter ProjectService.Search.
+type ProjectStatus string
+
+// The statuses of projects.
+const (
+	ProjectStatusLive     ProjectStatus = "live"
+	ProjectStatusArchived ProjectStatus = "archived"
+	ProjectStatusDeleted  ProjectStatus = "deleted"
+)
+
+// ProjectSearchResult is a page of projects returned by ProjectService.Search
+type ProjectSearchResult struct {
+	Self       string    `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage   string    `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	MaxResults int       `json:"maxResults" structs:"maxResults"`
+	StartAt    int       `json:"startAt" structs:"startAt"`
+	Total      int       `json:"total" structs:"total"`
+	IsLast     bool      `json:"isLast" structs:"isLast"`
+	Values     []Project `json:"values" structs:"values"`
+}
+
 // GetAll returns all projects form Jira with optional query params, like &GetQueryOptions{Expand: "issueTypes"} to get
 // a list of all projects and their supported issuetypes.
 //
+// Deprecated: Jira Cloud removed the endpoint listing all projects. Use SearchAll, which follows the pagination
+// of the project search and supports its filters.
+//
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-get
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ProjectService) GetAll(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error) {
-	apiEndpoint := "rest/api/2/project"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "project")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -112,6 +187,55 @@ func (s *ProjectService) GetAll(ctx context.Context, options *GetQueryOptions) (
 	return projectList, resp, nil
 }
 
+// Search returns a page of the projects visible to the user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-search-get
+func (s *ProjectService) Search(ctx context.Context, options *ProjectSearchOptions) (*ProjectSearchResult, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion2, "project/search"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(ProjectSearchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// SearchAll returns the projects of all pages visible to the user.
+// options.StartAt is ignored, the page size and a limit of projects can be set with pager.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-projects/#api-rest-api-2-project-search-get
+func (s *ProjectService) SearchAll(ctx context.Context, options *ProjectSearchOptions, pager *PagerOptions) ([]Project, error) {
+	return s.searchPager(options, pager).All(ctx)
+}
+
+// searchPager returns a Pager over the projects visible to the user.
+func (s *ProjectService) searchPager(options *ProjectSearchOptions, pager *PagerOptions) *Pager[Project] {
+	var opts ProjectSearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[Project], *Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		result, resp, err := s.Search(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[Project]{Values: result.Values, Total: result.Total, IsLast: result.IsLast}, resp, nil
+	}, pager)
+}
+
 // Get returns a full representation of the project for the given issue key.
 // Jira will attempt to identify the project by the projectIdOrKey path parameter.
 // This can be an project id, or an project key.
@@ -121,7 +245,7 @@ func (s *ProjectService) GetAll(ctx context.Context, options *GetQueryOptions) (
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ProjectService) Get(ctx context.Context, projectID string) (*Project, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/project/%s", projectID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "project/%s", projectID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -146,7 +270,7 @@ func (s *ProjectService) Get(ctx context.Context, projectID string) (*Project, *
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID string) (*PermissionScheme, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/project/%s/permissionscheme", projectID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "project/%s/permissionscheme", projectID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -161,3 +285,265 @@ func (s *ProjectService) GetPermissionScheme(ctx context.Context, projectID stri
 
 	return ps, resp, nil
 }
+
+// GetDefaultClassification returns the default data classification level of a project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-projectidorkey-classification-level-default-get
+func (s *ProjectService) GetDefaultClassification(ctx context.Context, projectIDOrKey string) (*ClassificationLevel, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "project/%s/classification-level/default", projectIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	level := new(ClassificationLevel)
+	resp, err := s.client.Do(req, level)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return level, resp, nil
+}
+
+// UpdateDefaultClassification sets the default data classification level of a project.
+// The classification level must be published.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-projectidorkey-classification-level-default-put
+// Caller must close resp.Body
+func (s *ProjectService) UpdateDefaultClassification(ctx context.Context, projectIDOrKey, classificationLevelID string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "project/%s/classification-level/default", projectIDOrKey)
+
+	payload := struct {
+		ID string `json:"id"`
+	}{
+		ID: classificationLevelID,
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RemoveDefaultClassification removes the default data classification level from a project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-projectidorkey-classification-level-default-delete
+// Caller must close resp.Body
+func (s *ProjectService) RemoveDefaultClassification(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "project/%s/classification-level/default", projectIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RecentProjectsOptions specifies the optional parameters to the ProjectService.GetRecent
+type RecentProjectsOptions struct {
+	// Expand can be used to include additional information like "description", "issueTypes", "lead" or "projectKeys".
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetRecent returns up to 20 projects recently viewed by the user that are still visible to the user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-recent-get
+func (s *ProjectService) GetRecent(ctx context.Context, options *RecentProjectsOptions) ([]Project, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "project/recent"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects := []Project{}
+	resp, err := s.client.Do(req, &projects)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return projects, resp, nil
+}
+
+// ProjectIssueTypeHierarchy is the issue type hierarchy of a project.
+type ProjectIssueTypeHierarchy struct {
+	ProjectID int64                   `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	Hierarchy []ProjectIssueTypeLevel `json:"hierarchy,omitempty" structs:"hierarchy,omitempty"`
+}
+
+// ProjectIssueTypeLevel is a level of the issue type hierarchy, e.g. Epic (1), Story (0) or Sub-task (-1).
+type ProjectIssueTypeLevel struct {
+	EntityID   string                      `json:"entityId,omitempty" structs:"entityId,omitempty"`
+	Level      int                         `json:"level" structs:"level"`
+	Name       string                      `json:"name,omitempty" structs:"name,omitempty"`
+	IssueTypes []ProjectIssueTypeLevelType `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
+}
+
+// ProjectIssueTypeLevelType is an issue type of a hierarchy level.
+type ProjectIssueTypeLevelType struct {
+	ID       int64  `json:"id,omitempty" structs:"id,omitempty"`
+	EntityID string `json:"entityId,omitempty" structs:"entityId,omitempty"`
+	Name     string `json:"name,omitempty" structs:"name,omitempty"`
+	AvatarID int64  `json:"avatarId,omitempty" structs:"avatarId,omitempty"`
+}
+
+// GetHierarchy returns the issue type hierarchy for a team-managed (next-gen) project.
+// Use IssueService.GetIssueTypeHierarchy for the hierarchy of the site, which includes company-managed projects.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-projectid-hierarchy-get
+func (s *ProjectService) GetHierarchy(ctx context.Context, projectID string) (*ProjectIssueTypeHierarchy, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "project/%s/hierarchy", projectID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hierarchy := new(ProjectIssueTypeHierarchy)
+	resp, err := s.client.Do(req, hierarchy)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return hierarchy, resp, nil
+}
+
+// ProjectSchemeOptions specifies the optional parameters for the project scheme lookups
+type ProjectSchemeOptions struct {
+	// Expand can be used to include additional information like "all", "field", "group", "user", "projectRole" or "notificationSchemeEvents".
+	Expand string `url:"expand,omitempty"`
+}
+
+// NotificationScheme represents a notification scheme.
+type NotificationScheme struct {
+	Expand                   string                    `json:"expand,omitempty" structs:"expand,omitempty"`
+	ID                       int64                     `json:"id,omitempty" structs:"id,omitempty"`
+	Self                     string                    `json:"self,omitempty" structs:"self,omitempty"`
+	Name                     string                    `json:"name,omitempty" structs:"name,omitempty"`
+	Description              string                    `json:"description,omitempty" structs:"description,omitempty"`
+	NotificationSchemeEvents []NotificationSchemeEvent `json:"notificationSchemeEvents,omitempty" structs:"notificationSchemeEvents,omitempty"`
+	Projects                 []int64                   `json:"projects,omitempty" structs:"projects,omitempty"`
+}
+
+// NotificationSchemeEvent maps an issue event to the notifications sent for it.
+type NotificationSchemeEvent struct {
+	Event         *IssueEvent             `json:"event,omitempty" structs:"event,omitempty"`
+	Notifications []NotificationRecipient `json:"notifications,omitempty" structs:"notifications,omitempty"`
+}
+
+// NotificationRecipient describes who is notified about an event.
+// NotificationType can take values like CurrentAssignee, Reporter, User, Group, ProjectRole, EmailAddress or UserCustomField.
+type NotificationRecipient struct {
+	ID               int64  `json:"id,omitempty" structs:"id,omitempty"`
+	NotificationType string `json:"notificationType,omitempty" structs:"notificationType,omitempty"`
+	Parameter        string `json:"parameter,omitempty" structs:"parameter,omitempty"`
+	Recipient        string `json:"recipient,omitempty" structs:"recipient,omitempty"`
+	EmailAddress     string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	Group            *Group `json:"group,omitempty" structs:"group,omitempty"`
+	User             *User  `json:"user,omitempty" structs:"user,omitempty"`
+	ProjectRole      *Role  `json:"projectRole,omitempty" structs:"projectRole,omitempty"`
+	Field            *Field `json:"field,omitempty" structs:"field,omitempty"`
+	Expand           string `json:"expand,omitempty" structs:"expand,omitempty"`
+}
+
+// IssueSecurityLevelScheme represents an issue security scheme and its levels.
+type IssueSecurityLevelScheme struct {
+	Self                   string               `json:"self,omitempty" structs:"self,omitempty"`
+	ID                     int64                `json:"id,omitempty" structs:"id,omitempty"`
+	Name                   string               `json:"name,omitempty" structs:"name,omitempty"`
+	Description            string               `json:"description,omitempty" structs:"description,omitempty"`
+	DefaultSecurityLevelID int64                `json:"defaultSecurityLevelId,omitempty" structs:"defaultSecurityLevelId,omitempty"`
+	Levels                 []IssueSecurityLevel `json:"levels,omitempty" structs:"levels,omitempty"`
+}
+
+// IssueSecurityLevel is a security level of an issue security scheme.
+type IssueSecurityLevel struct {
+	Self                  string `json:"self,omitempty" structs:"self,omitempty"`
+	ID                    string `json:"id,omitempty" structs:"id,omitempty"`
+	Name                  string `json:"name,omitempty" structs:"name,omitempty"`
+	Description           string `json:"description,omitempty" structs:"description,omitempty"`
+	IsDefault             bool   `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	IssueSecuritySchemeID string `json:"issueSecuritySchemeId,omitempty" structs:"issueSecuritySchemeId,omitempty"`
+}
+
+// GetNotificationScheme returns the notification scheme associated with the project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-projectkeyorid-notificationscheme-get
+func (s *ProjectService) GetNotificationScheme(ctx context.Context, projectIDOrKey string, options *ProjectSchemeOptions) (*NotificationScheme, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "project/%s/notificationscheme", projectIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(NotificationScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return scheme, resp, nil
+}
+
+// AssignPermissionScheme assigns a permission scheme with a project
+// and returns the newly assigned permission scheme.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-permission-schemes/#api-rest-api-3-project-projectkeyorid-permissionscheme-put
+func (s *ProjectService) AssignPermissionScheme(ctx context.Context, projectIDOrKey string, permissionSchemeID int, options *ProjectSchemeOptions) (*PermissionScheme, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "project/%s/permissionscheme", projectIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload := struct {
+		ID int `json:"id"`
+	}{
+		ID: permissionSchemeID,
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ps := new(PermissionScheme)
+	resp, err := s.client.Do(req, ps)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return ps, resp, nil
+}
+
+// GetIssueSecurityLevelScheme returns the issue security scheme associated with the project.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-permission-schemes/#api-rest-api-3-project-projectkeyorid-issuesecuritylevelscheme-get
+func (s *ProjectService) GetIssueSecurityLevelScheme(ctx context.Context, projectIDOrKey string) (*IssueSecurityLevelScheme, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "project/%s/issuesecuritylevelscheme", projectIDOrKey)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scheme := new(IssueSecurityLevelScheme)
+	resp, err := s.client.Do(req, scheme)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return scheme, resp, nil
+}
@@ -2,6 +2,7 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -137,3 +138,237 @@ func TestProjectService_GetPermissionScheme_Success(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestProjectService_GetDefaultClassification(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/PR/classification-level/default"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"ari:cloud:platform::classification-tag/5bfa70f7-4af1-44f5-9e12-1ce185f15a38","status":"PUBLISHED","name":"Restricted","rank":1}`)
+	})
+
+	level, _, err := testClient.Project.GetDefaultClassification(context.Background(), "PR")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if level == nil || level.Name != "Restricted" {
+		t.Errorf("Unexpected classification level: %+v", level)
+	}
+}
+
+func TestProjectService_UpdateDefaultClassification(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/PR/classification-level/default"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["id"] != "ari:cloud:platform::classification-tag/5bfa70f7" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Project.UpdateDefaultClassification(context.Background(), "PR", "ari:cloud:platform::classification-tag/5bfa70f7")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_RemoveDefaultClassification(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/PR/classification-level/default"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Project.RemoveDefaultClassification(context.Background(), "PR")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_GetRecent(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/recent"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"expand": "lead"})
+		fmt.Fprint(w, `[{"id":"10000","key":"EX","name":"Example","projectTypeKey":"software"},{"id":"10001","key":"ABC","name":"Alphabetical","projectTypeKey":"business"}]`)
+	})
+
+	projects, _, err := testClient.Project.GetRecent(context.Background(), &RecentProjectsOptions{Expand: "lead"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(projects) != 2 || projects[1].Key != "ABC" {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+}
+
+func TestProjectService_GetHierarchy(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/10030/hierarchy"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"hierarchy":[{"entityId":"ba9c5b2e-6b6b-4a4a-8a3c-1ccf2b4f1a11","issueTypes":[{"avatarId":10324,"entityId":"a7d8e8b6-9b1c-4ac6-9cd1-6a0f5dcc8d23","id":10008,"name":"Epic"}],"level":1,"name":"Epic"},{"entityId":"c4a7b7d2-3e2f-4e67-9b1f-3f54b8c7d1e3","issueTypes":[{"avatarId":10318,"id":10001,"name":"Story"}],"level":0,"name":"Base"},{"issueTypes":[{"avatarId":10316,"id":10002,"name":"Sub-task"}],"level":-1,"name":"Subtask"}],"projectId":10030}`)
+	})
+
+	hierarchy, _, err := testClient.Project.GetHierarchy(context.Background(), "10030")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if hierarchy == nil || hierarchy.ProjectID != 10030 || len(hierarchy.Hierarchy) != 3 {
+		t.Fatalf("Unexpected hierarchy: %+v", hierarchy)
+	}
+	if level := hierarchy.Hierarchy[2]; level.Level != -1 || level.IssueTypes[0].Name != "Sub-task" {
+		t.Errorf("Unexpected level: %+v", level)
+	}
+}
+
+func TestProjectService_GetNotificationScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/EX/notificationscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"expand": "all"})
+		fmt.Fprint(w, `{"expand":"notificationSchemeEvents,user,group,projectRole,field,all","id":10100,"self":"https://your-domain.atlassian.net/rest/api/3/notificationscheme","name":"notification scheme name","description":"description","notificationSchemeEvents":[{"event":{"id":1,"name":"Issue created","description":"Event published when an issue is created"},"notifications":[{"id":1,"notificationType":"Group","parameter":"jira-administrators","recipient":"276f955c-63d7-42c8-9520-92d01dca0625","group":{"name":"jira-administrators","self":"https://your-domain.atlassian.net/rest/api/3/group?groupname=jira-administrators"},"expand":"group"},{"id":2,"notificationType":"CurrentAssignee"}]}]}`)
+	})
+
+	scheme, _, err := testClient.Project.GetNotificationScheme(context.Background(), "EX", &ProjectSchemeOptions{Expand: "all"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 10100 || len(scheme.NotificationSchemeEvents) != 1 {
+		t.Fatalf("Unexpected notification scheme: %+v", scheme)
+	}
+	event := scheme.NotificationSchemeEvents[0]
+	if event.Event.ID != 1 || len(event.Notifications) != 2 {
+		t.Fatalf("Unexpected notification scheme event: %+v", event)
+	}
+	if event.Notifications[0].Group == nil || event.Notifications[0].Group.Name != "jira-administrators" {
+		t.Errorf("Unexpected notification: %+v", event.Notifications[0])
+	}
+}
+
+func TestProjectService_AssignPermissionScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/EX/permissionscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["id"] != 10000 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"id":10000,"self":"https://your-domain.atlassian.net/rest/api/3/permissionscheme/10000","name":"Example permission scheme","description":"description"}`)
+	})
+
+	scheme, _, err := testClient.Project.AssignPermissionScheme(context.Background(), "EX", 10000, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.ID != 10000 {
+		t.Errorf("Unexpected permission scheme: %+v", scheme)
+	}
+}
+
+func TestProjectService_GetIssueSecurityLevelScheme(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/project/EX/issuesecuritylevelscheme"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"defaultSecurityLevelId":10021,"description":"Description for the default issue security scheme","id":10000,"levels":[{"description":"Only the reporter and internal staff can see this issue.","id":"10021","name":"Reporter Only","self":"https://your-domain.atlassian.net/rest/api/3/issuesecurityscheme/level?id=10021"}],"name":"Default Issue Security Scheme","self":"https://your-domain.atlassian.net/rest/api/3/issuesecurityschemes/10000"}`)
+	})
+
+	scheme, _, err := testClient.Project.GetIssueSecurityLevelScheme(context.Background(), "EX")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if scheme == nil || scheme.DefaultSecurityLevelID != 10021 || len(scheme.Levels) != 1 || scheme.Levels[0].Name != "Reporter Only" {
+		t.Errorf("Unexpected issue security scheme: %+v", scheme)
+	}
+}
+
+func TestProjectService_SearchAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.RawQuery {
+		case "maxResults=1&query=jira":
+			fmt.Fprint(w, `{"maxResults": 1,"startAt": 0,"total": 2,"isLast": false,"values": [{"id": "10000","key": "EX"}]}`)
+		case "maxResults=1&query=jira&startAt=1":
+			fmt.Fprint(w, `{"maxResults": 1,"startAt": 1,"total": 2,"isLast": true,"values": [{"id": "10001","key": "ABC"}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	projects, err := testClient.Project.SearchAll(context.Background(), &ProjectSearchOptions{Query: "jira"}, &PagerOptions{PageSize: 1})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(projects) != 2 || projects[1].Key != "ABC" {
+		t.Errorf("Expected projects EX and ABC, got %+v", projects)
+	}
+}
+
+func TestProjectService_Search_Filters(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/project/search?action=browse&categoryId=10000&expand=lead&keys=EX&keys=ABC&orderBy=-lastIssueUpdatedTime&properties=team%2Ccost-center&status=live&status=archived&typeKey=software")
+		fmt.Fprint(w, `{"maxResults": 50,"startAt": 0,"total": 1,"isLast": true,"values": [{"id": "10000","key": "EX"}]}`)
+	})
+
+	options := &ProjectSearchOptions{
+		OrderBy:    "-lastIssueUpdatedTime",
+		TypeKey:    "software",
+		CategoryID: 10000,
+		Keys:       []string{"EX", "ABC"},
+		Action:     "browse",
+		Status:     []ProjectStatus{ProjectStatusLive, ProjectStatusArchived},
+		Properties: []string{"team", "cost-center"},
+		Expand:     "lead",
+	}
+	result, _, err := testClient.Project.Search(context.Background(), options)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(result.Values) != 1 || result.Values[0].Key != "EX" {
+		t.Errorf("Expected project EX, got %+v", result.Values)
+	}
+}
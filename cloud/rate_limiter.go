@@ -0,0 +1,104 @@
+package cloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter limits the rate of the requests sent by Client.Do with a token bucket,
+// so bulk jobs stay below the rate limits of Jira Cloud instead of reacting to 429 responses.
+//
+// The bucket holds up to Burst tokens and is refilled with Rate tokens per second.
+// Every request, including retries, takes a token and waits until one is available.
+//
+// A RateLimiter can be shared between clients, it is safe for concurrent use.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rate-limiting/
+type RateLimiter struct {
+	// Rate is the number of requests per second. Requests are not limited if 0.
+	Rate float64
+	// Burst is the number of requests that can be sent at once after being idle.
+	// It will default to 1 if 0.
+	Burst int
+	// PerHost limits the requests to each host separately, e.g. of a limiter shared by the clients of multiple sites.
+	PerHost bool
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket is the state of the bucket of a host. tokens is negative while requests wait for a token.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Wait waits until a request to host may be sent or ctx is done.
+// host is ignored unless PerHost is set.
+func (l *RateLimiter) Wait(ctx context.Context, host string) error {
+	if l == nil {
+		return nil
+	}
+	delay, ok := l.reserve(host, time.Now())
+	if !ok || delay == 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel(host)
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token of the bucket of host and returns how long to wait until it is available.
+func (l *RateLimiter) reserve(host string, now time.Time) (time.Duration, bool) {
+	if l.Rate <= 0 {
+		return 0, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bucket(host, now)
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * l.Rate
+		if burst := float64(l.burst()); b.tokens > burst {
+			b.tokens = burst
+		}
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0, true
+	}
+	return time.Duration(-b.tokens / l.Rate * float64(time.Second)), true
+}
+
+// cancel returns the token of a request that was canceled while waiting.
+func (l *RateLimiter) cancel(host string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.bucket(host, time.Now()).tokens++
+}
+
+func (l *RateLimiter) bucket(host string, now time.Time) *tokenBucket {
+	if !l.PerHost {
+		host = ""
+	}
+	if l.buckets == nil {
+		l.buckets = map[string]*tokenBucket{}
+	}
+	b, ok := l.buckets[host]
+	if !ok {
+		b = &tokenBucket{tokens: float64(l.burst()), last: now}
+		l.buckets[host] = b
+	}
+	return b
+}
+
+func (l *RateLimiter) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return 1
+}
@@ -0,0 +1,80 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	l := &RateLimiter{Rate: 10, Burst: 2}
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if d, ok := l.reserve("", now); !ok || d != 0 {
+			t.Errorf("Expected request %d of the burst to pass, got %s", i, d)
+		}
+	}
+	if d, _ := l.reserve("", now); d != 100*time.Millisecond {
+		t.Errorf("Expected to wait 100ms, got %s", d)
+	}
+	if d, _ := l.reserve("", now); d != 200*time.Millisecond {
+		t.Errorf("Expected to wait 200ms behind the waiting request, got %s", d)
+	}
+	if d, _ := l.reserve("", now.Add(time.Second)); d != 0 {
+		t.Errorf("Expected the bucket to be refilled, got %s", d)
+	}
+}
+
+func TestRateLimiter_PerHost(t *testing.T) {
+	l := &RateLimiter{Rate: 1, PerHost: true}
+	now := time.Now()
+
+	if d, _ := l.reserve("a.atlassian.net", now); d != 0 {
+		t.Errorf("Expected the first request to pass, got %s", d)
+	}
+	if d, _ := l.reserve("b.atlassian.net", now); d != 0 {
+		t.Errorf("Expected the request to another host to pass, got %s", d)
+	}
+	if d, _ := l.reserve("a.atlassian.net", now); d != time.Second {
+		t.Errorf("Expected to wait 1s, got %s", d)
+	}
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	var nilLimiter *RateLimiter
+	if err := nilLimiter.Wait(context.Background(), ""); err != nil {
+		t.Errorf("Expected a nil limiter to pass, got %v", err)
+	}
+
+	l := &RateLimiter{Rate: 0.1}
+	_ = l.Wait(context.Background(), "")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.Wait(ctx, ""); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline to be exceeded, got %v", err)
+	}
+	if d, _ := l.reserve("", time.Now()); d > 10*time.Second {
+		t.Errorf("Expected the token of the canceled request to be returned, got %s", d)
+	}
+}
+
+func TestClient_Do_RateLimiter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {})
+	testClient.RateLimiter = &RateLimiter{Rate: 50, Burst: 1}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+		if _, err := testClient.Do(req, nil); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 35*time.Millisecond {
+		t.Errorf("Expected the requests to be limited to 50 per second, took %s", elapsed)
+	}
+}
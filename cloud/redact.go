@@ -0,0 +1,196 @@
+package cloud
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces secrets in URLs, headers and messages.
+const redacted = "REDACTED"
+
+// defaultRedactedHeaders are the headers that are always redacted.
+var defaultRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Forge-Oauth-System",
+	"X-Forge-Oauth-User",
+}
+
+// defaultRedactedQueryParameters are the query parameters that are always redacted.
+var defaultRedactedQueryParameters = []string{
+	"jwt",
+	"token",
+	"access_token",
+	"refresh_token",
+	"api_key",
+	"apikey",
+	"password",
+	"secret",
+	"client_secret",
+	"os_password",
+}
+
+// Redactor masks credentials in URLs, headers, request dumps and error messages.
+//
+// Authorization and cookie headers, the password of URLs and common query
+// parameters holding credentials are always masked, even by a nil *Redactor.
+// Additional secrets, e.g. webhook secrets or tokens passed as query parameters, can be configured.
+type Redactor struct {
+	// Secrets are values that are masked wherever they appear.
+	Secrets []string
+	// Headers are the names of additional headers whose values are masked.
+	Headers []string
+	// QueryParameters are the names of additional query parameters whose values are masked.
+	QueryParameters []string
+}
+
+// String masks the configured secrets in s.
+func (r *Redactor) String(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, secret := range r.Secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, redacted)
+		}
+	}
+	return s
+}
+
+// URL returns u as string with the password and credential query parameters masked.
+func (r *Redactor) URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	u2 := *u
+	if _, ok := u2.User.Password(); ok {
+		u2.User = url.UserPassword(u2.User.Username(), redacted)
+	}
+	if u2.RawQuery != "" {
+		query := u2.Query()
+		changed := false
+		for k := range query {
+			if r.isRedactedQueryParameter(k) {
+				query[k] = []string{redacted}
+				changed = true
+			}
+		}
+		if changed {
+			u2.RawQuery = query.Encode()
+		}
+	}
+	return r.String(u2.String())
+}
+
+// Header returns a copy of h with the values of credential headers masked.
+func (r *Redactor) Header(h http.Header) http.Header {
+	h2 := h.Clone()
+	for k, v := range h2 {
+		if r.isRedactedHeader(k) {
+			for i := range v {
+				v[i] = redacted
+			}
+			continue
+		}
+		for i := range v {
+			v[i] = r.String(v[i])
+		}
+	}
+	return h2
+}
+
+// DumpRequest returns the wire representation of req with credentials masked, like httputil.DumpRequestOut.
+// If body is true, the body is included and req.Body is replaced, so it can still be sent.
+func (r *Redactor) DumpRequest(req *http.Request, body bool) ([]byte, error) {
+	req2 := req.Clone(req.Context())
+	req2.Header = r.Header(req.Header)
+	req2.Body = nil
+	if body && req.Body != nil && req.Body != http.NoBody {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		req2.Body = io.NopCloser(bytes.NewReader(data))
+	}
+
+	dump, err := httputil.DumpRequest(req2, body)
+	if err != nil {
+		return nil, err
+	}
+	dump = bytes.Replace(dump, []byte(req.URL.RequestURI()), []byte(r.requestURI(req.URL)), 1)
+	return []byte(r.String(string(dump))), nil
+}
+
+// DumpResponse returns the wire representation of resp with credentials masked, like httputil.DumpResponse.
+func (r *Redactor) DumpResponse(resp *http.Response, body bool) ([]byte, error) {
+	resp2 := *resp
+	resp2.Header = r.Header(resp.Header)
+	dump, err := httputil.DumpResponse(&resp2, body)
+	resp.Body = resp2.Body
+	if err != nil {
+		return nil, err
+	}
+	return []byte(r.String(string(dump))), nil
+}
+
+// requestURI returns the request URI of u with credential query parameters masked.
+func (r *Redactor) requestURI(u *url.URL) string {
+	u2 := url.URL{Path: u.Path, RawPath: u.RawPath, RawQuery: u.RawQuery}
+	redactedURL, err := url.Parse(r.URL(&u2))
+	if err != nil {
+		return u.RequestURI()
+	}
+	return redactedURL.RequestURI()
+}
+
+// redactError masks the URL of transport errors.
+func (r *Redactor) redactError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		u, parseErr := url.Parse(urlErr.URL)
+		if parseErr != nil {
+			return err
+		}
+		return &url.Error{Op: urlErr.Op, URL: r.URL(u), Err: urlErr.Err}
+	}
+	return err
+}
+
+func (r *Redactor) isRedactedHeader(name string) bool {
+	for _, h := range defaultRedactedHeaders {
+		if strings.EqualFold(h, name) {
+			return true
+		}
+	}
+	if r != nil {
+		for _, h := range r.Headers {
+			if strings.EqualFold(h, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Redactor) isRedactedQueryParameter(name string) bool {
+	for _, p := range defaultRedactedQueryParameters {
+		if strings.EqualFold(p, name) {
+			return true
+		}
+	}
+	if r != nil {
+		for _, p := range r.QueryParameters {
+			if strings.EqualFold(p, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RemoteLinkService handles remote link information of the development panel for the Jira instance / API.
+// These are links to generic remote data like dashboards or documents, submitted by a provider app.
+// Use IssueService for the remote links of an issue.
+// The remote link provider endpoints can only be used by Connect or OAuth 2.0 apps with the remote link module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-remote-links/
+type RemoteLinkService service
+
+const (
+	ProviderRemoteLinkTypeDocument  = "document"
+	ProviderRemoteLinkTypeAlert     = "alert"
+	ProviderRemoteLinkTypeTest      = "test"
+	ProviderRemoteLinkTypeSecurity  = "security"
+	ProviderRemoteLinkTypeLogFile   = "logFile"
+	ProviderRemoteLinkTypePrototype = "prototype"
+	ProviderRemoteLinkTypeCoverage  = "coverage"
+	ProviderRemoteLinkTypeBugReport = "bugReport"
+	ProviderRemoteLinkTypeOther     = "other"
+)
+
+// ProviderRemoteLink represents remote link data submitted by a provider app.
+// Type is one of the ProviderRemoteLinkType* constants.
+type ProviderRemoteLink struct {
+	SchemaVersion        string                    `json:"schemaVersion,omitempty" structs:"schemaVersion,omitempty"`
+	ID                   string                    `json:"id" structs:"id"`
+	UpdateSequenceNumber int64                     `json:"updateSequenceNumber" structs:"updateSequenceNumber"`
+	DisplayName          string                    `json:"displayName" structs:"displayName"`
+	URL                  string                    `json:"url" structs:"url"`
+	Type                 string                    `json:"type" structs:"type"`
+	Description          string                    `json:"description,omitempty" structs:"description,omitempty"`
+	LastUpdated          *time.Time                `json:"lastUpdated" structs:"lastUpdated"`
+	Associations         []DevInfoAssociation      `json:"associations,omitempty" structs:"associations,omitempty"`
+	Status               *ProviderRemoteLinkStatus `json:"status,omitempty" structs:"status,omitempty"`
+	ActionIDs            []string                  `json:"actionIds,omitempty" structs:"actionIds,omitempty"`
+	AttributeMap         map[string]string         `json:"attributeMap,omitempty" structs:"attributeMap,omitempty"`
+}
+
+// ProviderRemoteLinkStatus is the status of a remote link, shown as a lozenge.
+// Appearance can take the values default, inprogress, moved, new, removed, prototype and success.
+type ProviderRemoteLinkStatus struct {
+	Appearance string `json:"appearance" structs:"appearance"`
+	Label      string `json:"label" structs:"label"`
+}
+
+// RemoteLinksSubmission is the payload of RemoteLinkService.Submit.
+// Properties can be used to delete remote links with RemoteLinkService.DeleteByProperties later on.
+type RemoteLinksSubmission struct {
+	Properties       map[string]string        `json:"properties,omitempty" structs:"properties,omitempty"`
+	RemoteLinks      []ProviderRemoteLink     `json:"remoteLinks" structs:"remoteLinks"`
+	ProviderMetadata *DevInfoProviderMetadata `json:"providerMetadata,omitempty" structs:"providerMetadata,omitempty"`
+}
+
+// RemoteLinksSubmissionResult is the result of RemoteLinkService.Submit.
+// RejectedRemoteLinks maps the IDs of rejected remote links to the reasons.
+type RemoteLinksSubmissionResult struct {
+	AcceptedRemoteLinks []string                  `json:"acceptedRemoteLinks,omitempty" structs:"acceptedRemoteLinks,omitempty"`
+	RejectedRemoteLinks map[string][]DevInfoError `json:"rejectedRemoteLinks,omitempty" structs:"rejectedRemoteLinks,omitempty"`
+	UnknownAssociations []DevInfoAssociation      `json:"unknownAssociations,omitempty" structs:"unknownAssociations,omitempty"`
+}
+
+// Submit updates or inserts remote link data.
+// Remote links are identified by their ID,
+// and are only updated if the UpdateSequenceNumber is higher than the stored one.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-remote-links/#api-rest-remotelinks-1-0-bulk-post
+func (s *RemoteLinkService) Submit(ctx context.Context, remoteLinks *RemoteLinksSubmission) (*RemoteLinksSubmissionResult, *Response, error) {
+	apiEndpoint := "rest/remotelinks/1.0/bulk"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, remoteLinks)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RemoteLinksSubmissionResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// Get returns the remote link data with the given ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-remote-links/#api-rest-remotelinks-1-0-remotelink-remotelinkid-get
+func (s *RemoteLinkService) Get(ctx context.Context, remoteLinkID string) (*ProviderRemoteLink, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/remotelinks/1.0/remotelink/%s", url.PathEscape(remoteLinkID))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remoteLink := new(ProviderRemoteLink)
+	resp, err := s.client.Do(req, remoteLink)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return remoteLink, resp, nil
+}
+
+// Delete deletes the remote link data with the given ID.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-remote-links/#api-rest-remotelinks-1-0-remotelink-remotelinkid-delete
+// Caller must close resp.Body
+func (s *RemoteLinkService) Delete(ctx context.Context, remoteLinkID string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/remotelinks/1.0/remotelink/%s", url.PathEscape(remoteLinkID))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteByProperties deletes all remote link data that was submitted with all of the given properties.
+// Deletion is performed asynchronously.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-remote-links/#api-rest-remotelinks-1-0-bulkbyproperties-delete
+// Caller must close resp.Body
+func (s *RemoteLinkService) DeleteByProperties(ctx context.Context, properties map[string]string) (*Response, error) {
+	return deleteDevInfoByProperties(ctx, s.client, "rest/remotelinks/1.0/bulkByProperties", properties)
+}
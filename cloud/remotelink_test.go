@@ -0,0 +1,115 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRemoteLinkService_Submit(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/remotelinks/1.0/bulk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload RemoteLinksSubmission
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.RemoteLinks) != 1 || payload.RemoteLinks[0].Type != ProviderRemoteLinkTypeDocument {
+			t.Fatalf("Unexpected remote links: %+v", payload.RemoteLinks)
+		}
+		if associations := payload.RemoteLinks[0].Associations; len(associations) != 1 || associations[0].AssociationType != DevInfoAssociationTypeIssueIDOrKeys {
+			t.Errorf("Unexpected associations: %+v", associations)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"acceptedRemoteLinks":["111-222-333"],"rejectedRemoteLinks":{"444-555-666":[{"message":"Invalid url"}]}}`)
+	})
+
+	result, _, err := testClient.RemoteLink.Submit(context.Background(), &RemoteLinksSubmission{
+		RemoteLinks: []ProviderRemoteLink{{
+			ID:                   "111-222-333",
+			UpdateSequenceNumber: 1,
+			DisplayName:          "Runbook",
+			URL:                  "https://docs.example.com/runbook",
+			Type:                 ProviderRemoteLinkTypeDocument,
+			Associations:         []DevInfoAssociation{{AssociationType: DevInfoAssociationTypeIssueIDOrKeys, Values: []string{"ISSUE-123"}}},
+		}},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil {
+		t.Fatal("Expected result. Result is nil")
+	}
+	if len(result.AcceptedRemoteLinks) != 1 {
+		t.Errorf("Unexpected accepted remote links: %+v", result.AcceptedRemoteLinks)
+	}
+	if errs := result.RejectedRemoteLinks["444-555-666"]; len(errs) != 1 || errs[0].Message != "Invalid url" {
+		t.Errorf("Unexpected rejected remote links: %+v", result.RejectedRemoteLinks)
+	}
+}
+
+func TestRemoteLinkService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/remotelinks/1.0/remotelink/111-222-333"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"schemaVersion":"1.0","id":"111-222-333","updateSequenceNumber":1523494301448,"displayName":"Service dashboard","url":"https://example.com/dashboard","type":"alert","lastUpdated":"2018-01-20T23:27:25.000Z","associations":[{"associationType":"serviceIdOrKeys","values":["svc-1"]}],"status":{"appearance":"removed","label":"ERROR"},"attributeMap":{"priority":"high"}}`)
+	})
+
+	remoteLink, _, err := testClient.RemoteLink.Get(context.Background(), "111-222-333")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if remoteLink == nil {
+		t.Fatal("Expected remote link. Remote link is nil")
+	}
+	if remoteLink.Type != ProviderRemoteLinkTypeAlert || remoteLink.Status.Label != "ERROR" || remoteLink.AttributeMap["priority"] != "high" {
+		t.Errorf("Unexpected remote link: %+v", remoteLink)
+	}
+}
+
+func TestRemoteLinkService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/remotelinks/1.0/remotelink/111-222-333"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.RemoteLink.Delete(context.Background(), "111-222-333")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRemoteLinkService_DeleteByProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/remotelinks/1.0/bulkByProperties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"accountId": "account-234"})
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	_, err := testClient.RemoteLink.DeleteByProperties(context.Background(), map[string]string{"accountId": "account-234"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // RequestService handles ServiceDesk customer requests for the Jira instance / API.
@@ -15,6 +16,7 @@ type Request struct {
 	IssueKey      string              `json:"issueKey,omitempty" structs:"issueKey,omitempty"`
 	TypeID        string              `json:"requestTypeId,omitempty" structs:"requestTypeId,omitempty"`
 	ServiceDeskID string              `json:"serviceDeskId,omitempty" structs:"serviceDeskId,omitempty"`
+	CreatedDate   *RequestDate        `json:"createdDate,omitempty" structs:"createdDate,omitempty"`
 	Reporter      *Customer           `json:"reporter,omitempty" structs:"reporter,omitempty"`
 	FieldValues   []RequestFieldValue `json:"requestFieldValues,omitempty" structs:"requestFieldValues,omitempty"`
 	Status        *RequestStatus      `json:"currentStatus,omitempty" structs:"currentStatus,omitempty"`
@@ -23,10 +25,13 @@ type Request struct {
 }
 
 // RequestFieldValue is a request field.
+// Value is a string for text fields, other field types use their JSON representation,
+// e.g. []map[string]string{{"name": "Hardware"}} for components.
 type RequestFieldValue struct {
-	FieldID string `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
-	Label   string `json:"label,omitempty" structs:"label,omitempty"`
-	Value   string `json:"value,omitempty" structs:"value,omitempty"`
+	FieldID       string      `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	Label         string      `json:"label,omitempty" structs:"label,omitempty"`
+	Value         interface{} `json:"value,omitempty" structs:"value,omitempty"`
+	RenderedValue interface{} `json:"renderedValue,omitempty" structs:"renderedValue,omitempty"`
 }
 
 // RequestDate is the date format used in requests.
@@ -34,14 +39,14 @@ type RequestDate struct {
 	ISO8601  string `json:"iso8601,omitempty" structs:"iso8601,omitempty"`
 	Jira     string `json:"jira,omitempty" structs:"jira,omitempty"`
 	Friendly string `json:"friendly,omitempty" structs:"friendly,omitempty"`
-	Epoch    int64  `json:"epoch,omitempty" structs:"epoch,omitempty"`
+	Epoch    int64  `json:"epochMillis,omitempty" structs:"epochMillis,omitempty"`
 }
 
 // RequestStatus is the status for a request.
 type RequestStatus struct {
-	Status   string
-	Category string
-	Date     RequestDate
+	Status   string      `json:"status,omitempty" structs:"status,omitempty"`
+	Category string      `json:"statusCategory,omitempty" structs:"statusCategory,omitempty"`
+	Date     RequestDate `json:"statusDate,omitempty" structs:"statusDate,omitempty"`
 }
 
 // RequestComment is a comment for a request.
@@ -56,22 +61,21 @@ type RequestComment struct {
 }
 
 // Create creates a new request.
+// The request is raised on behalf of requester if it is not empty, otherwise the request is raised by the calling user.
+// The FieldValues of request are sent as requestFieldValues, keyed by their FieldID.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-post
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (r *RequestService) Create(ctx context.Context, requester string, participants []string, request *Request) (*Request, *Response, error) {
 	apiEndpoint := "rest/servicedeskapi/request"
 
 	payload := struct {
 		*Request
-		FieldValues  map[string]string `json:"requestFieldValues,omitempty"`
-		Requester    string            `json:"raiseOnBehalfOf,omitempty"`
-		Participants []string          `json:"requestParticipants,omitempty"`
+		FieldValues  map[string]interface{} `json:"requestFieldValues,omitempty"`
+		Requester    string                 `json:"raiseOnBehalfOf,omitempty"`
+		Participants []string               `json:"requestParticipants,omitempty"`
 	}{
 		Request:      request,
-		FieldValues:  make(map[string]string),
+		FieldValues:  make(map[string]interface{}),
 		Requester:    requester,
 		Participants: participants,
 	}
@@ -94,12 +98,81 @@ func (r *RequestService) Create(ctx context.Context, requester string, participa
 	return responseRequest, resp, nil
 }
 
+// RequestListOptions is the query options for listing requests.
+// RequestOwnership can take the values OWNED_REQUESTS, PARTICIPATED_REQUESTS, ORGANIZATION, ALL_ORGANIZATIONS, APPROVER and ALL_REQUESTS.
+// RequestStatus can take the values CLOSED_REQUESTS, OPEN_REQUESTS and ALL_REQUESTS.
+// ApprovalStatus can take the values MY_PENDING_APPROVAL and MY_HISTORY_APPROVAL.
+type RequestListOptions struct {
+	SearchTerm       string   `url:"searchTerm,omitempty"`
+	RequestOwnership string   `url:"requestOwnership,omitempty"`
+	RequestStatus    string   `url:"requestStatus,omitempty"`
+	ApprovalStatus   string   `url:"approvalStatus,omitempty"`
+	OrganizationID   int      `url:"organizationId,omitempty"`
+	ServiceDeskID    int      `url:"serviceDeskId,omitempty"`
+	RequestTypeID    int      `url:"requestTypeId,omitempty"`
+	Expand           []string `url:"expand,comma,omitempty"`
+	Start            int      `url:"start,omitempty"`
+	Limit            int      `url:"limit,omitempty"`
+}
+
+// RequestList is a page of requests.
+type RequestList = ServiceDeskPage[Request]
+
+// RequestGetOptions is the query options for getting a request.
+// Expand can contain participant, status, sla, requestType, serviceDesk, attachment, action, comment and comment.attachment.
+type RequestGetOptions struct {
+	Expand []string `url:"expand,comma,omitempty"`
+}
+
+// GetList returns a page of customer requests.
+// By default, the requests the user participates in or owns are returned.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-get
+func (r *RequestService) GetList(ctx context.Context, options *RequestListOptions) (*RequestList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/request", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requests := new(RequestList)
+	resp, err := r.client.Do(req, requests)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return requests, resp, nil
+}
+
+// Get returns the customer request for the given issue ID or key.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-get
+func (r *RequestService) Get(ctx context.Context, issueIDOrKey string, options *RequestGetOptions) (*Request, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request := new(Request)
+	resp, err := r.client.Do(req, request)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return request, resp, nil
+}
+
 // CreateComment creates a comment on a request.
+// Set comment.Public to false to add an internal comment that is only visible to agents.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-comment-post
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (r *RequestService) CreateComment(ctx context.Context, issueIDOrKey string, comment *RequestComment) (*RequestComment, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/comment", issueIDOrKey)
 
@@ -116,3 +189,575 @@ func (r *RequestService) CreateComment(ctx context.Context, issueIDOrKey string,
 
 	return responseComment, resp, nil
 }
+
+// RequestCommentListOptions is the query options for listing the comments of a request.
+// Public and Internal filter public and internal comments, both are returned by default.
+// Expand can contain attachment and renderedBody.
+type RequestCommentListOptions struct {
+	Public   *bool    `url:"public,omitempty"`
+	Internal *bool    `url:"internal,omitempty"`
+	Expand   []string `url:"expand,comma,omitempty"`
+	Start    int      `url:"start,omitempty"`
+	Limit    int      `url:"limit,omitempty"`
+}
+
+// RequestCommentList is a page of request comments.
+type RequestCommentList = ServiceDeskPage[RequestComment]
+
+// GetComments returns a page of the comments on a request.
+// Internal comments are only returned to agents.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-comment-get
+func (r *RequestService) GetComments(ctx context.Context, issueIDOrKey string, options *RequestCommentListOptions) (*RequestCommentList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/comment", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(RequestCommentList)
+	resp, err := r.client.Do(req, comments)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return comments, resp, nil
+}
+
+// GetComment returns a comment on a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-comment-commentid-get
+func (r *RequestService) GetComment(ctx context.Context, issueIDOrKey string, commentID interface{}, options *RequestGetOptions) (*RequestComment, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/comment/%v", issueIDOrKey, commentID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment := new(RequestComment)
+	resp, err := r.client.Do(req, comment)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return comment, resp, nil
+}
+
+// requestParticipantsPayload is the payload to add or remove request participants
+type requestParticipantsPayload struct {
+	AccountIDs []string `json:"accountIds"`
+}
+
+// GetParticipants returns a page of the participants of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-participant-get
+func (r *RequestService) GetParticipants(ctx context.Context, issueIDOrKey string, options *CustomerListOptions) (*CustomerList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participants := new(CustomerList)
+	resp, err := r.client.Do(req, participants)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return participants, resp, nil
+}
+
+// AddParticipants adds participants to a request and returns the first page of participants.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-participant-post
+func (r *RequestService) AddParticipants(ctx context.Context, issueIDOrKey string, accountIDs ...string) (*CustomerList, *Response, error) {
+	return r.changeParticipants(ctx, http.MethodPost, issueIDOrKey, accountIDs)
+}
+
+// RemoveParticipants removes participants from a request and returns the first page of the remaining participants.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-participant-delete
+func (r *RequestService) RemoveParticipants(ctx context.Context, issueIDOrKey string, accountIDs ...string) (*CustomerList, *Response, error) {
+	return r.changeParticipants(ctx, http.MethodDelete, issueIDOrKey, accountIDs)
+}
+
+func (r *RequestService) changeParticipants(ctx context.Context, method, issueIDOrKey string, accountIDs []string) (*CustomerList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/participant", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, method, apiEndpoint, &requestParticipantsPayload{AccountIDs: accountIDs})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	participants := new(CustomerList)
+	resp, err := r.client.Do(req, participants)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return participants, resp, nil
+}
+
+// RequestSLA is the SLA information of a request for a single SLA metric.
+type RequestSLA struct {
+	ID              string                  `json:"id,omitempty" structs:"id,omitempty"`
+	Name            string                  `json:"name,omitempty" structs:"name,omitempty"`
+	OngoingCycle    *RequestSLAOngoingCycle `json:"ongoingCycle,omitempty" structs:"ongoingCycle,omitempty"`
+	CompletedCycles []RequestSLACycle       `json:"completedCycles,omitempty" structs:"completedCycles,omitempty"`
+	Links           *SelfLink               `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestSLAOngoingCycle is the currently running cycle of an SLA metric.
+type RequestSLAOngoingCycle struct {
+	StartTime           *RequestDate     `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	BreachTime          *RequestDate     `json:"breachTime,omitempty" structs:"breachTime,omitempty"`
+	Breached            bool             `json:"breached" structs:"breached"`
+	Paused              bool             `json:"paused" structs:"paused"`
+	WithinCalendarHours bool             `json:"withinCalendarHours" structs:"withinCalendarHours"`
+	GoalDuration        *RequestDuration `json:"goalDuration,omitempty" structs:"goalDuration,omitempty"`
+	ElapsedTime         *RequestDuration `json:"elapsedTime,omitempty" structs:"elapsedTime,omitempty"`
+	RemainingTime       *RequestDuration `json:"remainingTime,omitempty" structs:"remainingTime,omitempty"`
+}
+
+// RequestSLACycle is a completed cycle of an SLA metric.
+type RequestSLACycle struct {
+	StartTime     *RequestDate     `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	StopTime      *RequestDate     `json:"stopTime,omitempty" structs:"stopTime,omitempty"`
+	BreachTime    *RequestDate     `json:"breachTime,omitempty" structs:"breachTime,omitempty"`
+	Breached      bool             `json:"breached" structs:"breached"`
+	GoalDuration  *RequestDuration `json:"goalDuration,omitempty" structs:"goalDuration,omitempty"`
+	ElapsedTime   *RequestDuration `json:"elapsedTime,omitempty" structs:"elapsedTime,omitempty"`
+	RemainingTime *RequestDuration `json:"remainingTime,omitempty" structs:"remainingTime,omitempty"`
+}
+
+// RequestDuration is the duration format used in requests.
+// Millis is negative for the remaining time of breached SLAs.
+type RequestDuration struct {
+	Millis   int64  `json:"millis" structs:"millis"`
+	Friendly string `json:"friendly,omitempty" structs:"friendly,omitempty"`
+}
+
+// Duration returns the duration as time.Duration.
+func (d RequestDuration) Duration() time.Duration {
+	return time.Duration(d.Millis) * time.Millisecond
+}
+
+// RequestSLAList is a page of SLA information.
+type RequestSLAList = ServiceDeskPage[RequestSLA]
+
+// GetSLAs returns a page of the SLA information of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-sla-get
+func (r *RequestService) GetSLAs(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestSLAList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/sla", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	slas := new(RequestSLAList)
+	resp, err := r.client.Do(req, slas)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return slas, resp, nil
+}
+
+// GetSLA returns the SLA information of a request for the given SLA metric.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-sla-slametricid-get
+func (r *RequestService) GetSLA(ctx context.Context, issueIDOrKey string, slaMetricID interface{}) (*RequestSLA, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/sla/%v", issueIDOrKey, slaMetricID)
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sla := new(RequestSLA)
+	resp, err := r.client.Do(req, sla)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sla, resp, nil
+}
+
+const (
+	ApprovalDecisionApprove = "approve"
+	ApprovalDecisionDecline = "decline"
+)
+
+// RequestApproval is an approval of a request.
+// FinalDecision can take the values approved, declined and pending.
+type RequestApproval struct {
+	ID                string            `json:"id,omitempty" structs:"id,omitempty"`
+	Name              string            `json:"name,omitempty" structs:"name,omitempty"`
+	FinalDecision     string            `json:"finalDecision,omitempty" structs:"finalDecision,omitempty"`
+	CanAnswerApproval bool              `json:"canAnswerApproval" structs:"canAnswerApproval"`
+	Approvers         []RequestApprover `json:"approvers,omitempty" structs:"approvers,omitempty"`
+	CreatedDate       *RequestDate      `json:"createdDate,omitempty" structs:"createdDate,omitempty"`
+	CompletedDate     *RequestDate      `json:"completedDate,omitempty" structs:"completedDate,omitempty"`
+	Links             *SelfLink         `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestApprover is an approver of an approval and their decision.
+// ApproverDecision can take the values approved, declined and pending.
+type RequestApprover struct {
+	Approver         *Customer `json:"approver,omitempty" structs:"approver,omitempty"`
+	ApproverDecision string    `json:"approverDecision,omitempty" structs:"approverDecision,omitempty"`
+}
+
+// RequestApprovalList is a page of approvals.
+type RequestApprovalList = ServiceDeskPage[RequestApproval]
+
+// GetApprovals returns a page of the approvals of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-approval-get
+func (r *RequestService) GetApprovals(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestApprovalList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/approval", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approvals := new(RequestApprovalList)
+	resp, err := r.client.Do(req, approvals)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return approvals, resp, nil
+}
+
+// GetApproval returns an approval of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-approval-approvalid-get
+func (r *RequestService) GetApproval(ctx context.Context, issueIDOrKey string, approvalID interface{}) (*RequestApproval, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/approval/%v", issueIDOrKey, approvalID)
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(RequestApproval)
+	resp, err := r.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return approval, resp, nil
+}
+
+// AnswerApproval approves or declines an approval of a request on behalf of the user.
+// decision is either ApprovalDecisionApprove or ApprovalDecisionDecline.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-approval-approvalid-post
+func (r *RequestService) AnswerApproval(ctx context.Context, issueIDOrKey string, approvalID interface{}, decision string) (*RequestApproval, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/approval/%v", issueIDOrKey, approvalID)
+	payload := struct {
+		Decision string `json:"decision"`
+	}{Decision: decision}
+	req, err := r.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	approval := new(RequestApproval)
+	resp, err := r.client.Do(req, approval)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return approval, resp, nil
+}
+
+// RequestAttachmentCreate is the payload for attaching temporary files to a request.
+// The files have to be uploaded with ServiceDeskService.AttachTemporaryFile first.
+type RequestAttachmentCreate struct {
+	TemporaryAttachmentIDs []string                  `json:"temporaryAttachmentIds" structs:"temporaryAttachmentIds"`
+	Public                 bool                      `json:"public" structs:"public"`
+	AdditionalComment      *RequestAdditionalComment `json:"additionalComment,omitempty" structs:"additionalComment,omitempty"`
+}
+
+// RequestAdditionalComment is a comment added to a request as part of another action.
+type RequestAdditionalComment struct {
+	Body string `json:"body,omitempty" structs:"body,omitempty"`
+}
+
+// RequestAttachment is an attachment of a request.
+type RequestAttachment struct {
+	Filename string                  `json:"filename,omitempty" structs:"filename,omitempty"`
+	Author   *Customer               `json:"author,omitempty" structs:"author,omitempty"`
+	Created  *RequestDate            `json:"created,omitempty" structs:"created,omitempty"`
+	Size     int64                   `json:"size,omitempty" structs:"size,omitempty"`
+	MimeType string                  `json:"mimeType,omitempty" structs:"mimeType,omitempty"`
+	Links    *RequestAttachmentLinks `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestAttachmentLinks are the links of a request attachment.
+type RequestAttachmentLinks struct {
+	Self      string `json:"self,omitempty" structs:"self,omitempty"`
+	JiraRest  string `json:"jiraRest,omitempty" structs:"jiraRest,omitempty"`
+	Content   string `json:"content,omitempty" structs:"content,omitempty"`
+	Thumbnail string `json:"thumbnail,omitempty" structs:"thumbnail,omitempty"`
+}
+
+// RequestAttachmentList is a page of request attachments.
+type RequestAttachmentList = ServiceDeskPage[RequestAttachment]
+
+// RequestAttachmentResult is the result of attaching files to a request.
+// Comment is the comment created for the attachments.
+type RequestAttachmentResult struct {
+	Comment     *RequestComment        `json:"comment,omitempty" structs:"comment,omitempty"`
+	Attachments *RequestAttachmentList `json:"attachments,omitempty" structs:"attachments,omitempty"`
+}
+
+// CreateAttachment attaches temporary files to a request.
+// If attachment.Public is false, the attachments are only visible to agents.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-attachment-post
+func (r *RequestService) CreateAttachment(ctx context.Context, issueIDOrKey string, attachment *RequestAttachmentCreate) (*RequestAttachmentResult, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/attachment", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, http.MethodPost, apiEndpoint, attachment)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(RequestAttachmentResult)
+	resp, err := r.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// RequestStatusList is a page of the status history of a request.
+type RequestStatusList = ServiceDeskPage[RequestStatus]
+
+// GetStatuses returns a page of the status history of a request, the most recent status first.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-status-get
+func (r *RequestService) GetStatuses(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*RequestStatusList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/status", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statuses := new(RequestStatusList)
+	resp, err := r.client.Do(req, statuses)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return statuses, resp, nil
+}
+
+// CustomerTransition is a transition of a request that can be performed by the customer.
+type CustomerTransition struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// CustomerTransitionList is a page of customer transitions.
+type CustomerTransitionList = ServiceDeskPage[CustomerTransition]
+
+// GetTransitions returns a page of the transitions of a request the user can perform as a customer.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-transition-get
+func (r *RequestService) GetTransitions(ctx context.Context, issueIDOrKey string, options *ServiceDeskListOptions) (*CustomerTransitionList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/request/%v/transition", issueIDOrKey), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transitions := new(CustomerTransitionList)
+	resp, err := r.client.Do(req, transitions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return transitions, resp, nil
+}
+
+// DoTransition performs a customer transition on a request.
+// If comment is not empty, it is added to the request as a public comment.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-transition-post
+// Caller must close resp.Body
+func (r *RequestService) DoTransition(ctx context.Context, issueIDOrKey string, transitionID interface{}, comment string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/transition", issueIDOrKey)
+	payload := struct {
+		ID                string                    `json:"id"`
+		AdditionalComment *RequestAdditionalComment `json:"additionalComment,omitempty"`
+	}{ID: fmt.Sprint(transitionID)}
+	if comment != "" {
+		payload.AdditionalComment = &RequestAdditionalComment{Body: comment}
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// RequestFeedback is the customer satisfaction feedback of a request.
+// Rating is a value between 1 and 5, Type is always "csat".
+type RequestFeedback struct {
+	Type    string                    `json:"type,omitempty" structs:"type,omitempty"`
+	Rating  int                       `json:"rating,omitempty" structs:"rating,omitempty"`
+	Comment *RequestAdditionalComment `json:"comment,omitempty" structs:"comment,omitempty"`
+}
+
+// GetFeedback returns the feedback of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-get
+func (r *RequestService) GetFeedback(ctx context.Context, issueIDOrKey string) (*RequestFeedback, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// this is an experimental endpoint
+	req.Header.Set(experimentalAPIHeader, "opt-in")
+
+	feedback := new(RequestFeedback)
+	resp, err := r.client.Do(req, feedback)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return feedback, resp, nil
+}
+
+// CreateFeedback adds feedback to a request on behalf of the user.
+// Only the reporter of the request can create feedback.
+// The Type of feedback defaults to "csat" if empty.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-post
+func (r *RequestService) CreateFeedback(ctx context.Context, issueIDOrKey string, feedback *RequestFeedback) (*RequestFeedback, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", issueIDOrKey)
+	payload := *feedback
+	if payload.Type == "" {
+		payload.Type = "csat"
+	}
+	req, err := r.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// this is an experimental endpoint
+	req.Header.Set(experimentalAPIHeader, "opt-in")
+
+	result := new(RequestFeedback)
+	resp, err := r.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// DeleteFeedback removes the feedback of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-requestidorkey-feedback-delete
+// Caller must close resp.Body
+func (r *RequestService) DeleteFeedback(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/feedback", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// this is an experimental endpoint
+	req.Header.Set(experimentalAPIHeader, "opt-in")
+
+	resp, err := r.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetSubscription returns whether the user is subscribed to the notifications of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-notification-get
+func (r *RequestService) GetSubscription(ctx context.Context, issueIDOrKey string) (bool, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	subscription := new(struct {
+		Subscribed bool `json:"subscribed"`
+	})
+	resp, err := r.client.Do(req, subscription)
+	if err != nil {
+		return false, resp, NewJiraError(resp, err)
+	}
+
+	return subscription.Subscribed, resp, nil
+}
+
+// Subscribe subscribes the user to the notifications of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-notification-put
+// Caller must close resp.Body
+func (r *RequestService) Subscribe(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	return r.changeSubscription(ctx, http.MethodPut, issueIDOrKey)
+}
+
+// Unsubscribe unsubscribes the user from the notifications of a request.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-request/#api-rest-servicedeskapi-request-issueidorkey-notification-delete
+// Caller must close resp.Body
+func (r *RequestService) Unsubscribe(ctx context.Context, issueIDOrKey string) (*Response, error) {
+	return r.changeSubscription(ctx, http.MethodDelete, issueIDOrKey)
+}
+
+func (r *RequestService) changeSubscription(ctx context.Context, method, issueIDOrKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/request/%v/notification", issueIDOrKey)
+	req, err := r.client.NewRequest(ctx, method, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,170 @@
+package cloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RequestOption modifies an API request before it is sent, e.g. to add query parameters.
+// It can be passed to the API methods that accept options, like IssueService.Get or IssueService.Search.
+// The options are applied after the option structs of the method, so they take precedence.
+//
+// Options can be passed to any method with ContextWithRequestOptions.
+type RequestOption = func(*http.Request) error
+
+type requestOptionsKey struct{}
+
+// ContextWithRequestOptions returns a copy of ctx with the options, which are applied to all requests
+// created with this context, so they can be passed to the methods that don't accept options:
+//
+//	ctx = ContextWithRequestOptions(ctx, WithRequestHeader("X-Force-Accept-Language", "true"), WithRequestTimeout(5*time.Second))
+//	project, _, err := client.Project.Get(ctx, "EX")
+//
+// They are applied when the request is created, before the options of the method.
+// Options already in ctx are kept and applied first.
+func ContextWithRequestOptions(ctx context.Context, options ...RequestOption) context.Context {
+	options = append(requestOptionsFromContext(ctx), options...)
+	return context.WithValue(ctx, requestOptionsKey{}, options)
+}
+
+// requestOptionsFromContext returns a copy of the options of ContextWithRequestOptions in ctx.
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	options, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return append([]RequestOption(nil), options...)
+}
+
+// WithRequestHeader sets the header of the request, e.g. WithRequestHeader("X-Force-Accept-Language", "true").
+func WithRequestHeader(key, value string) RequestOption {
+	return func(r *http.Request) error {
+		r.Header.Set(key, value)
+		return nil
+	}
+}
+
+// WithQueryParameter sets the query parameter of the request, replacing its values.
+func WithQueryParameter(key, value string) RequestOption {
+	return withQueryParameter(key, value)
+}
+
+type requestTimeoutKey struct{}
+
+// WithRequestTimeout limits the time of the request, including its retries and reading the response,
+// e.g. to a shorter deadline than the timeout of the http.Client on interactive paths.
+// It doesn't extend the deadline of the context of the request.
+func WithRequestTimeout(timeout time.Duration) RequestOption {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), requestTimeoutKey{}, timeout))
+		return nil
+	}
+}
+
+// applyRequestTimeout returns req with the deadline of WithRequestTimeout and the function releasing it,
+// nil if the request has no timeout.
+func applyRequestTimeout(req *http.Request) (*http.Request, context.CancelFunc) {
+	timeout, ok := req.Context().Value(requestTimeoutKey{}).(time.Duration)
+	if !ok || timeout <= 0 {
+		return req, nil
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// cancelOnClose releases the deadline of WithRequestTimeout when the body of the response is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+type retryNonIdempotentKey struct{}
+
+// WithRetryNonIdempotent allows the RetryPolicy of the client to retry the request even though its method
+// is not idempotent, e.g. a POST that is known to be harmless if duplicated.
+func WithRetryNonIdempotent() RequestOption {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), retryNonIdempotentKey{}, true))
+		return nil
+	}
+}
+
+// IdempotencyCheck reports whether a failed request took effect anyway, e.g. whether the issue of a POST
+// that timed out was created. See IssueService.CreateIdempotent for a check of created issues.
+type IdempotencyCheck func(ctx context.Context) (done bool, err error)
+
+type idempotencyCheckKey struct{}
+
+// WithIdempotencyCheck allows the RetryPolicy of the client to retry the request even though its method
+// is not idempotent, but runs check before every retry.
+// The request is not retried and its failure is returned if check reports that it took effect or fails.
+func WithIdempotencyCheck(check IdempotencyCheck) RequestOption {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), idempotencyCheckKey{}, check))
+		return nil
+	}
+}
+
+// idempotencyCheckFromContext returns the check of WithIdempotencyCheck of a request, nil if it has none.
+func idempotencyCheckFromContext(ctx context.Context) IdempotencyCheck {
+	check, _ := ctx.Value(idempotencyCheckKey{}).(IdempotencyCheck)
+	return check
+}
+
+// WithFields requests only the given fields, e.g. WithFields("summary", "status").
+// Prefix a field with "-" to exclude it, use "*all" or "*navigable" to request all or the navigable fields.
+func WithFields(fields ...string) RequestOption {
+	return withQueryParameter("fields", strings.Join(fields, ","))
+}
+
+// WithExpand expands the given sections of the response, e.g. WithExpand("changelog", "renderedFields").
+func WithExpand(expand ...string) RequestOption {
+	return withQueryParameter("expand", strings.Join(expand, ","))
+}
+
+// WithProperties requests the given entity properties, e.g. WithProperties("*all").
+func WithProperties(properties ...string) RequestOption {
+	return withQueryParameter("properties", strings.Join(properties, ","))
+}
+
+// WithValidateQuery sets how strictly the JQL query of a search is validated: strict, warn or none.
+func WithValidateQuery(validateQuery string) RequestOption {
+	return withQueryParameter("validateQuery", validateQuery)
+}
+
+// experimentalAPIHeader opts in to experimental APIs, e.g. of Jira Service Management.
+const experimentalAPIHeader = "X-ExperimentalApi"
+
+// WithExperimentalAPI opts in to an experimental API for the request.
+// Experimental APIs may change without notice.
+func WithExperimentalAPI() RequestOption {
+	return func(r *http.Request) error {
+		r.Header.Set(experimentalAPIHeader, "opt-in")
+		return nil
+	}
+}
+
+// withQueryParameter sets the query parameter key to value.
+func withQueryParameter(key, value string) RequestOption {
+	return func(r *http.Request) error {
+		q := r.URL.Query()
+		q.Set(key, value)
+		r.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// applyRequestOptions applies options to req in order.
+func applyRequestOptions(req *http.Request, options []RequestOption) error {
+	for _, option := range options {
+		if err := option(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRequestOptions(t *testing.T) {
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1?expand=names", nil)
+
+	err := applyRequestOptions(req, []RequestOption{
+		WithFields("summary", "status"),
+		WithExpand("changelog", "renderedFields"),
+		WithProperties("*all"),
+		WithValidateQuery("warn"),
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := "expand=changelog%2CrenderedFields&fields=summary%2Cstatus&properties=%2Aall&validateQuery=warn"
+	if got := req.URL.RawQuery; got != want {
+		t.Errorf("Query = %s, want %s", got, want)
+	}
+}
+
+func TestRequestOptions_Error(t *testing.T) {
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1", nil)
+	wantErr := errors.New("invalid option")
+
+	err := applyRequestOptions(req, []RequestOption{
+		func(*http.Request) error { return wantErr },
+		WithFields("summary"),
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Expected the error of the option, got %v", err)
+	}
+	if req.URL.RawQuery != "" {
+		t.Errorf("Expected the options after the error to be skipped, got %s", req.URL.RawQuery)
+	}
+}
+
+func TestWithExperimentalAPI(t *testing.T) {
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/servicedeskapi/request", nil)
+	if err := applyRequestOptions(req, []RequestOption{WithExperimentalAPI()}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := req.Header.Get("X-ExperimentalApi"); got != "opt-in" {
+		t.Errorf("X-ExperimentalApi = %q, want opt-in", got)
+	}
+}
+
+func TestClient_ExperimentalAPI(t *testing.T) {
+	c, _ := NewClient(testJiraInstanceURL, nil)
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/servicedeskapi/request", nil)
+	if got := req.Header.Get("X-ExperimentalApi"); got != "" {
+		t.Errorf("Expected no opt-in by default, got %q", got)
+	}
+
+	c.ExperimentalAPI = true
+	req, _ = c.NewRequest(context.Background(), http.MethodGet, "rest/servicedeskapi/request", nil)
+	if got := req.Header.Get("X-ExperimentalApi"); got != "opt-in" {
+		t.Errorf("X-ExperimentalApi = %q, want opt-in", got)
+	}
+}
+
+func TestContextWithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"expand": "lead"})
+		if got := r.Header.Get("X-Force-Accept-Language"); got != "true" {
+			t.Errorf("X-Force-Accept-Language = %q, want true", got)
+		}
+		fmt.Fprint(w, `{"key":"EX"}`)
+	})
+
+	ctx := ContextWithRequestOptions(context.Background(), WithRequestHeader("X-Force-Accept-Language", "true"))
+	ctx = ContextWithRequestOptions(ctx, WithQueryParameter("expand", "lead"))
+	if _, _, err := testClient.Project.Get(ctx, "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	ctx := ContextWithRequestOptions(context.Background(), WithRequestTimeout(10*time.Millisecond))
+	req, _ := testClient.NewRequest(ctx, http.MethodGet, "rest/api/2/myself", nil)
+	_, err := testClient.Do(req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the deadline to be exceeded, got %v", err)
+	}
+}
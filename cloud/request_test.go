@@ -3,9 +3,11 @@ package cloud
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestRequestService_Create(t *testing.T) {
@@ -198,3 +200,559 @@ func TestRequestService_CreateComment(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRequestService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{
+			"requestStatus": "OPEN_REQUESTS",
+			"serviceDeskId": "10",
+			"expand":        "participant,status",
+			"limit":         "1",
+		})
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":1,"isLastPage":false,"values":[{"issueId":"107001","issueKey":"HELPDESK-1","requestTypeId":"25","serviceDeskId":"10","requestFieldValues":[{"fieldId":"components","label":"Component","value":[{"name":"Hardware"}]}],"currentStatus":{"status":"Waiting for Support","statusCategory":"NEW","statusDate":{"epochMillis":1444287660000}}}]}`)
+	})
+
+	requests, _, err := testClient.Request.GetList(context.Background(), &RequestListOptions{
+		RequestStatus: "OPEN_REQUESTS",
+		ServiceDeskID: 10,
+		Expand:        []string{"participant", "status"},
+		Limit:         1,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if requests == nil || len(requests.Values) != 1 {
+		t.Fatalf("Unexpected requests: %+v", requests)
+	}
+	request := requests.Values[0]
+	if request.Status == nil || request.Status.Category != "NEW" || request.Status.Date.Epoch != 1444287660000 {
+		t.Errorf("Unexpected status: %+v", request.Status)
+	}
+	if _, ok := request.FieldValues[0].Value.([]interface{}); !ok {
+		t.Errorf("Expected component field value to be a list, got %+v", request.FieldValues[0].Value)
+	}
+}
+
+func TestRequestService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"expand": "sla"})
+		fmt.Fprint(w, `{"issueId":"107001","issueKey":"HELPDESK-1","requestTypeId":"25","serviceDeskId":"10","createdDate":{"iso8601":"2015-10-08T14:42:00+0700","epochMillis":1444290120000},"requestFieldValues":[{"fieldId":"description","label":"Why do you need this?","value":"I need a new *mouse* for my Mac","renderedValue":{"html":"<p>I need a new <b>mouse</b> for my Mac</p>"}}]}`)
+	})
+
+	request, _, err := testClient.Request.Get(context.Background(), "HELPDESK-1", &RequestGetOptions{Expand: []string{"sla"}})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if request == nil || request.IssueKey != "HELPDESK-1" {
+		t.Fatalf("Unexpected request: %+v", request)
+	}
+	if request.CreatedDate == nil || request.CreatedDate.Epoch != 1444290120000 {
+		t.Errorf("Unexpected created date: %+v", request.CreatedDate)
+	}
+	if request.FieldValues[0].RenderedValue == nil {
+		t.Errorf("Expected rendered value to be set")
+	}
+}
+
+func TestRequestService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/comment"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"public": "false", "start": "1", "limit": "1"})
+		fmt.Fprint(w, `{"size":1,"start":1,"limit":1,"isLastPage":false,"values":[{"id":"1000","body":"Internal note","public":false,"created":{"epochMillis":1444360920000}}]}`)
+	})
+
+	comments, _, err := testClient.Request.GetComments(context.Background(), "HELPDESK-1", &RequestCommentListOptions{
+		Public: Bool(false),
+		Start:  1,
+		Limit:  1,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if comments == nil || len(comments.Values) != 1 || comments.IsLast {
+		t.Fatalf("Unexpected comments: %+v", comments)
+	}
+	if comments.Values[0].Public || comments.Values[0].Body != "Internal note" {
+		t.Errorf("Unexpected comment: %+v", comments.Values[0])
+	}
+}
+
+func TestRequestService_GetComment(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/comment/1000"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"1000","body":"Hello there","public":true}`)
+	})
+
+	comment, _, err := testClient.Request.GetComment(context.Background(), "HELPDESK-1", 1000, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if comment == nil || !comment.Public || comment.ID != "1000" {
+		t.Errorf("Unexpected comment: %+v", comment)
+	}
+}
+
+func TestRequestService_GetParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"limit": "10"})
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":10,"isLastPage":true,"values":[{"accountId":"5b10a2844c20165700ede21g","emailAddress":"fred@example.com","displayName":"Fred F. User"}]}`)
+	})
+
+	participants, _, err := testClient.Request.GetParticipants(context.Background(), "HELPDESK-1", &CustomerListOptions{Limit: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 1 || participants.Values[0].DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected participants: %+v", participants)
+	}
+}
+
+func TestRequestService_AddParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload requestParticipantsPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(payload.AccountIDs, []string{"5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5"}) {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[{"accountId":"5b10a2844c20165700ede21g"},{"accountId":"5b10ac8d82e05b22cc7d4ef5"}]}`)
+	})
+
+	participants, _, err := testClient.Request.AddParticipants(context.Background(), "HELPDESK-1", "5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 2 {
+		t.Errorf("Unexpected participants: %+v", participants)
+	}
+}
+
+func TestRequestService_RemoveParticipants(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/participant"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload requestParticipantsPayload
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.AccountIDs) != 1 || payload.AccountIDs[0] != "5b10ac8d82e05b22cc7d4ef5" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"accountId":"5b10a2844c20165700ede21g"}]}`)
+	})
+
+	participants, _, err := testClient.Request.RemoveParticipants(context.Background(), "HELPDESK-1", "5b10ac8d82e05b22cc7d4ef5")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if participants == nil || len(participants.Values) != 1 {
+		t.Errorf("Unexpected participants: %+v", participants)
+	}
+}
+
+func TestRequestService_GetSLAs(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/sla"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"10020","name":"Time to first response","ongoingCycle":{"startTime":{"epochMillis":1444287720000},"breachTime":{"epochMillis":1444291320000},"breached":true,"paused":false,"withinCalendarHours":true,"goalDuration":{"millis":14400000,"friendly":"4h"},"elapsedTime":{"millis":18000000,"friendly":"5h"},"remainingTime":{"millis":-3600000,"friendly":"-1h"}},"completedCycles":[{"startTime":{"epochMillis":1444183320000},"stopTime":{"epochMillis":1444190520000},"breached":false,"goalDuration":{"millis":14400000,"friendly":"4h"},"elapsedTime":{"millis":7200000,"friendly":"2h"},"remainingTime":{"millis":7200000,"friendly":"2h"}}]}]}`)
+	})
+
+	slas, _, err := testClient.Request.GetSLAs(context.Background(), "HELPDESK-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if slas == nil || len(slas.Values) != 1 {
+		t.Fatalf("Unexpected SLAs: %+v", slas)
+	}
+	sla := slas.Values[0]
+	if sla.OngoingCycle == nil || !sla.OngoingCycle.Breached || sla.OngoingCycle.BreachTime == nil {
+		t.Fatalf("Unexpected ongoing cycle: %+v", sla.OngoingCycle)
+	}
+	if remaining := sla.OngoingCycle.RemainingTime.Duration(); remaining != -time.Hour {
+		t.Errorf("Expected remaining time of -1h, got %s", remaining)
+	}
+	if len(sla.CompletedCycles) != 1 || sla.CompletedCycles[0].Breached || sla.CompletedCycles[0].ElapsedTime.Duration() != 2*time.Hour {
+		t.Errorf("Unexpected completed cycles: %+v", sla.CompletedCycles)
+	}
+}
+
+func TestRequestService_GetSLA(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/sla/10020"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10020","name":"Time to first response","ongoingCycle":{"breached":false,"paused":true,"withinCalendarHours":false,"remainingTime":{"millis":60000,"friendly":"1m"}}}`)
+	})
+
+	sla, _, err := testClient.Request.GetSLA(context.Background(), "HELPDESK-1", 10020)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sla == nil || sla.OngoingCycle == nil || !sla.OngoingCycle.Paused || sla.OngoingCycle.RemainingTime.Duration() != time.Minute {
+		t.Errorf("Unexpected SLA: %+v", sla)
+	}
+}
+
+func TestRequestService_GetApprovals(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","name":"Please approve my request","finalDecision":"approved","canAnswerApproval":false,"approvers":[{"approver":{"accountId":"5b10a2844c20165700ede21g","displayName":"Fred F. User"},"approverDecision":"approved"}],"createdDate":{"epochMillis":1444290120000},"completedDate":{"epochMillis":1444300920000}}]}`)
+	})
+
+	approvals, _, err := testClient.Request.GetApprovals(context.Background(), "HELPDESK-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if approvals == nil || len(approvals.Values) != 1 {
+		t.Fatalf("Unexpected approvals: %+v", approvals)
+	}
+	approval := approvals.Values[0]
+	if approval.FinalDecision != "approved" || approval.CompletedDate == nil {
+		t.Errorf("Unexpected approval: %+v", approval)
+	}
+	if len(approval.Approvers) != 1 || approval.Approvers[0].Approver.DisplayName != "Fred F. User" {
+		t.Errorf("Unexpected approvers: %+v", approval.Approvers)
+	}
+}
+
+func TestRequestService_GetApproval(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"1","name":"Please approve my request","finalDecision":"pending","canAnswerApproval":true}`)
+	})
+
+	approval, _, err := testClient.Request.GetApproval(context.Background(), "HELPDESK-1", 1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if approval == nil || approval.FinalDecision != "pending" || !approval.CanAnswerApproval {
+		t.Errorf("Unexpected approval: %+v", approval)
+	}
+}
+
+func TestRequestService_AnswerApproval(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/HELPDESK-1/approval/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["decision"] != ApprovalDecisionDecline {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		fmt.Fprint(w, `{"id":"1","finalDecision":"declined","canAnswerApproval":false}`)
+	})
+
+	approval, _, err := testClient.Request.AnswerApproval(context.Background(), "HELPDESK-1", 1, ApprovalDecisionDecline)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if approval == nil || approval.FinalDecision != "declined" {
+		t.Errorf("Unexpected approval: %+v", approval)
+	}
+}
+
+func TestRequestService_CreateAttachment(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/attachment"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		want := map[string]interface{}{
+			"temporaryAttachmentIds": []interface{}{"temp910441317820424274"},
+			"public":                 false,
+			"additionalComment":      map[string]interface{}{"body": "Please find the screenshot attached."},
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Unexpected payload %v, want %v", payload, want)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"comment":{"id":"1000","body":"Please find the screenshot attached.\n\n[^screenshot.png]","public":false},"attachments":{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"filename":"screenshot.png","size":23123,"mimeType":"image/png","created":{"epochMillis":1444992000000},"_links":{"content":"https://your-domain.atlassian.net/secure/attachment/10000/screenshot.png"}}]}}`)
+	})
+
+	result, _, err := testClient.Request.CreateAttachment(context.Background(), "SD-1", &RequestAttachmentCreate{
+		TemporaryAttachmentIDs: []string{"temp910441317820424274"},
+		AdditionalComment:      &RequestAdditionalComment{Body: "Please find the screenshot attached."},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil || result.Comment == nil || result.Comment.ID != "1000" {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if result.Attachments == nil || len(result.Attachments.Values) != 1 || result.Attachments.Values[0].Filename != "screenshot.png" {
+		t.Errorf("Unexpected attachments: %+v", result.Attachments)
+	}
+}
+
+func TestRequestService_GetStatuses(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/status"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[{"status":"Resolved","statusCategory":"DONE","statusDate":{"epochMillis":1444992000000}},{"status":"Waiting for support","statusCategory":"NEW","statusDate":{"epochMillis":1444906600000}}]}`)
+	})
+
+	statuses, _, err := testClient.Request.GetStatuses(context.Background(), "SD-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if statuses == nil || len(statuses.Values) != 2 {
+		t.Fatalf("Unexpected statuses: %+v", statuses)
+	}
+	if statuses.Values[0].Status != "Resolved" || statuses.Values[0].Category != "DONE" || statuses.Values[0].Date.Epoch != 1444992000000 {
+		t.Errorf("Unexpected status: %+v", statuses.Values[0])
+	}
+}
+
+func TestRequestService_GetTransitions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/transition"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"1","name":"Cancel request"}]}`)
+	})
+
+	transitions, _, err := testClient.Request.GetTransitions(context.Background(), "SD-1", nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if transitions == nil || len(transitions.Values) != 1 || transitions.Values[0].Name != "Cancel request" {
+		t.Errorf("Unexpected transitions: %+v", transitions)
+	}
+}
+
+func TestRequestService_DoTransition(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/transition"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		want := map[string]interface{}{
+			"id":                "1",
+			"additionalComment": map[string]interface{}{"body": "Not needed anymore."},
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Unexpected payload %v, want %v", payload, want)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Request.DoTransition(context.Background(), "SD-1", 1, "Not needed anymore.")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRequestService_GetFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/feedback"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		if got := r.Header.Get("X-ExperimentalApi"); got != "opt-in" {
+			t.Errorf("Expected X-ExperimentalApi header opt-in, got %q", got)
+		}
+		fmt.Fprint(w, `{"type":"csat","rating":4,"comment":{"body":"Quick and helpful"}}`)
+	})
+
+	feedback, _, err := testClient.Request.GetFeedback(context.Background(), "SD-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if feedback == nil || feedback.Rating != 4 || feedback.Comment == nil || feedback.Comment.Body != "Quick and helpful" {
+		t.Errorf("Unexpected feedback: %+v", feedback)
+	}
+}
+
+func TestRequestService_CreateFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/feedback"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Error decoding payload: %s", err)
+		}
+		want := map[string]interface{}{
+			"type":   "csat",
+			"rating": float64(5),
+		}
+		if !reflect.DeepEqual(payload, want) {
+			t.Errorf("Unexpected payload %v, want %v", payload, want)
+		}
+
+		fmt.Fprint(w, `{"type":"csat","rating":5}`)
+	})
+
+	feedback, _, err := testClient.Request.CreateFeedback(context.Background(), "SD-1", &RequestFeedback{Rating: 5})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if feedback == nil || feedback.Rating != 5 {
+		t.Errorf("Unexpected feedback: %+v", feedback)
+	}
+}
+
+func TestRequestService_DeleteFeedback(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/feedback"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Request.DeleteFeedback(context.Background(), "SD-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRequestService_GetSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/notification"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"subscribed":true}`)
+	})
+
+	subscribed, _, err := testClient.Request.GetSubscription(context.Background(), "SD-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if !subscribed {
+		t.Error("Expected subscribed to be true")
+	}
+}
+
+func TestRequestService_Subscribe(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/notification"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Request.Subscribe(context.Background(), "SD-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestRequestService_Unsubscribe(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/request/SD-1/notification"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Request.Unsubscribe(context.Background(), "SD-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -26,7 +26,7 @@ type Resolution struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ResolutionService) GetList(ctx context.Context) ([]Resolution, *Response, error) {
-	apiEndpoint := "rest/api/2/resolution"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "resolution")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
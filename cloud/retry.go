@@ -0,0 +1,314 @@
+package cloud
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// defaultRetryDelay is the delay before the first retry if the response
+// doesn't tell when to retry. It is doubled for every further retry.
+const defaultRetryDelay = time.Second
+
+// defaultRetryableStatusCodes are retried if RetryPolicy.RetryableStatusCodes is empty.
+var defaultRetryableStatusCodes = []int{http.StatusTooManyRequests, http.StatusServiceUnavailable}
+
+// RetryPolicy configures the retry of failed requests.
+// It is applied to all requests sent with Client.Do.
+//
+// By default, requests that were rate limited (429 Too Many Requests)
+// or hit an unavailable Jira (503 Service Unavailable) are retried.
+// Only requests with idempotent methods (GET, HEAD, OPTIONS, PUT and DELETE) are retried,
+// as a retried POST could e.g. create an issue twice if the first attempt reached Jira.
+// Opt in to retries of other methods with RetryNonIdempotent or, for single requests,
+// WithRetryNonIdempotent and WithIdempotencyCheck.
+// The delay is taken from the Retry-After header or, if the rate limit is exhausted,
+// the X-RateLimit-Reset header. Otherwise it grows exponentially from BaseDelay up to MaxDelay.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rate-limiting/
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries of a request,
+	// so a request is sent at most MaxRetries+1 times.
+	MaxRetries int
+	// MaxWait is the longest delay that is waited for before a retry.
+	// If Jira asks to wait longer, the response is returned without retrying.
+	// There is no limit if 0.
+	MaxWait time.Duration
+
+	// BaseDelay is the delay before the first retry if Jira doesn't tell when to retry.
+	// It is doubled for every further retry. It will default to 1s if 0.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponentially growing delay. There is no cap if 0.
+	MaxDelay time.Duration
+	// Jitter enables full jitter: a random delay between 0 and the exponential delay is used,
+	// so concurrent clients don't retry at the same time.
+	// Delays requested by Jira are used as they are.
+	Jitter bool
+
+	// RetryableStatusCodes are the status codes of responses that are retried if Classifier and Retryable are nil.
+	// It will default to 429 and 503 if empty.
+	RetryableStatusCodes []int
+	// Classifier decides whether a request is retried.
+	// If nil, Retryable is used and, if that is nil too, the responses with RetryableStatusCodes are retried
+	// and errors are never retried.
+	Classifier RetryClassifier
+	// Retryable classifies whether a request should be retried.
+	// err is the error of the HTTP client, e.g. a timeout, resp is nil in this case.
+	// It is only used if Classifier is nil.
+	//
+	// Deprecated: Use Classifier with a RetryClassifierFunc, which also gets the request.
+	Retryable func(resp *http.Response, err error) bool
+
+	// RetryNonIdempotent retries requests with non-idempotent methods like POST too.
+	// Only enable it if duplicated requests are harmless, e.g. for searches sent with POST.
+	RetryNonIdempotent bool
+}
+
+// idempotentMethod reports whether requests with method can be sent twice with the effect of sending them once.
+func idempotentMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// allowRetry marks req as safe to retry although it is sent with POST, for read-only endpoints like searches.
+func allowRetry(req *http.Request) {
+	_ = WithRetryNonIdempotent()(req)
+}
+
+// retryAllowed reports whether the method of req or an opt-in of the request allows to retry it.
+func (p *RetryPolicy) retryAllowed(req *http.Request) bool {
+	if req == nil || p.RetryNonIdempotent || idempotentMethod(req.Method) {
+		return true
+	}
+	optIn, _ := req.Context().Value(retryNonIdempotentKey{}).(bool)
+	return optIn || idempotencyCheckFromContext(req.Context()) != nil
+}
+
+// RetryClassifier decides whether a failed request is retried by a RetryPolicy,
+// so the failure modes of an environment can be encoded:
+//
+//	policy := &RetryPolicy{
+//		MaxRetries: 3,
+//		Classifier: RetryClassifierFunc(func(req *http.Request, resp *http.Response, err error) bool {
+//			// A conflicting transition won't succeed when retried.
+//			if resp != nil && resp.StatusCode == http.StatusConflict && strings.HasSuffix(req.URL.Path, "/transitions") {
+//				return false
+//			}
+//			// The load balancer in front of the nodes returns 502 while a node restarts.
+//			return StatusCodeRetryClassifier{429, 502, 503}.Retryable(req, resp, err)
+//		}),
+//	}
+type RetryClassifier interface {
+	// Retryable reports whether req should be retried after it returned resp or err.
+	// err is the error of the HTTP client, e.g. a timeout, resp is nil in this case.
+	Retryable(req *http.Request, resp *http.Response, err error) bool
+}
+
+// RetryClassifierFunc is a function implementing RetryClassifier.
+type RetryClassifierFunc func(req *http.Request, resp *http.Response, err error) bool
+
+// Retryable calls f(req, resp, err).
+func (f RetryClassifierFunc) Retryable(req *http.Request, resp *http.Response, err error) bool {
+	return f(req, resp, err)
+}
+
+// StatusCodeRetryClassifier retries responses with one of the status codes. Errors are not retried.
+type StatusCodeRetryClassifier []int
+
+// Retryable implements RetryClassifier.
+func (codes StatusCodeRetryClassifier) Retryable(_ *http.Request, resp *http.Response, err error) bool {
+	if err != nil || resp == nil {
+		return false
+	}
+	for _, code := range codes {
+		if resp.StatusCode == code {
+			return true
+		}
+	}
+	return false
+}
+
+// retryable reports whether req that returned resp or err should be retried.
+func (p *RetryPolicy) retryable(req *http.Request, resp *http.Response, err error) bool {
+	return p.classifier().Retryable(req, resp, err)
+}
+
+// classifier returns the Classifier, the deprecated Retryable adapted to a RetryClassifier
+// or, if both are nil, a StatusCodeRetryClassifier of the RetryableStatusCodes.
+func (p *RetryPolicy) classifier() RetryClassifier {
+	if p.Classifier != nil {
+		return p.Classifier
+	}
+	if retryable := p.Retryable; retryable != nil {
+		return RetryClassifierFunc(func(_ *http.Request, resp *http.Response, err error) bool {
+			return retryable(resp, err)
+		})
+	}
+	codes := p.RetryableStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+	return StatusCodeRetryClassifier(codes)
+}
+
+// retryDelay returns how long to wait before retrying req that returned resp or err
+// and whether it should be retried at all.
+// attempt is the number of retries done so far.
+func (p *RetryPolicy) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxRetries || !p.retryAllowed(req) || !p.retryable(req, resp, err) {
+		return 0, false
+	}
+
+	var delay time.Duration
+	ok := false
+	if resp != nil {
+		delay, ok = retryAfter(resp.Header, time.Now())
+	}
+	if !ok {
+		delay = p.backoff(attempt)
+	}
+	if p.MaxWait > 0 && delay > p.MaxWait {
+		return 0, false
+	}
+	return delay, true
+}
+
+// backoff returns the exponential delay before the retry after attempt retries.
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	if delay <= 0 {
+		delay = defaultRetryDelay
+	}
+	for i := 0; i < attempt && delay < math.MaxInt64/2; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+	return delay
+}
+
+// retryAfter returns the delay requested by the Retry-After header or,
+// if no requests are remaining, the X-RateLimit-Reset header.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return nonNegative(t.Sub(now)), true
+		}
+	}
+	if h.Get("X-RateLimit-Remaining") == "0" {
+		reset := h.Get("X-RateLimit-Reset")
+		for _, layout := range rateLimitResetLayouts {
+			if t, err := time.Parse(layout, reset); err == nil {
+				return nonNegative(t.Sub(now)), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// rateLimitResetLayouts are the ISO 8601 layouts of the X-RateLimit-Reset header, e.g. "2024-02-22T14:05Z".
+var rateLimitResetLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04Z0700",
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// send sends req and retries it according to c.RetryPolicy.
+// Every attempt waits for c.RateLimiter and passes c.CircuitBreaker.
+// The request is reported to c.MetricsRecorder.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	c.logRequest(req)
+	req, err := c.compressRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, retries, err := c.sendWithRetries(req)
+	if err == nil {
+		err = decompressResponse(resp)
+		if err != nil {
+			resp = nil
+		}
+	}
+	c.logResponse(req, resp, err, time.Since(start))
+	if c.MetricsRecorder != nil {
+		c.MetricsRecorder.RecordRequest(req.Context(), newRequestMetrics(req, resp, err, retries, time.Since(start)))
+	}
+	return resp, err
+}
+
+// sendWithRetries sends req and returns the number of retries.
+func (c *Client) sendWithRetries(req *http.Request) (*http.Response, int, error) {
+	for attempt := 0; ; attempt++ {
+		if err := c.RateLimiter.Wait(req.Context(), req.URL.Host); err != nil {
+			return nil, attempt, err
+		}
+		if err := c.CircuitBreaker.allow(); err != nil {
+			return nil, attempt, err
+		}
+		resp, err := c.client.Do(req)
+		c.CircuitBreaker.record(resp, err)
+
+		delay, retry := c.RetryPolicy.retryDelay(req, resp, err, attempt)
+		// A request with a body can only be retried if the body can be read again.
+		if !retry || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, attempt, err
+		}
+		// The failure is returned as it is if the request took effect anyway or that can't be checked.
+		if check := idempotencyCheckFromContext(req.Context()); check != nil {
+			if done, checkErr := check(req.Context()); done || checkErr != nil {
+				return resp, attempt, err
+			}
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		c.logRetry(req, resp, err, attempt, delay)
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, attempt, err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, attempt, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,278 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header http.Header
+		delay  time.Duration
+		ok     bool
+	}{
+		{"seconds", http.Header{"Retry-After": {"3"}}, 3 * time.Second, true},
+		{"date", http.Header{"Retry-After": {now.Add(2 * time.Second).Format(http.TimeFormat)}}, 2 * time.Second, true},
+		{"rate limit reset", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"2024-01-02T03:05:05Z"}}, time.Minute, true},
+		{"rate limit reset minutes", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"2024-01-02T03:06Z"}}, 115 * time.Second, true},
+		{"rate limit reset offset", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"2024-01-02T04:06+0100"}}, 115 * time.Second, true},
+		{"rate limit reset fraction", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"2024-01-02T03:04:06.500Z"}}, 1500 * time.Millisecond, true},
+		{"rate limit reset invalid", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"soon"}}, 0, false},
+		{"remaining requests", http.Header{"X-Ratelimit-Remaining": {"5"}, "X-Ratelimit-Reset": {"2024-01-02T03:05:05Z"}}, 0, false},
+		{"none", http.Header{}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := retryAfter(tt.header, now)
+			if delay != tt.delay || ok != tt.ok {
+				t.Errorf("Expected (%v, %t), got (%v, %t)", tt.delay, tt.ok, delay, ok)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_retryDelay(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+
+	var nilPolicy *RetryPolicy
+	if _, ok := nilPolicy.retryDelay(nil, resp, nil, 0); ok {
+		t.Error("Expected no retry without policy")
+	}
+
+	p := &RetryPolicy{MaxRetries: 2, MaxWait: 3 * time.Second}
+	if d, ok := p.retryDelay(nil, resp, nil, 1); !ok || d != 2*time.Second {
+		t.Errorf("Expected retry after 2s, got (%v, %t)", d, ok)
+	}
+	if _, ok := p.retryDelay(nil, resp, nil, 2); ok {
+		t.Error("Expected no retry after MaxRetries")
+	}
+	resp.Header.Set("Retry-After", "10")
+	if _, ok := p.retryDelay(nil, resp, nil, 0); ok {
+		t.Error("Expected no retry if Retry-After exceeds MaxWait")
+	}
+	if _, ok := p.retryDelay(nil, &http.Response{StatusCode: http.StatusBadRequest}, nil, 0); ok {
+		t.Error("Expected no retry of a 400")
+	}
+}
+
+func TestClient_Do_RetryRateLimited(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 3}
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "{\"key\":\"TEST-1\"}\n" {
+			t.Errorf("Expected the body in attempt %d, got %q", calls, body)
+		}
+		if calls < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"key":"TEST-1"}`)
+	})
+
+	ctx := ContextWithRequestOptions(context.Background(), WithRetryNonIdempotent())
+	req, _ := testClient.NewRequest(ctx, http.MethodPost, "rest/api/2/issue", map[string]string{"key": "TEST-1"})
+	resp, err := testClient.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK || calls != 3 {
+		t.Errorf("Expected status 200 after 3 requests, got %d after %d", resp.StatusCode, calls)
+	}
+}
+
+func TestClient_Do_RetryContextCanceled(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 3}
+
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := testClient.NewRequest(ctx, http.MethodGet, "rest/api/2/issue", nil)
+	_, err := testClient.Do(req, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		if got := p.backoff(attempt); got != want {
+			t.Errorf("Expected %v for attempt %d, got %v", want, attempt, got)
+		}
+	}
+
+	p.Jitter = true
+	for attempt := 0; attempt < 10; attempt++ {
+		if got := p.backoff(attempt); got < 0 || got > time.Second {
+			t.Errorf("Expected a delay between 0 and 1s, got %v", got)
+		}
+	}
+}
+
+func TestRetryPolicy_retryDelay_Classifier(t *testing.T) {
+	timeout := errors.New("timeout")
+	p := &RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond, RetryableStatusCodes: []int{http.StatusBadGateway}}
+	if _, ok := p.retryDelay(nil, &http.Response{StatusCode: http.StatusBadGateway}, nil, 0); !ok {
+		t.Error("Expected a retry of a configured status code")
+	}
+	if _, ok := p.retryDelay(nil, &http.Response{StatusCode: http.StatusTooManyRequests}, nil, 0); ok {
+		t.Error("Expected no retry of a status code that is not configured")
+	}
+	if _, ok := p.retryDelay(nil, nil, timeout, 0); ok {
+		t.Error("Expected no retry of an error by default")
+	}
+
+	p.Retryable = func(resp *http.Response, err error) bool {
+		return errors.Is(err, timeout)
+	}
+	if _, ok := p.retryDelay(nil, nil, timeout, 0); !ok {
+		t.Error("Expected a retry of an error accepted by Retryable")
+	}
+
+	p.Classifier = StatusCodeRetryClassifier{http.StatusBadGateway}
+	if _, ok := p.retryDelay(nil, nil, timeout, 0); ok {
+		t.Error("Expected the Classifier to be used instead of the deprecated Retryable")
+	}
+}
+
+func TestRetryPolicy_retryDelay_RetryClassifier(t *testing.T) {
+	p := &RetryPolicy{
+		MaxRetries: 1,
+		BaseDelay:  time.Millisecond,
+		Classifier: RetryClassifierFunc(func(req *http.Request, resp *http.Response, err error) bool {
+			if resp != nil && resp.StatusCode == http.StatusConflict && strings.HasSuffix(req.URL.Path, "/transitions") {
+				return false
+			}
+			return StatusCodeRetryClassifier{http.StatusConflict, http.StatusBadGateway}.Retryable(req, resp, err)
+		}),
+	}
+	transition, _ := http.NewRequest(http.MethodPost, "https://example.atlassian.net/rest/api/2/issue/EX-1/transitions", nil)
+	issue, _ := http.NewRequest(http.MethodPut, "https://example.atlassian.net/rest/api/2/issue/EX-1", nil)
+
+	if _, ok := p.retryDelay(transition, &http.Response{StatusCode: http.StatusConflict}, nil, 0); ok {
+		t.Error("Expected no retry of a conflicting transition")
+	}
+	if _, ok := p.retryDelay(issue, &http.Response{StatusCode: http.StatusConflict}, nil, 0); !ok {
+		t.Error("Expected a retry of a conflicting update")
+	}
+	if _, ok := p.retryDelay(issue, &http.Response{StatusCode: http.StatusBadGateway}, nil, 0); !ok {
+		t.Error("Expected a retry of a bad gateway")
+	}
+	if _, ok := p.retryDelay(issue, nil, errors.New("timeout"), 0); ok {
+		t.Error("Expected no retry of an error rejected by the classifier")
+	}
+}
+
+type failingTransport struct {
+	calls int
+}
+
+func (t *failingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	if t.calls == 1 {
+		return nil, errors.New("connection reset")
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Header: http.Header{}, Request: req}, nil
+}
+
+func TestClient_Do_RetryError(t *testing.T) {
+	tp := &failingTransport{}
+	c, _ := NewClient("https://example.atlassian.net/", &http.Client{Transport: tp})
+	c.RetryPolicy = &RetryPolicy{
+		MaxRetries: 1,
+		BaseDelay:  time.Millisecond,
+		Classifier: RetryClassifierFunc(func(req *http.Request, resp *http.Response, err error) bool {
+			return err != nil
+		}),
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/myself", nil)
+	if _, err := c.Do(req, nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if tp.calls != 2 {
+		t.Errorf("Expected 2 requests, got %d", tp.calls)
+	}
+}
+
+func TestClient_Do_RetryOnlyIdempotentMethods(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 1}
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	for _, tc := range []struct {
+		method string
+		policy RetryPolicy
+		calls  int
+	}{
+		{method: http.MethodGet, calls: 2},
+		{method: http.MethodPut, calls: 2},
+		{method: http.MethodDelete, calls: 2},
+		{method: http.MethodPost, calls: 1},
+		{method: http.MethodPost, policy: RetryPolicy{RetryNonIdempotent: true}, calls: 2},
+	} {
+		calls = 0
+		tc.policy.MaxRetries = 1
+		testClient.RetryPolicy = &tc.policy
+		req, _ := testClient.NewRequest(context.Background(), tc.method, "rest/api/2/issue", nil)
+		testClient.Do(req, nil)
+		if calls != tc.calls {
+			t.Errorf("Expected %d requests for %s with %+v, got %d", tc.calls, tc.method, tc.policy, calls)
+		}
+	}
+}
+
+func TestClient_Do_RetryIdempotencyCheck(t *testing.T) {
+	setup()
+	defer teardown()
+	testClient.RetryPolicy = &RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond}
+
+	calls := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	checks := 0
+	check := func(ctx context.Context) (bool, error) {
+		checks++
+		// The second attempt reached Jira.
+		return checks == 2, nil
+	}
+	req, _ := testClient.NewRequest(context.Background(), http.MethodPost, "rest/api/2/issue", nil)
+	WithIdempotencyCheck(check)(req)
+	resp, err := testClient.Do(req, nil)
+	if err == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected the failure of the last attempt, got %v", err)
+	}
+	if calls != 2 || checks != 2 {
+		t.Errorf("Expected 2 requests and checks, got %d requests and %d checks", calls, checks)
+	}
+}
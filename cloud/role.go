@@ -42,7 +42,7 @@ type ActorUser struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *RoleService) GetList(ctx context.Context) (*[]Role, *Response, error) {
-	apiEndpoint := "rest/api/3/role"
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "role")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -63,7 +63,7 @@ func (s *RoleService) GetList(ctx context.Context) (*[]Role, *Response, error) {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *RoleService) Get(ctx context.Context, roleID int) (*Role, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/3/role/%d", roleID)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "role/%d", roleID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
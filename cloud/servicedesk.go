@@ -1,13 +1,12 @@
 package cloud
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
-
-	"github.com/google/go-querystring/query"
 )
 
 // ServiceDeskService handles ServiceDesk for the Jira instance / API.
@@ -18,13 +17,119 @@ type ServiceDeskOrganizationDTO struct {
 	OrganizationID int `json:"organizationId,omitempty" structs:"organizationId,omitempty"`
 }
 
+// ServiceDesk represents a service desk of Jira Service Management.
+type ServiceDesk struct {
+	ID          string    `json:"id,omitempty" structs:"id,omitempty"`
+	ProjectID   string    `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	ProjectName string    `json:"projectName,omitempty" structs:"projectName,omitempty"`
+	ProjectKey  string    `json:"projectKey,omitempty" structs:"projectKey,omitempty"`
+	Links       *SelfLink `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// ServiceDeskListOptions is the query options for listing service desks.
+type ServiceDeskListOptions struct {
+	Start int `url:"start,omitempty"`
+	Limit int `url:"limit,omitempty"`
+}
+
+// ServiceDeskPage is a page of a paginated list returned by the Jira Service Management API.
+type ServiceDeskPage[T any] struct {
+	Values  []T                   `json:"values,omitempty" structs:"values,omitempty"`
+	Start   int                   `json:"start,omitempty" structs:"start,omitempty"`
+	Limit   int                   `json:"limit,omitempty" structs:"limit,omitempty"`
+	Size    int                   `json:"size,omitempty" structs:"size,omitempty"`
+	IsLast  bool                  `json:"isLastPage,omitempty" structs:"isLastPage,omitempty"`
+	Expands []string              `json:"_expands,omitempty" structs:"_expands,omitempty"`
+	Links   *ServiceDeskPageLinks `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// ServiceDeskPageLinks are the links of a ServiceDeskPage.
+// Next and Prev are empty on the last and first page.
+type ServiceDeskPageLinks struct {
+	Base    string `json:"base,omitempty" structs:"base,omitempty"`
+	Context string `json:"context,omitempty" structs:"context,omitempty"`
+	Next    string `json:"next,omitempty" structs:"next,omitempty"`
+	Prev    string `json:"prev,omitempty" structs:"prev,omitempty"`
+}
+
+// NextStart returns the start of the page following p.
+func (p *ServiceDeskPage[T]) NextStart() int {
+	return p.Start + p.Size
+}
+
+// ServiceDeskAllPages calls list with the start of each page until the last page is returned
+// and collects the values of all pages.
+// It stops at the first error and returns the values collected so far with the error.
+//
+// Example:
+//
+//	queues, err := ServiceDeskAllPages(ctx, func(ctx context.Context, start int) (*QueueList, *Response, error) {
+//		return client.ServiceDesk.GetQueues(ctx, serviceDeskID, &QueueListOptions{Start: start})
+//	})
+func ServiceDeskAllPages[T any](ctx context.Context, list func(ctx context.Context, start int) (*ServiceDeskPage[T], *Response, error)) ([]T, error) {
+	var values []T
+	start := 0
+	for {
+		page, _, err := list(ctx, start)
+		if err != nil {
+			return values, err
+		}
+		values = append(values, page.Values...)
+		if page.IsLast || page.Size == 0 {
+			return values, nil
+		}
+		start = page.NextStart()
+	}
+}
+
+// ServiceDeskList is a page of service desks.
+type ServiceDeskList = ServiceDeskPage[ServiceDesk]
+
+// GetList returns a page of the service desks the user has permission to access.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-get
+func (s *ServiceDeskService) GetList(ctx context.Context, options *ServiceDeskListOptions) (*ServiceDeskList, *Response, error) {
+	apiEndpoint, err := addOptions("rest/servicedeskapi/servicedesk", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceDesks := new(ServiceDeskList)
+	resp, err := s.client.Do(req, serviceDesks)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return serviceDesks, resp, nil
+}
+
+// Get returns the service desk for the given service desk ID.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-get
+func (s *ServiceDeskService) Get(ctx context.Context, serviceDeskID interface{}) (*ServiceDesk, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v", serviceDeskID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceDesk := new(ServiceDesk)
+	resp, err := s.client.Do(req, serviceDesk)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return serviceDesk, resp, nil
+}
+
 // GetOrganizations returns a list of
 // all organizations associated with a service desk.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-servicedesk-servicedeskid-organization-get
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedDTO, *Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization?start=%d&limit=%d", serviceDeskID, start, limit)
 	if accountID != "" {
@@ -32,11 +137,10 @@ func (s *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID
 	}
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndPoint, nil)
-	req.Header.Set("Accept", "application/json")
-
 	if err != nil {
 		return nil, nil, err
 	}
+	req.Header.Set("Accept", "application/json")
 
 	orgs := new(PagedDTO)
 	resp, err := s.client.Do(req, &orgs)
@@ -55,9 +159,6 @@ func (s *ServiceDeskService) GetOrganizations(ctx context.Context, serviceDeskID
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-servicedesk-servicedeskid-organization-post
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskID)
 
@@ -87,9 +188,6 @@ func (s *ServiceDeskService) AddOrganization(ctx context.Context, serviceDeskID
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-organization/#api-rest-servicedeskapi-servicedesk-servicedeskid-organization-delete
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error) {
 	apiEndPoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/organization", serviceDeskID)
 
@@ -115,9 +213,6 @@ func (s *ServiceDeskService) RemoveOrganization(ctx context.Context, serviceDesk
 // AddCustomers adds customers to the given service desk.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-customer-post
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
@@ -145,14 +240,11 @@ func (s *ServiceDeskService) AddCustomers(ctx context.Context, serviceDeskID int
 // RemoveCustomers removes customers to the given service desk.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-customer-delete
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
 
 	payload := struct {
-		AccountIDs []string `json:"accountIDs"`
+		AccountIDs []string `json:"accountIds"`
 	}{
 		AccountIDs: acountIDs,
 	}
@@ -173,39 +265,426 @@ func (s *ServiceDeskService) RemoveCustomers(ctx context.Context, serviceDeskID
 }
 
 // ListCustomers lists customers for a ServiceDesk.
+// This is an experimental endpoint of Jira Service Management.
 //
 // https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-customer-get
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *ServiceDeskService) ListCustomers(ctx context.Context, serviceDeskID interface{}, options *CustomerListOptions) (*CustomerList, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID)
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/customer", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// this is an experiemntal endpoint
-	req.Header.Set("X-ExperimentalApi", "opt-in")
+	req.Header.Set(experimentalAPIHeader, "opt-in")
 
-	if options != nil {
-		q, err := query.Values(options)
-		if err != nil {
+	customerList := new(CustomerList)
+	resp, err := s.client.Do(req, customerList)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return customerList, resp, nil
+}
+
+// RequestType represents a request type of a service desk.
+type RequestType struct {
+	ID            string                 `json:"id,omitempty" structs:"id,omitempty"`
+	Name          string                 `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string                 `json:"description,omitempty" structs:"description,omitempty"`
+	HelpText      string                 `json:"helpText,omitempty" structs:"helpText,omitempty"`
+	IssueTypeID   string                 `json:"issueTypeId,omitempty" structs:"issueTypeId,omitempty"`
+	ServiceDeskID string                 `json:"serviceDeskId,omitempty" structs:"serviceDeskId,omitempty"`
+	PortalID      string                 `json:"portalId,omitempty" structs:"portalId,omitempty"`
+	GroupIDs      []string               `json:"groupIds,omitempty" structs:"groupIds,omitempty"`
+	Icon          *RequestTypeIcon       `json:"icon,omitempty" structs:"icon,omitempty"`
+	Fields        *RequestTypeFieldsList `json:"fields,omitempty" structs:"fields,omitempty"`
+	Links         *SelfLink              `json:"_links,omitempty" structs:"_links,omitempty"`
+	Expands       []string               `json:"_expands,omitempty" structs:"_expands,omitempty"`
+}
+
+// RequestTypeIcon is the icon of a request type.
+type RequestTypeIcon struct {
+	ID    string `json:"id,omitempty" structs:"id,omitempty"`
+	Links *struct {
+		IconURLs map[string]string `json:"iconUrls,omitempty" structs:"iconUrls,omitempty"`
+	} `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// RequestTypeListOptions is the query options for listing the request types of a service desk.
+type RequestTypeListOptions struct {
+	SearchQuery string   `url:"searchQuery,omitempty"`
+	GroupID     int      `url:"groupId,omitempty"`
+	Expand      []string `url:"expand,comma,omitempty"`
+	Start       int      `url:"start,omitempty"`
+	Limit       int      `url:"limit,omitempty"`
+}
+
+// RequestTypeList is a page of request types.
+type RequestTypeList = ServiceDeskPage[RequestType]
+
+// RequestTypeFieldsList contains the fields of a request type and
+// whether the user can raise requests on behalf of others or add participants.
+type RequestTypeFieldsList struct {
+	RequestTypeFields         []RequestTypeField `json:"requestTypeFields,omitempty" structs:"requestTypeFields,omitempty"`
+	CanRaiseOnBehalfOf        bool               `json:"canRaiseOnBehalfOf,omitempty" structs:"canRaiseOnBehalfOf,omitempty"`
+	CanAddRequestParticipants bool               `json:"canAddRequestParticipants,omitempty" structs:"canAddRequestParticipants,omitempty"`
+}
+
+// RequestTypeField is a field that can be set when creating a request of a request type.
+type RequestTypeField struct {
+	FieldID       string                  `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	Name          string                  `json:"name,omitempty" structs:"name,omitempty"`
+	Description   string                  `json:"description,omitempty" structs:"description,omitempty"`
+	Required      bool                    `json:"required" structs:"required"`
+	Visible       bool                    `json:"visible" structs:"visible"`
+	DefaultValues []RequestTypeFieldValue `json:"defaultValues,omitempty" structs:"defaultValues,omitempty"`
+	ValidValues   []RequestTypeFieldValue `json:"validValues,omitempty" structs:"validValues,omitempty"`
+	PresetValues  []string                `json:"presetValues,omitempty" structs:"presetValues,omitempty"`
+	JiraSchema    *FieldSchema            `json:"jiraSchema,omitempty" structs:"jiraSchema,omitempty"`
+}
+
+// RequestTypeFieldValue is a default or valid value of a request type field.
+type RequestTypeFieldValue struct {
+	Value    string                  `json:"value,omitempty" structs:"value,omitempty"`
+	Label    string                  `json:"label,omitempty" structs:"label,omitempty"`
+	Children []RequestTypeFieldValue `json:"children,omitempty" structs:"children,omitempty"`
+}
+
+// RequestTypeGroup is a group request types are organized in on the customer portal.
+type RequestTypeGroup struct {
+	ID   string `json:"id,omitempty" structs:"id,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// RequestTypeGroupList is a page of request type groups.
+type RequestTypeGroupList = ServiceDeskPage[RequestTypeGroup]
+
+// GetRequestTypes returns a page of the request types of a service desk.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-get
+func (s *ServiceDeskService) GetRequestTypes(ctx context.Context, serviceDeskID interface{}, options *RequestTypeListOptions) (*RequestTypeList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestTypes := new(RequestTypeList)
+	resp, err := s.client.Do(req, requestTypes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return requestTypes, resp, nil
+}
+
+// GetRequestType returns a request type of a service desk.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-requesttypeid-get
+func (s *ServiceDeskService) GetRequestType(ctx context.Context, serviceDeskID, requestTypeID interface{}) (*RequestType, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestType := new(RequestType)
+	resp, err := s.client.Do(req, requestType)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return requestType, resp, nil
+}
+
+// GetRequestTypeFields returns the fields for a request of a request type,
+// including whether they are required and their valid values.
+// Use them to build the FieldValues of a request for RequestService.Create.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttype-requesttypeid-field-get
+func (s *ServiceDeskService) GetRequestTypeFields(ctx context.Context, serviceDeskID, requestTypeID interface{}) (*RequestTypeFieldsList, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype/%v/field", serviceDeskID, requestTypeID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields := new(RequestTypeFieldsList)
+	resp, err := s.client.Do(req, fields)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return fields, resp, nil
+}
+
+// GetRequestTypeGroups returns a page of the request type groups of a service desk.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-requesttypegroup-get
+func (s *ServiceDeskService) GetRequestTypeGroups(ctx context.Context, serviceDeskID interface{}, options *ServiceDeskListOptions) (*RequestTypeGroupList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttypegroup", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := new(RequestTypeGroupList)
+	resp, err := s.client.Do(req, groups)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return groups, resp, nil
+}
+
+// Queue represents a queue of a service desk.
+// IssueCount is only set if the count was requested.
+type Queue struct {
+	ID         string    `json:"id,omitempty" structs:"id,omitempty"`
+	Name       string    `json:"name,omitempty" structs:"name,omitempty"`
+	JQL        string    `json:"jql,omitempty" structs:"jql,omitempty"`
+	Fields     []string  `json:"fields,omitempty" structs:"fields,omitempty"`
+	IssueCount int       `json:"issueCount,omitempty" structs:"issueCount,omitempty"`
+	Links      *SelfLink `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// QueueListOptions is the query options for listing and getting queues.
+// If IncludeCount is set, the number of issues in each queue is returned.
+type QueueListOptions struct {
+	IncludeCount bool `url:"includeCount,omitempty"`
+	Start        int  `url:"start,omitempty"`
+	Limit        int  `url:"limit,omitempty"`
+}
+
+// QueueList is a page of queues.
+type QueueList = ServiceDeskPage[Queue]
+
+// QueueIssueList is a page of the issues in a queue.
+type QueueIssueList = ServiceDeskPage[Issue]
+
+// GetQueues returns a page of the queues of a service desk.
+// The user must be an agent of the service desk.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-queue-get
+func (s *ServiceDeskService) GetQueues(ctx context.Context, serviceDeskID interface{}, options *QueueListOptions) (*QueueList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue", serviceDeskID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queues := new(QueueList)
+	resp, err := s.client.Do(req, queues)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return queues, resp, nil
+}
+
+// GetQueue returns a queue of a service desk.
+// Only IncludeCount of options is used.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-queue-queueid-get
+func (s *ServiceDeskService) GetQueue(ctx context.Context, serviceDeskID, queueID interface{}, options *QueueListOptions) (*Queue, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue/%v", serviceDeskID, queueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queue := new(Queue)
+	resp, err := s.client.Do(req, queue)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return queue, resp, nil
+}
+
+// GetQueueIssues returns a page of the issues in a queue of a service desk.
+// Only the fields configured for the queue are returned for each issue.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-queue-queueid-issue-get
+func (s *ServiceDeskService) GetQueueIssues(ctx context.Context, serviceDeskID, queueID interface{}, options *ServiceDeskListOptions) (*QueueIssueList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/queue/%v/issue", serviceDeskID, queueID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := new(QueueIssueList)
+	resp, err := s.client.Do(req, issues)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return issues, resp, nil
+}
+
+// KnowledgeBaseArticle represents an article of a knowledge base linked to a service desk.
+type KnowledgeBaseArticle struct {
+	Title   string                       `json:"title,omitempty" structs:"title,omitempty"`
+	Excerpt string                       `json:"excerpt,omitempty" structs:"excerpt,omitempty"`
+	Source  *KnowledgeBaseArticleSource  `json:"source,omitempty" structs:"source,omitempty"`
+	Content *KnowledgeBaseArticleContent `json:"content,omitempty" structs:"content,omitempty"`
+}
+
+// KnowledgeBaseArticleSource describes where a knowledge base article is stored.
+type KnowledgeBaseArticleSource struct {
+	Type     string `json:"type,omitempty" structs:"type,omitempty"`
+	PageID   string `json:"pageId,omitempty" structs:"pageId,omitempty"`
+	SpaceKey string `json:"spaceKey,omitempty" structs:"spaceKey,omitempty"`
+}
+
+// KnowledgeBaseArticleContent holds the link to the rendered content of a knowledge base article.
+type KnowledgeBaseArticleContent struct {
+	IframeSrc string `json:"iframeSrc,omitempty" structs:"iframeSrc,omitempty"`
+}
+
+// KnowledgeBaseSearchOptions is the query options for searching knowledge base articles.
+// Query is required. If Highlight is set, the matching terms are
+// wrapped in @@@hl@@@ and @@@endhl@@@ in the title and excerpt.
+type KnowledgeBaseSearchOptions struct {
+	Query     string `url:"query,omitempty"`
+	Highlight bool   `url:"highlight,omitempty"`
+	Start     int    `url:"start,omitempty"`
+	Limit     int    `url:"limit,omitempty"`
+}
+
+// KnowledgeBaseArticleList is a page of knowledge base articles.
+type KnowledgeBaseArticleList = ServiceDeskPage[KnowledgeBaseArticle]
+
+// SearchArticles returns the knowledge base articles matching the query
+// from all service desks the user has access to.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-knowledgebase/#api-rest-servicedeskapi-knowledgebase-article-get
+func (s *ServiceDeskService) SearchArticles(ctx context.Context, options *KnowledgeBaseSearchOptions) (*KnowledgeBaseArticleList, *Response, error) {
+	return s.searchArticles(ctx, "rest/servicedeskapi/knowledgebase/article", options)
+}
+
+// SearchServiceDeskArticles returns the knowledge base articles matching the query
+// from the knowledge base linked to a service desk.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-knowledgebase-article-get
+func (s *ServiceDeskService) SearchServiceDeskArticles(ctx context.Context, serviceDeskID interface{}, options *KnowledgeBaseSearchOptions) (*KnowledgeBaseArticleList, *Response, error) {
+	return s.searchArticles(ctx, fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/knowledgebase/article", serviceDeskID), options)
+}
+
+func (s *ServiceDeskService) searchArticles(ctx context.Context, endpoint string, options *KnowledgeBaseSearchOptions) (*KnowledgeBaseArticleList, *Response, error) {
+	apiEndpoint, err := addOptions(endpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	articles := new(KnowledgeBaseArticleList)
+	resp, err := s.client.Do(req, articles)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return articles, resp, nil
+}
+
+// TemporaryAttachment is a file uploaded to a service desk that is not attached to a request yet.
+type TemporaryAttachment struct {
+	TemporaryAttachmentID string `json:"temporaryAttachmentId,omitempty" structs:"temporaryAttachmentId,omitempty"`
+	FileName              string `json:"fileName,omitempty" structs:"fileName,omitempty"`
+}
+
+// TemporaryAttachments is the result of uploading temporary files to a service desk.
+type TemporaryAttachments struct {
+	TemporaryAttachments []TemporaryAttachment `json:"temporaryAttachments,omitempty" structs:"temporaryAttachments,omitempty"`
+}
+
+// AttachTemporaryFile uploads a file to a service desk as a temporary attachment.
+// The returned TemporaryAttachmentID can be used with RequestService.CreateAttachment
+// to attach the file to a request. Temporary attachments are removed after a short time if they are not used.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-servicedesk/#api-rest-servicedeskapi-servicedesk-servicedeskid-attachtemporaryfile-post
+func (s *ServiceDeskService) AttachTemporaryFile(ctx context.Context, serviceDeskID interface{}, r io.Reader, fileName string) (*TemporaryAttachments, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/attachTemporaryFile", serviceDeskID)
+
+	b := new(bytes.Buffer)
+	writer := multipart.NewWriter(b)
+
+	fw, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if r != nil {
+		if _, err = io.Copy(fw, r); err != nil {
 			return nil, nil, err
 		}
-		req.URL.RawQuery = q.Encode()
 	}
+	writer.Close()
 
-	resp, err := s.client.Do(req, nil)
+	req, err := s.client.NewMultiPartRequest(ctx, http.MethodPost, apiEndpoint, b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	// this is an experimental endpoint
+	req.Header.Set(experimentalAPIHeader, "opt-in")
+
+	attachments := new(TemporaryAttachments)
+	resp, err := s.client.Do(req, attachments)
 	if err != nil {
 		return nil, resp, NewJiraError(resp, err)
 	}
-	defer resp.Body.Close()
 
-	customerList := new(CustomerList)
-	if err := json.NewDecoder(resp.Body).Decode(customerList); err != nil {
-		return nil, resp, fmt.Errorf("could not unmarshall the data into struct")
+	return attachments, resp, nil
+}
+
+// ServiceDeskInfo is the version information of Jira Service Management.
+type ServiceDeskInfo struct {
+	Version          string       `json:"version,omitempty" structs:"version,omitempty"`
+	PlatformVersion  string       `json:"platformVersion,omitempty" structs:"platformVersion,omitempty"`
+	BuildDate        *RequestDate `json:"buildDate,omitempty" structs:"buildDate,omitempty"`
+	BuildChangeSet   string       `json:"buildChangeSet,omitempty" structs:"buildChangeSet,omitempty"`
+	IsLicensedForUse bool         `json:"isLicensedForUse,omitempty" structs:"isLicensedForUse,omitempty"`
+	Links            *SelfLink    `json:"_links,omitempty" structs:"_links,omitempty"`
+}
+
+// GetInfo returns the version information of Jira Service Management.
+//
+// https://developer.atlassian.com/cloud/jira/service-desk/rest/api-group-info/#api-rest-servicedeskapi-info-get
+func (s *ServiceDeskService) GetInfo(ctx context.Context) (*ServiceDeskInfo, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/servicedeskapi/info", nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return customerList, resp, nil
+	info := new(ServiceDeskInfo)
+	resp, err := s.client.Do(req, info)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return info, resp, nil
 }
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -296,15 +298,13 @@ func TestServiceDeskService_RemoveCustomers(t *testing.T) {
 				testMethod(t, r, http.MethodDelete)
 				testRequestURL(t, r, fmt.Sprintf("/rest/servicedeskapi/servicedesk/%v/customer", test.serviceDeskID))
 
-				var payload struct {
-					AccountIDs []string `json:"accountIds"`
-				}
+				var payload map[string][]string
 
 				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 					t.Fatal(err)
 				}
 
-				gotAccountIDs = append(gotAccountIDs, payload.AccountIDs...)
+				gotAccountIDs = append(gotAccountIDs, payload["accountIds"]...)
 
 				w.WriteHeader(http.StatusNoContent)
 			})
@@ -429,3 +429,372 @@ func TestServiceDeskService_ListCustomers(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceDeskService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"start": "1", "limit": "2"})
+		fmt.Fprint(w, `{"_expands":[],"size":2,"start":1,"limit":2,"isLastPage":false,"values":[{"id":"10001","projectId":"11001","projectName":"IT Help Desk","projectKey":"ITH","_links":{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/servicedesk/10001"}},{"id":"10002","projectId":"11002","projectName":"HR Self Serve Desk","projectKey":"HR"}]}`)
+	})
+
+	serviceDesks, _, err := testClient.ServiceDesk.GetList(context.Background(), &ServiceDeskListOptions{Start: 1, Limit: 2})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if serviceDesks == nil {
+		t.Fatal("Expected service desks. Service desks is nil")
+	}
+	if serviceDesks.IsLast || serviceDesks.Size != 2 || len(serviceDesks.Values) != 2 {
+		t.Errorf("Unexpected page: %+v", serviceDesks)
+	}
+	if serviceDesks.Values[0].ProjectKey != "ITH" || serviceDesks.Values[0].Links == nil {
+		t.Errorf("Unexpected service desk: %+v", serviceDesks.Values[0])
+	}
+}
+
+func TestServiceDeskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10001","projectId":"11001","projectName":"IT Help Desk","projectKey":"ITH"}`)
+	})
+
+	serviceDesk, _, err := testClient.ServiceDesk.Get(context.Background(), 10001)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if serviceDesk == nil || serviceDesk.ProjectName != "IT Help Desk" {
+		t.Errorf("Unexpected service desk: %+v", serviceDesk)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypes(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/28/requesttype"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"searchQuery": "laptop", "groupId": "12"})
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":100,"isLastPage":true,"values":[{"id":"11001","name":"Get IT Help","description":"Get IT Help","helpText":"Please tell us clearly the problem you have within 100 words.","issueTypeId":"12345","serviceDeskId":"28","portalId":"2","groupIds":["12"],"icon":{"id":"12345","_links":{"iconUrls":{"48x48":"https://your-domain.atlassian.net/rest/api/2/universal_avatar/view/type/SD_REQTYPE/avatar/12345?size=large"}}}}]}`)
+	})
+
+	requestTypes, _, err := testClient.ServiceDesk.GetRequestTypes(context.Background(), 28, &RequestTypeListOptions{SearchQuery: "laptop", GroupID: 12})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if requestTypes == nil || len(requestTypes.Values) != 1 {
+		t.Fatalf("Unexpected request types: %+v", requestTypes)
+	}
+	requestType := requestTypes.Values[0]
+	if requestType.IssueTypeID != "12345" || len(requestType.GroupIDs) != 1 {
+		t.Errorf("Unexpected request type: %+v", requestType)
+	}
+	if requestType.Icon == nil || requestType.Icon.Links == nil || requestType.Icon.Links.IconURLs["48x48"] == "" {
+		t.Errorf("Unexpected icon: %+v", requestType.Icon)
+	}
+}
+
+func TestServiceDeskService_GetRequestType(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/28/requesttype/11001"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"11001","name":"Get IT Help","issueTypeId":"12345","serviceDeskId":"28"}`)
+	})
+
+	requestType, _, err := testClient.ServiceDesk.GetRequestType(context.Background(), 28, 11001)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if requestType == nil || requestType.Name != "Get IT Help" {
+		t.Errorf("Unexpected request type: %+v", requestType)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypeFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/28/requesttype/11001/field"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"requestTypeFields":[{"fieldId":"summary","name":"What do you need?","description":"Summary of your request","required":true,"visible":true,"validValues":[],"jiraSchema":{"type":"string","system":"summary"}},{"fieldId":"customfield_10010","name":"Priority","required":false,"visible":true,"validValues":[{"value":"1","label":"High","children":[]},{"value":"2","label":"Low","children":[]}],"jiraSchema":{"type":"option","custom":"com.atlassian.jira.plugin.system.customfieldtypes:select","customId":10010}}],"canRaiseOnBehalfOf":true,"canAddRequestParticipants":true}`)
+	})
+
+	fields, _, err := testClient.ServiceDesk.GetRequestTypeFields(context.Background(), 28, "11001")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if fields == nil || len(fields.RequestTypeFields) != 2 || !fields.CanRaiseOnBehalfOf {
+		t.Fatalf("Unexpected fields: %+v", fields)
+	}
+	if !fields.RequestTypeFields[0].Required || fields.RequestTypeFields[0].JiraSchema.System != "summary" {
+		t.Errorf("Unexpected summary field: %+v", fields.RequestTypeFields[0])
+	}
+	if values := fields.RequestTypeFields[1].ValidValues; len(values) != 2 || values[0].Label != "High" {
+		t.Errorf("Unexpected valid values: %+v", values)
+	}
+}
+
+func TestServiceDeskService_GetRequestTypeGroups(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/28/requesttypegroup"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"12","name":"Common Requests"},{"id":"13","name":"Logins and Accounts"}]}`)
+	})
+
+	groups, _, err := testClient.ServiceDesk.GetRequestTypeGroups(context.Background(), 28, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if groups == nil || len(groups.Values) != 2 || groups.Values[1].Name != "Logins and Accounts" {
+		t.Errorf("Unexpected groups: %+v", groups)
+	}
+}
+
+func TestServiceDeskService_GetQueues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/queue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"includeCount": "true"})
+		fmt.Fprint(w, `{"size":2,"start":0,"limit":50,"isLastPage":true,"values":[{"id":"10","name":"Unassigned issues","jql":"project = SD AND assignee = EMPTY","fields":["issuetype","issuekey","summary"],"issueCount":10},{"id":"20","name":"Assigned to me","jql":"project = SD AND assignee = currentUser()","fields":["issuetype","issuekey"],"issueCount":0}]}`)
+	})
+
+	queues, _, err := testClient.ServiceDesk.GetQueues(context.Background(), 10001, &QueueListOptions{IncludeCount: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if queues == nil || len(queues.Values) != 2 {
+		t.Fatalf("Unexpected queues: %+v", queues)
+	}
+	if queues.Values[0].IssueCount != 10 || len(queues.Values[0].Fields) != 3 {
+		t.Errorf("Unexpected queue: %+v", queues.Values[0])
+	}
+}
+
+func TestServiceDeskService_GetQueue(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/queue/10"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":"10","name":"Unassigned issues","jql":"project = SD AND assignee = EMPTY"}`)
+	})
+
+	queue, _, err := testClient.ServiceDesk.GetQueue(context.Background(), 10001, 10, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if queue == nil || queue.Name != "Unassigned issues" {
+		t.Errorf("Unexpected queue: %+v", queue)
+	}
+}
+
+func TestServiceDeskService_GetQueueIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/queue/10/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"start": "50", "limit": "50"})
+		fmt.Fprint(w, `{"size":1,"start":50,"limit":50,"isLastPage":true,"values":[{"id":"10001","key":"SD-1","fields":{"summary":"Help!"}}]}`)
+	})
+
+	issues, _, err := testClient.ServiceDesk.GetQueueIssues(context.Background(), 10001, 10, &ServiceDeskListOptions{Start: 50, Limit: 50})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Values) != 1 || issues.Values[0].Key != "SD-1" || issues.Values[0].Fields.Summary != "Help!" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestServiceDeskService_SearchArticles(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/knowledgebase/article"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"query": "printer", "highlight": "true"})
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"title":"Fixing the @@@hl@@@printer@@@endhl@@@","excerpt":"Turn it off and on","source":{"type":"confluence","pageId":"8786177","spaceKey":"IT"},"content":{"iframeSrc":"https://your-domain.atlassian.net/rest/servicedeskapi/knowledgebase/article/view/8786177"}}]}`)
+	})
+
+	articles, _, err := testClient.ServiceDesk.SearchArticles(context.Background(), &KnowledgeBaseSearchOptions{Query: "printer", Highlight: true})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if articles == nil || len(articles.Values) != 1 {
+		t.Fatalf("Unexpected articles: %+v", articles)
+	}
+	if articles.Values[0].Source == nil || articles.Values[0].Source.PageID != "8786177" {
+		t.Errorf("Unexpected article source: %+v", articles.Values[0].Source)
+	}
+}
+
+func TestServiceDeskService_SearchServiceDeskArticles(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/knowledgebase/article"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"query": "vpn"})
+		fmt.Fprint(w, `{"size":1,"start":0,"limit":50,"isLastPage":true,"values":[{"title":"VPN setup","excerpt":"Install the client"}]}`)
+	})
+
+	articles, _, err := testClient.ServiceDesk.SearchServiceDeskArticles(context.Background(), 10001, &KnowledgeBaseSearchOptions{Query: "vpn"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if articles == nil || len(articles.Values) != 1 || articles.Values[0].Title != "VPN setup" {
+		t.Errorf("Unexpected articles: %+v", articles)
+	}
+}
+
+func TestServiceDeskService_AttachTemporaryFile(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/attachTemporaryFile"
+	testAttachment := "Here is an attachment"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+		if got := r.Header.Get("X-ExperimentalApi"); got != "opt-in" {
+			t.Errorf("Expected X-ExperimentalApi header opt-in, got %q", got)
+		}
+		if got := r.Header.Get("X-Atlassian-Token"); got != "nocheck" {
+			t.Errorf("Expected X-Atlassian-Token header nocheck, got %q", got)
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Error reading form file: %s", err)
+		}
+		defer file.Close()
+		data, err := io.ReadAll(file)
+		if err != nil {
+			t.Fatalf("Error reading form file: %s", err)
+		}
+		if string(data) != testAttachment || header.Filename != "screenshot.png" {
+			t.Errorf("Unexpected file %q with content %q", header.Filename, data)
+		}
+
+		fmt.Fprint(w, `{"temporaryAttachments":[{"temporaryAttachmentId":"temp910441317820424274","fileName":"screenshot.png"}]}`)
+	})
+
+	attachments, _, err := testClient.ServiceDesk.AttachTemporaryFile(context.Background(), 10001, strings.NewReader(testAttachment), "screenshot.png")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if attachments == nil || len(attachments.TemporaryAttachments) != 1 || attachments.TemporaryAttachments[0].TemporaryAttachmentID != "temp910441317820424274" {
+		t.Errorf("Unexpected temporary attachments: %+v", attachments)
+	}
+}
+
+func TestServiceDeskAllPages(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/queue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		switch start := r.URL.Query().Get("start"); start {
+		case "":
+			fmt.Fprint(w, `{"size":2,"start":0,"limit":2,"isLastPage":false,"values":[{"id":"1"},{"id":"2"}],"_links":{"next":"https://your-domain.atlassian.net/rest/servicedeskapi/servicedesk/10001/queue?start=2&limit=2"}}`)
+		case "2":
+			fmt.Fprint(w, `{"size":1,"start":2,"limit":2,"isLastPage":true,"values":[{"id":"3"}]}`)
+		default:
+			t.Errorf("Unexpected start %q", start)
+		}
+	})
+
+	queues, err := ServiceDeskAllPages(context.Background(), func(ctx context.Context, start int) (*QueueList, *Response, error) {
+		return testClient.ServiceDesk.GetQueues(ctx, 10001, &QueueListOptions{Start: start})
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	var ids []string
+	for _, q := range queues {
+		ids = append(ids, q.ID)
+	}
+	if want := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("Unexpected queue IDs %v, want %v", ids, want)
+	}
+}
+
+func TestServiceDeskAllPages_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/servicedesk/10001/queue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "" {
+			fmt.Fprint(w, `{"size":1,"start":0,"limit":1,"isLastPage":false,"values":[{"id":"1"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	queues, err := ServiceDeskAllPages(context.Background(), func(ctx context.Context, start int) (*QueueList, *Response, error) {
+		return testClient.ServiceDesk.GetQueues(ctx, 10001, &QueueListOptions{Start: start})
+	})
+	if err == nil {
+		t.Error("Expected an error")
+	}
+	if len(queues) != 1 {
+		t.Errorf("Expected the values of the first page, got %+v", queues)
+	}
+}
+
+func TestServiceDeskService_GetInfo(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/servicedeskapi/info"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"version":"3.6.2","platformVersion":"7.6.2","buildDate":{"iso8601":"2017-12-20T05:38:55+0700","epochMillis":1513723135000},"buildChangeSet":"f4d4e1f29e2ea1ff1e5f8945ee8d0b4523f3e0c7","isLicensedForUse":true,"_links":{"self":"https://your-domain.atlassian.net/rest/servicedeskapi/info"}}`)
+	})
+
+	info, _, err := testClient.ServiceDesk.GetInfo(context.Background())
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if info == nil || info.Version != "3.6.2" || !info.IsLicensedForUse || info.BuildDate == nil || info.BuildDate.Epoch != 1513723135000 {
+		t.Errorf("Unexpected info: %+v", info)
+	}
+}
@@ -0,0 +1,212 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrSiteNotFound is returned by SiteSet.Get if no site matches.
+var ErrSiteNotFound = errors.New("jira: site not found")
+
+// Site is a Jira site of a SiteSet.
+type Site struct {
+	// CloudID is the cloud ID of the site. It is optional if URL is set.
+	CloudID string
+	// URL is the URL of the site, e.g. "https://your-domain.atlassian.net". It is optional if CloudID is set.
+	URL string
+	// BaseURL is the base URL of the client, e.g. OAuth2BaseURL(cloudID) for OAuth 2.0 apps.
+	// It will default to URL if empty.
+	BaseURL string
+	// HTTPClient sends the requests to the site, usually with the transport authenticating them.
+	// It will default to a client with http.DefaultTransport if nil.
+	HTTPClient *http.Client
+}
+
+// SiteSet manages the clients of multiple Jira sites with different base URLs and credentials,
+// e.g. the sites an app is installed on. The clients are looked up by cloud ID or hostname
+// and share the middlewares, the retry policy, the rate limiter and the circuit breaker of the set.
+//
+// Example:
+//
+//	sites := &cloud.SiteSet{RateLimiter: &cloud.RateLimiter{Rate: 10, Burst: 10, PerHost: true}}
+//	_, err := sites.Add(cloud.Site{URL: "https://customer.atlassian.net", HTTPClient: tp.Client()})
+//	client, err := sites.Get("customer.atlassian.net")
+//
+// Changes of the shared settings only affect clients added afterwards.
+// A SiteSet is safe for concurrent use.
+type SiteSet struct {
+	// Middlewares are added to every client.
+	Middlewares []Middleware
+	// RetryPolicy, RateLimiter and CircuitBreaker are shared by all clients.
+	// Use RateLimiter.PerHost to limit the requests to each site separately.
+	RetryPolicy    *RetryPolicy
+	RateLimiter    *RateLimiter
+	CircuitBreaker *CircuitBreaker
+	// Configure is called with every new client, e.g. to set a Logger or UserAgent.
+	Configure func(site Site, client *Client)
+
+	mu      sync.RWMutex
+	sites   []*siteClient
+	byKey   map[string]*siteClient
+	keysFor map[*siteClient][]string
+}
+
+type siteClient struct {
+	site   Site
+	client *Client
+}
+
+// Add creates the client of the site and adds it, replacing a site with the same cloud ID or hostname.
+func (s *SiteSet) Add(site Site) (*Client, error) {
+	keys, err := siteKeys(site)
+	if err != nil {
+		return nil, err
+	}
+	baseURL := site.BaseURL
+	if baseURL == "" {
+		baseURL = site.URL
+	}
+	if baseURL == "" {
+		return nil, errors.New("jira: site has no URL or base URL")
+	}
+	client, err := NewClient(baseURL, site.HTTPClient)
+	if err != nil {
+		return nil, err
+	}
+	client.RetryPolicy = s.RetryPolicy
+	client.RateLimiter = s.RateLimiter
+	client.CircuitBreaker = s.CircuitBreaker
+	client.Use(s.Middlewares...)
+	if s.Configure != nil {
+		s.Configure(site, client)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range keys {
+		if existing, ok := s.byKey[key]; ok {
+			s.remove(existing)
+		}
+	}
+	if s.byKey == nil {
+		s.byKey = map[string]*siteClient{}
+		s.keysFor = map[*siteClient][]string{}
+	}
+	sc := &siteClient{site: site, client: client}
+	s.sites = append(s.sites, sc)
+	for _, key := range keys {
+		s.byKey[key] = sc
+	}
+	s.keysFor[sc] = keys
+	return client, nil
+}
+
+// AddInstallation adds the site of the Connect app installation, authenticated with JWTs
+// signed with its shared secret from the store.
+func (s *SiteSet) AddInstallation(store InstallationStore, appKey string, installation *Installation) (*Client, error) {
+	tp := NewInstallationJWTAuthTransport(store, appKey, installation.ClientKey)
+	return s.Add(Site{CloudID: installation.CloudID, URL: installation.BaseURL, HTTPClient: tp.Client()})
+}
+
+// Get returns the client of the site with the cloud ID or hostname, or an error wrapping ErrSiteNotFound.
+// A URL of the site is accepted as hostname, e.g. the base URL of a webhook payload.
+func (s *SiteSet) Get(cloudIDOrHost string) (*Client, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if sc, ok := s.byKey[lookupKey(cloudIDOrHost)]; ok {
+		return sc.client, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrSiteNotFound, cloudIDOrHost)
+}
+
+// Remove removes the site with the cloud ID or hostname. Removing a missing site is no error.
+func (s *SiteSet) Remove(cloudIDOrHost string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sc, ok := s.byKey[lookupKey(cloudIDOrHost)]; ok {
+		s.remove(sc)
+	}
+}
+
+// Sites returns the sites in the order they were added.
+func (s *SiteSet) Sites() []Site {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	sites := make([]Site, 0, len(s.sites))
+	for _, sc := range s.sites {
+		sites = append(sites, sc.site)
+	}
+	return sites
+}
+
+// Each calls f with the client of every site, sorted by URL, until f returns an error.
+func (s *SiteSet) Each(ctx context.Context, f func(ctx context.Context, site Site, client *Client) error) error {
+	s.mu.RLock()
+	sites := append([]*siteClient(nil), s.sites...)
+	s.mu.RUnlock()
+	sort.SliceStable(sites, func(i, j int) bool { return sites[i].site.URL < sites[j].site.URL })
+
+	for _, sc := range sites {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := f(ctx, sc.site, sc.client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SiteSet) remove(sc *siteClient) {
+	for _, key := range s.keysFor[sc] {
+		delete(s.byKey, key)
+	}
+	delete(s.keysFor, sc)
+	for i, existing := range s.sites {
+		if existing == sc {
+			s.sites = append(s.sites[:i], s.sites[i+1:]...)
+			break
+		}
+	}
+}
+
+// siteKeys returns the lookup keys of the site: its cloud ID and its hostname.
+func siteKeys(site Site) ([]string, error) {
+	var keys []string
+	if site.CloudID != "" {
+		keys = append(keys, "id:"+site.CloudID)
+	}
+	if site.URL != "" {
+		u, err := url.Parse(site.URL)
+		if err != nil {
+			return nil, err
+		}
+		if u.Hostname() == "" {
+			return nil, fmt.Errorf("jira: site URL %q has no hostname", site.URL)
+		}
+		keys = append(keys, "host:"+strings.ToLower(u.Hostname()))
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jira: site has no cloud ID or URL")
+	}
+	return keys, nil
+}
+
+// lookupKey returns the key of a cloud ID, hostname or URL.
+func lookupKey(cloudIDOrHost string) string {
+	if strings.Contains(cloudIDOrHost, "://") {
+		if u, err := url.Parse(cloudIDOrHost); err == nil {
+			return "host:" + strings.ToLower(u.Hostname())
+		}
+	}
+	if strings.Contains(cloudIDOrHost, ".") {
+		return "host:" + strings.ToLower(cloudIDOrHost)
+	}
+	return "id:" + cloudIDOrHost
+}
@@ -0,0 +1,101 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestSiteSet(t *testing.T) {
+	limiter := &RateLimiter{Rate: 10, PerHost: true}
+	var configured []string
+	sites := &SiteSet{
+		RateLimiter: limiter,
+		Configure: func(site Site, client *Client) {
+			configured = append(configured, site.URL)
+			client.UserAgent = "triage-bot"
+		},
+	}
+
+	a, err := sites.Add(Site{CloudID: "11111111-1111", URL: "https://a.atlassian.net"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	b, err := sites.Add(Site{CloudID: "22222222-2222", URL: "https://b.atlassian.net", BaseURL: OAuth2BaseURL("22222222-2222")})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	for _, key := range []string{"11111111-1111", "a.atlassian.net", "A.Atlassian.net", "https://a.atlassian.net/rest/api/2/issue/EX-1"} {
+		if got, err := sites.Get(key); err != nil || got != a {
+			t.Errorf("Get(%q) = %v, %v, want the client of site a", key, got, err)
+		}
+	}
+	if got, _ := sites.Get("22222222-2222"); got != b || got.BaseURL.String() != "https://api.atlassian.com/ex/jira/22222222-2222/" {
+		t.Errorf("Expected the client of site b with the OAuth 2.0 base URL, got %v", got)
+	}
+	if a.RateLimiter != limiter || a.UserAgent != "triage-bot" || len(configured) != 2 {
+		t.Errorf("Expected the clients to share the settings of the set")
+	}
+
+	sites.Remove("a.atlassian.net")
+	if _, err := sites.Get("11111111-1111"); !errors.Is(err, ErrSiteNotFound) {
+		t.Errorf("Expected ErrSiteNotFound after the removal, got %v", err)
+	}
+	if got := sites.Sites(); len(got) != 1 || got[0].CloudID != "22222222-2222" {
+		t.Errorf("Unexpected sites %+v", got)
+	}
+}
+
+func TestSiteSet_AddReplaces(t *testing.T) {
+	sites := &SiteSet{}
+	_, _ = sites.Add(Site{CloudID: "1", URL: "https://a.atlassian.net"})
+	replaced, _ := sites.Add(Site{URL: "https://a.atlassian.net", HTTPClient: &http.Client{}})
+
+	if got, _ := sites.Get("a.atlassian.net"); got != replaced {
+		t.Error("Expected the site to be replaced")
+	}
+	if _, err := sites.Get("1"); !errors.Is(err, ErrSiteNotFound) {
+		t.Errorf("Expected the cloud ID of the replaced site to be removed, got %v", err)
+	}
+	if len(sites.Sites()) != 1 {
+		t.Errorf("Expected 1 site, got %d", len(sites.Sites()))
+	}
+	if _, err := sites.Add(Site{}); err == nil {
+		t.Error("Expected an error for a site without cloud ID and URL")
+	}
+}
+
+func TestSiteSet_Each(t *testing.T) {
+	sites := &SiteSet{}
+	_, _ = sites.Add(Site{URL: "https://b.atlassian.net"})
+	_, _ = sites.Add(Site{URL: "https://a.atlassian.net"})
+
+	var visited []string
+	err := sites.Each(context.Background(), func(ctx context.Context, site Site, client *Client) error {
+		visited = append(visited, site.URL)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(visited) != 2 || visited[0] != "https://a.atlassian.net" {
+		t.Errorf("Expected the sites sorted by URL, got %v", visited)
+	}
+}
+
+func TestSiteSet_AddInstallation(t *testing.T) {
+	store := NewMemoryInstallationStore()
+	installation := &Installation{ClientKey: "client", SharedSecret: "secret", BaseURL: "https://a.atlassian.net", CloudID: "1"}
+	_ = store.Save(context.Background(), installation)
+
+	sites := &SiteSet{}
+	client, err := sites.AddInstallation(store, "com.example.app", installation)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, ok := client.Client().Transport.(*JWTAuthTransport); !ok {
+		t.Errorf("Expected a JWTAuthTransport, got %T", client.Client().Transport)
+	}
+}
@@ -0,0 +1,138 @@
+package cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sprintFieldKeyRe matches the keys of the serialized sprints of Jira Data Center.
+// Only known keys are matched, as names and goals may contain commas.
+var sprintFieldKeyRe = regexp.MustCompile(`(?:^|,)(id|rapidViewId|state|name|goal|startDate|endDate|completeDate|activatedDate|sequence|autoStartStop|synced|incompleteIssuesDestinationId)=`)
+
+// ParseSprintField parses the value of the sprint custom field of an issue.
+// Jira Cloud returns the sprints as JSON objects, Jira Data Center as strings like
+//
+//	com.atlassian.greenhopper.service.sprint.Sprint@1b8e4b4[id=1,rapidViewId=2,state=CLOSED,name=Sprint 1,goal=,startDate=2016-10-01T10:00:00.000+02:00,...]
+//
+// Both are parsed into Sprints. The state is returned in lower case, like by the SprintService.
+func ParseSprintField(value interface{}) ([]Sprint, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		sprint, err := parseSprintString(v)
+		if err != nil {
+			return nil, err
+		}
+		return []Sprint{*sprint}, nil
+	case []string:
+		values := make([]interface{}, len(v))
+		for i, s := range v {
+			values[i] = s
+		}
+		return ParseSprintField(values)
+	case []interface{}:
+		sprints := make([]Sprint, 0, len(v))
+		for _, item := range v {
+			s, err := ParseSprintField(item)
+			if err != nil {
+				return nil, err
+			}
+			sprints = append(sprints, s...)
+		}
+		return sprints, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var sprint struct {
+		Sprint
+		BoardID int `json:"boardId"`
+	}
+	if err := json.Unmarshal(data, &sprint); err != nil {
+		return nil, fmt.Errorf("jira: can not parse sprint %s: %w", data, err)
+	}
+	if sprint.OriginBoardID == 0 {
+		sprint.OriginBoardID = sprint.BoardID
+	}
+	sprint.State = strings.ToLower(sprint.State)
+	return []Sprint{sprint.Sprint}, nil
+}
+
+// parseSprintString parses a sprint serialized by Jira Data Center.
+func parseSprintString(s string) (*Sprint, error) {
+	start, end := strings.IndexByte(s, '['), strings.LastIndexByte(s, ']')
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("jira: can not parse sprint %q", s)
+	}
+	s = s[start+1 : end]
+
+	values := map[string]string{}
+	matches := sprintFieldKeyRe.FindAllStringSubmatchIndex(s, -1)
+	for i, m := range matches {
+		valueEnd := len(s)
+		if i+1 < len(matches) {
+			valueEnd = matches[i+1][0]
+		}
+		if value := s[m[1]:valueEnd]; value != "<null>" {
+			values[s[m[2]:m[3]]] = value
+		}
+	}
+
+	sprint := &Sprint{
+		Name:  values["name"],
+		State: strings.ToLower(values["state"]),
+		Goal:  values["goal"],
+	}
+	var err error
+	if sprint.ID, err = atoiSprintValue(values, "id"); err != nil {
+		return nil, err
+	}
+	if sprint.OriginBoardID, err = atoiSprintValue(values, "rapidViewId"); err != nil {
+		return nil, err
+	}
+	for key, t := range map[string]**time.Time{
+		"startDate":    &sprint.StartDate,
+		"endDate":      &sprint.EndDate,
+		"completeDate": &sprint.CompleteDate,
+	} {
+		value, ok := values[key]
+		if !ok || value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, fmt.Errorf("jira: can not parse %s of sprint: %w", key, err)
+		}
+		*t = &parsed
+	}
+	return sprint, nil
+}
+
+func atoiSprintValue(values map[string]string, key string) (int, error) {
+	value, ok := values[key]
+	if !ok || value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("jira: can not parse %s of sprint: %w", key, err)
+	}
+	return n, nil
+}
+
+// Sprints returns the sprints of the sprint custom field with the ID, e.g. "customfield_10020".
+// It returns ErrCustomFieldNotSet if the issue is in no sprint.
+func (i *IssueFields) Sprints(fieldID string) ([]Sprint, error) {
+	value, err := i.customField(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSprintField(value)
+}
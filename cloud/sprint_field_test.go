@@ -0,0 +1,55 @@
+package cloud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseSprintField_DataCenter(t *testing.T) {
+	sprints, err := ParseSprintField([]interface{}{
+		"com.atlassian.greenhopper.service.sprint.Sprint@1b8e4b4[id=1,rapidViewId=2,state=CLOSED,name=Sprint 1, the first,goal=Ship it, finally,startDate=2016-10-01T10:00:00.000+02:00,endDate=2016-10-15T10:00:00.000+02:00,completeDate=<null>,activatedDate=<null>,sequence=1,autoStartStop=false]",
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(sprints) != 1 {
+		t.Fatalf("Expected 1 sprint, got %d", len(sprints))
+	}
+
+	s := sprints[0]
+	if s.ID != 1 || s.OriginBoardID != 2 || s.State != "closed" || s.Name != "Sprint 1, the first" || s.Goal != "Ship it, finally" {
+		t.Errorf("Unexpected sprint %+v", s)
+	}
+	if s.StartDate == nil || !s.StartDate.Equal(time.Date(2016, 10, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected start date %v", s.StartDate)
+	}
+	if s.CompleteDate != nil {
+		t.Errorf("Expected no complete date, got %v", s.CompleteDate)
+	}
+}
+
+func TestIssueFields_Sprints_Cloud(t *testing.T) {
+	fields := new(IssueFields)
+	err := json.Unmarshal([]byte(`{"customfield_10020":[{"id":37,"name":"Sprint 5","state":"active","boardId":4,"goal":"","startDate":"2023-06-01T08:00:00.000Z","endDate":"2023-06-15T08:00:00.000Z"}]}`), fields)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	sprints, err := fields.Sprints("customfield_10020")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(sprints) != 1 || sprints[0].ID != 37 || sprints[0].OriginBoardID != 4 || sprints[0].State != "active" || sprints[0].EndDate == nil {
+		t.Errorf("Unexpected sprints %+v", sprints)
+	}
+}
+
+func TestParseSprintField_Invalid(t *testing.T) {
+	if _, err := ParseSprintField("not a sprint"); err == nil {
+		t.Error("Expected an error")
+	}
+	if _, err := ParseSprintField("com.atlassian.greenhopper.service.sprint.Sprint@1[id=x]"); err == nil {
+		t.Error("Expected an error for an invalid ID")
+	}
+}
@@ -29,7 +29,7 @@ type Status struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *StatusService) GetAllStatuses(ctx context.Context) ([]Status, *Response, error) {
-	apiEndpoint := "rest/api/2/status"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "status")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 
 	if err != nil {
@@ -3,7 +3,6 @@ package cloud
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net/http"
 )
 
@@ -37,7 +36,7 @@ const (
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-workflow-status-categories/#api-rest-api-3-statuscategory-get
 func (s *StatusCategoryService) GetList(ctx context.Context) ([]StatusCategory, *Response, error) {
-	apiEndpoint := "/rest/api/3/statuscategory"
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "statuscategory")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -63,7 +62,7 @@ func (s *StatusCategoryService) Get(ctx context.Context, statusCategoryID string
 		return nil, nil, errors.New("no status category id set")
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/3/statuscategory/%v", statusCategoryID)
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "statuscategory/%v", statusCategoryID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,131 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TaskService handles long-running asynchronous tasks for the Jira instance / API.
+// Tasks are started e.g. by deleting projects, bulk operations, workflow migrations and reindexing.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/
+type TaskService service
+
+// Task statuses, see Task.Status.
+const (
+	TaskStatusEnqueued        = "ENQUEUED"
+	TaskStatusRunning         = "RUNNING"
+	TaskStatusComplete        = "COMPLETE"
+	TaskStatusFailed          = "FAILED"
+	TaskStatusCancelRequested = "CANCEL_REQUESTED"
+	TaskStatusCancelled       = "CANCELLED"
+	TaskStatusDead            = "DEAD"
+)
+
+// ErrTaskFailed is returned by Client.WaitForTask if the task failed, was cancelled or died.
+var ErrTaskFailed = errors.New("jira: task did not complete")
+
+// Task represents the progress of a long-running asynchronous task.
+// The times are milliseconds since the epoch.
+type Task struct {
+	Self           string      `json:"self,omitempty" structs:"self,omitempty"`
+	ID             string      `json:"id,omitempty" structs:"id,omitempty"`
+	Description    string      `json:"description,omitempty" structs:"description,omitempty"`
+	Status         string      `json:"status,omitempty" structs:"status,omitempty"`
+	Message        string      `json:"message,omitempty" structs:"message,omitempty"`
+	Result         interface{} `json:"result,omitempty" structs:"result,omitempty"`
+	SubmittedBy    int64       `json:"submittedBy,omitempty" structs:"submittedBy,omitempty"`
+	Progress       int64       `json:"progress" structs:"progress"`
+	ElapsedRuntime int64       `json:"elapsedRuntime,omitempty" structs:"elapsedRuntime,omitempty"`
+	Submitted      int64       `json:"submitted,omitempty" structs:"submitted,omitempty"`
+	Started        int64       `json:"started,omitempty" structs:"started,omitempty"`
+	Finished       int64       `json:"finished,omitempty" structs:"finished,omitempty"`
+	LastUpdate     int64       `json:"lastUpdate,omitempty" structs:"lastUpdate,omitempty"`
+}
+
+// Done reports whether the task has finished, successfully or not.
+func (t *Task) Done() bool {
+	switch t.Status {
+	case TaskStatusComplete, TaskStatusFailed, TaskStatusCancelled, TaskStatusDead:
+		return true
+	}
+	return false
+}
+
+// Get returns the status of a long-running asynchronous task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-get
+func (s *TaskService) Get(ctx context.Context, taskID string) (*Task, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "task/%s", taskID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(Task)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return task, resp, nil
+}
+
+// Cancel requests the cancellation of a long-running asynchronous task.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-tasks/#api-rest-api-2-task-taskid-cancel-post
+// Caller must close resp.Body
+func (s *TaskService) Cancel(ctx context.Context, taskID string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "task/%s/cancel", taskID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// maxTaskPollInterval caps the growing poll interval of WaitForTask.
+const maxTaskPollInterval = time.Minute
+
+// WaitForTask polls the task until it is done.
+// The poll interval starts at pollInterval and doubles after every poll up to 1 minute.
+// pollInterval will default to 1s if 0.
+//
+// The task is returned once it completed. If the task failed, was cancelled or died,
+// the task is returned with an error wrapping ErrTaskFailed.
+// WaitForTask stops with the error of ctx if ctx is done, the task keeps running in Jira.
+func (c *Client) WaitForTask(ctx context.Context, taskID string, pollInterval time.Duration) (*Task, error) {
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
+	for {
+		task, _, err := c.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if task.Done() {
+			if task.Status != TaskStatusComplete {
+				return task, fmt.Errorf("%w: task %s is %s: %s", ErrTaskFailed, taskID, task.Status, task.Message)
+			}
+			return task, nil
+		}
+
+		if err := sleep(ctx, pollInterval); err != nil {
+			return task, err
+		}
+		pollInterval *= 2
+		if pollInterval > maxTaskPollInterval {
+			pollInterval = maxTaskPollInterval
+		}
+	}
+}
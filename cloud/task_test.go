@@ -0,0 +1,96 @@
+package cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestTaskService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/task/1")
+		fmt.Fprint(w, `{"self":"https://your-domain.atlassian.net/rest/api/2/task/1","id":"1","description":"Task description","status":"COMPLETE","result":"the task result, this may be any JSON","submittedBy":10000,"progress":100,"elapsedRuntime":156,"submitted":1501708132800,"started":1501708132900,"finished":1501708133000,"lastUpdate":1501708133000}`)
+	})
+
+	task, _, err := testClient.Task.Get(context.Background(), "1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if task == nil || task.Status != TaskStatusComplete || task.Progress != 100 || !task.Done() {
+		t.Errorf("Unexpected task %+v", task)
+	}
+}
+
+func TestTaskService_Cancel(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/1/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	if _, err := testClient.Task.Cancel(context.Background(), "1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestClient_WaitForTask(t *testing.T) {
+	setup()
+	defer teardown()
+
+	polls := 0
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := TaskStatusRunning
+		if polls == 3 {
+			status = TaskStatusComplete
+		}
+		fmt.Fprintf(w, `{"id":"1","status":"%s","progress":%d}`, status, polls*33)
+	})
+
+	task, err := testClient.WaitForTask(context.Background(), "1", time.Millisecond)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if task.Status != TaskStatusComplete || polls != 3 {
+		t.Errorf("Expected the task to complete after 3 polls, got %s after %d", task.Status, polls)
+	}
+}
+
+func TestClient_WaitForTask_Failed(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"1","status":"FAILED","message":"Reindex failed"}`)
+	})
+
+	task, err := testClient.WaitForTask(context.Background(), "1", time.Millisecond)
+	if !errors.Is(err, ErrTaskFailed) {
+		t.Errorf("Expected ErrTaskFailed, got %v", err)
+	}
+	if task == nil || task.Message != "Reindex failed" {
+		t.Errorf("Expected the failed task, got %+v", task)
+	}
+}
+
+func TestClient_WaitForTask_ContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/task/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":"1","status":"RUNNING"}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := testClient.WaitForTask(ctx, "1", 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+}
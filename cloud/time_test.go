@@ -0,0 +1,74 @@
+package cloud
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	want := time.Date(2018, 6, 20, 13, 50, 35, 0, time.UTC)
+	for _, in := range []string{
+		`"2018-06-20T16:50:35.000+0300"`,
+		`"2018-06-20T16:50:35+0300"`,
+		`"2018-06-20T16:50:35.000+03:00"`,
+		`"2018-06-20T13:50:35Z"`,
+		`1529502635000`,
+	} {
+		var got Time
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Errorf("Error given for %s: %s", in, err)
+			continue
+		}
+		if !time.Time(got).Equal(want) {
+			t.Errorf("Unmarshal %s = %v, want %v", in, time.Time(got), want)
+		}
+	}
+
+	for _, in := range []string{`null`, `""`} {
+		got := Time(want)
+		if err := json.Unmarshal([]byte(in), &got); err != nil || !got.IsZero() {
+			t.Errorf("Expected zero time for %s, got %v, %v", in, got, err)
+		}
+	}
+
+	var got Time
+	if err := json.Unmarshal([]byte(`"yesterday"`), &got); err == nil {
+		t.Error("Expected an error for an invalid time")
+	}
+}
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Date     Date  `json:"date"`
+		DateTime Date  `json:"dateTime"`
+		Null     *Date `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"date":"2023-06-30","dateTime":"2023-06-30T00:00:00.000+0000","null":null}`), &v)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !v.Date.Equal(*NewDate(2023, time.June, 30)) || !v.DateTime.Equal(*NewDate(2023, time.June, 30)) || v.Null != nil {
+		t.Errorf("Unexpected dates %+v", v)
+	}
+}
+
+func TestTime_MarshalJSON_Zero(t *testing.T) {
+	data, _ := json.Marshal(struct {
+		Time Time `json:"time"`
+		Date Date `json:"date"`
+	}{})
+	if string(data) != `{"time":null,"date":null}` {
+		t.Errorf("Expected zero values to be null, got %s", data)
+	}
+}
+
+func TestComment_UnmarshalJSON_Times(t *testing.T) {
+	var c Comment
+	if err := json.Unmarshal([]byte(`{"id":"1","created":"2016-03-16T04:22:37.356+0000","updated":"2016-03-16T04:22:37.356+0000"}`), &c); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if c.Created == nil || c.Created.String() != "2016-03-16T04:22:37.356+0000" || c.Updated == nil {
+		t.Errorf("Unexpected comment times %v, %v", c.Created, c.Updated)
+	}
+}
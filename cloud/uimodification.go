@@ -0,0 +1,132 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// UIModificationService handles UI modifications for the Jira instance / API.
+// UI modifications are only available to Forge apps with the "jira:uiModifications" module.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-ui-modifications-apps-/#api-group-ui-modifications--apps-
+type UIModificationService service
+
+// UIModification represents a UI modification and the contexts it is applied to.
+type UIModification struct {
+	ID          string                  `json:"id,omitempty" structs:"id,omitempty"`
+	Self        string                  `json:"self,omitempty" structs:"self,omitempty"`
+	Name        string                  `json:"name,omitempty" structs:"name,omitempty"`
+	Description string                  `json:"description,omitempty" structs:"description,omitempty"`
+	Data        string                  `json:"data,omitempty" structs:"data,omitempty"`
+	Contexts    []UIModificationContext `json:"contexts,omitempty" structs:"contexts,omitempty"`
+}
+
+// UIModificationContext is the context in which a UI modification is applied.
+// ViewType can take the values GIC (global issue create), IssueView and IssueTransition.
+type UIModificationContext struct {
+	ID          string `json:"id,omitempty" structs:"id,omitempty"`
+	ProjectID   string `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	IssueTypeID string `json:"issueTypeId,omitempty" structs:"issueTypeId,omitempty"`
+	ViewType    string `json:"viewType,omitempty" structs:"viewType,omitempty"`
+	IsAvailable *bool  `json:"isAvailable,omitempty" structs:"isAvailable,omitempty"`
+}
+
+// UIModificationList is a page of UI modifications.
+type UIModificationList struct {
+	Self       string           `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage   string           `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	MaxResults int              `json:"maxResults" structs:"maxResults"`
+	StartAt    int              `json:"startAt" structs:"startAt"`
+	Total      int              `json:"total" structs:"total"`
+	IsLast     bool             `json:"isLast" structs:"isLast"`
+	Values     []UIModification `json:"values" structs:"values"`
+}
+
+// UIModificationListOptions specifies the optional parameters to the UIModificationService.GetList
+type UIModificationListOptions struct {
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+	// Expand can be used to include the "data" and "contexts" of the UI modifications.
+	Expand string `url:"expand,omitempty"`
+}
+
+// GetList returns a paginated list of UI modifications created by the calling app.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-ui-modifications-apps-/#api-rest-api-3-uimodifications-get
+func (s *UIModificationService) GetList(ctx context.Context, options *UIModificationListOptions) (*UIModificationList, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "uiModifications"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := new(UIModificationList)
+	resp, err := s.client.Do(req, list)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return list, resp, nil
+}
+
+// Create creates a UI modification.
+// The response only contains the ID and the self link of the new UI modification.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-ui-modifications-apps-/#api-rest-api-3-uimodifications-post
+func (s *UIModificationService) Create(ctx context.Context, modification *UIModification) (*UIModification, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "uiModifications")
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, modification)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseModification := new(UIModification)
+	resp, err := s.client.Do(req, responseModification)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseModification, resp, nil
+}
+
+// Update updates the UI modification with the given ID.
+// If Contexts is set, the existing contexts are replaced by the given ones.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-ui-modifications-apps-/#api-rest-api-3-uimodifications-uimodificationid-put
+// Caller must close resp.Body
+func (s *UIModificationService) Update(ctx context.Context, modificationID string, modification *UIModification) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "uiModifications/%s", modificationID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, modification)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Delete deletes the UI modification with the given ID, including all of its contexts.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-ui-modifications-apps-/#api-rest-api-3-uimodifications-uimodificationid-delete
+// Caller must close resp.Body
+func (s *UIModificationService) Delete(ctx context.Context, modificationID string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "uiModifications/%s", modificationID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,108 @@
+package cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUIModificationService_GetList(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/uiModifications"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"expand": "data,contexts", "maxResults": "50"})
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{"id":"d7dbda8a-6239-4b63-8e13-a5ef975c8e61","name":"Reveal Story Points","description":"Reveals Story Points field when any Sprint is selected.","self":"https://api.atlassian.com/ex/jira/{cloudid}/rest/api/2/uiModifications/d7dbda8a-6239-4b63-8e13-a5ef975c8e61","data":"{field: 'Story Points', config: {hidden: false}}","contexts":[{"id":"1533537a-bda3-4ac6-8481-846128cd9ef4","projectId":"10000","issueTypeId":"10000","viewType":"GIC","isAvailable":true}]}]}`)
+	})
+
+	list, _, err := testClient.UIModification.GetList(context.Background(), &UIModificationListOptions{
+		MaxResults: 50,
+		Expand:     "data,contexts",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if list == nil {
+		t.Fatal("Expected UI modification list. List is nil")
+	}
+	if len(list.Values) != 1 {
+		t.Fatalf("Expected 1 UI modification. Got %d", len(list.Values))
+	}
+	if got := list.Values[0].Contexts[0].ViewType; got != "GIC" {
+		t.Errorf("Expected view type GIC. Got %s", got)
+	}
+}
+
+func TestUIModificationService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/uiModifications"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload UIModification
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Name != "Reveal Story Points" || len(payload.Contexts) != 1 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"d7dbda8a-6239-4b63-8e13-a5ef975c8e61","self":"https://api.atlassian.com/ex/jira/{cloudid}/rest/api/2/uiModifications/d7dbda8a-6239-4b63-8e13-a5ef975c8e61"}`)
+	})
+
+	modification, _, err := testClient.UIModification.Create(context.Background(), &UIModification{
+		Name: "Reveal Story Points",
+		Contexts: []UIModificationContext{
+			{ProjectID: "10000", IssueTypeID: "10000", ViewType: "GIC"},
+		},
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if modification == nil || modification.ID != "d7dbda8a-6239-4b63-8e13-a5ef975c8e61" {
+		t.Errorf("Unexpected UI modification: %+v", modification)
+	}
+}
+
+func TestUIModificationService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/uiModifications/d7dbda8a-6239-4b63-8e13-a5ef975c8e61"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.UIModification.Update(context.Background(), "d7dbda8a-6239-4b63-8e13-a5ef975c8e61", &UIModification{Name: "Updated"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUIModificationService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/uiModifications/d7dbda8a-6239-4b63-8e13-a5ef975c8e61"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.UIModification.Delete(context.Background(), "d7dbda8a-6239-4b63-8e13-a5ef975c8e61")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
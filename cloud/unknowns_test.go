@@ -0,0 +1,67 @@
+package cloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProject_Unknowns_RoundTrip(t *testing.T) {
+	in := `{"id":"10000","key":"EX","name":"Example","simplified":true,"style":"next-gen","insight":{"totalIssueCount":5}}`
+
+	var project Project
+	if err := json.Unmarshal([]byte(in), &project); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Key != "EX" || len(project.Unknowns) != 3 || project.Unknowns["style"] != "next-gen" {
+		t.Errorf("Unexpected project %+v", project)
+	}
+
+	out, err := json.Marshal(project)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	var got, want map[string]interface{}
+	json.Unmarshal(out, &got)
+	json.Unmarshal([]byte(in), &want)
+	for key, value := range want {
+		if _, ok := got[key]; !ok {
+			t.Errorf("Expected %s=%v to be sent again, got %s", key, value, out)
+		}
+	}
+}
+
+func TestUnknowns_KnownFieldsTakePrecedence(t *testing.T) {
+	v := Version{Name: "1.0", Unknowns: map[string]interface{}{"name": "old", "overdue": true}}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if string(out) != `{"name":"1.0","overdue":true}` {
+		t.Errorf("Unexpected JSON %s", out)
+	}
+}
+
+func TestUnknowns_None(t *testing.T) {
+	var c Comment
+	if err := json.Unmarshal([]byte(`{"id":"1","body":"text"}`), &c); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if c.Unknowns != nil {
+		t.Errorf("Expected no unknowns, got %v", c.Unknowns)
+	}
+
+	var u *User
+	if err := json.Unmarshal([]byte(`null`), &u); err != nil || u != nil {
+		t.Errorf("Expected a nil user, got %v, %v", u, err)
+	}
+}
+
+func TestIssue_Unknowns(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"key":"EX-1","fields":{"summary":"s"},"versionedRepresentations":{"summary":{"1":"s"}}}`), &issue); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, ok := issue.Unknowns["versionedRepresentations"]; !ok || issue.Fields.Summary != "s" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -28,12 +31,37 @@ type User struct {
 	Locale           string           `json:"locale,omitempty" structs:"locale,omitempty"`
 	Groups           UserGroups       `json:"groups,omitempty" structs:"groups,omitempty"`
 	ApplicationRoles ApplicationRoles `json:"applicationRoles,omitempty" structs:"applicationRoles,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the User struct.
+// It adds the Unknowns to the encoded fields.
+func (u User) MarshalJSON() ([]byte, error) {
+	type Alias User
+	return marshalWithUnknowns(Alias(u), u.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the User struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type Alias User
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(u))
+	if err != nil {
+		return err
+	}
+	u.Unknowns = unknowns
+	return nil
 }
 
 // UserGroup represents the group list
 type UserGroup struct {
 	Self string `json:"self,omitempty" structs:"self,omitempty"`
 	Name string `json:"name,omitempty" structs:"name,omitempty"`
+	// GroupID is the ID of the group, which doesn't change when the group is renamed.
+	GroupID string `json:"groupId,omitempty" structs:"groupId,omitempty"`
 }
 
 // Groups is a wrapper for UserGroup
@@ -83,7 +111,7 @@ type UserSearchF func(UserSearch) UserSearch
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) Get(ctx context.Context, accountId string) (*User, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountId)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user?accountId=%s", accountId)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -105,7 +133,7 @@ func (s *UserService) Get(ctx context.Context, accountId string) (*User, *Respon
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) GetByAccountID(ctx context.Context, accountID string) (*User, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user?accountId=%s", accountID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -126,7 +154,7 @@ func (s *UserService) GetByAccountID(ctx context.Context, accountID string) (*Us
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) Create(ctx context.Context, user *User) (*User, *Response, error) {
-	apiEndpoint := "/rest/api/2/user"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, user)
 	if err != nil {
 		return nil, nil, err
@@ -156,7 +184,7 @@ func (s *UserService) Create(ctx context.Context, user *User) (*User, *Response,
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *UserService) Delete(ctx context.Context, accountId string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", accountId)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user?accountId=%s", accountId)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
 	if err != nil {
 		return nil, err
@@ -169,14 +197,12 @@ func (s *UserService) Delete(ctx context.Context, accountId string) (*Response,
 	return resp, nil
 }
 
-// GetGroups returns the groups which the user belongs to
-//
-// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-groups-get
+// GetGroups returns the groups which the user with the account ID belongs to, directly or through nested groups,
+// e.g. to review the access of a user without walking the members of every group.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-groups-get
 func (s *UserService) GetGroups(ctx context.Context, accountId string) (*[]UserGroup, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/groups?accountId=%s", accountId)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user/groups?accountId=%s", url.QueryEscape(accountId))
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -194,7 +220,7 @@ func (s *UserService) GetGroups(ctx context.Context, accountId string) (*[]UserG
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-myself-get
 func (s *UserService) GetCurrentUser(ctx context.Context) (*User, *Response, error) {
-	const apiEndpoint = "rest/api/3/myself"
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "myself")
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -288,7 +314,7 @@ func (s *UserService) Find(ctx context.Context, property string, tweaks ...UserS
 		queryString += param.name + "=" + param.value + "&"
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", queryString[:len(queryString)-1])
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user/search?%s", queryString[:len(queryString)-1])
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -301,3 +327,113 @@ func (s *UserService) Find(ctx context.Context, property string, tweaks ...UserS
 	}
 	return users, resp, nil
 }
+
+// FindAll returns the users of all pages of a search, see Find.
+// WithStartAt and WithMaxResults are ignored, the page size and a limit of users can be set with pager.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/#api-rest-api-2-user-search-get
+func (s *UserService) FindAll(ctx context.Context, property string, pager *PagerOptions, tweaks ...UserSearchF) ([]User, error) {
+	return s.findPager(property, pager, tweaks...).All(ctx)
+}
+
+// findPager returns a Pager over the users of a search.
+func (s *UserService) findPager(property string, pager *PagerOptions, tweaks ...UserSearchF) *Pager[User] {
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[User], *Response, error) {
+		// Jira uses the first occurrence of a query parameter, so the paging parameters take precedence.
+		paging := []UserSearchF{WithStartAt(page.StartAt)}
+		if page.MaxResults != 0 {
+			paging = append(paging, WithMaxResults(page.MaxResults))
+		}
+		users, resp, err := s.Find(ctx, property, append(paging, tweaks...)...)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[User]{Values: users}, resp, nil
+	}, pager)
+}
+
+// UserAndGroupPickerOptions specifies the optional parameters to the UserService.FindUsersAndGroups
+type UserAndGroupPickerOptions struct {
+	// Query is the search string. Required.
+	Query      string `url:"query"`
+	MaxResults int    `url:"maxResults,omitempty"`
+	ShowAvatar bool   `url:"showAvatar,omitempty"`
+	// FieldID, ProjectID and IssueTypeID limit the results to users and groups
+	// that can be selected in the given custom field context.
+	FieldID              string   `url:"fieldId,omitempty"`
+	ProjectID            []string `url:"projectId,omitempty"`
+	IssueTypeID          []string `url:"issueTypeId,omitempty"`
+	AvatarSize           string   `url:"avatarSize,omitempty"`
+	CaseInsensitive      bool     `url:"caseInsensitive,omitempty"`
+	ExcludeConnectAddons bool     `url:"excludeConnectAddons,omitempty"`
+}
+
+// UsersAndGroups is the result of the combined user and group picker.
+type UsersAndGroups struct {
+	Users  *UserPickerResult  `json:"users,omitempty" structs:"users,omitempty"`
+	Groups *GroupPickerResult `json:"groups,omitempty" structs:"groups,omitempty"`
+}
+
+// UserPickerResult is the list of users found by the picker.
+type UserPickerResult struct {
+	Header string            `json:"header,omitempty" structs:"header,omitempty"`
+	Total  int               `json:"total" structs:"total"`
+	Users  []UserPickerMatch `json:"users,omitempty" structs:"users,omitempty"`
+}
+
+// UserPickerMatch is a user found by the picker.
+// HTML contains the display name with the matched query highlighted.
+type UserPickerMatch struct {
+	AccountID   string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	AccountType string `json:"accountType,omitempty" structs:"accountType,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	AvatarURL   string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+	HTML        string `json:"html,omitempty" structs:"html,omitempty"`
+}
+
+// GroupPickerResult is the list of groups found by the picker.
+type GroupPickerResult struct {
+	Header string             `json:"header,omitempty" structs:"header,omitempty"`
+	Total  int                `json:"total" structs:"total"`
+	Groups []GroupPickerMatch `json:"groups,omitempty" structs:"groups,omitempty"`
+}
+
+// GroupPickerMatch is a group found by the picker.
+// HTML contains the group name with the matched query highlighted.
+type GroupPickerMatch struct {
+	GroupID string             `json:"groupId,omitempty" structs:"groupId,omitempty"`
+	Name    string             `json:"name,omitempty" structs:"name,omitempty"`
+	HTML    string             `json:"html,omitempty" structs:"html,omitempty"`
+	Labels  []GroupPickerLabel `json:"labels,omitempty" structs:"labels,omitempty"`
+}
+
+// GroupPickerLabel is a label shown next to a group in the picker.
+// Type can take the values ADMIN, SINGLE and MULTIPLE.
+type GroupPickerLabel struct {
+	Text  string `json:"text,omitempty" structs:"text,omitempty"`
+	Title string `json:"title,omitempty" structs:"title,omitempty"`
+	Type  string `json:"type,omitempty" structs:"type,omitempty"`
+}
+
+// FindUsersAndGroups returns a list of users and groups matching a string.
+// It is meant to power pickers that let a user select either a user or a group, like @-mentions.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-group-and-user-picker/#api-rest-api-3-groupuserpicker-get
+func (s *UserService) FindUsersAndGroups(ctx context.Context, options *UserAndGroupPickerOptions) (*UsersAndGroups, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion3, "groupuserpicker"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(UsersAndGroups)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
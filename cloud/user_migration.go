@@ -0,0 +1,216 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/google/go-querystring/query"
+)
+
+// UserMigration maps the username or key of a user, removed from the APIs of Jira Cloud for privacy reasons,
+// to the account ID of the user.
+type UserMigration struct {
+	Username  string `json:"username,omitempty"`
+	Key       string `json:"key,omitempty"`
+	AccountID string `json:"accountId"`
+}
+
+// UserMigrationOptions are the users looked up with GetAccountIDs, by username or key.
+type UserMigrationOptions struct {
+	Usernames  []string `url:"username,omitempty"`
+	Keys       []string `url:"key,omitempty"`
+	StartAt    int      `url:"startAt,omitempty"`
+	MaxResults int      `url:"maxResults,omitempty"`
+}
+
+// GetAccountIDs returns the account IDs of the users with the usernames or keys of the options.
+// Users which don't exist are left out.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-users/#api-rest-api-2-user-bulk-migration-get
+func (s *UserService) GetAccountIDs(ctx context.Context, options *UserMigrationOptions) ([]UserMigration, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "user/bulk/migration")
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, nil, err
+		}
+		apiEndpoint += "?" + q.Encode()
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var migrations []UserMigration
+	resp, err := s.client.Do(req, &migrations)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return migrations, resp, nil
+}
+
+// JQLConversion is the result of ConvertJQL.
+type JQLConversion struct {
+	// QueryStrings are the converted queries, in the order of the queries passed to ConvertJQL.
+	QueryStrings []string `json:"queryStrings"`
+	// QueriesWithUnknownUsers are the queries referencing users which don't exist.
+	QueriesWithUnknownUsers []JQLQueryWithUnknownUsers `json:"queriesWithUnknownUsers"`
+}
+
+// JQLQueryWithUnknownUsers is a query of a JQLConversion referencing users which don't exist.
+type JQLQueryWithUnknownUsers struct {
+	OriginalQuery  string `json:"originalQuery"`
+	ConvertedQuery string `json:"convertedQuery"`
+}
+
+// ConvertJQL converts the usernames and user keys in the JQL queries to account IDs,
+// e.g. "assignee = jsmith" to "assignee = 5b10a2844c20165700ede21g".
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-jql/#api-rest-api-2-jql-pdcleaner-post
+func (s *UserService) ConvertJQL(ctx context.Context, queries []string) (*JQLConversion, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "jql/pdcleaner")
+	body := struct {
+		QueryStrings []string `json:"queryStrings"`
+	}{queries}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The conversion doesn't change anything, so it is retried like a GET request.
+	allowRetry(req)
+
+	conversion := new(JQLConversion)
+	resp, err := s.client.Do(req, conversion)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return conversion, resp, nil
+}
+
+// accountIDBatchSize is the number of users looked up per request by the AccountIDResolver.
+const accountIDBatchSize = 50
+
+// AccountIDResolver resolves the usernames and keys of users to their account IDs,
+// e.g. to migrate configuration and scripts written before Jira Cloud removed them from its APIs.
+// The users are looked up in batches with UserService.GetAccountIDs and the results are cached,
+// including the users which don't exist, for the lifetime of the resolver.
+//
+// If a username is not found, the resolver searches for it with UserService.Find,
+// which finds the user if the username is the email address of the user.
+// The result of the search is only used if it is a single user with that email address.
+//
+// An AccountIDResolver is safe for concurrent use.
+type AccountIDResolver struct {
+	client *Client
+
+	mu         sync.Mutex
+	byUsername map[string]string
+	byKey      map[string]string
+}
+
+// NewAccountIDResolver returns an AccountIDResolver for the users of the Jira instance of client.
+func NewAccountIDResolver(client *Client) *AccountIDResolver {
+	return &AccountIDResolver{
+		client:     client,
+		byUsername: map[string]string{},
+		byKey:      map[string]string{},
+	}
+}
+
+// ResolveUsernames returns the account IDs of the users with the usernames, by username.
+// Users which don't exist are left out.
+func (r *AccountIDResolver) ResolveUsernames(ctx context.Context, usernames []string) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	missing := r.missing(r.byUsername, usernames)
+	for start := 0; start < len(missing); start += accountIDBatchSize {
+		batch := missing[start:min(start+accountIDBatchSize, len(missing))]
+		migrations, _, err := r.client.User.GetAccountIDs(ctx, &UserMigrationOptions{Usernames: batch, MaxResults: len(batch)})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range migrations {
+			r.byUsername[m.Username] = m.AccountID
+		}
+		for _, username := range batch {
+			if _, ok := r.byUsername[username]; ok {
+				continue
+			}
+			accountID, err := r.search(ctx, username)
+			if err != nil {
+				return nil, err
+			}
+			r.byUsername[username] = accountID
+		}
+	}
+	return resolved(r.byUsername, usernames), nil
+}
+
+// ResolveKeys returns the account IDs of the users with the user keys, by key.
+// Users which don't exist are left out.
+func (r *AccountIDResolver) ResolveKeys(ctx context.Context, keys []string) (map[string]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	missing := r.missing(r.byKey, keys)
+	for start := 0; start < len(missing); start += accountIDBatchSize {
+		batch := missing[start:min(start+accountIDBatchSize, len(missing))]
+		migrations, _, err := r.client.User.GetAccountIDs(ctx, &UserMigrationOptions{Keys: batch, MaxResults: len(batch)})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range migrations {
+			r.byKey[m.Key] = m.AccountID
+		}
+		for _, key := range batch {
+			if _, ok := r.byKey[key]; !ok {
+				r.byKey[key] = ""
+			}
+		}
+	}
+	return resolved(r.byKey, keys), nil
+}
+
+// missing returns the distinct names which are not cached yet.
+func (r *AccountIDResolver) missing(cache map[string]string, names []string) []string {
+	var missing []string
+	seen := map[string]bool{}
+	for _, name := range names {
+		if _, ok := cache[name]; ok || seen[name] || name == "" {
+			continue
+		}
+		seen[name] = true
+		missing = append(missing, name)
+	}
+	return missing
+}
+
+// search returns the account ID of the single user with the email address username, "" if there is none.
+func (r *AccountIDResolver) search(ctx context.Context, username string) (string, error) {
+	if !strings.Contains(username, "@") {
+		return "", nil
+	}
+	users, _, err := r.client.User.Find(ctx, url.QueryEscape(username), WithMaxResults(2))
+	if err != nil {
+		return "", err
+	}
+	if len(users) != 1 || !strings.EqualFold(users[0].EmailAddress, username) {
+		return "", nil
+	}
+	return users[0].AccountID, nil
+}
+
+// resolved returns the cached account IDs of the names, leaving out the users which don't exist.
+func resolved(cache map[string]string, names []string) map[string]string {
+	result := make(map[string]string, len(names))
+	for _, name := range names {
+		if accountID := cache[name]; accountID != "" {
+			result[name] = accountID
+		}
+	}
+	return result
+}
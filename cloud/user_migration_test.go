@@ -0,0 +1,117 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestUserService_GetAccountIDs(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/bulk/migration?maxResults=2&username=jsmith&username=jdoe")
+
+		fmt.Fprint(w, `[{"username":"jsmith","accountId":"5b10a2844c20165700ede21g"}]`)
+	})
+
+	migrations, _, err := testClient.User.GetAccountIDs(context.Background(), &UserMigrationOptions{Usernames: []string{"jsmith", "jdoe"}, MaxResults: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := []UserMigration{{Username: "jsmith", AccountID: "5b10a2844c20165700ede21g"}}
+	if !reflect.DeepEqual(migrations, want) {
+		t.Errorf("Expected %+v, got %+v", want, migrations)
+	}
+}
+
+func TestUserService_ConvertJQL(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/jql/pdcleaner", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"queryStrings":["assignee = jsmith","reporter = nobody"]}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected body\n got: %s\nwant: %s", body, want)
+		}
+
+		fmt.Fprint(w, `{"queryStrings":["assignee = 5b10a2844c20165700ede21g","reporter = nobody"],
+			"queriesWithUnknownUsers":[{"originalQuery":"reporter = nobody","convertedQuery":"reporter = unknown"}]}`)
+	})
+
+	conversion, _, err := testClient.User.ConvertJQL(context.Background(), []string{"assignee = jsmith", "reporter = nobody"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := &JQLConversion{
+		QueryStrings:            []string{"assignee = 5b10a2844c20165700ede21g", "reporter = nobody"},
+		QueriesWithUnknownUsers: []JQLQueryWithUnknownUsers{{OriginalQuery: "reporter = nobody", ConvertedQuery: "reporter = unknown"}},
+	}
+	if !reflect.DeepEqual(conversion, want) {
+		t.Errorf("Expected %+v, got %+v", want, conversion)
+	}
+}
+
+func TestAccountIDResolver_ResolveUsernames(t *testing.T) {
+	setup()
+	defer teardown()
+	var lookups, searches int
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		lookups++
+		testRequestURL(t, r, "/rest/api/2/user/bulk/migration?maxResults=3&username=jsmith&username=jane%40example.com&username=gone")
+
+		fmt.Fprint(w, `[{"username":"jsmith","accountId":"id-jsmith"}]`)
+	})
+	testMux.HandleFunc("/rest/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		testRequestURL(t, r, "/rest/api/2/user/search?query=jane%40example.com&maxResults=2")
+
+		fmt.Fprint(w, `[{"accountId":"id-jane","emailAddress":"Jane@example.com"}]`)
+	})
+
+	resolver := NewAccountIDResolver(testClient)
+	want := map[string]string{"jsmith": "id-jsmith", "jane@example.com": "id-jane"}
+	for i := 0; i < 2; i++ {
+		got, err := resolver.ResolveUsernames(context.Background(), []string{"jsmith", "jane@example.com", "gone", "jsmith"})
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+	if lookups != 1 || searches != 1 {
+		t.Errorf("Expected the users to be looked up once, got %d lookups and %d searches", lookups, searches)
+	}
+}
+
+func TestAccountIDResolver_ResolveKeys_Batches(t *testing.T) {
+	setup()
+	defer teardown()
+	var batches []int
+	testMux.HandleFunc("/rest/api/2/user/bulk/migration", func(w http.ResponseWriter, r *http.Request) {
+		keys := r.URL.Query()["key"]
+		batches = append(batches, len(keys))
+		fmt.Fprintf(w, `[{"key":%q,"accountId":"id-first"}]`, keys[0])
+	})
+
+	keys := make([]string, accountIDBatchSize+1)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key%d", i)
+	}
+	got, err := NewAccountIDResolver(testClient).ResolveKeys(context.Background(), keys)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []int{accountIDBatchSize, 1}; !reflect.DeepEqual(batches, want) {
+		t.Errorf("Expected batches of %v, got %v", want, batches)
+	}
+	want := map[string]string{"key0": "id-first", fmt.Sprintf("key%d", accountIDBatchSize): "id-first"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
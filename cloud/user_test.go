@@ -191,6 +191,25 @@ func TestUserService_GetGroups(t *testing.T) {
 	}
 }
 
+func TestUserService_GetGroups_GroupID(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/groups", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/groups?accountId=557058%3Af58131cb-b67d-43c7-b30d-6b58d40bd077")
+
+		fmt.Fprint(w, `[{"name":"jira-administrators","groupId":"276f955c-63d7-42c8-9520-92d01dca0625"}]`)
+	})
+
+	groups, _, err := testClient.User.GetGroups(context.Background(), "557058:f58131cb-b67d-43c7-b30d-6b58d40bd077")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*groups) != 1 || (*groups)[0].GroupID != "276f955c-63d7-42c8-9520-92d01dca0625" {
+		t.Errorf("Unexpected groups %+v", *groups)
+	}
+}
+
 func TestUserService_GetCurrentUser(t *testing.T) {
 	setup()
 	defer teardown()
@@ -282,3 +301,30 @@ func TestUserService_Find_SuccessParams(t *testing.T) {
 		t.Error("Expected user. User is nil")
 	}
 }
+
+func TestUserService_FindUsersAndGroups(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/groupuserpicker"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"query": "mia", "maxResults": "10"})
+		fmt.Fprint(w, `{"groups":{"groups":[{"html":"<b>mia</b>-administrators","name":"mia-administrators","groupId":"276f955c-63d7-42c8-9520-92d01dca0625","labels":[{"text":"Admin","title":"Users added to this group will be given administrative access.","type":"ADMIN"}]}],"header":"Showing 1 of 1 matching groups","total":1},"users":{"header":"Showing 1 of 1 matching users","total":1,"users":[{"accountId":"5b10a2844c20165700ede21g","accountType":"atlassian","avatarUrl":"","displayName":"Mia Krystof","html":"<strong>Mia</strong> Krystof - <strong>mia</strong>@example.com"}]}}`)
+	})
+
+	result, _, err := testClient.User.FindUsersAndGroups(context.Background(), &UserAndGroupPickerOptions{Query: "mia", MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if result == nil || result.Users == nil || result.Groups == nil {
+		t.Fatalf("Unexpected result: %+v", result)
+	}
+	if len(result.Users.Users) != 1 || result.Users.Users[0].AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected users: %+v", result.Users)
+	}
+	if len(result.Groups.Groups) != 1 || result.Groups.Groups[0].Labels[0].Type != "ADMIN" {
+		t.Errorf("Unexpected groups: %+v", result.Groups)
+	}
+}
@@ -3,8 +3,9 @@ package cloud
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
+
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // VersionService handles Versions for the Jira instance / API.
@@ -20,10 +21,33 @@ type Version struct {
 	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty" structs:"startDate,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Version struct.
+// It adds the Unknowns to the encoded fields.
+func (v Version) MarshalJSON() ([]byte, error) {
+	type Alias Version
+	return marshalWithUnknowns(Alias(v), v.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Version struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	type Alias Version
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(v))
+	if err != nil {
+		return err
+	}
+	v.Unknowns = unknowns
+	return nil
 }
 
 // Get gets version info from Jira
@@ -33,7 +57,7 @@ type Version struct {
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *VersionService) Get(ctx context.Context, versionID int) (*Version, *Response, error) {
-	apiEndpoint := fmt.Sprintf("/rest/api/2/version/%v", versionID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "version/%v", versionID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -54,7 +78,7 @@ func (s *VersionService) Get(ctx context.Context, versionID int) (*Version, *Res
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *VersionService) Create(ctx context.Context, version *Version) (*Version, *Response, error) {
-	apiEndpoint := "/rest/api/2/version"
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "version")
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
@@ -83,7 +107,7 @@ func (s *VersionService) Create(ctx context.Context, version *Version) (*Version
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *VersionService) Update(ctx context.Context, version *Version) (*Version, *Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/api/2/version/%v", version.ID)
+	apiEndpoint := s.client.restAPIPath(APIVersion2, "version/%v", version.ID)
 	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, version)
 	if err != nil {
 		return nil, nil, err
@@ -99,3 +123,91 @@ func (s *VersionService) Update(ctx context.Context, version *Version) (*Version
 	ret := *version
 	return &ret, resp, nil
 }
+
+// VersionRelatedWork is a related work item of a version, like a design document or a communication plan.
+type VersionRelatedWork struct {
+	// Category is required, e.g. "Design", "Communication" or "Documentation".
+	Category      string `json:"category,omitempty" structs:"category,omitempty"`
+	IssueID       int64  `json:"issueId,omitempty" structs:"issueId,omitempty"`
+	RelatedWorkID string `json:"relatedWorkId,omitempty" structs:"relatedWorkId,omitempty"`
+	Title         string `json:"title,omitempty" structs:"title,omitempty"`
+	URL           string `json:"url,omitempty" structs:"url,omitempty"`
+}
+
+// GetRelatedWork returns related work items for the given version ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-get
+func (s *VersionService) GetRelatedWork(ctx context.Context, versionID string) ([]VersionRelatedWork, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "version/%s/relatedwork", versionID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	relatedWork := []VersionRelatedWork{}
+	resp, err := s.client.Do(req, &relatedWork)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return relatedWork, resp, nil
+}
+
+// CreateRelatedWork creates a related work item for the given version.
+// A URL is required unless the category is "Native release notes".
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-post
+func (s *VersionService) CreateRelatedWork(ctx context.Context, versionID string, relatedWork *VersionRelatedWork) (*VersionRelatedWork, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "version/%s/relatedwork", versionID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, relatedWork)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseRelatedWork := new(VersionRelatedWork)
+	resp, err := s.client.Do(req, responseRelatedWork)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseRelatedWork, resp, nil
+}
+
+// UpdateRelatedWork updates a related work item of the given version.
+// The RelatedWorkID identifies the item to update.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-id-relatedwork-put
+func (s *VersionService) UpdateRelatedWork(ctx context.Context, versionID string, relatedWork *VersionRelatedWork) (*VersionRelatedWork, *Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "version/%s/relatedwork", versionID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, relatedWork)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseRelatedWork := new(VersionRelatedWork)
+	resp, err := s.client.Do(req, responseRelatedWork)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseRelatedWork, resp, nil
+}
+
+// DeleteRelatedWork deletes a related work item of the given version.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-project-versions/#api-rest-api-3-version-versionid-relatedwork-relatedworkid-delete
+// Caller must close resp.Body
+func (s *VersionService) DeleteRelatedWork(ctx context.Context, versionID, relatedWorkID string) (*Response, error) {
+	apiEndpoint := s.client.restAPIPath(APIVersion3, "version/%s/relatedwork/%s", versionID, relatedWorkID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
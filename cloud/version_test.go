@@ -2,9 +2,11 @@ package cloud
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestVersionService_Get_Success(t *testing.T) {
@@ -64,9 +66,9 @@ func TestVersionService_Create(t *testing.T) {
 		ProjectID:       10000,
 		Released:        Bool(true),
 		Archived:        Bool(false),
-		ReleaseDate:     "2010-07-06",
+		ReleaseDate:     NewDate(2010, time.July, 6),
 		UserReleaseDate: "6/Jul/2010",
-		StartDate:       "2018-07-01",
+		StartDate:       NewDate(2018, time.July, 1),
 	}
 
 	version, _, err := testClient.Version.Create(context.Background(), v)
@@ -111,3 +113,98 @@ func TestServiceService_Update(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestVersionService_GetRelatedWork(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/version/10000/relatedwork"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"category":"Design","relatedWorkId":"fabcdef6-7878-1234-beaf-43211234abcd","title":"Design link","url":"https://www.atlassian.com"},{"category":"Bug fix","issueId":10001,"relatedWorkId":"12345678-1234-1234-1234-123456789012","url":"https://your-domain.atlassian.net/browse/PROJ-123"}]`)
+	})
+
+	relatedWork, _, err := testClient.Version.GetRelatedWork(context.Background(), "10000")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(relatedWork) != 2 || relatedWork[1].IssueID != 10001 {
+		t.Errorf("Unexpected related work: %+v", relatedWork)
+	}
+}
+
+func TestVersionService_CreateRelatedWork(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/version/10000/relatedwork"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload VersionRelatedWork
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload.Category != "Design" || payload.URL != "https://www.atlassian.com" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"category":"Design","relatedWorkId":"fabcdef6-7878-1234-beaf-43211234abcd","title":"Design link","url":"https://www.atlassian.com"}`)
+	})
+
+	relatedWork, _, err := testClient.Version.CreateRelatedWork(context.Background(), "10000", &VersionRelatedWork{
+		Category: "Design",
+		Title:    "Design link",
+		URL:      "https://www.atlassian.com",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if relatedWork == nil || relatedWork.RelatedWorkID != "fabcdef6-7878-1234-beaf-43211234abcd" {
+		t.Errorf("Unexpected related work: %+v", relatedWork)
+	}
+}
+
+func TestVersionService_UpdateRelatedWork(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/version/10000/relatedwork"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"category":"Design","relatedWorkId":"fabcdef6-7878-1234-beaf-43211234abcd","title":"Updated design link","url":"https://www.atlassian.com"}`)
+	})
+
+	relatedWork, _, err := testClient.Version.UpdateRelatedWork(context.Background(), "10000", &VersionRelatedWork{
+		Category:      "Design",
+		RelatedWorkID: "fabcdef6-7878-1234-beaf-43211234abcd",
+		Title:         "Updated design link",
+		URL:           "https://www.atlassian.com",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if relatedWork == nil || relatedWork.Title != "Updated design link" {
+		t.Errorf("Unexpected related work: %+v", relatedWork)
+	}
+}
+
+func TestVersionService_DeleteRelatedWork(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/3/version/10000/relatedwork/fabcdef6-7878-1234-beaf-43211234abcd"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Version.DeleteRelatedWork(context.Background(), "10000", "fabcdef6-7878-1234-beaf-43211234abcd")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,71 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The types of Visibility.
+const (
+	VisibilityTypeGroup = "group"
+	VisibilityTypeRole  = "role"
+)
+
+// Visibility restricts a comment or worklog to the members of a group or a project role.
+// An empty Visibility doesn't restrict it, everybody who can see the issue can see it.
+//
+// Use VisibilityGroup, VisibilityGroupID or VisibilityRole to create a valid Visibility.
+type Visibility struct {
+	// Type is VisibilityTypeGroup or VisibilityTypeRole.
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	// Value is the name of the group or project role.
+	Value string `json:"value,omitempty" structs:"value,omitempty"`
+	// Identifier is the ID of the group, which is preferred over its name, as groups can be renamed.
+	Identifier string `json:"identifier,omitempty" structs:"identifier,omitempty"`
+}
+
+// VisibilityGroup restricts a comment or worklog to the members of the group with the name.
+func VisibilityGroup(name string) Visibility {
+	return Visibility{Type: VisibilityTypeGroup, Value: name}
+}
+
+// VisibilityGroupID restricts a comment or worklog to the members of the group with the ID.
+func VisibilityGroupID(groupID string) Visibility {
+	return Visibility{Type: VisibilityTypeGroup, Identifier: groupID}
+}
+
+// VisibilityRole restricts a comment or worklog to the members of the project role with the name, e.g. "Administrators".
+func VisibilityRole(name string) Visibility {
+	return Visibility{Type: VisibilityTypeRole, Value: name}
+}
+
+// IsRestricted reports whether v restricts the comment or worklog.
+func (v Visibility) IsRestricted() bool {
+	return v != Visibility{}
+}
+
+// Validate returns an error if v restricts the comment or worklog but is incomplete,
+// e.g. a role without its name, which Jira may ignore and make the comment or worklog public.
+// An empty Visibility is valid.
+func (v Visibility) Validate() error {
+	switch {
+	case !v.IsRestricted():
+		return nil
+	case v.Type == VisibilityTypeGroup:
+		if v.Value == "" && v.Identifier == "" {
+			return errors.New("jira: visibility of type group requires the name or ID of the group")
+		}
+	case v.Type == VisibilityTypeRole:
+		if v.Value == "" {
+			return errors.New("jira: visibility of type role requires the name of the role")
+		}
+		if v.Identifier != "" {
+			return errors.New("jira: visibility of type role doesn't support an identifier")
+		}
+	case v.Type == "":
+		return fmt.Errorf("jira: visibility requires a type, %s or %s", VisibilityTypeGroup, VisibilityTypeRole)
+	default:
+		return fmt.Errorf("jira: unknown visibility type %q", v.Type)
+	}
+	return nil
+}
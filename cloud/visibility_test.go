@@ -0,0 +1,93 @@
+package cloud
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestVisibility_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility Visibility
+		valid      bool
+	}{
+		{"public", Visibility{}, true},
+		{"group by name", VisibilityGroup("jira-administrators"), true},
+		{"group by ID", VisibilityGroupID("276f955c-63d7-42c8-9520-92d01dca0625"), true},
+		{"role", VisibilityRole("Administrators"), true},
+		{"group without name or ID", Visibility{Type: VisibilityTypeGroup}, false},
+		{"role without name", Visibility{Type: VisibilityTypeRole}, false},
+		{"role with identifier", Visibility{Type: VisibilityTypeRole, Value: "Administrators", Identifier: "10002"}, false},
+		{"value without type", Visibility{Value: "Administrators"}, false},
+		{"unknown type", Visibility{Type: "user", Value: "fred"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.visibility.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Expected %+v to be valid, got %s", tt.visibility, err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("Expected %+v to be invalid", tt.visibility)
+			}
+		})
+	}
+}
+
+func TestIssueService_AddComment_InvalidVisibility(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be sent for an invalid visibility")
+	})
+
+	c := &Comment{Body: "Internal note", Visibility: Visibility{Type: VisibilityTypeRole}}
+	if _, _, err := testClient.Issue.AddComment(context.Background(), "10000", c); err == nil {
+		t.Error("Expected an error for a role without name")
+	}
+}
+
+func TestIssueService_UpdateComment_Visibility(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/comment/10001", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"body":"Internal note","visibility":{"type":"group","identifier":"276f955c-63d7-42c8-9520-92d01dca0625"}}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected body\n got: %s\nwant: %s", body, want)
+		}
+		w.Write([]byte(`{"id":"10001"}`))
+	})
+
+	c := &Comment{ID: "10001", Body: "Internal note", Visibility: VisibilityGroupID("276f955c-63d7-42c8-9520-92d01dca0625")}
+	if _, _, err := testClient.Issue.UpdateComment(context.Background(), "10000", c); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_AddWorklogRecord_Visibility(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10000/worklog", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		body, _ := io.ReadAll(r.Body)
+		if want := `{"timeSpentSeconds":3600,"visibility":{"type":"role","value":"Developers"}}` + "\n"; string(body) != want {
+			t.Errorf("Unexpected body\n got: %s\nwant: %s", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"10100"}`))
+	})
+
+	v := VisibilityRole("Developers")
+	record := &WorklogRecord{TimeSpentSeconds: 3600, Visibility: &v}
+	if _, _, err := testClient.Issue.AddWorklogRecord(context.Background(), "10000", record); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+
+	record.Visibility = &Visibility{Type: VisibilityTypeGroup}
+	if _, _, err := testClient.Issue.AddWorklogRecord(context.Background(), "10000", record); err == nil {
+		t.Error("Expected an error for a group without name or ID")
+	}
+}
@@ -0,0 +1,196 @@
+package cloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// WorkflowService handles workflows for the Jira instance / API.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/
+type WorkflowService service
+
+// The expands of WorkflowSearchOptions.Expand.
+const (
+	WorkflowExpandTransitions          = "transitions"
+	WorkflowExpandTransitionRules      = "transitions.rules"
+	WorkflowExpandTransitionProperties = "transitions.properties"
+	WorkflowExpandStatuses             = "statuses"
+	WorkflowExpandStatusProperties     = "statuses.properties"
+	WorkflowExpandDefault              = "default"
+	WorkflowExpandSchemes              = "schemes"
+	WorkflowExpandProjects             = "projects"
+	WorkflowExpandHasDraftWorkflow     = "hasDraftWorkflow"
+	WorkflowExpandOperations           = "operations"
+)
+
+// WorkflowSearchOptions specifies the optional parameters for WorkflowService.Search
+type WorkflowSearchOptions struct {
+	// StartAt: The starting index of the returned workflows. Base index: 0.
+	StartAt int `url:"startAt,omitempty"`
+	// MaxResults: The maximum number of workflows to return per page. Default: 50.
+	MaxResults int `url:"maxResults,omitempty"`
+	// WorkflowNames filters the results by the names of the workflows.
+	WorkflowNames []string `url:"workflowName,omitempty"`
+	// QueryString filters the results by a case insensitive part of the name of the workflows.
+	QueryString string `url:"queryString,omitempty"`
+	// OrderBy orders the results by name, created or updated, optionally prefixed with - for descending order.
+	OrderBy string `url:"orderBy,omitempty"`
+	// IsActive filters the results by whether the workflows are used by a workflow scheme.
+	IsActive *bool `url:"isActive,omitempty"`
+	// Expand are the WorkflowExpand values of the information to include, e.g. WorkflowExpandProjects.
+	Expand []string `url:"expand,omitempty,comma"`
+}
+
+// WorkflowSearchResult is a page of workflows returned by WorkflowService.Search
+type WorkflowSearchResult struct {
+	Self       string     `json:"self,omitempty" structs:"self,omitempty"`
+	NextPage   string     `json:"nextPage,omitempty" structs:"nextPage,omitempty"`
+	MaxResults int        `json:"maxResults" structs:"maxResults"`
+	StartAt    int        `json:"startAt" structs:"startAt"`
+	Total      int        `json:"total" structs:"total"`
+	IsLast     bool       `json:"isLast" structs:"isLast"`
+	Values     []Workflow `json:"values" structs:"values"`
+}
+
+// Workflow represents a workflow of Jira.
+// The transitions, statuses, schemes, projects and operations are only returned if requested with
+// WorkflowSearchOptions.Expand.
+type Workflow struct {
+	ID               WorkflowID           `json:"id" structs:"id"`
+	Description      string               `json:"description,omitempty" structs:"description,omitempty"`
+	Created          string               `json:"created,omitempty" structs:"created,omitempty"`
+	Updated          string               `json:"updated,omitempty" structs:"updated,omitempty"`
+	Transitions      []WorkflowTransition `json:"transitions,omitempty" structs:"transitions,omitempty"`
+	Statuses         []WorkflowStatus     `json:"statuses,omitempty" structs:"statuses,omitempty"`
+	IsDefault        bool                 `json:"isDefault,omitempty" structs:"isDefault,omitempty"`
+	Schemes          []WorkflowSchemeRef  `json:"schemes,omitempty" structs:"schemes,omitempty"`
+	Projects         []WorkflowProject    `json:"projects,omitempty" structs:"projects,omitempty"`
+	HasDraftWorkflow bool                 `json:"hasDraftWorkflow,omitempty" structs:"hasDraftWorkflow,omitempty"`
+	Operations       *WorkflowOperations  `json:"operations,omitempty" structs:"operations,omitempty"`
+}
+
+// WorkflowID identifies a workflow by its name and, for workflows of team-managed projects, its entity ID.
+type WorkflowID struct {
+	Name     string `json:"name" structs:"name"`
+	EntityID string `json:"entityId,omitempty" structs:"entityId,omitempty"`
+}
+
+// WorkflowTransition is a transition of a workflow.
+type WorkflowTransition struct {
+	ID          string `json:"id" structs:"id"`
+	Name        string `json:"name" structs:"name"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	// From are the IDs of the statuses the transition starts at, empty for global and initial transitions.
+	From []string `json:"from,omitempty" structs:"from,omitempty"`
+	// To is the ID of the status the transition ends at.
+	To string `json:"to" structs:"to"`
+	// Type is global, initial or directed.
+	Type   string                  `json:"type" structs:"type"`
+	Screen *WorkflowScreen         `json:"screen,omitempty" structs:"screen,omitempty"`
+	Rules  *WorkflowTransitionRule `json:"rules,omitempty" structs:"rules,omitempty"`
+	// Properties are the properties of the transition, returned with WorkflowExpandTransitionProperties.
+	Properties map[string]interface{} `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowScreen is the screen shown for a transition.
+type WorkflowScreen struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// WorkflowTransitionRule are the conditions, validators and post functions of a transition.
+type WorkflowTransitionRule struct {
+	Conditions     []WorkflowRule         `json:"conditions,omitempty" structs:"conditions,omitempty"`
+	ConditionsTree map[string]interface{} `json:"conditionsTree,omitempty" structs:"conditionsTree,omitempty"`
+	Validators     []WorkflowRule         `json:"validators,omitempty" structs:"validators,omitempty"`
+	PostFunctions  []WorkflowRule         `json:"postFunctions,omitempty" structs:"postFunctions,omitempty"`
+}
+
+// WorkflowRule is a condition, validator or post function of a transition.
+type WorkflowRule struct {
+	Type          string                 `json:"type" structs:"type"`
+	Configuration map[string]interface{} `json:"configuration,omitempty" structs:"configuration,omitempty"`
+}
+
+// WorkflowStatus is a status of a workflow.
+type WorkflowStatus struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+	// Properties are the properties of the status, returned with WorkflowExpandStatusProperties.
+	Properties map[string]interface{} `json:"properties,omitempty" structs:"properties,omitempty"`
+}
+
+// WorkflowSchemeRef is a workflow scheme using a workflow.
+type WorkflowSchemeRef struct {
+	ID   string `json:"id" structs:"id"`
+	Name string `json:"name" structs:"name"`
+}
+
+// WorkflowProject is a project using a workflow.
+type WorkflowProject struct {
+	ID             string `json:"id" structs:"id"`
+	Key            string `json:"key" structs:"key"`
+	Name           string `json:"name" structs:"name"`
+	ProjectTypeKey string `json:"projectTypeKey,omitempty" structs:"projectTypeKey,omitempty"`
+	Simplified     bool   `json:"simplified,omitempty" structs:"simplified,omitempty"`
+}
+
+// WorkflowOperations are the operations the user can perform on a workflow.
+type WorkflowOperations struct {
+	CanEdit   bool `json:"canEdit" structs:"canEdit"`
+	CanDelete bool `json:"canDelete" structs:"canDelete"`
+}
+
+// Search returns a page of the workflows, e.g. with the projects and schemes using them:
+//
+//	result, _, err := client.Workflow.Search(ctx, &WorkflowSearchOptions{
+//		Expand: []string{WorkflowExpandProjects, WorkflowExpandSchemes},
+//	})
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/#api-rest-api-2-workflow-search-get
+func (s *WorkflowService) Search(ctx context.Context, options *WorkflowSearchOptions) (*WorkflowSearchResult, *Response, error) {
+	apiEndpoint, err := addOptions(s.client.restAPIPath(APIVersion2, "workflow/search"), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(WorkflowSearchResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return result, resp, nil
+}
+
+// SearchAll returns the workflows of all pages.
+// options.StartAt is ignored, the page size and a limit of workflows can be set with pager.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-workflows/#api-rest-api-2-workflow-search-get
+func (s *WorkflowService) SearchAll(ctx context.Context, options *WorkflowSearchOptions, pager *PagerOptions) ([]Workflow, error) {
+	return s.searchPager(options, pager).All(ctx)
+}
+
+// searchPager returns a Pager over the workflows.
+func (s *WorkflowService) searchPager(options *WorkflowSearchOptions, pager *PagerOptions) *Pager[Workflow] {
+	var opts WorkflowSearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return NewPager(func(ctx context.Context, page PageRequest) (*Page[Workflow], *Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		result, resp, err := s.Search(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &Page[Workflow]{Values: result.Values, Total: result.Total, IsLast: result.IsLast}, resp, nil
+	}, pager)
+}
@@ -0,0 +1,73 @@
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestWorkflowService_Search(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/workflow/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/workflow/search?expand=transitions%2Cstatuses%2Cprojects%2Cschemes&isActive=true&workflowName=Software+Simplified+Workflow")
+		fmt.Fprint(w, `{"maxResults":50,"startAt":0,"total":1,"isLast":true,"values":[{
+			"id":{"name":"Software Simplified Workflow","entityId":"5ed312c5-f7a6-4a78-a1f6-8ff7f307d063"},
+			"description":"Generated by Jira Software",
+			"transitions":[{"id":"11","name":"To Do","from":[],"to":"10000","type":"global"},{"id":"21","name":"Start","from":["10000"],"to":"3","type":"directed","screen":{"id":"10001"}}],
+			"statuses":[{"id":"10000","name":"To Do"},{"id":"3","name":"In Progress"}],
+			"schemes":[{"id":"10002","name":"EX: Software Simplified Workflow Scheme"}],
+			"projects":[{"id":"10000","key":"EX","name":"Example","projectTypeKey":"software","simplified":false}]
+		}]}`)
+	})
+
+	active := true
+	options := &WorkflowSearchOptions{
+		WorkflowNames: []string{"Software Simplified Workflow"},
+		IsActive:      &active,
+		Expand:        []string{WorkflowExpandTransitions, WorkflowExpandStatuses, WorkflowExpandProjects, WorkflowExpandSchemes},
+	}
+	result, _, err := testClient.Workflow.Search(context.Background(), options)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(result.Values) != 1 {
+		t.Fatalf("Expected 1 workflow, got %d", len(result.Values))
+	}
+	workflow := result.Values[0]
+	if workflow.ID.Name != "Software Simplified Workflow" || workflow.ID.EntityID == "" {
+		t.Errorf("Unexpected workflow ID %+v", workflow.ID)
+	}
+	if len(workflow.Transitions) != 2 || workflow.Transitions[1].From[0] != "10000" || workflow.Transitions[1].Screen.ID != "10001" {
+		t.Errorf("Unexpected transitions %+v", workflow.Transitions)
+	}
+	if len(workflow.Statuses) != 2 || len(workflow.Schemes) != 1 || len(workflow.Projects) != 1 || workflow.Projects[0].Key != "EX" {
+		t.Errorf("Unexpected workflow %+v", workflow)
+	}
+}
+
+func TestWorkflowService_SearchAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/workflow/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		switch r.URL.RawQuery {
+		case "maxResults=1":
+			fmt.Fprint(w, `{"maxResults":1,"startAt":0,"total":2,"isLast":false,"values":[{"id":{"name":"jira"}}]}`)
+		case "maxResults=1&startAt=1":
+			fmt.Fprint(w, `{"maxResults":1,"startAt":1,"total":2,"isLast":true,"values":[{"id":{"name":"Bug Workflow"}}]}`)
+		default:
+			t.Errorf("Unexpected URL: %v", r.URL)
+		}
+	})
+
+	workflows, err := testClient.Workflow.SearchAll(context.Background(), nil, &PagerOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(workflows) != 2 || workflows[1].ID.Name != "Bug Workflow" {
+		t.Errorf("Expected workflows jira and Bug Workflow, got %+v", workflows)
+	}
+}
@@ -0,0 +1,268 @@
+// Package fakejira provides an in-memory fake of the Jira services of the cloud client,
+// so end-to-end tests of code using the interfaces of jira.API run hermetically:
+//
+//	func TestTriage(t *testing.T) {
+//		fake := fakejira.New()
+//		fake.AddProject(jira.Project{ID: "10000", Key: "EX", Name: "Example"})
+//		fake.AddIssue(jira.Issue{Fields: &jira.IssueFields{
+//			Project: jira.Project{Key: "EX"},
+//			Summary: "Login fails",
+//		}})
+//
+//		err := triage(context.Background(), fake.API())
+//
+//		issue, _ := fake.Issue("EX-1")
+//		if issue.Fields.Status.Name != "In Progress" {
+//			t.Errorf("Expected EX-1 to be in progress, got %s", issue.Fields.Status.Name)
+//		}
+//	}
+//
+// The fake keeps projects, issues and comments in memory. Its issue and search services
+// support getting, creating, editing and deleting issues, comments, assignees, transitions
+// and searches with the JQL subset of Compile and pagination. Its project service supports
+// listing, getting and searching projects. Calling other methods of these services, or
+// services of the API other than Issue, Search and Project, panics.
+//
+// Errors are returned like the client returns them: a *jira.Error with the status code of
+// the response a Jira instance would have returned.
+package fakejira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// defaultMaxResults is the page size of searches without MaxResults.
+const defaultMaxResults = 50
+
+// defaultTransitions are the transitions of the simplified workflow of new fakes.
+var defaultTransitions = []jira.Transition{
+	{ID: "11", Name: "To Do", To: jira.Status{ID: "1", Name: "To Do", StatusCategory: jira.StatusCategory{ID: 2, Key: jira.StatusCategoryToDo, Name: "To Do"}}},
+	{ID: "21", Name: "In Progress", To: jira.Status{ID: "3", Name: "In Progress", StatusCategory: jira.StatusCategory{ID: 4, Key: jira.StatusCategoryInProgress, Name: "In Progress"}}},
+	{ID: "31", Name: "Done", To: jira.Status{ID: "10001", Name: "Done", StatusCategory: jira.StatusCategory{ID: 3, Key: jira.StatusCategoryComplete, Name: "Done"}}},
+}
+
+// Jira is an in-memory fake of a Jira instance. It is safe for concurrent use.
+type Jira struct {
+	// Now returns the time of created and updated timestamps.
+	// It will default to time.Now if nil.
+	Now func() time.Time
+
+	mu          sync.Mutex
+	projects    []jira.Project
+	issues      []*jira.Issue
+	comments    map[string][]*jira.Comment
+	transitions []jira.Transition
+	nextID      int
+}
+
+// New returns an empty fake with the transitions "To Do", "In Progress" and "Done".
+func New() *Jira {
+	return &Jira{
+		comments:    map[string][]*jira.Comment{},
+		transitions: append([]jira.Transition(nil), defaultTransitions...),
+		nextID:      10000,
+	}
+}
+
+// API returns the services of the fake.
+func (f *Jira) API() *jira.API {
+	issues := &issueService{fake: f}
+	return &jira.API{
+		Issue:   issues,
+		Search:  issues,
+		Project: &projectService{fake: f},
+	}
+}
+
+// AddProject adds the project, replacing a project with the same key.
+// The ID is generated if it is empty.
+func (f *Jira) AddProject(project jira.Project) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if project.ID == "" {
+		project.ID = f.newID()
+	}
+	for i, existing := range f.projects {
+		if strings.EqualFold(existing.Key, project.Key) {
+			f.projects[i] = project
+			return
+		}
+	}
+	f.projects = append(f.projects, project)
+}
+
+// AddIssue adds the issue, replacing an issue with the same key, and returns a copy of it.
+// The ID and key are generated if they are empty, the key from the project of the issue.
+// New issues get the status of the first transition.
+func (f *Jira) AddIssue(issue jira.Issue) *jira.Issue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	issue = *copyIssue(&issue)
+	return copyIssue(f.addIssue(&issue))
+}
+
+func (f *Jira) addIssue(issue *jira.Issue) *jira.Issue {
+	if issue.Fields == nil {
+		issue.Fields = &jira.IssueFields{}
+	}
+	if issue.ID == "" {
+		issue.ID = f.newID()
+	}
+	if issue.Key == "" {
+		issue.Key = f.nextKey(issue.Fields.Project.Key)
+	}
+	if issue.Fields.Status == nil && len(f.transitions) > 0 {
+		status := f.transitions[0].To
+		issue.Fields.Status = &status
+	}
+	now := jira.Time(f.now())
+	if time.Time(issue.Fields.Created).IsZero() {
+		issue.Fields.Created = now
+	}
+	if time.Time(issue.Fields.Updated).IsZero() {
+		issue.Fields.Updated = now
+	}
+
+	for i, existing := range f.issues {
+		if existing.Key == issue.Key {
+			f.issues[i] = issue
+			return issue
+		}
+	}
+	f.issues = append(f.issues, issue)
+	return issue
+}
+
+// Issue returns a copy of the issue with the ID or key.
+func (f *Jira) Issue(idOrKey string) (*jira.Issue, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	issue := f.issue(idOrKey)
+	if issue == nil {
+		return nil, false
+	}
+	return copyIssue(issue), true
+}
+
+// Issues returns copies of all issues in the order they were added.
+func (f *Jira) Issues() []jira.Issue {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	issues := make([]jira.Issue, 0, len(f.issues))
+	for _, issue := range f.issues {
+		issues = append(issues, *copyIssue(issue))
+	}
+	return issues
+}
+
+// Comments returns copies of the comments of the issue with the ID or key.
+func (f *Jira) Comments(idOrKey string) []jira.Comment {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	issue := f.issue(idOrKey)
+	if issue == nil {
+		return nil
+	}
+	var comments []jira.Comment
+	for _, comment := range f.comments[issue.ID] {
+		comments = append(comments, *comment)
+	}
+	return comments
+}
+
+// SetTransitions replaces the transitions available for all issues.
+// New issues get the status of the first transition.
+func (f *Jira) SetTransitions(transitions []jira.Transition) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.transitions = append([]jira.Transition(nil), transitions...)
+}
+
+func (f *Jira) issue(idOrKey string) *jira.Issue {
+	for _, issue := range f.issues {
+		if issue.ID == idOrKey || strings.EqualFold(issue.Key, idOrKey) {
+			return issue
+		}
+	}
+	return nil
+}
+
+func (f *Jira) project(idOrKey string) *jira.Project {
+	for i := range f.projects {
+		if f.projects[i].ID == idOrKey || strings.EqualFold(f.projects[i].Key, idOrKey) {
+			return &f.projects[i]
+		}
+	}
+	return nil
+}
+
+func (f *Jira) newID() string {
+	f.nextID++
+	return strconv.Itoa(f.nextID)
+}
+
+// nextKey returns the next free issue key of the project.
+func (f *Jira) nextKey(projectKey string) string {
+	last := 0
+	for _, issue := range f.issues {
+		if n, ok := strings.CutPrefix(issue.Key, projectKey+"-"); ok {
+			if i, err := strconv.Atoi(n); err == nil && i > last {
+				last = i
+			}
+		}
+	}
+	return fmt.Sprintf("%s-%d", projectKey, last+1)
+}
+
+func (f *Jira) now() time.Time {
+	if f.Now != nil {
+		return f.Now()
+	}
+	return time.Now()
+}
+
+// copyIssue returns a deep copy of issue.
+func copyIssue(issue *jira.Issue) *jira.Issue {
+	data, _ := json.Marshal(issue)
+	c := new(jira.Issue)
+	_ = json.Unmarshal(data, c)
+	return c
+}
+
+// response returns a response with the status code.
+func response(code int) *jira.Response {
+	return &jira.Response{Response: &http.Response{
+		Status:     fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+	}}
+}
+
+// errorResponse returns a response with the status code and the error a client would
+// return for it, with the error message in the format of Jira.
+func errorResponse(code int, method, path string, format string, args ...interface{}) (*jira.Response, error) {
+	return errorsResponse(code, method, path, []string{fmt.Sprintf(format, args...)}, nil)
+}
+
+// errorsResponse is like errorResponse with error messages and field errors.
+func errorsResponse(code int, method, path string, messages []string, errs map[string]string) (*jira.Response, error) {
+	if errs == nil {
+		errs = map[string]string{}
+	}
+	return response(code), &jira.Error{
+		HTTPError:     &jira.ResponseError{Method: method, URL: "/rest/api/2/" + path, StatusCode: code},
+		ErrorMessages: messages,
+		Errors:        errs,
+		StatusCode:    code,
+	}
+}
@@ -0,0 +1,216 @@
+package fakejira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func newTestFake() *Jira {
+	fake := New()
+	fake.AddProject(jira.Project{ID: "10000", Key: "EX", Name: "Example"})
+	fake.AddProject(jira.Project{ID: "10001", Key: "OPS", Name: "Operations"})
+	return fake
+}
+
+func TestJira_Issues(t *testing.T) {
+	fake := newTestFake()
+	api := fake.API()
+	ctx := context.Background()
+
+	created, resp, err := api.Issue.Create(ctx, &jira.Issue{Fields: &jira.IssueFields{
+		Project: jira.Project{Key: "EX"},
+		Type:    jira.IssueType{Name: "Bug"},
+		Summary: "Login fails",
+	}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created.Key != "EX-1" || resp.StatusCode != http.StatusCreated {
+		t.Errorf("Expected EX-1 to be created, got %s with %d", created.Key, resp.StatusCode)
+	}
+
+	payload := jira.NewIssueUpdate().Summary("Login fails on Safari").AddLabels("auth", "safari").Build()
+	if _, err := api.Issue.UpdatePayload(ctx, "EX-1", payload, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	payload = jira.NewIssueUpdate().RemoveLabels("safari").AddComment("Reproduced").Build()
+	if _, err := api.Issue.UpdatePayload(ctx, "EX-1", payload, nil); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, err := api.Issue.UpdateAssignee(ctx, "EX-1", &jira.User{AccountID: "5b10a2844c20165700ede21g"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	issue, _, err := api.Issue.Get(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Login fails on Safari" || len(issue.Fields.Labels) != 1 || issue.Fields.Labels[0] != "auth" {
+		t.Errorf("Unexpected fields %+v", issue.Fields)
+	}
+	if issue.Fields.Status.Name != "To Do" || issue.Fields.Assignee.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected status %v or assignee %v", issue.Fields.Status, issue.Fields.Assignee)
+	}
+	if comments := fake.Comments("EX-1"); len(comments) != 1 || comments[0].Body != "Reproduced" {
+		t.Errorf("Unexpected comments %v", comments)
+	}
+
+	_, resp, err = api.Issue.Create(ctx, &jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: "NOPE"}}})
+	var jerr *jira.Error
+	if !errors.As(err, &jerr) || resp.StatusCode != http.StatusBadRequest || jerr.Errors["project"] == "" {
+		t.Errorf("Expected a bad request, got %v", err)
+	}
+
+	if _, err := api.Issue.Delete(ctx, "EX-1"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	_, resp, err = api.Issue.Get(ctx, "EX-1", nil)
+	if !errors.Is(err, jira.ErrNotFound) || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
+
+func TestJira_Comments(t *testing.T) {
+	fake := newTestFake()
+	fake.AddIssue(jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: "EX"}, Summary: "Login fails"}})
+	api := fake.API()
+	ctx := context.Background()
+
+	comment, _, err := api.Issue.AddComment(ctx, "EX-1", &jira.Comment{Body: "First"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if _, _, err := api.Issue.UpdateComment(ctx, "EX-1", &jira.Comment{ID: comment.ID, Body: "Edited"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	comments, _, err := api.Issue.GetComments(ctx, "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if comments.Total != 1 || comments.Comments[0].Body != "Edited" {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+
+	if err := api.Issue.DeleteComment(ctx, "EX-1", comment.ID); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if err := api.Issue.DeleteComment(ctx, "EX-1", comment.ID); !errors.Is(err, jira.ErrNotFound) {
+		t.Errorf("Expected not found, got %v", err)
+	}
+	if _, _, err := api.Issue.AddComment(ctx, "EX-1", &jira.Comment{}); err == nil {
+		t.Error("Expected an error for an empty comment")
+	}
+}
+
+func TestJira_Transitions(t *testing.T) {
+	fake := newTestFake()
+	fake.AddIssue(jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: "EX"}, Summary: "Login fails"}})
+	api := fake.API()
+	ctx := context.Background()
+
+	transitions, _, err := api.Issue.GetTransitions(ctx, "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(transitions) != 3 {
+		t.Fatalf("Expected 3 transitions, got %d", len(transitions))
+	}
+	if _, err := api.Issue.DoTransitionWithPayload(ctx, "EX-1", jira.CreateTransitionPayload{
+		Transition: jira.TransitionPayload{ID: "31"},
+		Fields:     jira.TransitionPayloadFields{Resolution: &jira.Resolution{Name: "Fixed"}},
+	}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	issue, _ := fake.Issue("EX-1")
+	if issue.Fields.Status.Name != "Done" || issue.Fields.Resolution.Name != "Fixed" {
+		t.Errorf("Expected EX-1 to be done and fixed, got %v", issue.Fields.Status)
+	}
+	if _, err := api.Issue.DoTransition(ctx, "EX-1", "99"); err == nil {
+		t.Error("Expected an error for an unknown transition")
+	}
+}
+
+func TestJira_Search(t *testing.T) {
+	fake := newTestFake()
+	for _, project := range []string{"EX", "EX", "OPS", "EX"} {
+		fake.AddIssue(jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: project}, Summary: "Issue of " + project}})
+	}
+	api := fake.API()
+	ctx := context.Background()
+
+	issues, resp, err := api.Search.Search(ctx, "project = EX ORDER BY key DESC", &jira.SearchOptions{StartAt: 1, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-2" || resp.Total != 3 || resp.IsLast {
+		t.Errorf("Unexpected page %v of %d", issues, resp.Total)
+	}
+
+	all, err := api.Search.SearchAll(ctx, "project = EX", nil, &jira.PagerOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Expected 3 issues, got %d", len(all))
+	}
+
+	var keys []string
+	if err := api.Search.SearchPages(ctx, "project = OPS", nil, func(issue jira.Issue) error {
+		keys = append(keys, issue.Key)
+		return nil
+	}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(keys) != 1 || keys[0] != "OPS-1" {
+		t.Errorf("Expected OPS-1, got %v", keys)
+	}
+
+	if _, resp, err := api.Search.Search(ctx, "created >= -1d", nil); err == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a bad request for unsupported JQL, got %v", err)
+	}
+}
+
+func TestJira_Projects(t *testing.T) {
+	api := newTestFake().API()
+	ctx := context.Background()
+
+	projects, _, err := api.Project.GetAll(ctx, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*projects) != 2 {
+		t.Errorf("Expected 2 projects, got %d", len(*projects))
+	}
+
+	result, _, err := api.Project.Search(ctx, &jira.ProjectSearchOptions{Query: "oper"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Total != 1 || result.Values[0].Key != "OPS" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	all, err := api.Project.SearchAll(ctx, &jira.ProjectSearchOptions{OrderBy: "-key"}, &jira.PagerOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(all) != 2 || all[0].Key != "OPS" {
+		t.Errorf("Unexpected projects %v", all)
+	}
+
+	if _, resp, err := api.Project.Get(ctx, "NOPE"); err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
+
+func TestJira_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := newTestFake().API().Issue.Get(ctx, "EX-1", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
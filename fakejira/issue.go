@@ -0,0 +1,434 @@
+package fakejira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// issueService is the fake of the issue and search services.
+type issueService struct {
+	// IssueAPI is nil, so the methods not implemented by the fake panic.
+	jira.IssueAPI
+
+	fake *Jira
+}
+
+var _ jira.IssueAPI = (*issueService)(nil)
+
+func (s *issueService) Get(ctx context.Context, issueID string, options *jira.GetQueryOptions, opts ...jira.RequestOption) (*jira.Issue, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		resp, err := notFound(http.MethodGet, issueID)
+		return nil, resp, err
+	}
+	return copyIssue(issue), response(http.StatusOK), nil
+}
+
+func (s *issueService) Create(ctx context.Context, issue *jira.Issue) (*jira.Issue, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+	return s.create(copyIssue(issue), nil)
+}
+
+func (s *issueService) CreatePayload(ctx context.Context, payload *jira.IssuePayload) (*jira.Issue, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	fields, err := mergeFields(&jira.IssueFields{}, payload.Fields)
+	if err != nil {
+		resp, err := errorResponse(http.StatusBadRequest, http.MethodPost, "issue", "%s", err)
+		return nil, resp, err
+	}
+	return s.create(&jira.Issue{Fields: fields}, payload.Update)
+}
+
+// create validates and adds the issue, then applies the update operations to it.
+func (s *issueService) create(issue *jira.Issue, update map[string][]jira.IssueUpdateOperation) (*jira.Issue, *jira.Response, error) {
+	errs := map[string]string{}
+	var project *jira.Project
+	if issue.Fields != nil {
+		if project = s.fake.project(issue.Fields.Project.Key); project == nil && issue.Fields.Project.ID != "" {
+			project = s.fake.project(issue.Fields.Project.ID)
+		}
+	}
+	if project == nil {
+		errs["project"] = "valid project is required"
+	}
+	if issue.Fields == nil || issue.Fields.Summary == "" {
+		errs["summary"] = "You must specify a summary of the issue."
+	}
+	if issue.Fields == nil || (issue.Fields.Type.Name == "" && issue.Fields.Type.ID == "") {
+		errs["issuetype"] = "Specify an issue type"
+	}
+	if len(errs) > 0 {
+		resp, err := errorsResponse(http.StatusBadRequest, http.MethodPost, "issue", nil, errs)
+		return nil, resp, err
+	}
+
+	issue.ID, issue.Key = "", ""
+	issue.Fields.Project = jira.Project{ID: project.ID, Key: project.Key, Name: project.Name}
+	created := s.fake.addIssue(issue)
+	if err := s.update(created, update); err != nil {
+		resp, err := errorResponse(http.StatusBadRequest, http.MethodPost, "issue", "%s", err)
+		return nil, resp, err
+	}
+	return &jira.Issue{ID: created.ID, Key: created.Key}, response(http.StatusCreated), nil
+}
+
+func (s *issueService) Update(ctx context.Context, issue *jira.Issue, opts *jira.UpdateQueryOptions) (*jira.Issue, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	data, _ := json.Marshal(issue.Fields)
+	fields := map[string]interface{}{}
+	_ = json.Unmarshal(data, &fields)
+
+	resp, err := s.edit(issue.Key, fields, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+	ret := *issue
+	return &ret, resp, nil
+}
+
+func (s *issueService) UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var payload jira.IssuePayload
+	b, _ := json.Marshal(data)
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return errorResponse(http.StatusBadRequest, http.MethodPut, "issue/"+jiraID, "%s", err)
+	}
+	return s.edit(jiraID, payload.Fields, payload.Update)
+}
+
+func (s *issueService) UpdatePayload(ctx context.Context, issueID string, payload *jira.IssuePayload, opts *jira.UpdateQueryOptions) (*jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.edit(issueID, payload.Fields, payload.Update)
+}
+
+// edit sets the fields of the issue and applies the update operations to it.
+func (s *issueService) edit(issueID string, fields map[string]interface{}, update map[string][]jira.IssueUpdateOperation) (*jira.Response, error) {
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		return notFound(http.MethodPut, issueID)
+	}
+	edited, err := mergeFields(issue.Fields, fields)
+	if err == nil {
+		// Apply the operations to a copy, so a failed edit leaves the issue unchanged.
+		c := &jira.Issue{ID: issue.ID, Key: issue.Key, Fields: edited}
+		if err = s.update(c, update); err == nil {
+			c.Fields.Updated = jira.Time(s.fake.now())
+			issue.Fields = c.Fields
+		}
+	}
+	if err != nil {
+		return errorResponse(http.StatusBadRequest, http.MethodPut, "issue/"+issueID, "%s", err)
+	}
+	return response(http.StatusNoContent), nil
+}
+
+// update applies the update operations "add", "remove" and "set" of list fields,
+// like labels and components, and adds the comments of the "comment" operations.
+func (s *issueService) update(issue *jira.Issue, update map[string][]jira.IssueUpdateOperation) error {
+	if len(update) == 0 {
+		return nil
+	}
+	data, _ := json.Marshal(issue.Fields)
+	fields := map[string]interface{}{}
+	_ = json.Unmarshal(data, &fields)
+
+	var comments []string
+	for field, operations := range update {
+		for _, operation := range operations {
+			for verb, value := range operation {
+				if field == "comment" && verb == "add" {
+					body, _ := value.(map[string]interface{})["body"].(string)
+					comments = append(comments, body)
+					continue
+				}
+				values, _ := fields[field].([]interface{})
+				switch verb {
+				case "add":
+					values = append(values, value)
+				case "remove":
+					kept := values[:0]
+					for _, v := range values {
+						if !sameValue(v, value) {
+							kept = append(kept, v)
+						}
+					}
+					values = kept
+				case "set":
+					values, _ = value.([]interface{})
+				default:
+					return fmt.Errorf("fakejira: unsupported update operation %q of %s", verb, field)
+				}
+				fields[field] = values
+			}
+		}
+	}
+
+	edited, err := mergeFields(&jira.IssueFields{}, fields)
+	if err != nil {
+		return err
+	}
+	issue.Fields = edited
+	for _, body := range comments {
+		s.addComment(issue, &jira.Comment{Body: body})
+	}
+	return nil
+}
+
+// sameValue reports whether v is the value of a remove operation, comparing objects by ID or name.
+func sameValue(v, value interface{}) bool {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(v, value)
+	}
+	existing, _ := v.(map[string]interface{})
+	for _, key := range []string{"id", "name", "key"} {
+		if want, ok := object[key]; ok {
+			return existing[key] == want
+		}
+	}
+	return false
+}
+
+// mergeFields returns a copy of fields with the values of edit, by their JSON names.
+func mergeFields(fields *jira.IssueFields, edit map[string]interface{}) (*jira.IssueFields, error) {
+	data, _ := json.Marshal(fields)
+	merged := map[string]interface{}{}
+	_ = json.Unmarshal(data, &merged)
+	for id, value := range edit {
+		merged[id] = value
+	}
+	data, _ = json.Marshal(merged)
+	edited := new(jira.IssueFields)
+	if err := json.Unmarshal(data, edited); err != nil {
+		return nil, err
+	}
+	return edited, nil
+}
+
+func (s *issueService) Delete(ctx context.Context, issueID string) (*jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	for i, issue := range s.fake.issues {
+		if issue == s.fake.issue(issueID) {
+			s.fake.issues = append(s.fake.issues[:i], s.fake.issues[i+1:]...)
+			delete(s.fake.comments, issue.ID)
+			return response(http.StatusNoContent), nil
+		}
+	}
+	return notFound(http.MethodDelete, issueID)
+}
+
+func (s *issueService) UpdateAssignee(ctx context.Context, issueID string, assignee *jira.User) (*jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		return notFound(http.MethodPut, issueID+"/assignee")
+	}
+	if assignee == nil || (assignee.AccountID == "" && assignee.Name == "") {
+		issue.Fields.Assignee = nil
+	} else {
+		u := *assignee
+		issue.Fields.Assignee = &u
+	}
+	issue.Fields.Updated = jira.Time(s.fake.now())
+	return response(http.StatusNoContent), nil
+}
+
+func (s *issueService) GetComments(ctx context.Context, issueID string, opts ...jira.RequestOption) (*jira.Comments, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		resp, err := notFound(http.MethodGet, issueID+"/comment")
+		return nil, resp, err
+	}
+	comments := &jira.Comments{MaxResults: len(s.fake.comments[issue.ID]), Total: len(s.fake.comments[issue.ID])}
+	for _, comment := range s.fake.comments[issue.ID] {
+		c := *comment
+		comments.Comments = append(comments.Comments, &c)
+	}
+	return comments, response(http.StatusOK), nil
+}
+
+func (s *issueService) AddComment(ctx context.Context, issueID string, comment *jira.Comment) (*jira.Comment, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		resp, err := notFound(http.MethodPost, issueID+"/comment")
+		return nil, resp, err
+	}
+	if comment.Body == "" {
+		resp, err := errorsResponse(http.StatusBadRequest, http.MethodPost, "issue/"+issueID+"/comment", nil, map[string]string{"comment": "Comment body can not be empty!"})
+		return nil, resp, err
+	}
+	c := *s.addComment(issue, comment)
+	return &c, response(http.StatusCreated), nil
+}
+
+func (s *issueService) addComment(issue *jira.Issue, comment *jira.Comment) *jira.Comment {
+	c := *comment
+	now := jira.Time(s.fake.now())
+	c.ID = s.fake.newID()
+	c.Created, c.Updated = &now, &now
+	s.fake.comments[issue.ID] = append(s.fake.comments[issue.ID], &c)
+	return &c
+}
+
+func (s *issueService) UpdateComment(ctx context.Context, issueID string, comment *jira.Comment) (*jira.Comment, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	if c := s.comment(issueID, comment.ID); c != nil {
+		now := jira.Time(s.fake.now())
+		c.Body, c.Updated = comment.Body, &now
+		ret := *c
+		return &ret, response(http.StatusOK), nil
+	}
+	resp, err := errorResponse(http.StatusNotFound, http.MethodPut, "issue/"+issueID+"/comment/"+comment.ID, "Can not find a comment for the id: %s.", comment.ID)
+	return nil, resp, err
+}
+
+func (s *issueService) DeleteComment(ctx context.Context, issueID, commentID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	if issue := s.fake.issue(issueID); issue != nil {
+		for i, c := range s.fake.comments[issue.ID] {
+			if c.ID == commentID {
+				s.fake.comments[issue.ID] = append(s.fake.comments[issue.ID][:i], s.fake.comments[issue.ID][i+1:]...)
+				return nil
+			}
+		}
+	}
+	_, err := errorResponse(http.StatusNotFound, http.MethodDelete, "issue/"+issueID+"/comment/"+commentID, "Can not find a comment for the id: %s.", commentID)
+	return err
+}
+
+// comment returns the comment with the ID of the issue with the ID or key.
+func (s *issueService) comment(issueID, commentID string) *jira.Comment {
+	issue := s.fake.issue(issueID)
+	if issue == nil {
+		return nil
+	}
+	for _, c := range s.fake.comments[issue.ID] {
+		if c.ID == commentID {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *issueService) GetTransitions(ctx context.Context, id string) ([]jira.Transition, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	if s.fake.issue(id) == nil {
+		resp, err := notFound(http.MethodGet, id+"/transitions")
+		return nil, resp, err
+	}
+	return append([]jira.Transition(nil), s.fake.transitions...), response(http.StatusOK), nil
+}
+
+func (s *issueService) DoTransition(ctx context.Context, ticketID, transitionID string) (*jira.Response, error) {
+	return s.DoTransitionWithPayload(ctx, ticketID, jira.CreateTransitionPayload{
+		Transition: jira.TransitionPayload{ID: transitionID},
+	})
+}
+
+func (s *issueService) DoTransitionWithPayload(ctx context.Context, ticketID, payload interface{}) (*jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("issue/%s/transitions", ticketID)
+	var transition jira.CreateTransitionPayload
+	data, _ := json.Marshal(payload)
+	if err := json.Unmarshal(data, &transition); err != nil {
+		return errorResponse(http.StatusBadRequest, http.MethodPost, path, "%s", err)
+	}
+
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	issue := s.fake.issue(fmt.Sprint(ticketID))
+	if issue == nil {
+		return notFound(http.MethodPost, fmt.Sprintf("%s/transitions", ticketID))
+	}
+	for _, t := range s.fake.transitions {
+		if t.ID != transition.Transition.ID {
+			continue
+		}
+		status := t.To
+		issue.Fields.Status = &status
+		if transition.Fields.Resolution != nil {
+			resolution := *transition.Fields.Resolution
+			issue.Fields.Resolution = &resolution
+		}
+		issue.Fields.Updated = jira.Time(s.fake.now())
+		for _, comment := range transition.Update.Comment {
+			s.addComment(issue, &jira.Comment{Body: comment.Add.Body})
+		}
+		return response(http.StatusNoContent), nil
+	}
+	return errorResponse(http.StatusBadRequest, http.MethodPost, path, "Transition id '%s' is not valid for this issue.", transition.Transition.ID)
+}
+
+// notFound returns the response and error of Jira for an issue which doesn't exist.
+func notFound(method, issueID string) (*jira.Response, error) {
+	return errorResponse(http.StatusNotFound, method, "issue/"+issueID, "Issue does not exist or you do not have permission to see it.")
+}
@@ -0,0 +1,385 @@
+package fakejira
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// Query is a compiled JQL query of the subset supported by the fake.
+type Query struct {
+	// or are the clauses combined with OR of clauses combined with AND.
+	or    [][]func(issue *jira.Issue) bool
+	order []orderBy
+}
+
+type orderBy struct {
+	field string
+	desc  bool
+}
+
+// Compile compiles the JQL query. It supports
+//   - the operators =, !=, ~, !~, in, not in, is EMPTY and is not EMPTY,
+//   - the fields project, key, summary, description, text, status, statusCategory, issuetype,
+//     priority, resolution, labels, component, fixVersion, assignee, reporter and parent,
+//   - clauses combined with AND and OR, AND binding stronger, without parentheses,
+//   - ORDER BY key, summary, status, priority, created and updated, ASC or DESC.
+//
+// Other queries return an error, so tests don't silently pass with unsupported JQL.
+func Compile(query string) (*Query, error) {
+	tokens, err := tokenizeJQL(query)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{}
+	var and []func(issue *jira.Issue) bool
+	for len(tokens) > 0 {
+		if tokens[0].is("order") {
+			if q.order, err = parseOrderBy(query, tokens[1:]); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if len(and) > 0 {
+			switch {
+			case tokens[0].is("and"):
+			case tokens[0].is("or"):
+				q.or = append(q.or, and)
+				and = nil
+			default:
+				return nil, fmt.Errorf("fakejira: unsupported JQL %q, only clauses combined with AND and OR are supported", query)
+			}
+			tokens = tokens[1:]
+		}
+
+		var clause func(issue *jira.Issue) bool
+		clause, tokens, err = parseClause(query, tokens)
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, clause)
+	}
+	if len(and) > 0 {
+		q.or = append(q.or, and)
+	}
+	return q, nil
+}
+
+// Match reports whether the issue matches the query. All issues match an empty query.
+func (q *Query) Match(issue *jira.Issue) bool {
+	if len(q.or) == 0 {
+		return true
+	}
+	for _, and := range q.or {
+		matched := true
+		for _, clause := range and {
+			if !clause(issue) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort sorts the issues by the ORDER BY of the query. The order is kept without ORDER BY.
+func (q *Query) Sort(issues []*jira.Issue) {
+	if len(q.order) == 0 {
+		return
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		for _, o := range q.order {
+			c := compareIssues(issues[i], issues[j], o.field)
+			if c == 0 {
+				continue
+			}
+			return (c < 0) != o.desc
+		}
+		return false
+	})
+}
+
+// compareIssues compares the field of a and b.
+func compareIssues(a, b *jira.Issue, field string) int {
+	fa, fb := fields(a), fields(b)
+	switch field {
+	case "key", "issuekey", "id":
+		pa, na := splitKey(a.Key)
+		pb, nb := splitKey(b.Key)
+		if c := strings.Compare(pa, pb); c != 0 {
+			return c
+		}
+		return na - nb
+	case "created":
+		return time.Time(fa.Created).Compare(time.Time(fb.Created))
+	case "updated":
+		return time.Time(fa.Updated).Compare(time.Time(fb.Updated))
+	}
+	va, _ := issueValues(a, field)
+	vb, _ := issueValues(b, field)
+	first := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		return strings.ToLower(values[0])
+	}
+	return strings.Compare(first(va), first(vb))
+}
+
+// splitKey splits the issue key into its project key and number.
+func splitKey(key string) (string, int) {
+	i := strings.LastIndex(key, "-")
+	if i < 0 {
+		return key, 0
+	}
+	n, _ := strconv.Atoi(key[i+1:])
+	return key[:i], n
+}
+
+func fields(issue *jira.Issue) *jira.IssueFields {
+	if issue.Fields == nil {
+		return &jira.IssueFields{}
+	}
+	return issue.Fields
+}
+
+// issueValues returns the values of the field of issue the JQL of searches is matched against.
+// ok is false if the field is not supported.
+func issueValues(issue *jira.Issue, field string) (values []string, ok bool) {
+	f := fields(issue)
+	user := func(u *jira.User) []string {
+		if u == nil {
+			return nil
+		}
+		return []string{u.AccountID, u.Name, u.DisplayName, u.EmailAddress}
+	}
+	switch strings.ToLower(field) {
+	case "project":
+		return []string{f.Project.Key, f.Project.ID, f.Project.Name}, true
+	case "key", "issuekey", "id":
+		return []string{issue.Key, issue.ID}, true
+	case "summary":
+		return []string{f.Summary}, true
+	case "description":
+		return []string{f.Description}, true
+	case "text":
+		return []string{f.Summary, f.Description}, true
+	case "status":
+		if f.Status == nil {
+			return nil, true
+		}
+		return []string{f.Status.Name, f.Status.ID}, true
+	case "statuscategory":
+		if f.Status == nil {
+			return nil, true
+		}
+		return []string{f.Status.StatusCategory.Name, f.Status.StatusCategory.Key, strconv.Itoa(f.Status.StatusCategory.ID)}, true
+	case "issuetype", "type":
+		return []string{f.Type.Name, f.Type.ID}, true
+	case "priority":
+		if f.Priority == nil {
+			return nil, true
+		}
+		return []string{f.Priority.Name, f.Priority.ID}, true
+	case "resolution":
+		if f.Resolution == nil {
+			return nil, true
+		}
+		return []string{f.Resolution.Name, f.Resolution.ID}, true
+	case "labels":
+		return f.Labels, true
+	case "component":
+		for _, c := range f.Components {
+			values = append(values, c.Name, c.ID)
+		}
+		return values, true
+	case "fixversion":
+		for _, v := range f.FixVersions {
+			values = append(values, v.Name, v.ID)
+		}
+		return values, true
+	case "assignee":
+		return user(f.Assignee), true
+	case "reporter":
+		return user(f.Reporter), true
+	case "parent":
+		if f.Parent == nil {
+			return nil, true
+		}
+		return []string{f.Parent.Key, f.Parent.ID}, true
+	}
+	return nil, false
+}
+
+// parseClause parses the clause at the front of tokens and returns the remaining tokens.
+func parseClause(query string, tokens []jqlToken) (func(issue *jira.Issue) bool, []jqlToken, error) {
+	unsupported := fmt.Errorf("fakejira: unsupported JQL %q", query)
+	if len(tokens) < 3 {
+		return nil, nil, unsupported
+	}
+	field := tokens[0].text
+	if _, ok := issueValues(&jira.Issue{}, field); !ok {
+		return nil, nil, fmt.Errorf("fakejira: unsupported JQL field %q", field)
+	}
+
+	op := strings.ToLower(tokens[1].text)
+	tokens = tokens[2:]
+	if (op == "not" && tokens[0].is("in")) || (op == "is" && tokens[0].is("not")) {
+		op, tokens = op+" "+strings.ToLower(tokens[0].text), tokens[1:]
+	}
+
+	var want []string
+	switch op {
+	case "=", "!=", "~", "!~":
+		if len(tokens) == 0 {
+			return nil, nil, unsupported
+		}
+		want, tokens = []string{tokens[0].text}, tokens[1:]
+	case "is", "is not":
+		if len(tokens) == 0 || !(tokens[0].is("empty") || tokens[0].is("null")) {
+			return nil, nil, unsupported
+		}
+		tokens = tokens[1:]
+	case "in", "not in":
+		if len(tokens) == 0 || tokens[0].text != "(" || tokens[0].quoted {
+			return nil, nil, unsupported
+		}
+		tokens = tokens[1:]
+		for len(tokens) > 0 && (tokens[0].text != ")" || tokens[0].quoted) {
+			if tokens[0].text != "," || tokens[0].quoted {
+				want = append(want, tokens[0].text)
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			return nil, nil, unsupported
+		}
+		tokens = tokens[1:]
+	default:
+		return nil, nil, fmt.Errorf("fakejira: unsupported JQL operator %q", op)
+	}
+
+	negate := op == "!=" || op == "!~" || op == "not in" || op == "is not"
+	contains := op == "~" || op == "!~"
+	return func(issue *jira.Issue) bool {
+		values, _ := issueValues(issue, field)
+		if op == "is" || op == "is not" {
+			for _, v := range values {
+				if v != "" {
+					return negate
+				}
+			}
+			return !negate
+		}
+		for _, v := range values {
+			for _, w := range want {
+				if v == "" {
+					continue
+				}
+				if contains && strings.Contains(strings.ToLower(v), strings.ToLower(strings.Trim(w, "*"))) {
+					return !negate
+				}
+				if !contains && strings.EqualFold(v, w) {
+					return !negate
+				}
+			}
+		}
+		return negate
+	}, tokens, nil
+}
+
+// parseOrderBy parses the tokens following ORDER.
+func parseOrderBy(query string, tokens []jqlToken) ([]orderBy, error) {
+	if len(tokens) < 2 || !tokens[0].is("by") {
+		return nil, fmt.Errorf("fakejira: unsupported JQL %q", query)
+	}
+	tokens = tokens[1:]
+
+	var order []orderBy
+	for len(tokens) > 0 {
+		field := strings.ToLower(tokens[0].text)
+		switch field {
+		case "key", "issuekey", "id", "summary", "status", "priority", "created", "updated":
+		default:
+			return nil, fmt.Errorf("fakejira: unsupported JQL ORDER BY field %q", tokens[0].text)
+		}
+		o := orderBy{field: field}
+		tokens = tokens[1:]
+		if len(tokens) > 0 && (tokens[0].is("asc") || tokens[0].is("desc")) {
+			o.desc = tokens[0].is("desc")
+			tokens = tokens[1:]
+		}
+		order = append(order, o)
+		if len(tokens) > 0 {
+			if tokens[0].text != "," || tokens[0].quoted {
+				return nil, fmt.Errorf("fakejira: unsupported JQL %q", query)
+			}
+			tokens = tokens[1:]
+		}
+	}
+	return order, nil
+}
+
+// jqlToken is a word, quoted string, operator or punctuation of a JQL query.
+type jqlToken struct {
+	text   string
+	quoted bool
+}
+
+// is reports whether the token is the unquoted keyword.
+func (t jqlToken) is(keyword string) bool {
+	return !t.quoted && strings.EqualFold(t.text, keyword)
+}
+
+// tokenizeJQL splits the JQL query into its tokens.
+func tokenizeJQL(query string) ([]jqlToken, error) {
+	var tokens []jqlToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				b.WriteRune(runes[j])
+			}
+			if j == len(runes) {
+				return nil, fmt.Errorf("fakejira: unterminated string in JQL %q", query)
+			}
+			tokens = append(tokens, jqlToken{text: b.String(), quoted: true})
+			i = j + 1
+		case r == '(' || r == ')' || r == ',' || r == '=' || r == '~':
+			tokens = append(tokens, jqlToken{text: string(r)})
+			i++
+		case r == '!' && i+1 < len(runes) && (runes[i+1] == '=' || runes[i+1] == '~'):
+			tokens = append(tokens, jqlToken{text: string(runes[i : i+2])})
+			i += 2
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !strings.ContainsRune(`()",='!~`, runes[j]) {
+				j++
+			}
+			if j == i {
+				return nil, fmt.Errorf("fakejira: unsupported JQL %q", query)
+			}
+			tokens = append(tokens, jqlToken{text: string(runes[i:j])})
+			i = j
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,74 @@
+package fakejira
+
+import (
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestCompile(t *testing.T) {
+	issues := []*jira.Issue{
+		{Key: "EX-1", Fields: &jira.IssueFields{
+			Project: jira.Project{Key: "EX"}, Summary: "Login fails", Labels: []string{"auth"},
+			Status: &jira.Status{Name: "To Do"}, Assignee: &jira.User{AccountID: "5b10a2844c20165700ede21g"},
+		}},
+		{Key: "EX-2", Fields: &jira.IssueFields{
+			Project: jira.Project{Key: "EX"}, Summary: "Document the API",
+			Status: &jira.Status{Name: "Done"},
+		}},
+		{Key: "EX-10", Fields: &jira.IssueFields{
+			Project: jira.Project{Key: "EX"}, Summary: "Login is slow", Description: "It takes a minute",
+			Status: &jira.Status{Name: "In Progress"},
+		}},
+	}
+
+	for jql, want := range map[string][]string{
+		"":                                  {"EX-1", "EX-2", "EX-10"},
+		`summary ~ login ORDER BY key DESC`: {"EX-10", "EX-1"},
+		`text ~ "minute"`:                   {"EX-10"},
+		`assignee is EMPTY ORDER BY key`:    {"EX-2", "EX-10"},
+		`assignee is not empty`:             {"EX-1"},
+		`status = Done OR labels in (auth)`: {"EX-1", "EX-2"},
+		`project = EX AND status != Done OR key = EX-2 ORDER BY summary`: {"EX-2", "EX-1", "EX-10"},
+		`labels not in (auth) AND summary !~ Document`:                   {"EX-10"},
+	} {
+		query, err := Compile(jql)
+		if err != nil {
+			t.Errorf("Error given for %q: %s", jql, err)
+			continue
+		}
+		var matched []*jira.Issue
+		for _, issue := range issues {
+			if query.Match(issue) {
+				matched = append(matched, issue)
+			}
+		}
+		query.Sort(matched)
+		var keys []string
+		for _, issue := range matched {
+			keys = append(keys, issue.Key)
+		}
+		if len(keys) != len(want) {
+			t.Errorf("%q: expected %v, got %v", jql, want, keys)
+			continue
+		}
+		for i := range want {
+			if keys[i] != want[i] {
+				t.Errorf("%q: expected %v, got %v", jql, want, keys)
+				break
+			}
+		}
+	}
+
+	for _, jql := range []string{
+		`created >= -1d`,
+		`(status = Done)`,
+		`flavour = sweet`,
+		`summary ~ "unterminated`,
+		`project = EX ORDER BY rank`,
+	} {
+		if _, err := Compile(jql); err == nil {
+			t.Errorf("Expected an error for %q", jql)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package fakejira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// projectService is the fake of the project service.
+type projectService struct {
+	// ProjectAPI is nil, so the methods not implemented by the fake panic.
+	jira.ProjectAPI
+
+	fake *Jira
+}
+
+var _ jira.ProjectAPI = (*projectService)(nil)
+
+func (s *projectService) GetAll(ctx context.Context, options *jira.GetQueryOptions) (*jira.ProjectList, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	data, _ := json.Marshal(s.fake.projects)
+	s.fake.mu.Unlock()
+
+	projects := jira.ProjectList{}
+	if err := json.Unmarshal(data, &projects); err != nil {
+		return nil, nil, err
+	}
+	return &projects, response(http.StatusOK), nil
+}
+
+func (s *projectService) Get(ctx context.Context, projectID string) (*jira.Project, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+
+	project := s.fake.project(projectID)
+	if project == nil {
+		resp, err := errorResponse(http.StatusNotFound, http.MethodGet, "project/"+projectID, "No project could be found with key '%s'.", projectID)
+		return nil, resp, err
+	}
+	p := *project
+	return &p, response(http.StatusOK), nil
+}
+
+// Search returns a page of the projects matching the Query and CategoryID of options,
+// ordered by key or name.
+func (s *projectService) Search(ctx context.Context, options *jira.ProjectSearchOptions) (*jira.ProjectSearchResult, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	var opts jira.ProjectSearchOptions
+	if options != nil {
+		opts = *options
+	}
+	if opts.MaxResults <= 0 {
+		opts.MaxResults = defaultMaxResults
+	}
+
+	s.fake.mu.Lock()
+	var matched []jira.Project
+	for _, project := range s.fake.projects {
+		query := strings.ToLower(opts.Query)
+		if query != "" && !strings.Contains(strings.ToLower(project.Key), query) && !strings.Contains(strings.ToLower(project.Name), query) {
+			continue
+		}
+		if opts.CategoryID != 0 && project.ProjectCategory.ID != strconv.Itoa(opts.CategoryID) {
+			continue
+		}
+		matched = append(matched, project)
+	}
+	s.fake.mu.Unlock()
+
+	orderBy := strings.TrimLeft(opts.OrderBy, "+-")
+	desc := strings.HasPrefix(opts.OrderBy, "-")
+	sort.SliceStable(matched, func(i, j int) bool {
+		a, b := matched[i].Key, matched[j].Key
+		if orderBy == "name" {
+			a, b = matched[i].Name, matched[j].Name
+		}
+		if desc {
+			return strings.ToLower(a) > strings.ToLower(b)
+		}
+		return strings.ToLower(a) < strings.ToLower(b)
+	})
+
+	result := &jira.ProjectSearchResult{StartAt: opts.StartAt, MaxResults: opts.MaxResults, Total: len(matched), Values: []jira.Project{}}
+	for i := opts.StartAt; i < len(matched) && i < opts.StartAt+opts.MaxResults; i++ {
+		result.Values = append(result.Values, matched[i])
+	}
+	result.IsLast = opts.StartAt+len(result.Values) >= len(matched)
+
+	resp := response(http.StatusOK)
+	resp.StartAt, resp.MaxResults, resp.Total, resp.IsLast = result.StartAt, result.MaxResults, result.Total, result.IsLast
+	return result, resp, nil
+}
+
+func (s *projectService) SearchAll(ctx context.Context, options *jira.ProjectSearchOptions, pager *jira.PagerOptions) ([]jira.Project, error) {
+	var opts jira.ProjectSearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return jira.NewPager(func(ctx context.Context, page jira.PageRequest) (*jira.Page[jira.Project], *jira.Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		result, resp, err := s.Search(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &jira.Page[jira.Project]{Values: result.Values, Total: result.Total, IsLast: result.IsLast}, resp, nil
+	}, pager).All(ctx)
+}
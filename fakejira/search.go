@@ -0,0 +1,86 @@
+package fakejira
+
+import (
+	"context"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func (s *issueService) Search(ctx context.Context, jql string, options *jira.SearchOptions, opts ...jira.RequestOption) ([]jira.Issue, *jira.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	query, err := Compile(jql)
+	if err != nil {
+		resp, err := errorResponse(http.StatusBadRequest, http.MethodGet, "search", "%s", err)
+		return nil, resp, err
+	}
+
+	s.fake.mu.Lock()
+	var matched []*jira.Issue
+	for _, issue := range s.fake.issues {
+		if query.Match(issue) {
+			matched = append(matched, issue)
+		}
+	}
+	query.Sort(matched)
+
+	startAt, maxResults := 0, defaultMaxResults
+	if options != nil {
+		startAt = options.StartAt
+		if options.MaxResults > 0 {
+			maxResults = options.MaxResults
+		}
+	}
+	issues := []jira.Issue{}
+	for i := startAt; i < len(matched) && i < startAt+maxResults; i++ {
+		issues = append(issues, *copyIssue(matched[i]))
+	}
+	s.fake.mu.Unlock()
+
+	resp := response(http.StatusOK)
+	resp.StartAt, resp.MaxResults, resp.Total = startAt, maxResults, len(matched)
+	resp.IsLast = startAt+len(issues) >= len(matched)
+	return issues, resp, nil
+}
+
+func (s *issueService) SearchStream(ctx context.Context, jql string, options *jira.SearchOptions, f func(jira.Issue) error, opts ...jira.RequestOption) (*jira.Response, error) {
+	issues, resp, err := s.Search(ctx, jql, options, opts...)
+	if err != nil {
+		return resp, err
+	}
+	for _, issue := range issues {
+		if err := f(issue); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *issueService) SearchPages(ctx context.Context, jql string, options *jira.SearchOptions, f func(jira.Issue) error) error {
+	return s.pager(jql, options, nil).Each(ctx, f)
+}
+
+func (s *issueService) SearchAll(ctx context.Context, jql string, options *jira.SearchOptions, pager *jira.PagerOptions) ([]jira.Issue, error) {
+	return s.pager(jql, options, pager).All(ctx)
+}
+
+// pager returns a Pager over the issues of a search.
+func (s *issueService) pager(jql string, options *jira.SearchOptions, pager *jira.PagerOptions) *jira.Pager[jira.Issue] {
+	var opts jira.SearchOptions
+	if options != nil {
+		opts = *options
+	}
+	return jira.NewPager(func(ctx context.Context, page jira.PageRequest) (*jira.Page[jira.Issue], *jira.Response, error) {
+		opts.StartAt = page.StartAt
+		if page.MaxResults != 0 {
+			opts.MaxResults = page.MaxResults
+		}
+		issues, resp, err := s.Search(ctx, jql, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return &jira.Page[jira.Issue]{Values: issues, Total: resp.Total, IsLast: resp.IsLast}, resp, nil
+	}, pager)
+}
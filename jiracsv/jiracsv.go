@@ -0,0 +1,228 @@
+// Package jiracsv writes the issues of JQL searches of the cloud client as CSV, e.g. for reports:
+//
+//	exporter := &jiracsv.Exporter{
+//		Client: client,
+//		Columns: []jiracsv.Column{
+//			{Field: "key"},
+//			{Field: "Summary"},
+//			{Field: "Story Points"},
+//			{Header: "Labels", Field: "labels"},
+//			{Header: "Reporter", Value: func(issue *jira.Issue) string { return issue.Fields.Reporter.EmailAddress }},
+//		},
+//	}
+//	w := csv.NewWriter(os.Stdout)
+//	n, err := exporter.Export(ctx, "project = EX ORDER BY key", w)
+//
+// Fields are referenced by name or ID, so custom fields can be exported by their display name.
+// Values are flattened to text: users, options and other objects by their display name, name or value,
+// and multi-value fields are joined. Rich text is exported as the wiki markup returned by the search.
+package jiracsv
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// defaultSeparator joins the values of multi-value fields.
+const defaultSeparator = "; "
+
+// Column is a column of the CSV.
+type Column struct {
+	// Header is the header of the column. It will default to Field if empty.
+	Header string
+	// Field is the name or ID of the field of the column, e.g. "Summary", "status" or "customfield_10010".
+	// "key" and "id" are the key and ID of the issue.
+	Field string
+	// Value returns the value of the column, instead of the value of Field.
+	Value func(issue *jira.Issue) string
+}
+
+// Exporter writes the issues of searches as CSV.
+type Exporter struct {
+	// Client searches the issues.
+	Client *jira.Client
+	// Columns are the columns of the CSV.
+	Columns []Column
+	// Fields resolves the names of the fields of the columns.
+	// It will default to a FieldRegistry of Client if nil.
+	Fields *jira.FieldRegistry
+	// Separator joins the values of multi-value fields. It will default to "; " if empty.
+	Separator string
+	// NoHeader omits the header row.
+	NoHeader bool
+	// PageSize is the number of issues fetched per request. It will default to 100 if 0.
+	PageSize int
+}
+
+// Export writes the issues matching jql to w, one row per issue, and returns the number of issues written.
+// The search is paginated automatically. w is flushed before Export returns.
+func (e *Exporter) Export(ctx context.Context, jql string, w *csv.Writer) (int, error) {
+	ids, err := e.fieldIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer w.Flush()
+
+	if !e.NoHeader {
+		header := make([]string, len(e.Columns))
+		for i, column := range e.Columns {
+			header[i] = column.Header
+			if header[i] == "" {
+				header[i] = column.Field
+			}
+		}
+		if err := w.Write(header); err != nil {
+			return 0, err
+		}
+	}
+
+	pageSize := e.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+	options := &jira.SearchOptions{MaxResults: pageSize, Fields: searchFields(ids)}
+	n := 0
+	err = e.Client.Issue.SearchPages(ctx, jql, options, func(issue jira.Issue) error {
+		row, err := e.row(&issue, ids)
+		if err != nil {
+			return err
+		}
+		n++
+		return w.Write(row)
+	})
+	if err != nil {
+		return n, err
+	}
+	w.Flush()
+	return n, w.Error()
+}
+
+// fieldIDs resolves the fields of the columns to their IDs, empty for columns with Value.
+func (e *Exporter) fieldIDs(ctx context.Context) ([]string, error) {
+	registry := e.Fields
+	ids := make([]string, len(e.Columns))
+	for i, column := range e.Columns {
+		switch {
+		case column.Value != nil:
+			continue
+		case column.Field == "key" || column.Field == "id":
+			ids[i] = column.Field
+			continue
+		case column.Field == "":
+			return nil, fmt.Errorf("jiracsv: column %d has no field or value", i)
+		}
+		if registry == nil {
+			registry = jira.NewFieldRegistry(e.Client, 0)
+		}
+		id, err := registry.ID(ctx, column.Field)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+// searchFields returns the fields to request in the search, all navigable fields if the columns only use Value.
+func searchFields(ids []string) []string {
+	var fields []string
+	for _, id := range ids {
+		if id != "" && id != "key" && id != "id" {
+			fields = append(fields, id)
+		}
+	}
+	if len(fields) == 0 && len(ids) > 0 {
+		return nil
+	}
+	for _, id := range ids {
+		if id == "" {
+			// Value functions may use any field.
+			return []string{"*navigable"}
+		}
+	}
+	return fields
+}
+
+// row returns the values of the columns of issue.
+func (e *Exporter) row(issue *jira.Issue, ids []string) ([]string, error) {
+	var fields map[string]interface{}
+	if issue.Fields != nil {
+		data, err := json.Marshal(issue.Fields)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+	}
+
+	row := make([]string, len(e.Columns))
+	for i, column := range e.Columns {
+		switch {
+		case column.Value != nil:
+			row[i] = column.Value(issue)
+		case ids[i] == "key":
+			row[i] = issue.Key
+		case ids[i] == "id":
+			row[i] = issue.ID
+		default:
+			row[i] = e.format(fields[ids[i]])
+		}
+	}
+	return row, nil
+}
+
+// format flattens the JSON value of a field to text.
+func (e *Exporter) format(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, value := range v {
+			if s := e.format(value); s != "" {
+				values = append(values, s)
+			}
+		}
+		separator := e.Separator
+		if separator == "" {
+			separator = defaultSeparator
+		}
+		return strings.Join(values, separator)
+	case map[string]interface{}:
+		return e.formatObject(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// formatObject flattens an object, e.g. a user or an option.
+func (e *Exporter) formatObject(v map[string]interface{}) string {
+	for _, key := range []string{"displayName", "name", "value", "key"} {
+		s, ok := v[key].(string)
+		if !ok {
+			continue
+		}
+		// Cascading select options have the selected child option.
+		if child, ok := v["child"].(map[string]interface{}); ok {
+			if c := e.formatObject(child); c != "" {
+				s += " - " + c
+			}
+		}
+		return s
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
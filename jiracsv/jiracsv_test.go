@@ -0,0 +1,105 @@
+package jiracsv
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+const testFields = `[
+	{"id":"summary","name":"Summary"},
+	{"id":"labels","name":"Labels"},
+	{"id":"assignee","name":"Assignee"},
+	{"id":"description","name":"Description"},
+	{"id":"customfield_10040","name":"Root Cause","custom":true},
+	{"id":"customfield_10010","name":"Story Points","custom":true},
+	{"id":"customfield_10020","name":"Team","custom":true},
+	{"id":"customfield_10030","name":"Platform","custom":true}
+]`
+
+func TestExporter_Export(t *testing.T) {
+	var searches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/field":
+			fmt.Fprint(w, testFields)
+		case "/rest/api/2/search":
+			searches = append(searches, r.URL.Query().Get("startAt"))
+			if got := r.URL.Query().Get("fields"); got != "summary,customfield_10010,labels,customfield_10020,customfield_10030,assignee,description,customfield_10040" {
+				t.Errorf("Unexpected fields %s", got)
+			}
+			if r.URL.Query().Get("startAt") == "" {
+				fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","fields":{
+					"summary":"Login fails",
+					"customfield_10010":3.5,
+					"labels":["auth","regression"],
+					"customfield_10020":[{"value":"Core"},{"value":"Web"}],
+					"customfield_10030":{"value":"Mobile","child":{"value":"iOS"}},
+					"assignee":{"displayName":"Jane Doe"},
+					"description":"See logs",
+					"customfield_10040":"Steps, with a comma"
+				}}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","fields":{"summary":"Slow search"}}]}`)
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+	client, _ := jira.NewClient(server.URL, nil)
+
+	exporter := &Exporter{
+		Client: client,
+		Columns: []Column{
+			{Field: "key"},
+			{Field: "Summary"},
+			{Header: "Points", Field: "Story Points"},
+			{Field: "labels"},
+			{Field: "Team"},
+			{Field: "Platform"},
+			{Field: "Assignee"},
+			{Field: "Description"},
+			{Field: "Root Cause"},
+		},
+		PageSize: 1,
+	}
+	var buf bytes.Buffer
+	n, err := exporter.Export(context.Background(), "project = EX", csv.NewWriter(&buf))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	want := "key,Summary,Points,labels,Team,Platform,Assignee,Description,Root Cause\n" +
+		"EX-1,Login fails,3.5,auth; regression,Core; Web,Mobile - iOS,Jane Doe,See logs,\"Steps, with a comma\"\n" +
+		"EX-2,Slow search,,,,,,,\n"
+	if n != 2 || buf.String() != want {
+		t.Errorf("Unexpected export of %d issues:\n%s\nwant:\n%s", n, buf.String(), want)
+	}
+	if len(searches) != 2 {
+		t.Errorf("Expected 2 pages, got %d", len(searches))
+	}
+}
+
+func TestExporter_Export_UnknownField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, testFields)
+	}))
+	defer server.Close()
+	client, _ := jira.NewClient(server.URL, nil)
+
+	exporter := &Exporter{Client: client, Columns: []Column{{Field: "Severity"}}}
+	var buf bytes.Buffer
+	if _, err := exporter.Export(context.Background(), "project = EX", csv.NewWriter(&buf)); err == nil {
+		t.Error("Expected an error for an unknown field")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing to be written, got %q", buf.String())
+	}
+}
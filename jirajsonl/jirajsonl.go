@@ -0,0 +1,73 @@
+// Package jirajsonl streams the issues of JQL searches of the cloud client as JSON Lines,
+// one JSON object per line, e.g. to load them into a data warehouse:
+//
+//	exporter := &jirajsonl.Exporter{
+//		Client:    client,
+//		Fields:    []string{"summary", "status", "customfield_10010"},
+//		Comments:  true,
+//		Changelog: true,
+//	}
+//	n, err := exporter.Export(ctx, "project = EX ORDER BY key", os.Stdout)
+//
+// The issues are fetched page by page and written as they arrive,
+// so the memory use doesn't grow with the number of issues.
+package jirajsonl
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// Exporter writes the issues of searches as JSON Lines.
+type Exporter struct {
+	// Client searches the issues.
+	Client *jira.Client
+	// Fields are the IDs of the exported fields, e.g. "summary" or "customfield_10010".
+	// All fields are exported if empty.
+	Fields []string
+	// Comments exports the comments of the issues.
+	Comments bool
+	// Changelog exports the change history of the issues.
+	Changelog bool
+	// PageSize is the number of issues fetched per request. It will default to 100 if 0.
+	PageSize int
+}
+
+// Export writes the issues matching jql to w, one issue per line, and returns the number of issues written.
+// The search is paginated automatically.
+func (e *Exporter) Export(ctx context.Context, jql string, w io.Writer) (int, error) {
+	pageSize := e.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+	options := &jira.SearchOptions{MaxResults: pageSize, Fields: e.fields()}
+	if e.Changelog {
+		options.Expand = "changelog"
+	}
+
+	enc := json.NewEncoder(w)
+	n := 0
+	err := e.Client.Issue.SearchPages(ctx, jql, options, func(issue jira.Issue) error {
+		if err := enc.Encode(&issue); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// fields returns the fields requested in the search.
+func (e *Exporter) fields() []string {
+	if len(e.Fields) == 0 {
+		return []string{"*all"}
+	}
+	fields := append([]string(nil), e.Fields...)
+	if e.Comments {
+		fields = append(fields, "comment")
+	}
+	return fields
+}
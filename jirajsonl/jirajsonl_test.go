@@ -0,0 +1,60 @@
+package jirajsonl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestExporter_Export(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("fields") != "summary,customfield_10010,comment" || q.Get("expand") != "changelog" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		if q.Get("startAt") == "" {
+			fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","fields":{
+				"summary":"Login fails","customfield_10010":3,
+				"comment":{"comments":[{"id":"1","body":"Reproduced"}],"total":1}},
+				"changelog":{"histories":[{"id":"100","items":[{"field":"status","fromString":"To Do","toString":"In Progress"}]}]}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","fields":{"summary":"Slow search"}}]}`)
+	}))
+	defer server.Close()
+	client, _ := jira.NewClient(server.URL, nil)
+
+	exporter := &Exporter{Client: client, Fields: []string{"summary", "customfield_10010"}, Comments: true, Changelog: true, PageSize: 1}
+	var buf bytes.Buffer
+	n, err := exporter.Export(context.Background(), "project = EX", &buf)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if n != 2 {
+		t.Errorf("Expected 2 issues, got %d", n)
+	}
+
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("Invalid line %s: %s", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 || lines[0]["key"] != "EX-1" || lines[1]["key"] != "EX-2" {
+		t.Fatalf("Unexpected lines %v", lines)
+	}
+	fields := lines[0]["fields"].(map[string]interface{})
+	if fields["customfield_10010"] != 3.0 || fields["comment"] == nil || lines[0]["changelog"] == nil {
+		t.Errorf("Expected the custom field, comments and changelog, got %v", lines[0])
+	}
+}
@@ -0,0 +1,192 @@
+// Package jiramigrate rewrites configuration written before Jira Cloud removed usernames and user keys
+// from its APIs to reference users by their account IDs, e.g. JQL queries kept in scripts,
+// the users of project roles and the queries of saved filters:
+//
+//	migrator := &jiramigrate.Migrator{Client: client}
+//	rewrites, err := migrator.RewriteJQL(ctx, []string{"assignee = jsmith"})
+//	changes, err := migrator.MigrateFilters(ctx, []int{10000, 10001})
+//
+// Users are resolved with a jira.AccountIDResolver, so each user is only looked up once per Migrator.
+// Set DryRun to see the changes of MigrateFilters and MigrateRoleActors without saving them.
+package jiramigrate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// jqlBatchSize is the number of queries converted per request.
+const jqlBatchSize = 100
+
+// Migrator rewrites configuration to reference users by account ID.
+type Migrator struct {
+	// Client reads and updates the configuration in Jira.
+	Client *jira.Client
+	// Resolver resolves usernames and user keys. A resolver of Client is created if nil.
+	Resolver *jira.AccountIDResolver
+	// DryRun only reports the changes of MigrateFilters and MigrateRoleActors without saving them.
+	DryRun bool
+
+	once sync.Once
+}
+
+// JQLRewrite is a query rewritten by RewriteJQL.
+type JQLRewrite struct {
+	Original  string
+	Rewritten string
+	// UnknownUsers is set if the query references users which don't exist.
+	// Jira replaced them in Rewritten, so the query doesn't match the same issues anymore.
+	UnknownUsers bool
+}
+
+// Changed reports whether the query was rewritten.
+func (r JQLRewrite) Changed() bool {
+	return r.Original != r.Rewritten
+}
+
+// FilterChange is a filter rewritten by MigrateFilters.
+type FilterChange struct {
+	ID   int
+	Name string
+	JQLRewrite
+	// Updated is set if the filter was saved with the rewritten query.
+	Updated bool
+}
+
+// RewriteJQL rewrites the usernames and user keys of the queries to account IDs.
+// The rewrites are returned in the order of the queries.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-jql/#api-rest-api-2-jql-pdcleaner-post
+func (m *Migrator) RewriteJQL(ctx context.Context, queries []string) ([]JQLRewrite, error) {
+	rewrites := make([]JQLRewrite, 0, len(queries))
+	for start := 0; start < len(queries); start += jqlBatchSize {
+		batch := queries[start:min(start+jqlBatchSize, len(queries))]
+		conversion, _, err := m.Client.User.ConvertJQL(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		if len(conversion.QueryStrings) != len(batch) {
+			return nil, fmt.Errorf("jiramigrate: got %d converted queries for %d queries", len(conversion.QueryStrings), len(batch))
+		}
+		unknown := make(map[string]bool, len(conversion.QueriesWithUnknownUsers))
+		for _, q := range conversion.QueriesWithUnknownUsers {
+			unknown[q.OriginalQuery] = true
+		}
+		for i, query := range batch {
+			rewrites = append(rewrites, JQLRewrite{
+				Original:     query,
+				Rewritten:    conversion.QueryStrings[i],
+				UnknownUsers: unknown[query],
+			})
+		}
+	}
+	return rewrites, nil
+}
+
+// RewriteActors returns the account IDs of the users, given by username or user key,
+// e.g. the actors of project roles of a configuration file.
+// The users which don't exist are returned as unresolved.
+func (m *Migrator) RewriteActors(ctx context.Context, users []string) (accountIDs, unresolved []string, err error) {
+	resolver := m.resolver()
+	byUsername, err := resolver.ResolveUsernames(ctx, users)
+	if err != nil {
+		return nil, nil, err
+	}
+	var keys []string
+	for _, user := range users {
+		if _, ok := byUsername[user]; !ok {
+			keys = append(keys, user)
+		}
+	}
+	byKey, err := resolver.ResolveKeys(ctx, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, user := range users {
+		if accountID, ok := byUsername[user]; ok {
+			accountIDs = append(accountIDs, accountID)
+		} else if accountID, ok := byKey[user]; ok {
+			accountIDs = append(accountIDs, accountID)
+		} else {
+			unresolved = append(unresolved, user)
+		}
+	}
+	return accountIDs, unresolved, nil
+}
+
+// MigrateFilters rewrites the queries of the filters with the IDs and saves the changed filters.
+// Only the changed filters are returned.
+// Filters with queries referencing users which don't exist are returned, but not saved,
+// as Jira replaced the users in the rewritten query.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-filters/#api-rest-api-2-filter-id-put
+func (m *Migrator) MigrateFilters(ctx context.Context, filterIDs []int) ([]FilterChange, error) {
+	filters := make([]*jira.Filter, len(filterIDs))
+	queries := make([]string, len(filterIDs))
+	for i, id := range filterIDs {
+		filter, _, err := m.Client.Filter.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		filters[i] = filter
+		queries[i] = filter.Jql
+	}
+	rewrites, err := m.RewriteJQL(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FilterChange
+	for i, rewrite := range rewrites {
+		if !rewrite.Changed() {
+			continue
+		}
+		change := FilterChange{ID: filterIDs[i], Name: filters[i].Name, JQLRewrite: rewrite}
+		if !m.DryRun && !rewrite.UnknownUsers {
+			body := map[string]string{"name": filters[i].Name, "jql": rewrite.Rewritten}
+			if _, err := m.Client.Call(ctx, http.MethodPut, fmt.Sprintf("rest/api/2/filter/%d", change.ID), nil, body, nil); err != nil {
+				return changes, err
+			}
+			change.Updated = true
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// MigrateRoleActors adds the users, given by username or user key, to the role of the project by their account IDs,
+// e.g. to restore the members of a role from a configuration file.
+// The users which don't exist are returned as unresolved.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-project-role-actors/#api-rest-api-2-project-projectidorkey-role-id-post
+func (m *Migrator) MigrateRoleActors(ctx context.Context, projectKey string, roleID int, users []string) (unresolved []string, err error) {
+	accountIDs, unresolved, err := m.RewriteActors(ctx, users)
+	if err != nil {
+		return nil, err
+	}
+	if m.DryRun || len(accountIDs) == 0 {
+		return unresolved, nil
+	}
+	path := fmt.Sprintf("rest/api/2/project/%s/role/%d", url.PathEscape(projectKey), roleID)
+	body := map[string][]string{"user": accountIDs}
+	if _, err := m.Client.Call(ctx, http.MethodPost, path, nil, body, nil); err != nil {
+		return unresolved, err
+	}
+	return unresolved, nil
+}
+
+// resolver returns the Resolver, creating it on first use if it isn't set.
+func (m *Migrator) resolver() *jira.AccountIDResolver {
+	m.once.Do(func() {
+		if m.Resolver == nil {
+			m.Resolver = jira.NewAccountIDResolver(m.Client)
+		}
+	})
+	return m.Resolver
+}
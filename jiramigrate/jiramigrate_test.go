@@ -0,0 +1,153 @@
+package jiramigrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// newMigrator returns a Migrator of a client of a test server with the handler.
+func newMigrator(t *testing.T, handler http.HandlerFunc) *Migrator {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := jira.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Migrator{Client: client}
+}
+
+// convertJQL answers a request to convert queries, replacing "jsmith" and "nobody".
+func convertJQL(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		QueryStrings []string `json:"queryStrings"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	result := jira.JQLConversion{}
+	for _, q := range body.QueryStrings {
+		switch q {
+		case "assignee = jsmith":
+			result.QueryStrings = append(result.QueryStrings, "assignee = id-jsmith")
+		case "reporter = nobody":
+			result.QueryStrings = append(result.QueryStrings, "reporter = unknown")
+			result.QueriesWithUnknownUsers = append(result.QueriesWithUnknownUsers, jira.JQLQueryWithUnknownUsers{OriginalQuery: q, ConvertedQuery: "reporter = unknown"})
+		default:
+			result.QueryStrings = append(result.QueryStrings, q)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+func TestMigrator_RewriteJQL(t *testing.T) {
+	m := newMigrator(t, func(w http.ResponseWriter, r *http.Request) {
+		convertJQL(t, w, r)
+	})
+
+	rewrites, err := m.RewriteJQL(context.Background(), []string{"assignee = jsmith", "project = EX", "reporter = nobody"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := []JQLRewrite{
+		{Original: "assignee = jsmith", Rewritten: "assignee = id-jsmith"},
+		{Original: "project = EX", Rewritten: "project = EX"},
+		{Original: "reporter = nobody", Rewritten: "reporter = unknown", UnknownUsers: true},
+	}
+	if !reflect.DeepEqual(rewrites, want) {
+		t.Errorf("Expected %+v, got %+v", want, rewrites)
+	}
+	if rewrites[1].Changed() {
+		t.Error("Expected the query without users to be unchanged")
+	}
+}
+
+func TestMigrator_MigrateFilters(t *testing.T) {
+	var updated []string
+	m := newMigrator(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/2/filter/1":
+			fmt.Fprint(w, `{"id":"1","name":"Mine","jql":"assignee = jsmith"}`)
+		case "GET /rest/api/2/filter/2":
+			fmt.Fprint(w, `{"id":"2","name":"Project","jql":"project = EX"}`)
+		case "GET /rest/api/2/filter/3":
+			fmt.Fprint(w, `{"id":"3","name":"Gone","jql":"reporter = nobody"}`)
+		case "POST /rest/api/2/jql/pdcleaner":
+			convertJQL(t, w, r)
+		case "PUT /rest/api/2/filter/1":
+			body, _ := io.ReadAll(r.Body)
+			updated = append(updated, string(body))
+			fmt.Fprint(w, `{}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+
+	changes, err := m.MigrateFilters(context.Background(), []int{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := []FilterChange{
+		{ID: 1, Name: "Mine", JQLRewrite: JQLRewrite{Original: "assignee = jsmith", Rewritten: "assignee = id-jsmith"}, Updated: true},
+		{ID: 3, Name: "Gone", JQLRewrite: JQLRewrite{Original: "reporter = nobody", Rewritten: "reporter = unknown", UnknownUsers: true}},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("Expected %+v, got %+v", want, changes)
+	}
+	if want := []string{`{"jql":"assignee = id-jsmith","name":"Mine"}` + "\n"}; !reflect.DeepEqual(updated, want) {
+		t.Errorf("Expected the updates %q, got %q", want, updated)
+	}
+}
+
+func TestMigrator_MigrateRoleActors(t *testing.T) {
+	var added string
+	m := newMigrator(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /rest/api/2/user/bulk/migration":
+			if r.URL.Query().Get("username") != "" {
+				fmt.Fprint(w, `[{"username":"jsmith","accountId":"id-jsmith"}]`)
+			} else {
+				fmt.Fprint(w, `[{"key":"JIRAUSER10100","accountId":"id-key"}]`)
+			}
+		case "POST /rest/api/2/project/EX/role/10002":
+			body, _ := io.ReadAll(r.Body)
+			added = string(body)
+			fmt.Fprint(w, `{}`)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+
+	unresolved, err := m.MigrateRoleActors(context.Background(), "EX", 10002, []string{"jsmith", "JIRAUSER10100", "gone"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := []string{"gone"}; !reflect.DeepEqual(unresolved, want) {
+		t.Errorf("Expected the unresolved users %v, got %v", want, unresolved)
+	}
+	if want := `{"user":["id-jsmith","id-key"]}` + "\n"; added != want {
+		t.Errorf("Expected the actors %s, got %s", want, added)
+	}
+}
+
+func TestMigrator_MigrateRoleActors_DryRun(t *testing.T) {
+	m := newMigrator(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		fmt.Fprint(w, `[{"username":"jsmith","accountId":"id-jsmith"}]`)
+	})
+	m.DryRun = true
+
+	if _, err := m.MigrateRoleActors(context.Background(), "EX", 10002, []string{"jsmith"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+}
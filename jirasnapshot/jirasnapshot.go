@@ -0,0 +1,221 @@
+// Package jirasnapshot archives the configuration and issues of a project of the cloud client
+// as a single JSON document, e.g. for audits or to compare a project across sites:
+//
+//	snapshotter := &jirasnapshot.Snapshotter{Client: client}
+//	err := snapshotter.Snapshot(ctx, "EX", file)
+//
+// The archive is a JSON object with the keys, in this order:
+//
+//	format      always "go-jira-snapshot"
+//	version     the version of the archive format, currently 1
+//	createdAt   the time the snapshot was started, in RFC 3339
+//	project     the project, as returned by ProjectService.Get
+//	components  the components of the project
+//	versions    the versions of the project
+//	roles       the project roles with their actors, ordered by name
+//	schemes     references to the permission, notification and issue security schemes of the project,
+//	            each with the id, name and self link, or null if the project has none
+//	issues      all issues of the project with all fields, ordered by key
+//	issueCount  the number of issues
+//
+// The issues are fetched page by page and written as they arrive,
+// so the memory use doesn't grow with the number of issues.
+package jirasnapshot
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/jql"
+)
+
+// Format is the value of the "format" key of an archive.
+const Format = "go-jira-snapshot"
+
+// Version is the version of the archive format written by Snapshot.
+const Version = 1
+
+// SchemeRef is the reference to a scheme of a project in an archive.
+type SchemeRef struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Self string `json:"self,omitempty"`
+}
+
+// Schemes are the schemes of a project in an archive.
+type Schemes struct {
+	Permission    *SchemeRef `json:"permission"`
+	Notification  *SchemeRef `json:"notification"`
+	IssueSecurity *SchemeRef `json:"issueSecurity"`
+}
+
+// Snapshotter writes snapshots of projects.
+type Snapshotter struct {
+	// Client fetches the project and its issues.
+	Client *jira.Client
+	// PageSize is the number of issues fetched per request. It will default to 100 if 0.
+	PageSize int
+}
+
+// Snapshot writes the archive of the project with the key to w.
+// The configuration of the project is fetched before anything is written,
+// an error while streaming the issues leaves an incomplete archive in w.
+func (s *Snapshotter) Snapshot(ctx context.Context, projectKey string, w io.Writer) error {
+	createdAt := time.Now().UTC()
+
+	project, _, err := s.Client.Project.Get(ctx, projectKey)
+	if err != nil {
+		return err
+	}
+	roles, err := s.roles(ctx, project)
+	if err != nil {
+		return err
+	}
+	schemes, err := s.schemes(ctx, project.Key)
+	if err != nil {
+		return err
+	}
+
+	aw := &archiveWriter{w: w}
+	aw.key("format", Format)
+	aw.key("version", Version)
+	aw.key("createdAt", createdAt.Format(time.RFC3339))
+	aw.key("project", project)
+	aw.key("components", nonNil(project.Components))
+	aw.key("versions", nonNil(project.Versions))
+	aw.key("roles", roles)
+	aw.key("schemes", schemes)
+	aw.raw(`,"issues":[`)
+	if aw.err != nil {
+		return aw.err
+	}
+
+	pageSize := s.PageSize
+	if pageSize == 0 {
+		pageSize = 100
+	}
+	query := jql.Project(project.Key).OrderBy("key", jql.Asc).String()
+	options := &jira.SearchOptions{MaxResults: pageSize, Fields: []string{"*all"}}
+	count := 0
+	err = s.Client.Issue.SearchPages(ctx, query, options, func(issue jira.Issue) error {
+		if count > 0 {
+			aw.raw(",")
+		}
+		aw.value(&issue)
+		count++
+		return aw.err
+	})
+	if err != nil {
+		return err
+	}
+
+	aw.raw("]")
+	aw.key("issueCount", count)
+	aw.raw("}\n")
+	return aw.err
+}
+
+// roles fetches the project roles of the project with their actors.
+func (s *Snapshotter) roles(ctx context.Context, project *jira.Project) ([]*jira.Role, error) {
+	names := make([]string, 0, len(project.Roles))
+	for name := range project.Roles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	roles := make([]*jira.Role, 0, len(names))
+	for _, name := range names {
+		// The roles of a project link to rest/api/2/project/{projectIdOrKey}/role/{id}.
+		id := path.Base(project.Roles[name])
+		role := new(jira.Role)
+		if _, err := s.Client.Call(ctx, http.MethodGet, "rest/api/2/project/"+project.Key+"/role/"+id, nil, nil, role); err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// schemes fetches the references to the schemes of the project.
+func (s *Snapshotter) schemes(ctx context.Context, projectKey string) (*Schemes, error) {
+	schemes := &Schemes{}
+
+	permission, _, err := s.Client.Project.GetPermissionScheme(ctx, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	schemes.Permission = &SchemeRef{ID: int64(permission.ID), Name: permission.Name, Self: permission.Self}
+
+	notification, resp, err := s.Client.Project.GetNotificationScheme(ctx, projectKey, nil)
+	switch {
+	case err == nil:
+		schemes.Notification = &SchemeRef{ID: notification.ID, Name: notification.Name, Self: notification.Self}
+	case resp == nil || resp.StatusCode != http.StatusNotFound:
+		return nil, err
+	}
+
+	security, resp, err := s.Client.Project.GetIssueSecurityLevelScheme(ctx, projectKey)
+	switch {
+	case err == nil:
+		schemes.IssueSecurity = &SchemeRef{ID: security.ID, Name: security.Name, Self: security.Self}
+	case resp == nil || resp.StatusCode != http.StatusNotFound:
+		return nil, err
+	}
+
+	return schemes, nil
+}
+
+// archiveWriter writes the keys of the archive object to w and keeps the first error.
+type archiveWriter struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+// key writes the key with the JSON encoded value, opening the archive object with the first key.
+func (aw *archiveWriter) key(name string, v interface{}) {
+	if aw.n == 0 {
+		aw.raw("{")
+	} else {
+		aw.raw(",")
+	}
+	aw.n++
+	aw.value(name)
+	aw.raw(":")
+	aw.value(v)
+}
+
+// value writes v encoded as JSON.
+func (aw *archiveWriter) value(v interface{}) {
+	if aw.err != nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		aw.err = err
+		return
+	}
+	_, aw.err = aw.w.Write(b)
+}
+
+// raw writes s as it is.
+func (aw *archiveWriter) raw(s string) {
+	if aw.err != nil {
+		return
+	}
+	_, aw.err = io.WriteString(aw.w, s)
+}
+
+// nonNil returns an empty slice instead of nil, so it is encoded as [] rather than null.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
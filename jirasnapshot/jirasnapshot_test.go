@@ -0,0 +1,102 @@
+package jirasnapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestSnapshotter_Snapshot(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/2/project/EX":
+			fmt.Fprintf(w, `{"id":"10000","key":"EX","name":"Example",
+				"components":[{"id":"100","name":"Backend"}],
+				"versions":[{"id":"200","name":"1.0"}],
+				"roles":{"Developers":"%[1]s/rest/api/2/project/10000/role/10002","Administrators":"%[1]s/rest/api/2/project/10000/role/10001"}}`, server.URL)
+		case "/rest/api/2/project/EX/role/10001":
+			fmt.Fprint(w, `{"id":10001,"name":"Administrators","actors":[{"id":1,"displayName":"Jane","type":"atlassian-user-role-actor"}]}`)
+		case "/rest/api/2/project/EX/role/10002":
+			fmt.Fprint(w, `{"id":10002,"name":"Developers","actors":[]}`)
+		case "/rest/api/2/project/EX/permissionscheme":
+			fmt.Fprint(w, `{"id":300,"name":"Default Permission Scheme"}`)
+		case "/rest/api/3/project/EX/notificationscheme":
+			fmt.Fprint(w, `{"id":400,"name":"Default Notification Scheme"}`)
+		case "/rest/api/3/project/EX/issuesecuritylevelscheme":
+			w.WriteHeader(http.StatusNotFound)
+		case "/rest/api/2/search":
+			if got := r.URL.Query().Get("jql"); got != `project = "EX" ORDER BY key ASC` {
+				t.Errorf("Unexpected JQL %s", got)
+			}
+			if r.URL.Query().Get("startAt") == "" {
+				fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":2,"issues":[{"id":"10001","key":"EX-1","fields":{"summary":"Login fails"}}]}`)
+			} else {
+				fmt.Fprint(w, `{"startAt":1,"maxResults":1,"total":2,"issues":[{"id":"10002","key":"EX-2","fields":{"summary":"Logout fails"}}]}`)
+			}
+		default:
+			t.Errorf("Unexpected request %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := jira.NewClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := (&Snapshotter{Client: client, PageSize: 1}).Snapshot(context.Background(), "EX", &buf); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	var archive struct {
+		Format     string                   `json:"format"`
+		Version    int                      `json:"version"`
+		CreatedAt  string                   `json:"createdAt"`
+		Project    jira.Project             `json:"project"`
+		Components []jira.ProjectComponent  `json:"components"`
+		Versions   []jira.Version           `json:"versions"`
+		Roles      []jira.Role              `json:"roles"`
+		Schemes    Schemes                  `json:"schemes"`
+		Issues     []map[string]interface{} `json:"issues"`
+		IssueCount int                      `json:"issueCount"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &archive); err != nil {
+		t.Fatalf("Invalid archive: %s\n%s", err, buf.String())
+	}
+
+	if archive.Format != Format || archive.Version != Version || archive.CreatedAt == "" {
+		t.Errorf("Unexpected header %s %d %s", archive.Format, archive.Version, archive.CreatedAt)
+	}
+	if archive.Project.Key != "EX" {
+		t.Errorf("Expected project EX, got %s", archive.Project.Key)
+	}
+	if len(archive.Components) != 1 || archive.Components[0].Name != "Backend" {
+		t.Errorf("Unexpected components %+v", archive.Components)
+	}
+	if len(archive.Versions) != 1 || archive.Versions[0].Name != "1.0" {
+		t.Errorf("Unexpected versions %+v", archive.Versions)
+	}
+	if len(archive.Roles) != 2 || archive.Roles[0].Name != "Administrators" || len(archive.Roles[0].Actors) != 1 {
+		t.Errorf("Unexpected roles %+v", archive.Roles)
+	}
+	if archive.Schemes.Permission == nil || archive.Schemes.Permission.ID != 300 {
+		t.Errorf("Unexpected permission scheme %+v", archive.Schemes.Permission)
+	}
+	if archive.Schemes.Notification == nil || archive.Schemes.Notification.Name != "Default Notification Scheme" {
+		t.Errorf("Unexpected notification scheme %+v", archive.Schemes.Notification)
+	}
+	if archive.Schemes.IssueSecurity != nil {
+		t.Errorf("Expected no issue security scheme, got %+v", archive.Schemes.IssueSecurity)
+	}
+	if archive.IssueCount != 2 || len(archive.Issues) != 2 || archive.Issues[1]["key"] != "EX-2" {
+		t.Errorf("Unexpected issues %d %v", archive.IssueCount, archive.Issues)
+	}
+}
@@ -0,0 +1,280 @@
+package jiratest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// RecordEnv is the environment variable switching the recorders of NewRecorder to record,
+// e.g. JIRATEST_RECORD=1 go test ./...
+const RecordEnv = "JIRATEST_RECORD"
+
+// RecorderMode is the mode of a Recorder.
+type RecorderMode int
+
+const (
+	// Replay serves the requests from the recorded interactions. Unknown requests fail.
+	Replay RecorderMode = iota
+	// Record sends the requests and records the interactions.
+	Record
+)
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a recorded request.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// URI is the path and query of the request, without the host of the Jira instance.
+	URI    string      `json:"uri"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder is an http.RoundTripper recording the interactions with a real Jira instance
+// to a golden file and replaying them in tests, so tests run against realistic payloads
+// without the instance.
+//
+// Credentials are scrubbed before the interactions are saved: the values of authorization
+// and cookie headers and of credential query parameters are always masked, and the Secrets
+// of the Redactor wherever they appear, e.g. email addresses.
+//
+// Example:
+//
+//	rec := jiratest.NewRecorder(t, "testdata/search.json")
+//	rec.Transport = (&jira.BasicAuthTransport{Username: user, APIToken: token}).Client().Transport
+//	client, _ := jira.NewClient(baseURL, rec.Client())
+//
+// The golden file is recorded with JIRATEST_RECORD=1 go test and replayed otherwise.
+type Recorder struct {
+	// Path is the path of the golden file.
+	Path string
+	// Mode is the mode of the recorder.
+	Mode RecorderMode
+	// Transport sends the requests while recording.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+	// Redactor scrubs the interactions while recording.
+	Redactor *jira.Redactor
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// NewRecorder returns a Recorder for the golden file at path, recording if the RecordEnv
+// environment variable is set and replaying otherwise. Recorded interactions are saved
+// when the test finishes.
+func NewRecorder(t testing.TB, path string) *Recorder {
+	t.Helper()
+
+	r := &Recorder{Path: path, Mode: Replay}
+	if os.Getenv(RecordEnv) != "" {
+		r.Mode = Record
+		t.Cleanup(func() {
+			if err := r.Save(); err != nil {
+				t.Errorf("jiratest: saving %s: %s", path, err)
+			}
+		})
+		return r
+	}
+	if err := r.Load(); err != nil {
+		t.Fatalf("jiratest: loading %s: %s, record it with %s=1", path, err, RecordEnv)
+	}
+	return r
+}
+
+// Client returns an *http.Client using the recorder.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Interactions returns the recorded or loaded interactions.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Interaction(nil), r.interactions...)
+}
+
+// Load reads the interactions from the golden file.
+func (r *Recorder) Load() error {
+	data, err := os.ReadFile(r.Path)
+	if err != nil {
+		return err
+	}
+	var interactions []Interaction
+	if err := json.Unmarshal(data, &interactions); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.interactions = interactions
+	r.used = make([]bool, len(interactions))
+	return nil
+}
+
+// Save writes the interactions to the golden file, creating its directory if needed.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.interactions, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.Path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.Path, append(data, '\n'), 0o644)
+}
+
+// RoundTrip records or replays the request.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Mode == Record {
+		return r.record(req, body)
+	}
+	return r.replay(req, body)
+}
+
+func (r *Recorder) record(req *http.Request, body []byte) (*http.Response, error) {
+	req2 := req.Clone(req.Context())
+	req2.Body = io.NopCloser(bytes.NewReader(body))
+	transport := r.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req2)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := readBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	header := resp.Header.Clone()
+	header.Del("Content-Encoding")
+	header.Del("Content-Length")
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URI:    r.uri(req2.URL),
+			Header: r.Redactor.Header(req2.Header),
+			Body:   r.Redactor.String(string(body)),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     r.Redactor.Header(header),
+			Body:       r.Redactor.String(string(respBody)),
+		},
+	}
+	r.mu.Lock()
+	r.interactions = append(r.interactions, interaction)
+	r.used = append(r.used, true)
+	r.mu.Unlock()
+
+	return response(req, resp.StatusCode, header, respBody), nil
+}
+
+func (r *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	uri := r.uri(req.URL)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Prefer an interaction with the same body, e.g. for searches sent with POST.
+	match := -1
+	for i, interaction := range r.interactions {
+		if r.used[i] || interaction.Request.Method != req.Method || interaction.Request.URI != uri {
+			continue
+		}
+		if interaction.Request.Body == r.Redactor.String(string(body)) {
+			match = i
+			break
+		}
+		if match < 0 {
+			match = i
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("jiratest: no recorded interaction for %s %s in %s", req.Method, uri, r.Path)
+	}
+
+	r.used[match] = true
+	recorded := r.interactions[match].Response
+	return response(req, recorded.StatusCode, recorded.Header.Clone(), []byte(recorded.Body)), nil
+}
+
+// uri returns the scrubbed path and query of u.
+func (r *Recorder) uri(u *url.URL) string {
+	return r.Redactor.URL(&url.URL{Path: u.Path, RawPath: u.RawPath, RawQuery: u.RawQuery})
+}
+
+// readBody reads the body of resp, decompressing it if it is gzip encoded,
+// so the golden files stay readable.
+func readBody(resp *http.Response) ([]byte, error) {
+	var body io.Reader = resp.Body
+	if strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		gz, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		body = gz
+	}
+	return io.ReadAll(body)
+}
+
+// response returns the response to req with the status code, header and body.
+func response(req *http.Request, code int, header http.Header, body []byte) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", code, http.StatusText(code)),
+		StatusCode:    code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
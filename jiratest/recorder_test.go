@@ -0,0 +1,93 @@
+package jiratest
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestRecorder(t *testing.T) {
+	srv := NewServer(t)
+	path := filepath.Join(t.TempDir(), "testdata", "issue.json")
+
+	rec := &Recorder{
+		Path:      path,
+		Mode:      Record,
+		Transport: (&jira.BasicAuthTransport{Username: "jdoe@example.com", APIToken: "s3cr3t"}).Client().Transport,
+		Redactor:  &jira.Redactor{Secrets: []string{"jdoe@example.com"}},
+	}
+	client, _ := jira.NewClient(srv.URL, rec.Client())
+	recorded, _, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if strings.Contains(string(data), "s3cr3t") || strings.Contains(string(data), "jdoe@example.com") {
+		t.Errorf("Expected the credentials to be scrubbed, got %s", data)
+	}
+
+	srv.Close()
+	rec = &Recorder{Path: path}
+	if err := rec.Load(); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	client, _ = jira.NewClient("https://example.atlassian.net", rec.Client())
+	replayed, _, err := client.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if replayed.Key != recorded.Key || replayed.Fields.Summary != recorded.Fields.Summary {
+		t.Errorf("Expected %s %q, got %s %q", recorded.Key, recorded.Fields.Summary, replayed.Key, replayed.Fields.Summary)
+	}
+
+	if _, _, err := client.Issue.Get(context.Background(), "EX-1", nil); err == nil {
+		t.Error("Expected an error for a replayed interaction")
+	}
+}
+
+func TestRecorder_Gzip(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(`{"accountId":"5b10a2844c20165700ede21g"}`))
+		_ = gz.Close()
+	}))
+	defer ts.Close()
+
+	rec := &Recorder{Path: filepath.Join(t.TempDir(), "myself.json"), Mode: Record}
+	client, _ := jira.NewClient(ts.URL, rec.Client())
+	user, _, err := client.User.GetCurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if user.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Expected the decompressed user, got %+v", user)
+	}
+	interactions := rec.Interactions()
+	if len(interactions) != 1 || !strings.Contains(interactions[0].Response.Body, "5b10a2844c20165700ede21g") {
+		t.Errorf("Unexpected interactions %+v", interactions)
+	}
+}
+
+func TestNewRecorder_Missing(t *testing.T) {
+	t.Setenv(RecordEnv, "1")
+	rec := NewRecorder(t, filepath.Join(t.TempDir(), "missing.json"))
+	if rec.Mode != Record {
+		t.Errorf("Expected the recorder to record with %s set", RecordEnv)
+	}
+}
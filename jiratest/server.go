@@ -0,0 +1,444 @@
+// Package jiratest provides a mock Jira server for the tests of code using the cloud client.
+//
+// NewServer starts an httptest.Server serving the common endpoints from fixtures,
+// with a client configured for it:
+//
+//	func TestTriage(t *testing.T) {
+//		srv := jiratest.NewServer(t)
+//
+//		err := triage(context.Background(), srv.Client, "EX-1")
+//
+//		issue, _ := srv.Issue("EX-1")
+//		if issue.Fields.Status.Name != "In Progress" {
+//			t.Errorf("Expected EX-1 to be in progress, got %s", issue.Fields.Status.Name)
+//		}
+//	}
+//
+// The server keeps the issues in memory, so created, edited and transitioned issues
+// are returned by later requests. Other endpoints can be added with Handle.
+package jiratest
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/andygrunwald/go-jira/v2/fakejira"
+)
+
+//go:embed fixtures/*.json
+var fixtures embed.FS
+
+// apiPath is the path of the REST API served.
+const apiPath = "/rest/api/2/"
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	// Path is the path of the request, e.g. "/rest/api/2/issue/EX-1".
+	Path  string
+	Query url.Values
+	Body  []byte
+}
+
+// Server is a mock Jira server. It serves the issues, projects and transitions of its fixtures:
+//   - GET and POST /rest/api/2/search, matching the JQL subset of fakejira.Compile,
+//   - POST /rest/api/2/issue and GET, PUT and DELETE /rest/api/2/issue/{issueIdOrKey},
+//   - GET and POST /rest/api/2/issue/{issueIdOrKey}/transitions,
+//   - GET /rest/api/2/project and /rest/api/2/project/{projectIdOrKey}.
+//
+// A Server is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+	// Client is a client for the server.
+	Client *jira.Client
+
+	custom *http.ServeMux
+
+	mu          sync.Mutex
+	projects    []jira.Project
+	issues      []*jira.Issue
+	transitions []jira.Transition
+	requests    []Request
+	nextID      int
+}
+
+// NewServer starts a Server with the fixtures: the project EX with the issues EX-1 and EX-2
+// and the transitions "To Do", "In Progress" and "Done". It is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{custom: http.NewServeMux(), nextID: 10100}
+	for name, v := range map[string]interface{}{
+		"fixtures/projects.json":    &s.projects,
+		"fixtures/issues.json":      &s.issues,
+		"fixtures/transitions.json": &s.transitions,
+	} {
+		data, err := fixtures.ReadFile(name)
+		if err != nil {
+			t.Fatalf("jiratest: reading %s: %s", name, err)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("jiratest: decoding %s: %s", name, err)
+		}
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	client, err := jira.NewClient(s.URL, s.Server.Client())
+	if err != nil {
+		t.Fatalf("jiratest: creating client: %s", err)
+	}
+	s.Client = client
+	return s
+}
+
+// Handle registers the handler for the pattern of an http.ServeMux.
+// It takes precedence over the endpoints of the server, e.g. to return errors.
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.custom.Handle(pattern, handler)
+}
+
+// HandleFunc registers the handler function for the pattern, like Handle.
+func (s *Server) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	s.custom.HandleFunc(pattern, handler)
+}
+
+// AddProject adds the project.
+func (s *Server) AddProject(project jira.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects = append(s.projects, project)
+}
+
+// AddIssue adds the issue, replacing an issue with the same key.
+// The ID and key are generated if they are empty, the key from the project of the issue.
+func (s *Server) AddIssue(issue jira.Issue) *jira.Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addIssue(&issue)
+}
+
+func (s *Server) addIssue(issue *jira.Issue) *jira.Issue {
+	if issue.Fields == nil {
+		issue.Fields = &jira.IssueFields{}
+	}
+	if issue.ID == "" {
+		s.nextID++
+		issue.ID = strconv.Itoa(s.nextID)
+	}
+	if issue.Key == "" {
+		issue.Key = s.nextKey(issue.Fields.Project.Key)
+	}
+	for i, existing := range s.issues {
+		if existing.Key == issue.Key {
+			s.issues[i] = issue
+			return copyIssue(issue)
+		}
+	}
+	s.issues = append(s.issues, issue)
+	return copyIssue(issue)
+}
+
+// nextKey returns the next free issue key of the project.
+func (s *Server) nextKey(projectKey string) string {
+	last := 0
+	for _, issue := range s.issues {
+		if n, ok := strings.CutPrefix(issue.Key, projectKey+"-"); ok {
+			if i, err := strconv.Atoi(n); err == nil && i > last {
+				last = i
+			}
+		}
+	}
+	return fmt.Sprintf("%s-%d", projectKey, last+1)
+}
+
+// Issue returns a copy of the issue with the ID or key.
+func (s *Server) Issue(idOrKey string) (*jira.Issue, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	issue := s.issue(idOrKey)
+	if issue == nil {
+		return nil, false
+	}
+	return copyIssue(issue), true
+}
+
+func (s *Server) issue(idOrKey string) *jira.Issue {
+	for _, issue := range s.issues {
+		if issue.ID == idOrKey || strings.EqualFold(issue.Key, idOrKey) {
+			return issue
+		}
+	}
+	return nil
+}
+
+// SetTransitions replaces the transitions available for all issues.
+// New issues get the status of the first transition.
+func (s *Server) SetTransitions(transitions []jira.Transition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.transitions = transitions
+}
+
+// Requests returns the requests received by the server.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// copyIssue returns a deep copy of issue.
+func copyIssue(issue *jira.Issue) *jira.Issue {
+	data, _ := json.Marshal(issue)
+	c := new(jira.Issue)
+	_ = json.Unmarshal(data, c)
+	return c
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.Query(), Body: body})
+	s.mu.Unlock()
+
+	if _, pattern := s.custom.Handler(r); pattern != "" {
+		s.custom.ServeHTTP(w, r)
+		return
+	}
+
+	path, ok := strings.CutPrefix(r.URL.Path, apiPath)
+	if !ok {
+		writeError(w, http.StatusNotFound, "jiratest: no endpoint for "+r.URL.Path)
+		return
+	}
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case len(parts) == 1 && parts[0] == "search":
+		s.search(w, r, body)
+	case len(parts) == 1 && parts[0] == "issue" && r.Method == http.MethodPost:
+		s.createIssue(w, body)
+	case len(parts) == 2 && parts[0] == "issue":
+		s.serveIssue(w, r, parts[1], body)
+	case len(parts) == 3 && parts[0] == "issue" && parts[2] == "transitions":
+		s.serveTransitions(w, r, parts[1], body)
+	case len(parts) == 1 && parts[0] == "project" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, s.projects)
+	case len(parts) == 2 && parts[0] == "project" && r.Method == http.MethodGet:
+		for _, project := range s.projects {
+			if project.ID == parts[1] || strings.EqualFold(project.Key, parts[1]) {
+				writeJSON(w, http.StatusOK, project)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, fmt.Sprintf("No project could be found with key '%s'.", parts[1]))
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("jiratest: no endpoint for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request, body []byte) {
+	query := r.URL.Query()
+	params := struct {
+		JQL        string `json:"jql"`
+		StartAt    int    `json:"startAt"`
+		MaxResults int    `json:"maxResults"`
+	}{JQL: query.Get("jql"), MaxResults: 50}
+	params.StartAt, _ = strconv.Atoi(query.Get("startAt"))
+	if n, err := strconv.Atoi(query.Get("maxResults")); err == nil && n > 0 {
+		params.MaxResults = n
+	}
+	if r.Method == http.MethodPost {
+		if err := json.Unmarshal(body, &params); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	} else if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "jiratest: search supports GET and POST")
+		return
+	}
+
+	jql, err := fakejira.Compile(params.JQL)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var matched []*jira.Issue
+	for _, issue := range s.issues {
+		if jql.Match(issue) {
+			matched = append(matched, s.withSelf(issue))
+		}
+	}
+	jql.Sort(matched)
+
+	page := []*jira.Issue{}
+	if params.StartAt < len(matched) {
+		end := params.StartAt + params.MaxResults
+		if end > len(matched) {
+			end = len(matched)
+		}
+		page = matched[params.StartAt:end]
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"startAt":    params.StartAt,
+		"maxResults": params.MaxResults,
+		"total":      len(matched),
+		"issues":     page,
+	})
+}
+
+func (s *Server) createIssue(w http.ResponseWriter, body []byte) {
+	issue := new(jira.Issue)
+	if err := json.Unmarshal(body, issue); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	errs := map[string]string{}
+	var project *jira.Project
+	if issue.Fields != nil {
+		for i := range s.projects {
+			p := &s.projects[i]
+			if (issue.Fields.Project.Key != "" && strings.EqualFold(p.Key, issue.Fields.Project.Key)) ||
+				(issue.Fields.Project.ID != "" && p.ID == issue.Fields.Project.ID) {
+				project = p
+			}
+		}
+	}
+	if project == nil {
+		errs["project"] = "valid project is required"
+	}
+	if issue.Fields == nil || issue.Fields.Summary == "" {
+		errs["summary"] = "You must specify a summary of the issue."
+	}
+	if issue.Fields == nil || (issue.Fields.Type.Name == "" && issue.Fields.Type.ID == "") {
+		errs["issuetype"] = "Specify an issue type"
+	}
+	if len(errs) > 0 {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{"errorMessages": []string{}, "errors": errs})
+		return
+	}
+
+	issue.ID, issue.Key = "", ""
+	issue.Fields.Project = jira.Project{ID: project.ID, Key: project.Key, Name: project.Name}
+	if issue.Fields.Status == nil && len(s.transitions) > 0 {
+		status := s.transitions[0].To
+		issue.Fields.Status = &status
+	}
+	created := s.addIssue(issue)
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"id":   created.ID,
+		"key":  created.Key,
+		"self": s.URL + apiPath + "issue/" + created.ID,
+	})
+}
+
+func (s *Server) serveIssue(w http.ResponseWriter, r *http.Request, idOrKey string, body []byte) {
+	issue := s.issue(idOrKey)
+	if issue == nil {
+		writeError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.withSelf(issue))
+	case http.MethodPut:
+		var edit struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+		if err := json.Unmarshal(body, &edit); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		// Merge the fields into the JSON of the issue, so any field can be edited.
+		data, _ := json.Marshal(issue.Fields)
+		fields := map[string]interface{}{}
+		_ = json.Unmarshal(data, &fields)
+		for id, value := range edit.Fields {
+			fields[id] = value
+		}
+		data, _ = json.Marshal(fields)
+		edited := new(jira.IssueFields)
+		if err := json.Unmarshal(data, edited); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		issue.Fields = edited
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodDelete:
+		for i, existing := range s.issues {
+			if existing == issue {
+				s.issues = append(s.issues[:i], s.issues[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "jiratest: issues support GET, PUT and DELETE")
+	}
+}
+
+func (s *Server) serveTransitions(w http.ResponseWriter, r *http.Request, idOrKey string, body []byte) {
+	issue := s.issue(idOrKey)
+	if issue == nil {
+		writeError(w, http.StatusNotFound, "Issue does not exist or you do not have permission to see it.")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"transitions": s.transitions})
+	case http.MethodPost:
+		var payload jira.CreateTransitionPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, t := range s.transitions {
+			if t.ID == payload.Transition.ID {
+				status := t.To
+				issue.Fields.Status = &status
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Transition id '%s' is not valid for this issue.", payload.Transition.ID))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "jiratest: transitions support GET and POST")
+	}
+}
+
+// withSelf returns a copy of issue with its self link on the server.
+func (s *Server) withSelf(issue *jira.Issue) *jira.Issue {
+	c := copyIssue(issue)
+	c.Self = s.URL + apiPath + "issue/" + issue.ID
+	return c
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error in the format of Jira.
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]interface{}{"errorMessages": []string{message}, "errors": map[string]string{}})
+}
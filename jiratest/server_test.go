@@ -0,0 +1,153 @@
+package jiratest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestServer_Search(t *testing.T) {
+	srv := NewServer(t)
+	ctx := context.Background()
+
+	for jql, want := range map[string][]string{
+		"":                                       {"EX-1", "EX-2"},
+		`project = "EX" AND status = "To Do"`:    {"EX-1"},
+		`project = EX AND labels in (docs, api)`: {"EX-2"},
+		`key not in ("EX-1") ORDER BY created`:   {"EX-2"},
+		`status != 'In Progress' AND type = Bug`: {"EX-1"},
+		`project = OTHER`:                        {},
+	} {
+		issues, _, err := srv.Client.Issue.Search(ctx, jql, nil)
+		if err != nil {
+			t.Errorf("Error given for %q: %s", jql, err)
+			continue
+		}
+		var keys []string
+		for _, issue := range issues {
+			keys = append(keys, issue.Key)
+		}
+		if len(keys) != len(want) || (len(want) > 0 && keys[0] != want[0]) {
+			t.Errorf("Search %q: expected %v, got %v", jql, want, keys)
+		}
+	}
+
+	if _, _, err := srv.Client.Issue.Search(ctx, "created >= -1d", nil); err == nil {
+		t.Error("Expected an error for unsupported JQL")
+	}
+
+	issues, resp, err := srv.Client.Issue.Search(ctx, "", &jira.SearchOptions{StartAt: 1, MaxResults: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(issues) != 1 || issues[0].Key != "EX-2" || resp.Total != 2 {
+		t.Errorf("Unexpected page %v of %d", issues, resp.Total)
+	}
+}
+
+func TestServer_CreateGetUpdate(t *testing.T) {
+	srv := NewServer(t)
+	ctx := context.Background()
+
+	created, _, err := srv.Client.Issue.Create(ctx, &jira.Issue{Fields: &jira.IssueFields{
+		Project: jira.Project{Key: "EX"},
+		Type:    jira.IssueType{Name: "Task"},
+		Summary: "Write tests",
+	}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if created.Key != "EX-3" {
+		t.Errorf("Expected the key EX-3, got %s", created.Key)
+	}
+
+	if _, err := srv.Client.Issue.UpdateIssue(ctx, "EX-3", map[string]interface{}{
+		"fields": map[string]interface{}{"summary": "Write more tests"},
+	}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	issue, _, err := srv.Client.Issue.Get(ctx, created.ID, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Fields.Summary != "Write more tests" || issue.Fields.Status.Name != "To Do" || issue.Fields.Type.Name != "Task" {
+		t.Errorf("Unexpected issue %+v", issue.Fields)
+	}
+
+	_, resp, err := srv.Client.Issue.Create(ctx, &jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: "NOPE"}}})
+	if err == nil || resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a bad request, got %v", err)
+	}
+
+	_, resp, err = srv.Client.Issue.Get(ctx, "EX-99", nil)
+	if err == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected not found, got %v", err)
+	}
+}
+
+func TestServer_Transitions(t *testing.T) {
+	srv := NewServer(t)
+	ctx := context.Background()
+
+	transitions, _, err := srv.Client.Issue.GetTransitions(ctx, "EX-1")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(transitions) != 3 {
+		t.Fatalf("Expected 3 transitions, got %d", len(transitions))
+	}
+	if _, err := srv.Client.Issue.DoTransition(ctx, "EX-1", "31"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue, _ := srv.Issue("EX-1"); issue.Fields.Status.Name != "Done" {
+		t.Errorf("Expected EX-1 to be done, got %s", issue.Fields.Status.Name)
+	}
+	if _, err := srv.Client.Issue.DoTransition(ctx, "EX-1", "99"); err == nil {
+		t.Error("Expected an error for an unknown transition")
+	}
+}
+
+func TestServer_Projects(t *testing.T) {
+	srv := NewServer(t)
+	srv.AddProject(jira.Project{ID: "10001", Key: "OPS", Name: "Operations"})
+	srv.AddIssue(jira.Issue{Fields: &jira.IssueFields{Project: jira.Project{Key: "OPS"}, Summary: "Rotate keys"}})
+
+	projects, _, err := srv.Client.Project.GetAll(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*projects) != 2 {
+		t.Errorf("Expected 2 projects, got %d", len(*projects))
+	}
+	project, _, err := srv.Client.Project.Get(context.Background(), "OPS")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if project.Name != "Operations" {
+		t.Errorf("Expected Operations, got %s", project.Name)
+	}
+	if _, ok := srv.Issue("OPS-1"); !ok {
+		t.Error("Expected the issue OPS-1")
+	}
+}
+
+func TestServer_Handle(t *testing.T) {
+	srv := NewServer(t)
+	srv.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"errorMessages":["Maintenance"]}`, http.StatusServiceUnavailable)
+	})
+
+	_, _, err := srv.Client.Issue.Get(context.Background(), "EX-1", nil)
+	var jerr *jira.Error
+	if !errors.As(err, &jerr) || jerr.HTTPError == nil {
+		t.Errorf("Expected a Jira error, got %v", err)
+	}
+	requests := srv.Requests()
+	if len(requests) == 0 || requests[len(requests)-1].Path != "/rest/api/2/issue/EX-1" {
+		t.Errorf("Unexpected requests %v", requests)
+	}
+}
@@ -0,0 +1,227 @@
+package jirawebhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// DefaultMaxBodySize is the maximum size of the payloads accepted by a Handler if MaxBodySize is 0.
+const DefaultMaxBodySize = 10 << 20
+
+// Handler is an http.Handler receiving webhooks. It verifies and parses the payloads and
+// dispatches the events to the callbacks registered for their type.
+//
+// The status codes make Jira retry the deliveries that might succeed later:
+//   - 204 if the callbacks succeeded or no callback is registered for the event,
+//   - 400 if the payload is invalid and 401 if it is not authenticated,
+//   - 422 if a callback failed with an error wrapped by Permanent, which is not retried,
+//   - 500 if a callback failed with another error or panicked.
+//
+// Example:
+//
+//	h := jirawebhook.NewHandler(secret)
+//	h.OnIssueUpdated(func(ctx context.Context, e *jirawebhook.IssueEvent) error {
+//		return index(ctx, e.Issue)
+//	})
+//	http.Handle("/webhook", h)
+type Handler struct {
+	// Secret verifies the SignatureHeader of the payloads, if set.
+	Secret string
+	// Verify authenticates the requests in addition to Secret, e.g. with VerifyJWT.
+	Verify func(r *http.Request) error
+	// MaxBodySize is the maximum size of the payloads, DefaultMaxBodySize if 0.
+	MaxBodySize int64
+	// OnError is called with the errors of the requests and the callbacks, e.g. to log them.
+	OnError func(r *http.Request, err error)
+
+	mu        sync.RWMutex
+	callbacks map[string][]func(ctx context.Context, event Event) error
+	fallback  []func(ctx context.Context, event Event) error
+}
+
+// NewHandler returns a Handler verifying the payloads with the secret of the webhook.
+// An empty secret disables the verification.
+func NewHandler(secret string) *Handler {
+	return &Handler{Secret: secret}
+}
+
+// On registers the callback for the events of the types, like EventIssueCreated.
+// Without types, the callback is called for the events without another callback.
+func (h *Handler) On(f func(ctx context.Context, event Event) error, types ...string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(types) == 0 {
+		h.fallback = append(h.fallback, f)
+		return
+	}
+	if h.callbacks == nil {
+		h.callbacks = map[string][]func(ctx context.Context, event Event) error{}
+	}
+	for _, typ := range types {
+		h.callbacks[typ] = append(h.callbacks[typ], f)
+	}
+}
+
+// OnIssueCreated registers the callback for EventIssueCreated.
+func (h *Handler) OnIssueCreated(f func(ctx context.Context, event *IssueEvent) error) {
+	h.On(typed(f), EventIssueCreated)
+}
+
+// OnIssueUpdated registers the callback for EventIssueUpdated.
+func (h *Handler) OnIssueUpdated(f func(ctx context.Context, event *IssueEvent) error) {
+	h.On(typed(f), EventIssueUpdated)
+}
+
+// OnIssueDeleted registers the callback for EventIssueDeleted.
+func (h *Handler) OnIssueDeleted(f func(ctx context.Context, event *IssueEvent) error) {
+	h.On(typed(f), EventIssueDeleted)
+}
+
+// OnComment registers the callback for EventCommentCreated, EventCommentUpdated and EventCommentDeleted.
+func (h *Handler) OnComment(f func(ctx context.Context, event *CommentEvent) error) {
+	h.On(typed(f), EventCommentCreated, EventCommentUpdated, EventCommentDeleted)
+}
+
+// OnWorklog registers the callback for EventWorklogCreated, EventWorklogUpdated and EventWorklogDeleted.
+func (h *Handler) OnWorklog(f func(ctx context.Context, event *WorklogEvent) error) {
+	h.On(typed(f), EventWorklogCreated, EventWorklogUpdated, EventWorklogDeleted)
+}
+
+// OnSprint registers the callback for the sprint events, like EventSprintStarted.
+func (h *Handler) OnSprint(f func(ctx context.Context, event *SprintEvent) error) {
+	h.On(typed(f), EventSprintCreated, EventSprintUpdated, EventSprintStarted, EventSprintClosed, EventSprintDeleted)
+}
+
+// OnVersion registers the callback for the version events, like EventVersionReleased.
+func (h *Handler) OnVersion(f func(ctx context.Context, event *VersionEvent) error) {
+	h.On(typed(f), EventVersionCreated, EventVersionUpdated, EventVersionReleased, EventVersionUnreleased,
+		EventVersionMoved, EventVersionMerged, EventVersionDeleted)
+}
+
+// OnUser registers the callback for EventUserCreated, EventUserUpdated and EventUserDeleted.
+func (h *Handler) OnUser(f func(ctx context.Context, event *UserEvent) error) {
+	h.On(typed(f), EventUserCreated, EventUserUpdated, EventUserDeleted)
+}
+
+// typed adapts the callback of an event type to a callback of all events.
+func typed[E Event](f func(ctx context.Context, event E) error) func(ctx context.Context, event Event) error {
+	return func(ctx context.Context, event Event) error {
+		e, ok := event.(E)
+		if !ok {
+			return Permanent(fmt.Errorf("jirawebhook: unexpected %T for %s", event, event.Type()))
+		}
+		return f(ctx, e)
+	}
+}
+
+// permanentError is an error of a callback not to be retried.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent wraps the error of a callback, so the Handler responds with 422 and Jira does not retry the delivery,
+// e.g. if the event refers to an unknown project.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// ServeHTTP handles the webhook request r.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	maxBodySize := h.MaxBodySize
+	if maxBodySize == 0 {
+		maxBodySize = DefaultMaxBodySize
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			h.fail(w, r, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.Secret != "" {
+		if err := VerifySignature(body, r.Header.Get(SignatureHeader), h.Secret); err != nil {
+			h.fail(w, r, http.StatusUnauthorized, err)
+			return
+		}
+	}
+	if h.Verify != nil {
+		if err := h.Verify(r); err != nil {
+			h.fail(w, r, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	event, err := ParseEvent(body)
+	if err != nil {
+		h.fail(w, r, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.dispatch(r.Context(), event); err != nil {
+		var permanent *permanentError
+		if errors.As(err, &permanent) {
+			h.fail(w, r, http.StatusUnprocessableEntity, err)
+			return
+		}
+		h.fail(w, r, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// dispatch calls the callbacks of the event until one fails.
+func (h *Handler) dispatch(ctx context.Context, event Event) error {
+	h.mu.RLock()
+	callbacks, ok := h.callbacks[event.Type()]
+	if !ok {
+		callbacks = h.fallback
+	}
+	h.mu.RUnlock()
+
+	for _, f := range callbacks {
+		if err := call(ctx, f, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// call calls the callback f, returning a panic as error.
+func call(ctx context.Context, f func(ctx context.Context, event Event) error, event Event) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("jirawebhook: panic in callback for %s: %v", event.Type(), p)
+		}
+	}()
+	return f(ctx, event)
+}
+
+// fail responds with the status code and reports err to OnError.
+// The error is not written to the response, as it may contain details of the callbacks.
+func (h *Handler) fail(w http.ResponseWriter, r *http.Request, code int, err error) {
+	if h.OnError != nil {
+		h.OnError(r, err)
+	}
+	http.Error(w, http.StatusText(code), code)
+}
@@ -0,0 +1,119 @@
+package jirawebhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func serve(h *Handler, method, payload, secret string) int {
+	r := httptest.NewRequest(method, "/webhook", strings.NewReader(payload))
+	if secret != "" {
+		r.Header.Set(SignatureHeader, Sign([]byte(payload), secret))
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Code
+}
+
+func TestHandler_Dispatch(t *testing.T) {
+	h := NewHandler("secret")
+	var updated, comments, fallback []string
+	h.OnIssueUpdated(func(ctx context.Context, e *IssueEvent) error {
+		updated = append(updated, e.Issue.Key)
+		return nil
+	})
+	h.OnComment(func(ctx context.Context, e *CommentEvent) error {
+		comments = append(comments, e.Comment.Body)
+		return nil
+	})
+	h.On(func(ctx context.Context, e Event) error {
+		fallback = append(fallback, e.Type())
+		return nil
+	})
+
+	for _, payload := range []string{
+		`{"webhookEvent":"jira:issue_updated","issue":{"key":"EX-1"}}`,
+		`{"webhookEvent":"comment_updated","comment":{"body":"Edited"}}`,
+		`{"webhookEvent":"project_created"}`,
+	} {
+		if code := serve(h, http.MethodPost, payload, "secret"); code != http.StatusNoContent {
+			t.Errorf("Expected status 204 for %s, got %d", payload, code)
+		}
+	}
+	if len(updated) != 1 || updated[0] != "EX-1" {
+		t.Errorf("Unexpected issue updates %v", updated)
+	}
+	if len(comments) != 1 || comments[0] != "Edited" {
+		t.Errorf("Unexpected comments %v", comments)
+	}
+	if len(fallback) != 1 || fallback[0] != "project_created" {
+		t.Errorf("Unexpected fallback events %v", fallback)
+	}
+}
+
+func TestHandler_StatusCodes(t *testing.T) {
+	var reported []error
+	h := NewHandler("secret")
+	h.OnError = func(r *http.Request, err error) {
+		reported = append(reported, err)
+	}
+	h.OnIssueCreated(func(ctx context.Context, e *IssueEvent) error {
+		switch e.Issue.Key {
+		case "EX-1":
+			return errors.New("database is down")
+		case "EX-2":
+			return Permanent(errors.New("unknown project"))
+		default:
+			panic("boom")
+		}
+	})
+
+	for _, tt := range []struct {
+		name    string
+		method  string
+		payload string
+		secret  string
+		want    int
+	}{
+		{"method", http.MethodGet, "", "", http.StatusMethodNotAllowed},
+		{"unsigned", http.MethodPost, `{"webhookEvent":"jira:issue_deleted"}`, "", http.StatusUnauthorized},
+		{"wrong secret", http.MethodPost, `{"webhookEvent":"jira:issue_deleted"}`, "other", http.StatusUnauthorized},
+		{"invalid payload", http.MethodPost, `{}`, "secret", http.StatusBadRequest},
+		{"no callback", http.MethodPost, `{"webhookEvent":"jira:issue_deleted"}`, "secret", http.StatusNoContent},
+		{"error", http.MethodPost, `{"webhookEvent":"jira:issue_created","issue":{"key":"EX-1"}}`, "secret", http.StatusInternalServerError},
+		{"permanent error", http.MethodPost, `{"webhookEvent":"jira:issue_created","issue":{"key":"EX-2"}}`, "secret", http.StatusUnprocessableEntity},
+		{"panic", http.MethodPost, `{"webhookEvent":"jira:issue_created","issue":{"key":"EX-3"}}`, "secret", http.StatusInternalServerError},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := serve(h, tt.method, tt.payload, tt.secret); code != tt.want {
+				t.Errorf("Expected status %d, got %d", tt.want, code)
+			}
+		})
+	}
+	if len(reported) != 6 {
+		t.Errorf("Expected 6 reported errors, got %d: %v", len(reported), reported)
+	}
+}
+
+func TestHandler_MaxBodySize(t *testing.T) {
+	h := &Handler{MaxBodySize: 10}
+	if code := serve(h, http.MethodPost, `{"webhookEvent":"jira:issue_created"}`, ""); code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected status 413, got %d", code)
+	}
+}
+
+func TestHandler_Verify(t *testing.T) {
+	h := &Handler{Verify: func(r *http.Request) error {
+		if r.Header.Get("Authorization") == "" {
+			return ErrMissingSignature
+		}
+		return nil
+	}}
+	if code := serve(h, http.MethodPost, `{"webhookEvent":"jira:issue_created"}`, ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", code)
+	}
+}
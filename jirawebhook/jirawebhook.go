@@ -0,0 +1,207 @@
+// Package jirawebhook parses the payloads of Jira webhooks.
+//
+// ParseEvent returns one of the event types of this package, depending on the "webhookEvent"
+// of the payload:
+//
+//	event, err := jirawebhook.ParseEvent(body)
+//	if err != nil {
+//		http.Error(w, err.Error(), http.StatusBadRequest)
+//		return
+//	}
+//	switch e := event.(type) {
+//	case *jirawebhook.IssueEvent:
+//		log.Printf("%s: %s", e.WebhookEvent, e.Issue.Key)
+//	case *jirawebhook.CommentEvent:
+//		log.Printf("comment on %s: %s", e.Issue.Key, e.Comment.Body)
+//	}
+//
+// The payloads embed the REST API representations of issues, users and so on,
+// which are decoded into the types of the cloud package. Jira Data Center sends the same shapes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/webhooks/
+package jirawebhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// Webhook events, the "webhookEvent" of the payloads.
+const (
+	EventIssueCreated = "jira:issue_created"
+	EventIssueUpdated = "jira:issue_updated"
+	EventIssueDeleted = "jira:issue_deleted"
+
+	EventCommentCreated = "comment_created"
+	EventCommentUpdated = "comment_updated"
+	EventCommentDeleted = "comment_deleted"
+
+	EventWorklogCreated = "worklog_created"
+	EventWorklogUpdated = "worklog_updated"
+	EventWorklogDeleted = "worklog_deleted"
+
+	EventSprintCreated = "sprint_created"
+	EventSprintUpdated = "sprint_updated"
+	EventSprintStarted = "sprint_started"
+	EventSprintClosed  = "sprint_closed"
+	EventSprintDeleted = "sprint_deleted"
+
+	EventVersionCreated    = "jira:version_created"
+	EventVersionUpdated    = "jira:version_updated"
+	EventVersionReleased   = "jira:version_released"
+	EventVersionUnreleased = "jira:version_unreleased"
+	EventVersionMoved      = "jira:version_moved"
+	EventVersionMerged     = "jira:version_merged"
+	EventVersionDeleted    = "jira:version_deleted"
+
+	EventUserCreated = "user_created"
+	EventUserUpdated = "user_updated"
+	EventUserDeleted = "user_deleted"
+)
+
+// ErrNoEventType is returned by ParseEvent if the payload has no "webhookEvent".
+var ErrNoEventType = errors.New("jirawebhook: payload has no webhookEvent")
+
+// Event is a parsed webhook payload: *IssueEvent, *CommentEvent, *WorklogEvent, *SprintEvent,
+// *VersionEvent, *UserEvent or *UnknownEvent.
+type Event interface {
+	// Type returns the webhook event, like EventIssueCreated.
+	Type() string
+	// Time returns the time the event happened.
+	Time() time.Time
+}
+
+// Header contains the fields common to all webhook payloads.
+type Header struct {
+	// Timestamp is the time of the event in milliseconds since the Unix epoch.
+	Timestamp    int64  `json:"timestamp"`
+	WebhookEvent string `json:"webhookEvent"`
+}
+
+// Type returns the webhook event, like EventIssueCreated.
+func (h Header) Type() string {
+	return h.WebhookEvent
+}
+
+// Time returns the time the event happened.
+func (h Header) Time() time.Time {
+	return time.UnixMilli(h.Timestamp)
+}
+
+// IssueEvent is the payload of the issue events, like EventIssueUpdated.
+type IssueEvent struct {
+	Header
+	// IssueEventTypeName is the kind of the change, like "issue_generic", "issue_assigned" or "issue_commented".
+	IssueEventTypeName string `json:"issue_event_type_name,omitempty"`
+	// User is the user who changed the issue.
+	User  *jira.User  `json:"user,omitempty"`
+	Issue *jira.Issue `json:"issue,omitempty"`
+	// Changelog contains the changed fields of EventIssueUpdated.
+	Changelog *Changelog `json:"changelog,omitempty"`
+	// Comment is the comment added or edited with the change, if any.
+	Comment *jira.Comment `json:"comment,omitempty"`
+}
+
+// Changelog is the change of an issue of an IssueEvent.
+type Changelog struct {
+	ID    string                `json:"id"`
+	Items []jira.ChangelogItems `json:"items"`
+}
+
+// Item returns the change of the field, like "status", or nil if it did not change.
+func (c *Changelog) Item(field string) *jira.ChangelogItems {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Items {
+		if strings.EqualFold(c.Items[i].Field, field) {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// CommentEvent is the payload of the comment events, like EventCommentCreated.
+type CommentEvent struct {
+	Header
+	Comment *jira.Comment `json:"comment,omitempty"`
+	// Issue is the commented issue. Only a few fields of it are included.
+	Issue *jira.Issue `json:"issue,omitempty"`
+}
+
+// WorklogEvent is the payload of the worklog events, like EventWorklogCreated.
+type WorklogEvent struct {
+	Header
+	Worklog *jira.WorklogRecord `json:"worklog,omitempty"`
+}
+
+// SprintEvent is the payload of the sprint events, like EventSprintStarted.
+type SprintEvent struct {
+	Header
+	Sprint *jira.Sprint `json:"sprint,omitempty"`
+	// OldValue is the sprint before the change of EventSprintUpdated.
+	OldValue *jira.Sprint `json:"oldValue,omitempty"`
+}
+
+// VersionEvent is the payload of the version events, like EventVersionReleased.
+type VersionEvent struct {
+	Header
+	Version *jira.Version `json:"version,omitempty"`
+	// MergedTo is the version the version was merged into with EventVersionMerged.
+	MergedTo *jira.Version `json:"mergedTo,omitempty"`
+}
+
+// UserEvent is the payload of the user events, like EventUserCreated.
+type UserEvent struct {
+	Header
+	User *jira.User `json:"user,omitempty"`
+}
+
+// UnknownEvent is the payload of an event without a type of this package,
+// e.g. of project or board events.
+type UnknownEvent struct {
+	Header
+	// Payload is the complete payload.
+	Payload json.RawMessage `json:"-"`
+}
+
+// ParseEvent parses the webhook payload data.
+// Payloads of unknown events are returned as *UnknownEvent.
+func ParseEvent(data []byte) (Event, error) {
+	var header Header
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("jirawebhook: invalid payload: %w", err)
+	}
+	if header.WebhookEvent == "" {
+		return nil, ErrNoEventType
+	}
+
+	var event Event
+	switch header.WebhookEvent {
+	case EventIssueCreated, EventIssueUpdated, EventIssueDeleted:
+		event = new(IssueEvent)
+	case EventCommentCreated, EventCommentUpdated, EventCommentDeleted:
+		event = new(CommentEvent)
+	case EventWorklogCreated, EventWorklogUpdated, EventWorklogDeleted:
+		event = new(WorklogEvent)
+	case EventSprintCreated, EventSprintUpdated, EventSprintStarted, EventSprintClosed, EventSprintDeleted:
+		event = new(SprintEvent)
+	case EventVersionCreated, EventVersionUpdated, EventVersionReleased, EventVersionUnreleased,
+		EventVersionMoved, EventVersionMerged, EventVersionDeleted:
+		event = new(VersionEvent)
+	case EventUserCreated, EventUserUpdated, EventUserDeleted:
+		event = new(UserEvent)
+	default:
+		return &UnknownEvent{Header: header, Payload: append(json.RawMessage(nil), data...)}, nil
+	}
+
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, fmt.Errorf("jirawebhook: invalid %s payload: %w", header.WebhookEvent, err)
+	}
+	return event, nil
+}
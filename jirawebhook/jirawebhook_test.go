@@ -0,0 +1,121 @@
+package jirawebhook
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseEvent_IssueUpdated(t *testing.T) {
+	payload := `{"timestamp":1710000000000,"webhookEvent":"jira:issue_updated","issue_event_type_name":"issue_generic",` +
+		`"user":{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Jane"},` +
+		`"issue":{"id":"10002","key":"EX-1","fields":{"summary":"Login fails","status":{"name":"In Progress"}}},` +
+		`"changelog":{"id":"10100","items":[{"field":"status","fieldtype":"jira","from":"1","fromString":"Open","to":"3","toString":"In Progress"}]}}`
+
+	event, err := ParseEvent([]byte(payload))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	e, ok := event.(*IssueEvent)
+	if !ok {
+		t.Fatalf("Expected *IssueEvent, got %T", event)
+	}
+	if e.Type() != EventIssueUpdated {
+		t.Errorf("Expected type %s, got %s", EventIssueUpdated, e.Type())
+	}
+	if want := time.UnixMilli(1710000000000); !e.Time().Equal(want) {
+		t.Errorf("Expected time %s, got %s", want, e.Time())
+	}
+	if e.Issue.Key != "EX-1" || e.Issue.Fields.Summary != "Login fails" {
+		t.Errorf("Unexpected issue %+v", e.Issue)
+	}
+	if e.User.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+		t.Errorf("Unexpected user %+v", e.User)
+	}
+	item := e.Changelog.Item("Status")
+	if item == nil || item.FromString != "Open" || item.ToString != "In Progress" {
+		t.Errorf("Unexpected status change %+v", item)
+	}
+	if e.Changelog.Item("assignee") != nil {
+		t.Error("Expected no assignee change")
+	}
+}
+
+func TestParseEvent_Types(t *testing.T) {
+	for _, tt := range []struct {
+		payload string
+		check   func(t *testing.T, event Event)
+	}{
+		{
+			payload: `{"webhookEvent":"comment_created","comment":{"id":"10000","body":"Looks good"},"issue":{"key":"EX-1"}}`,
+			check: func(t *testing.T, event Event) {
+				e := event.(*CommentEvent)
+				if e.Comment.Body != "Looks good" || e.Issue.Key != "EX-1" {
+					t.Errorf("Unexpected comment event %+v", e)
+				}
+			},
+		},
+		{
+			payload: `{"webhookEvent":"worklog_updated","worklog":{"id":"100028","issueId":"10002","timeSpent":"3h 20m","timeSpentSeconds":12000}}`,
+			check: func(t *testing.T, event Event) {
+				if e := event.(*WorklogEvent); e.Worklog.TimeSpentSeconds != 12000 {
+					t.Errorf("Unexpected worklog %+v", e.Worklog)
+				}
+			},
+		},
+		{
+			payload: `{"webhookEvent":"sprint_started","sprint":{"id":37,"state":"active","name":"Sprint 2","startDate":"2024-03-01T10:00:00.000Z","originBoardId":5}}`,
+			check: func(t *testing.T, event Event) {
+				e := event.(*SprintEvent)
+				if e.Sprint.ID != 37 || e.Sprint.State != "active" || e.Sprint.StartDate == nil {
+					t.Errorf("Unexpected sprint %+v", e.Sprint)
+				}
+			},
+		},
+		{
+			payload: `{"webhookEvent":"jira:version_merged","version":{"id":"10000","name":"1.0"},"mergedTo":{"id":"10001","name":"1.1"}}`,
+			check: func(t *testing.T, event Event) {
+				e := event.(*VersionEvent)
+				if e.Version.Name != "1.0" || e.MergedTo.Name != "1.1" {
+					t.Errorf("Unexpected versions %+v, %+v", e.Version, e.MergedTo)
+				}
+			},
+		},
+		{
+			payload: `{"webhookEvent":"user_deleted","user":{"accountId":"5b10ac8d82e05b22cc7d4ef5"}}`,
+			check: func(t *testing.T, event Event) {
+				if e := event.(*UserEvent); e.User.AccountID != "5b10ac8d82e05b22cc7d4ef5" {
+					t.Errorf("Unexpected user %+v", e.User)
+				}
+			},
+		},
+		{
+			payload: `{"webhookEvent":"project_created","project":{"key":"EX"}}`,
+			check: func(t *testing.T, event Event) {
+				e := event.(*UnknownEvent)
+				if e.Type() != "project_created" || len(e.Payload) == 0 {
+					t.Errorf("Unexpected unknown event %+v", e)
+				}
+			},
+		},
+	} {
+		event, err := ParseEvent([]byte(tt.payload))
+		if err != nil {
+			t.Errorf("Error given for %s: %s", tt.payload, err)
+			continue
+		}
+		tt.check(t, event)
+	}
+}
+
+func TestParseEvent_Errors(t *testing.T) {
+	if _, err := ParseEvent([]byte(`{"timestamp":1}`)); !errors.Is(err, ErrNoEventType) {
+		t.Errorf("Expected ErrNoEventType, got %v", err)
+	}
+	if _, err := ParseEvent([]byte(`{`)); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+	if _, err := ParseEvent([]byte(`{"webhookEvent":"jira:issue_created","issue":"EX-1"}`)); err == nil {
+		t.Error("Expected an error for an invalid issue")
+	}
+}
@@ -0,0 +1,133 @@
+package jirawebhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// SignatureHeader is the header with the HMAC signature of the payloads of webhooks registered with a secret.
+const SignatureHeader = "X-Hub-Signature"
+
+var (
+	// ErrMissingSignature is returned if a request has no signature or JWT.
+	ErrMissingSignature = errors.New("jirawebhook: missing signature")
+	// ErrInvalidSignature is returned if the signature or JWT of a request does not match.
+	ErrInvalidSignature = errors.New("jirawebhook: invalid signature")
+)
+
+// signatureHashes are the hashes of the methods of the signature header.
+var signatureHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+// Sign returns the signature of payload with the secret as sent in the SignatureHeader,
+// like "sha256=<hex>", e.g. to test webhook receivers.
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature, the value of the SignatureHeader like "sha256=<hex>",
+// is the HMAC of payload with the secret of the webhook. The signatures are compared in constant time.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/webhooks/#secure-admin-webhooks
+func VerifySignature(payload []byte, signature, secret string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+	method, sig, ok := strings.Cut(signature, "=")
+	if !ok {
+		return fmt.Errorf("%w: malformed %s", ErrInvalidSignature, SignatureHeader)
+	}
+	newHash, ok := signatureHashes[strings.ToLower(method)]
+	if !ok {
+		return fmt.Errorf("%w: unsupported method %q", ErrInvalidSignature, method)
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return fmt.Errorf("%w: malformed %s", ErrInvalidSignature, SignatureHeader)
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(payload)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// VerifyRequest reads the body of the webhook request r and verifies its SignatureHeader with the secret.
+// It returns the body, e.g. for ParseEvent, and sets r.Body to read it again.
+func VerifyRequest(r *http.Request, secret string) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("jirawebhook: reading body: %w", err)
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := VerifySignature(body, r.Header.Get(SignatureHeader), secret); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// SecretFunc returns the shared secret of the Connect app installation with the client key,
+// as received in the installed lifecycle callback.
+type SecretFunc func(clientKey string) ([]byte, error)
+
+// VerifyJWT verifies the JWT Jira sends with the webhooks of Connect apps,
+// in the Authorization header or the "jwt" query parameter. The token must be signed with HS256
+// by the shared secret of its issuer, be valid now and its query string hash must match r.
+// contextPath is the path of the base URL of the app, e.g. "/my-app", which is not part of the hash.
+// The client key of the installation, the issuer of the token, is returned.
+//
+// Jira docs: https://developer.atlassian.com/cloud/jira/platform/understanding-jwt-for-connect-apps/
+func VerifyJWT(r *http.Request, contextPath string, secret SecretFunc) (string, error) {
+	tokenString := r.URL.Query().Get("jwt")
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "JWT ") {
+		tokenString = strings.TrimPrefix(auth, "JWT ")
+	}
+	if tokenString == "" {
+		return "", ErrMissingSignature
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if token.Method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+		clientKey, _ := claims["iss"].(string)
+		if clientKey == "" {
+			return nil, errors.New("token has no issuer")
+		}
+		return secret(clientKey)
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+
+	qsh, _ := claims["qsh"].(string)
+	want := jira.QueryStringHash(r.Method, r.URL, contextPath)
+	if subtle.ConstantTimeCompare([]byte(qsh), []byte(want)) != 1 {
+		return "", fmt.Errorf("%w: query string hash does not match", ErrInvalidSignature)
+	}
+	return claims["iss"].(string), nil
+}
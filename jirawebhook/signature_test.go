@@ -0,0 +1,120 @@
+package jirawebhook
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestVerifySignature(t *testing.T) {
+	payload := []byte(`{"webhookEvent":"jira:issue_created"}`)
+	secret := "It's a Secret to Everybody"
+
+	// Computed with: printf '%s' "$payload" | openssl dgst -sha256 -hmac "$secret"
+	signature := "sha256=ea22416bb36c50400b5c05ab6fd389eabf84e29aff452dd6ed5cfa41740de78e"
+	if got := Sign(payload, secret); got != signature {
+		t.Errorf("Expected signature %s, got %s", signature, got)
+	}
+
+	for _, tt := range []struct {
+		name      string
+		signature string
+		want      error
+	}{
+		{"valid", signature, nil},
+		{"upper case method", strings.Replace(signature, "sha256", "SHA256", 1), nil},
+		{"missing", "", ErrMissingSignature},
+		{"wrong", Sign(payload, "wrong secret"), ErrInvalidSignature},
+		{"malformed", "sha256", ErrInvalidSignature},
+		{"not hex", "sha256=xyz", ErrInvalidSignature},
+		{"unsupported method", "md5=00", ErrInvalidSignature},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifySignature(payload, tt.signature, secret); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestVerifyRequest(t *testing.T) {
+	payload := `{"webhookEvent":"jira:issue_created"}`
+	r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	r.Header.Set(SignatureHeader, Sign([]byte(payload), "secret"))
+
+	body, err := VerifyRequest(r, "secret")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if string(body) != payload {
+		t.Errorf("Expected body %s, got %s", payload, body)
+	}
+	if again, _ := io.ReadAll(r.Body); string(again) != payload {
+		t.Errorf("Expected the body to be readable again, got %s", again)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	r.Header.Set(SignatureHeader, Sign([]byte(payload), "other"))
+	if _, err := VerifyRequest(r, "secret"); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+}
+
+func TestVerifyJWT(t *testing.T) {
+	secrets := map[string][]byte{"client-key": []byte("shared secret")}
+	secret := func(clientKey string) ([]byte, error) {
+		s, ok := secrets[clientKey]
+		if !ok {
+			return nil, errors.New("unknown installation")
+		}
+		return s, nil
+	}
+
+	var clientKey string
+	var verifyErr error
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientKey, verifyErr = VerifyJWT(r, "/app", secret)
+	}))
+	defer server.Close()
+
+	send := func(tp *jira.JWTAuthTransport, path string) {
+		t.Helper()
+		resp, err := tp.Client().Post(server.URL+path, "application/json", strings.NewReader(`{}`))
+		if err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	send(&jira.JWTAuthTransport{Secret: secrets["client-key"], Issuer: "client-key", ContextPath: "/app"}, "/app/webhook?issue=EX-1")
+	if verifyErr != nil || clientKey != "client-key" {
+		t.Errorf("Expected client-key, got %q, %v", clientKey, verifyErr)
+	}
+
+	for name, tp := range map[string]*jira.JWTAuthTransport{
+		"wrong secret":  {Secret: []byte("wrong"), Issuer: "client-key", ContextPath: "/app"},
+		"unknown key":   {Secret: secrets["client-key"], Issuer: "other", ContextPath: "/app"},
+		"wrong qsh":     {Secret: secrets["client-key"], Issuer: "client-key"},
+		"expired token": {Secret: secrets["client-key"], Issuer: "client-key", ContextPath: "/app", Expiry: -time.Minute},
+	} {
+		send(tp, "/app/webhook?issue=EX-1")
+		if !errors.Is(verifyErr, ErrInvalidSignature) {
+			t.Errorf("%s: expected ErrInvalidSignature, got %v", name, verifyErr)
+		}
+	}
+
+	resp, err := http.Post(server.URL+"/app/webhook", "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	resp.Body.Close()
+	if !errors.Is(verifyErr, ErrMissingSignature) {
+		t.Errorf("Expected ErrMissingSignature, got %v", verifyErr)
+	}
+}
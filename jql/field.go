@@ -0,0 +1,210 @@
+package jql
+
+import (
+	"strings"
+)
+
+// Field is a field of a query, by its name, e.g. "status", "Story Points" or "cf[10010]".
+// It is quoted in the query if needed.
+type Field string
+
+// Assignee returns the field of the assignee.
+func Assignee() Field {
+	return "assignee"
+}
+
+// Component returns the field of the components.
+func Component() Field {
+	return "component"
+}
+
+// Created returns the field of the creation time.
+func Created() Field {
+	return "created"
+}
+
+// Description returns the field of the description.
+func Description() Field {
+	return "description"
+}
+
+// DueDate returns the field of the due date.
+func DueDate() Field {
+	return "duedate"
+}
+
+// FixVersion returns the field of the fix versions.
+func FixVersion() Field {
+	return "fixVersion"
+}
+
+// IssueKey returns the field of the issue key.
+func IssueKey() Field {
+	return "key"
+}
+
+// IssueType returns the field of the issue type.
+func IssueType() Field {
+	return "issuetype"
+}
+
+// Labels returns the field of the labels.
+func Labels() Field {
+	return "labels"
+}
+
+// Parent returns the field of the parent issue.
+func Parent() Field {
+	return "parent"
+}
+
+// Priority returns the field of the priority.
+func Priority() Field {
+	return "priority"
+}
+
+// Reporter returns the field of the reporter.
+func Reporter() Field {
+	return "reporter"
+}
+
+// Resolution returns the field of the resolution.
+func Resolution() Field {
+	return "resolution"
+}
+
+// Resolved returns the field of the resolution time.
+func Resolved() Field {
+	return "resolved"
+}
+
+// Sprint returns the field of the sprints.
+func Sprint() Field {
+	return "sprint"
+}
+
+// Status returns the field of the status.
+func Status() Field {
+	return "status"
+}
+
+// Summary returns the field of the summary.
+func Summary() Field {
+	return "summary"
+}
+
+// Text returns the field of the text search of all text fields.
+func Text() Field {
+	return "text"
+}
+
+// Updated returns the field of the last update time.
+func Updated() Field {
+	return "updated"
+}
+
+// Project returns the clause matching issues in the projects with the keys.
+func Project(keys ...string) Clause {
+	values := make([]interface{}, len(keys))
+	for i, key := range keys {
+		values[i] = key
+	}
+	if len(values) == 1 {
+		return Field("project").Eq(values[0])
+	}
+	return Field("project").In(values...)
+}
+
+// Eq returns the clause `f = value`.
+func (f Field) Eq(value interface{}) Clause {
+	return f.Op("=", value)
+}
+
+// NotEq returns the clause `f != value`.
+func (f Field) NotEq(value interface{}) Clause {
+	return f.Op("!=", value)
+}
+
+// Gt returns the clause `f > value`.
+func (f Field) Gt(value interface{}) Clause {
+	return f.Op(">", value)
+}
+
+// Gte returns the clause `f >= value`.
+func (f Field) Gte(value interface{}) Clause {
+	return f.Op(">=", value)
+}
+
+// Lt returns the clause `f < value`.
+func (f Field) Lt(value interface{}) Clause {
+	return f.Op("<", value)
+}
+
+// Lte returns the clause `f <= value`.
+func (f Field) Lte(value interface{}) Clause {
+	return f.Op("<=", value)
+}
+
+// In returns the clause `f in (values...)`. Jira rejects the clause if values is empty.
+func (f Field) In(values ...interface{}) Clause {
+	return f.Op("in", values)
+}
+
+// NotIn returns the clause `f not in (values...)`.
+func (f Field) NotIn(values ...interface{}) Clause {
+	return f.Op("not in", values)
+}
+
+// Contains returns the clause `f ~ text` for the text search of the field.
+// The characters of text with a meaning in the text search, like "*" and "?", are escaped.
+func (f Field) Contains(text string) Clause {
+	return f.Op("~", escapeText(text))
+}
+
+// NotContains returns the clause `f !~ text`, with text escaped like for Contains.
+func (f Field) NotContains(text string) Clause {
+	return f.Op("!~", escapeText(text))
+}
+
+// IsEmpty returns the clause `f is EMPTY`.
+func (f Field) IsEmpty() Clause {
+	return f.Op("is", Empty)
+}
+
+// IsNotEmpty returns the clause `f is not EMPTY`.
+func (f Field) IsNotEmpty() Clause {
+	return f.Op("is not", Empty)
+}
+
+// Was returns the clause `f was value`, matching issues whose field has or had the value.
+func (f Field) Was(value interface{}) Clause {
+	return f.Op("was", value)
+}
+
+// WasIn returns the clause `f was in (values...)`.
+func (f Field) WasIn(values ...interface{}) Clause {
+	return f.Op("was in", values)
+}
+
+// Op returns the clause `f op value` for an operator without a method, like "was not".
+// A slice value is rendered as list, like for In.
+func (f Field) Op(op string, value interface{}) Clause {
+	return Clause{text: quoteField(string(f)) + " " + op + " " + formatValue(value)}
+}
+
+// String returns the quoted name of f.
+func (f Field) String() string {
+	return quoteField(string(f))
+}
+
+// textEscaper escapes the special characters of the text search.
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`, `+`, `\+`, `-`, `\-`, `&`, `\&`, `|`, `\|`, `!`, `\!`, `(`, `\(`, `)`, `\)`,
+	`{`, `\{`, `}`, `\}`, `[`, `\[`, `]`, `\]`, `^`, `\^`, `~`, `\~`, `*`, `\*`, `?`, `\?`, `:`, `\:`,
+)
+
+// escapeText escapes the special characters of the text search in s.
+// The backslashes are escaped again when the value is quoted.
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
@@ -0,0 +1,155 @@
+// Package jql builds Jira Query Language (JQL) queries for the search of issues.
+//
+// Values are always quoted and escaped and field names are quoted if needed,
+// e.g. if they contain spaces or are reserved words, so user input can not break
+// or inject into a query:
+//
+//	query := jql.Project("ENG").
+//		And(jql.Status().In("Open", "In Progress")).
+//		And(jql.Summary().Contains(userInput)).
+//		OrderBy("created", jql.Desc)
+//	issues, _, err := client.Issue.Search(ctx, query.String(), nil)
+//
+// The package is shared by the cloud and onpremise clients.
+//
+// Jira docs: https://support.atlassian.com/jira-software-cloud/docs/use-advanced-search-with-jira-query-language-jql/
+package jql
+
+import (
+	"strings"
+)
+
+// Direction is the direction of an ORDER BY field.
+type Direction string
+
+// Directions of the ORDER BY fields.
+const (
+	Asc  Direction = "ASC"
+	Desc Direction = "DESC"
+)
+
+// Clause is a condition of a query, like `status = "Open"`, or clauses combined with AND or OR.
+// The zero Clause is empty. Empty clauses are skipped by And and Or,
+// so conditions can be added optionally.
+type Clause struct {
+	text string
+	// op is the operator combining the clause, "AND" or "OR", to add parentheses if needed.
+	op string
+}
+
+// Raw returns a clause with the JQL text as is, e.g. from a saved filter.
+// The text must not contain user input.
+func Raw(text string) Clause {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Clause{}
+	}
+	return Clause{text: text, op: "RAW"}
+}
+
+// IsEmpty reports whether c is empty.
+func (c Clause) IsEmpty() bool {
+	return c.text == ""
+}
+
+// String returns the JQL of c.
+func (c Clause) String() string {
+	return c.text
+}
+
+// And returns the clause matching c and all clauses.
+func (c Clause) And(clauses ...Clause) Clause {
+	return And(append([]Clause{c}, clauses...)...)
+}
+
+// Or returns the clause matching c or any of the clauses.
+func (c Clause) Or(clauses ...Clause) Clause {
+	return Or(append([]Clause{c}, clauses...)...)
+}
+
+// OrderBy returns a query of c ordered by the field.
+func (c Clause) OrderBy(field string, dir Direction) Query {
+	return Query{where: c}.OrderBy(field, dir)
+}
+
+// And returns the clause matching all clauses.
+func And(clauses ...Clause) Clause {
+	return join("AND", clauses)
+}
+
+// Or returns the clause matching any of the clauses.
+func Or(clauses ...Clause) Clause {
+	return join("OR", clauses)
+}
+
+// Not returns the clause matching if c does not match.
+func Not(c Clause) Clause {
+	if c.IsEmpty() {
+		return c
+	}
+	return Clause{text: "NOT " + group(c)}
+}
+
+// join combines the non-empty clauses with the operator.
+func join(op string, clauses []Clause) Clause {
+	nonEmpty := make([]Clause, 0, len(clauses))
+	for _, c := range clauses {
+		if !c.IsEmpty() {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+	switch len(nonEmpty) {
+	case 0:
+		return Clause{}
+	case 1:
+		return nonEmpty[0]
+	}
+
+	parts := make([]string, len(nonEmpty))
+	for i, c := range nonEmpty {
+		if c.op == op {
+			parts[i] = c.text
+		} else {
+			parts[i] = group(c)
+		}
+	}
+	return Clause{text: strings.Join(parts, " "+op+" "), op: op}
+}
+
+// group returns the JQL of c, in parentheses if it combines clauses.
+func group(c Clause) string {
+	if c.op == "" {
+		return c.text
+	}
+	return "(" + c.text + ")"
+}
+
+// Query is a clause with an ORDER BY.
+type Query struct {
+	where Clause
+	order []string
+}
+
+// OrderBy returns q additionally ordered by the field.
+func (q Query) OrderBy(field string, dir Direction) Query {
+	order := make([]string, len(q.order), len(q.order)+1)
+	copy(order, q.order)
+	term := quoteField(field)
+	if dir != "" {
+		term += " " + string(dir)
+	}
+	q.order = append(order, term)
+	return q
+}
+
+// String returns the JQL of q.
+func (q Query) String() string {
+	s := q.where.String()
+	if len(q.order) == 0 {
+		return s
+	}
+	if s != "" {
+		s += " "
+	}
+	return s + "ORDER BY " + strings.Join(q.order, ", ")
+}
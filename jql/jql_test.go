@@ -0,0 +1,114 @@
+package jql
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuery_String(t *testing.T) {
+	query := Project("ENG").
+		And(Status().In("Open", "In Progress")).
+		OrderBy("created", Desc).
+		OrderBy("Story Points", "")
+
+	want := `project = "ENG" AND status in ("Open", "In Progress") ORDER BY created DESC, "Story Points"`
+	if got := query.String(); got != want {
+		t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestClause_Precedence(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		clause Clause
+		want   string
+	}{
+		{
+			name:   "or in and",
+			clause: Project("ENG").And(Assignee().Eq(CurrentUser()).Or(Assignee().IsEmpty())),
+			want:   `project = "ENG" AND (assignee = currentUser() OR assignee is EMPTY)`,
+		},
+		{
+			name:   "and flattened",
+			clause: And(Project("A", "B"), And(Labels().Eq("x"), Labels().NotEq("y"))),
+			want:   `project in ("A", "B") AND labels = "x" AND labels != "y"`,
+		},
+		{
+			name:   "not",
+			clause: Not(Status().Eq("Done").Or(Resolution().IsNotEmpty())),
+			want:   `NOT (status = "Done" OR resolution is not EMPTY)`,
+		},
+		{
+			name:   "empty clauses skipped",
+			clause: And(Clause{}, Status().Eq("Open"), Or()),
+			want:   `status = "Open"`,
+		},
+		{
+			name:   "raw grouped",
+			clause: Raw("filter = 10000 OR labels = x").And(Priority().Eq("High")),
+			want:   `(filter = 10000 OR labels = x) AND priority = "High"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clause.String(); got != tt.want {
+				t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestField_Injection(t *testing.T) {
+	clause := Summary().Eq(`x" OR project = "SECRET`)
+	want := `summary = "x\" OR project = \"SECRET"`
+	if got := clause.String(); got != want {
+		t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, want)
+	}
+
+	clause = Text().Contains(`a\b [c]*`)
+	want = `text ~ "a\\\\b \\[c\\]\\*"`
+	if got := clause.String(); got != want {
+		t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestField_Quoting(t *testing.T) {
+	for name, want := range map[string]string{
+		"status":       "status",
+		"fixVersion":   "fixVersion",
+		"cf[10010]":    "cf[10010]",
+		"Story Points": `"Story Points"`,
+		"order":        `"order"`,
+		"User":         `"User"`,
+		"a\"b":         `"a\"b"`,
+	} {
+		if got := Field(name).String(); got != want {
+			t.Errorf("Field(%q): expected %s, got %s", name, want, got)
+		}
+	}
+}
+
+func TestField_Values(t *testing.T) {
+	for _, tt := range []struct {
+		clause Clause
+		want   string
+	}{
+		{Field("cf[10010]").Gte(5), `cf[10010] >= 5`},
+		{Field("Story Points").Lt(2.5), `"Story Points" < 2.5`},
+		{Created().Gt(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)), `created > "2024-03-01"`},
+		{Updated().Lte(time.Date(2024, 3, 1, 15, 4, 0, 0, time.UTC)), `updated <= "2024-03-01 15:04"`},
+		{Created().Gt(Func("startOfWeek", "-1")), `created > startOfWeek("-1")`},
+		{Status().WasIn("Open", "Reopened"), `status was in ("Open", "Reopened")`},
+		{Status().Op("was not", nil), `status was not EMPTY`},
+		{IssueKey().NotIn(), `key not in ()`},
+	} {
+		if got := tt.clause.String(); got != tt.want {
+			t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, tt.want)
+		}
+	}
+}
+
+func TestQuery_OrderByOnly(t *testing.T) {
+	if got, want := (Clause{}).OrderBy("rank", Asc).String(), "ORDER BY rank ASC"; got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
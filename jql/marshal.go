@@ -0,0 +1,188 @@
+package jql
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// operators maps the operators of the jql struct tag to the JQL operators.
+var operators = map[string]string{
+	"=": "=", "eq": "=",
+	"!=": "!=", "ne": "!=",
+	">": ">", "gt": ">",
+	">=": ">=", "gte": ">=",
+	"<": "<", "lt": "<",
+	"<=": "<=", "lte": "<=",
+	"in":     "in",
+	"not in": "not in", "notin": "not in",
+	"~": "~", "contains": "~",
+	"!~": "!~", "notcontains": "!~",
+	"was":    "was",
+	"was in": "was in", "wasin": "was in",
+	"empty": "empty",
+}
+
+// Marshal returns the JQL of the struct v, or a pointer to it, as built by FromStruct.
+func Marshal(v interface{}) (string, error) {
+	c, err := FromStruct(v)
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+// FromStruct returns the clause of the struct v, or a pointer to it, so a typed filter
+// can be exposed to users and still be queried safely.
+//
+// The fields with a "jql" tag are combined with AND. The tag is the name of the Jira field
+// and optionally an operator, like `jql:"created,>="`. The operator defaults to "=",
+// or "in" for slices. The operators are "=", "!=", ">", ">=", "<", "<=", "in", "not in",
+// "~", "!~", "was" and "was in", or their names "eq", "ne", "gt", "gte", "lt", "lte",
+// "notin", "contains", "notcontains" and "wasin". Values of "~" and "!~" are escaped like
+// for Field.Contains. The operator "empty" of a bool field matches "is EMPTY" if true and
+// "is not EMPTY" if false, which needs a *bool.
+//
+// Fields with a zero value, like an empty string, a nil pointer or an empty slice, are skipped,
+// so use a pointer to match a zero value. Fields of type Clause are added as is and
+// embedded structs are flattened. Fields without a tag or with the tag "-" are ignored.
+//
+// Example:
+//
+//	type IssueFilter struct {
+//		Project  string    `jql:"project"`
+//		Statuses []string  `jql:"status"`
+//		Text     string    `jql:"text,~"`
+//		Since    time.Time `jql:"created,>="`
+//		Points   *int      `jql:"Story Points,>="`
+//	}
+//	query, err := jql.Marshal(IssueFilter{Project: "ENG", Statuses: []string{"Open"}})
+//	// project = "ENG" AND status in ("Open")
+func FromStruct(v interface{}) (Clause, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return Clause{}, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return Clause{}, fmt.Errorf("jql: can not marshal %T, expected a struct", v)
+	}
+
+	clauses, err := structClauses(rv)
+	if err != nil {
+		return Clause{}, err
+	}
+	return And(clauses...), nil
+}
+
+var (
+	clauseType = reflect.TypeOf(Clause{})
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// structClauses returns the clauses of the tagged fields of the struct rv.
+func structClauses(rv reflect.Value) ([]Clause, error) {
+	var clauses []Clause
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		fv := rv.Field(i)
+		tag, tagged := sf.Tag.Lookup("jql")
+		if tag == "-" {
+			continue
+		}
+
+		if !tagged {
+			if !sf.Anonymous {
+				continue
+			}
+			for fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					break
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct && fv.Type() != timeType && fv.Type() != clauseType {
+				embedded, err := structClauses(fv)
+				if err != nil {
+					return nil, err
+				}
+				clauses = append(clauses, embedded...)
+			}
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+
+		if sf.Type == clauseType {
+			clauses = append(clauses, fv.Interface().(Clause))
+			continue
+		}
+		if fv.IsZero() || (fv.Kind() == reflect.Slice && fv.Len() == 0) {
+			continue
+		}
+
+		c, err := fieldClause(sf.Name, tag, fv)
+		if err != nil {
+			return nil, err
+		}
+		clauses = append(clauses, c)
+	}
+	return clauses, nil
+}
+
+// fieldClause returns the clause of the struct field with the tag and the non-zero value fv.
+func fieldClause(structField, tag string, fv reflect.Value) (Clause, error) {
+	name, op := tag, ""
+	if i := strings.LastIndex(tag, ","); i >= 0 {
+		name, op = strings.TrimSpace(tag[:i]), strings.TrimSpace(tag[i+1:])
+	}
+	if name == "" {
+		return Clause{}, fmt.Errorf("jql: field %s has no Jira field name in its tag", structField)
+	}
+	for fv.Kind() == reflect.Ptr || fv.Kind() == reflect.Interface {
+		fv = fv.Elem()
+	}
+	list := fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array
+
+	if op == "" {
+		op = "="
+		if list {
+			op = "in"
+		}
+	}
+	jqlOp, ok := operators[strings.ToLower(op)]
+	if !ok {
+		return Clause{}, fmt.Errorf("jql: field %s has the unknown operator %q", structField, op)
+	}
+
+	f, value := Field(name), fv.Interface()
+	switch jqlOp {
+	case "empty":
+		if fv.Kind() != reflect.Bool {
+			return Clause{}, fmt.Errorf("jql: field %s with the operator empty must be a bool", structField)
+		}
+		if fv.Bool() {
+			return f.IsEmpty(), nil
+		}
+		return f.IsNotEmpty(), nil
+	case "in", "not in", "was in":
+		if !list {
+			return Clause{}, fmt.Errorf("jql: field %s with the operator %s must be a slice", structField, jqlOp)
+		}
+	case "~", "!~":
+		if fv.Kind() != reflect.String {
+			return Clause{}, fmt.Errorf("jql: field %s with the operator %s must be a string", structField, jqlOp)
+		}
+		value = escapeText(fv.String())
+	default:
+		if list {
+			return Clause{}, fmt.Errorf("jql: field %s with the operator %s must not be a slice", structField, jqlOp)
+		}
+	}
+	return f.Op(jqlOp, value), nil
+}
@@ -0,0 +1,101 @@
+package jql
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+type pagination struct {
+	Limit int
+	Owner string `jql:"reporter"`
+}
+
+type issueFilter struct {
+	pagination
+	Project    string    `jql:"project"`
+	Statuses   []string  `jql:"status"`
+	Excluded   []string  `jql:"labels,not in"`
+	Text       string    `jql:"text,contains"`
+	Since      time.Time `jql:"created,>="`
+	Points     *int      `jql:"Story Points,gte"`
+	Unassigned *bool     `jql:"assignee,empty"`
+	Extra      Clause    `jql:"extra"`
+	Ignored    string    `jql:"-"`
+	Untagged   string
+}
+
+func TestMarshal(t *testing.T) {
+	zero, unassigned := 0, false
+	filter := &issueFilter{
+		pagination: pagination{Limit: 10, Owner: "fred"},
+		Project:    "ENG",
+		Statuses:   []string{"Open", "In Progress"},
+		Excluded:   []string{"wontfix"},
+		Text:       "crash*",
+		Since:      time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Points:     &zero,
+		Unassigned: &unassigned,
+		Extra:      Priority().Eq("High").Or(Priority().Eq("Highest")),
+		Ignored:    "x",
+		Untagged:   "y",
+	}
+
+	got, err := Marshal(filter)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	want := `reporter = "fred" AND project = "ENG" AND status in ("Open", "In Progress") AND labels not in ("wontfix") AND text ~ "crash\\*"` +
+		` AND created >= "2024-03-01" AND "Story Points" >= 0 AND assignee is not EMPTY AND (priority = "High" OR priority = "Highest")`
+	if got != want {
+		t.Errorf("Unexpected JQL\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestMarshal_SkipsZeroValues(t *testing.T) {
+	got, err := Marshal(issueFilter{Project: "ENG"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if want := `project = "ENG"`; got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	for name, v := range map[string]interface{}{
+		"not a struct": "project = ENG",
+		"unknown operator": struct {
+			Status string `jql:"status,like"`
+		}{"Open"},
+		"in without slice": struct {
+			Status string `jql:"status,in"`
+		}{"Open"},
+		"slice without in": struct {
+			Status []string `jql:"status,="`
+		}{[]string{"Open"}},
+		"contains without string": struct {
+			Points int `jql:"Story Points,~"`
+		}{5},
+		"missing name": struct {
+			Status string `jql:",="`
+		}{"Open"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Marshal(v)
+			if err == nil || !strings.HasPrefix(err.Error(), "jql: ") {
+				t.Errorf("Expected an error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFromStruct_Nil(t *testing.T) {
+	c, err := FromStruct((*issueFilter)(nil))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !c.IsEmpty() {
+		t.Errorf("Expected an empty clause, got %s", c)
+	}
+}
@@ -0,0 +1,153 @@
+package jql
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Function is a JQL function as value, like currentUser() or startOfWeek("-1").
+type Function struct {
+	Name string
+	Args []string
+}
+
+// Func returns the function with the name and arguments, e.g. Func("startOfDay", "-7d").
+// The arguments are quoted.
+func Func(name string, args ...string) Function {
+	return Function{Name: name, Args: args}
+}
+
+// CurrentUser returns the function currentUser().
+func CurrentUser() Function {
+	return Func("currentUser")
+}
+
+// String returns the JQL of the function call.
+func (f Function) String() string {
+	args := make([]string, len(f.Args))
+	for i, arg := range f.Args {
+		args[i] = Quote(arg)
+	}
+	return f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// keyword is a value rendered as is, like EMPTY.
+type keyword string
+
+// Empty is the EMPTY value, e.g. for Field.Op("was", Empty).
+const Empty keyword = "EMPTY"
+
+// Date and time layouts of values.
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04"
+)
+
+// formatValue returns the JQL of a value. Strings are quoted, numbers are formatted and
+// slices are rendered as lists. Times are formatted as date if they are midnight.
+// Times are not converted to the time zone of the user running the query, so use UTC or their zone.
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return string(Empty)
+	case keyword:
+		return string(v)
+	case Function:
+		return v.String()
+	case string:
+		return Quote(v)
+	case bool:
+		return Quote(strconv.FormatBool(v))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(v)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 {
+			return Quote(v.Format(dateLayout))
+		}
+		return Quote(v.Format(dateTimeLayout))
+	case fmt.Stringer:
+		return Quote(v.String())
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array {
+		values := make([]string, rv.Len())
+		for i := range values {
+			values[i] = formatValue(rv.Index(i).Interface())
+		}
+		return "(" + strings.Join(values, ", ") + ")"
+	}
+	return Quote(fmt.Sprint(value))
+}
+
+// quoteEscaper escapes the characters of quoted strings.
+var quoteEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// Quote returns s as quoted JQL string, e.g. for a value of a Raw clause.
+func Quote(s string) string {
+	return `"` + quoteEscaper.Replace(s) + `"`
+}
+
+var (
+	// plainField matches the field names not needing quotes.
+	plainField = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+	// customField matches the IDs of custom fields, like cf[10010].
+	customField = regexp.MustCompile(`^cf\[[0-9]+\]$`)
+)
+
+// quoteField returns the field name, quoted if it is not a plain word or is a reserved word.
+func quoteField(name string) string {
+	if customField.MatchString(name) {
+		return name
+	}
+	if plainField.MatchString(name) && !IsReserved(name) {
+		return name
+	}
+	return Quote(name)
+}
+
+// IsReserved reports whether word is a reserved word of JQL, which must be quoted as field name or value.
+func IsReserved(word string) bool {
+	_, ok := reservedWords[strings.ToLower(word)]
+	return ok
+}
+
+// reservedWords are the reserved words of JQL.
+//
+// Jira docs: https://support.atlassian.com/jira-software-cloud/docs/jql-reserved-words/
+var reservedWords = map[string]struct{}{}
+
+func init() {
+	for _, word := range strings.Fields(`
+		a an abort access add after alias all alter and any are as asc at audit avg
+		before begin between boolean break by byte
+		catch cf char character check checkpoint collate collation column commit connect continue count create current
+		date decimal declare decrement default defaults define delete delimiter desc difference distinct divide do double drop
+		else empty encoding end equals escape exclusive exec execute exists explain
+		false fetch file field first float for from function
+		go goto grant greater group
+		having
+		identified if immediate in increment index initial inner inout input insert int integer intersect intersection into is isempty isnull
+		join
+		last left less like limit lock long
+		max min minus mode modify modulo more multiply
+		next noaudit not notin nowait null number
+		object of on option or order outer output
+		power previous prior privileges public
+		raise raw remainder rename resume return returns revoke right row rowid rownum rows
+		select session set share size sqrt start strict string subtract sum synonym
+		table then to trans transaction trigger true
+		uid union unique update user
+		validate values view
+		when whenever where while with`) {
+		reservedWords[word] = struct{}{}
+	}
+}
@@ -0,0 +1,240 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// The reports of this file use the internal rest/greenhopper/1.0 API of Jira Software, which backs the
+// sprint report, velocity chart and burndown chart of the boards. There is no public API for these reports.
+// The internal API is not documented and not covered by the compatibility guarantees of the REST API,
+// its responses can change between versions of Jira Software.
+
+// ReportEstimate is an estimate of a report, like the sum of the story points of the completed issues.
+type ReportEstimate struct {
+	// Value is nil if the estimate is not set.
+	Value *float64 `json:"value,omitempty" structs:"value,omitempty"`
+	// Text is the formatted value, e.g. "13.0" or "1w 2d" for time estimates.
+	Text string `json:"text,omitempty" structs:"text,omitempty"`
+}
+
+// ReportEstimateStatistic is the estimate of an issue of a sprint report.
+type ReportEstimateStatistic struct {
+	StatFieldID    string         `json:"statFieldId,omitempty" structs:"statFieldId,omitempty"`
+	StatFieldValue ReportEstimate `json:"statFieldValue" structs:"statFieldValue"`
+}
+
+// SprintReportIssue is an issue of a SprintReport.
+type SprintReportIssue struct {
+	ID                       int64                    `json:"id" structs:"id"`
+	Key                      string                   `json:"key" structs:"key"`
+	Summary                  string                   `json:"summary,omitempty" structs:"summary,omitempty"`
+	TypeID                   string                   `json:"typeId,omitempty" structs:"typeId,omitempty"`
+	TypeName                 string                   `json:"typeName,omitempty" structs:"typeName,omitempty"`
+	PriorityName             string                   `json:"priorityName,omitempty" structs:"priorityName,omitempty"`
+	StatusID                 string                   `json:"statusId,omitempty" structs:"statusId,omitempty"`
+	StatusName               string                   `json:"statusName,omitempty" structs:"statusName,omitempty"`
+	Assignee                 string                   `json:"assignee,omitempty" structs:"assignee,omitempty"`
+	AssigneeName             string                   `json:"assigneeName,omitempty" structs:"assigneeName,omitempty"`
+	Epic                     string                   `json:"epic,omitempty" structs:"epic,omitempty"`
+	Done                     bool                     `json:"done" structs:"done"`
+	Hidden                   bool                     `json:"hidden,omitempty" structs:"hidden,omitempty"`
+	Flagged                  bool                     `json:"flagged,omitempty" structs:"flagged,omitempty"`
+	ProjectID                int64                    `json:"projectId,omitempty" structs:"projectId,omitempty"`
+	FixVersions              []int64                  `json:"fixVersions,omitempty" structs:"fixVersions,omitempty"`
+	EstimateStatistic        *ReportEstimateStatistic `json:"estimateStatistic,omitempty" structs:"estimateStatistic,omitempty"`
+	CurrentEstimateStatistic *ReportEstimateStatistic `json:"currentEstimateStatistic,omitempty" structs:"currentEstimateStatistic,omitempty"`
+}
+
+// SprintReportContents are the issues and estimate sums of a SprintReport.
+type SprintReportContents struct {
+	CompletedIssues                   []SprintReportIssue `json:"completedIssues" structs:"completedIssues"`
+	IssuesNotCompletedInCurrentSprint []SprintReportIssue `json:"issuesNotCompletedInCurrentSprint" structs:"issuesNotCompletedInCurrentSprint"`
+	// PuntedIssues are the issues removed from the sprint while it was active.
+	PuntedIssues                   []SprintReportIssue `json:"puntedIssues" structs:"puntedIssues"`
+	IssuesCompletedInAnotherSprint []SprintReportIssue `json:"issuesCompletedInAnotherSprint" structs:"issuesCompletedInAnotherSprint"`
+
+	CompletedIssuesInitialEstimateSum    ReportEstimate `json:"completedIssuesInitialEstimateSum" structs:"completedIssuesInitialEstimateSum"`
+	CompletedIssuesEstimateSum           ReportEstimate `json:"completedIssuesEstimateSum" structs:"completedIssuesEstimateSum"`
+	IssuesNotCompletedInitialEstimateSum ReportEstimate `json:"issuesNotCompletedInitialEstimateSum" structs:"issuesNotCompletedInitialEstimateSum"`
+	IssuesNotCompletedEstimateSum        ReportEstimate `json:"issuesNotCompletedEstimateSum" structs:"issuesNotCompletedEstimateSum"`
+	AllIssuesEstimateSum                 ReportEstimate `json:"allIssuesEstimateSum" structs:"allIssuesEstimateSum"`
+	PuntedIssuesInitialEstimateSum       ReportEstimate `json:"puntedIssuesInitialEstimateSum" structs:"puntedIssuesInitialEstimateSum"`
+	PuntedIssuesEstimateSum              ReportEstimate `json:"puntedIssuesEstimateSum" structs:"puntedIssuesEstimateSum"`
+
+	// IssueKeysAddedDuringSprint are the keys of the issues added after the sprint was started.
+	IssueKeysAddedDuringSprint map[string]bool `json:"issueKeysAddedDuringSprint" structs:"issueKeysAddedDuringSprint"`
+}
+
+// ReportSprint is a sprint of a SprintReport or VelocityReport.
+type ReportSprint struct {
+	ID       int64  `json:"id" structs:"id"`
+	Sequence int64  `json:"sequence,omitempty" structs:"sequence,omitempty"`
+	Name     string `json:"name" structs:"name"`
+	State    string `json:"state" structs:"state"`
+	Goal     string `json:"goal,omitempty" structs:"goal,omitempty"`
+	// The dates are formatted for the user, e.g. "20/Mar/24 9:00 AM", the ISO dates are only returned by newer versions.
+	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	EndDate         string `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	CompleteDate    string `json:"completeDate,omitempty" structs:"completeDate,omitempty"`
+	IsoStartDate    string `json:"isoStartDate,omitempty" structs:"isoStartDate,omitempty"`
+	IsoEndDate      string `json:"isoEndDate,omitempty" structs:"isoEndDate,omitempty"`
+	IsoCompleteDate string `json:"isoCompleteDate,omitempty" structs:"isoCompleteDate,omitempty"`
+}
+
+// SprintReport is the sprint report of a sprint of a board.
+type SprintReport struct {
+	Contents SprintReportContents `json:"contents" structs:"contents"`
+	Sprint   ReportSprint         `json:"sprint" structs:"sprint"`
+}
+
+// VelocityReport is the velocity chart of a board, the estimates committed and completed in its last sprints.
+type VelocityReport struct {
+	Sprints []ReportSprint `json:"sprints" structs:"sprints"`
+	// VelocityStatEntries are the estimates by sprint ID.
+	VelocityStatEntries map[string]VelocityStatEntry `json:"velocityStatEntries" structs:"velocityStatEntries"`
+}
+
+// VelocityStatEntry are the estimates committed at the start of a sprint of a VelocityReport and completed in it.
+type VelocityStatEntry struct {
+	Estimated ReportEstimate `json:"estimated" structs:"estimated"`
+	Completed ReportEstimate `json:"completed" structs:"completed"`
+}
+
+// Entry returns the estimates of the sprint with the ID and whether the report has them.
+func (r *VelocityReport) Entry(sprintID int64) (VelocityStatEntry, bool) {
+	entry, ok := r.VelocityStatEntries[fmt.Sprint(sprintID)]
+	return entry, ok
+}
+
+// BurndownChange is a change of the scope, estimate or status of an issue of a BurndownChart.
+type BurndownChange struct {
+	Key string `json:"key" structs:"key"`
+	// Added is set if the issue was added to the sprint, false if it was removed.
+	Added *bool `json:"added,omitempty" structs:"added,omitempty"`
+	// StatC is the change of the estimate of the issue.
+	StatC *BurndownValueChange `json:"statC,omitempty" structs:"statC,omitempty"`
+	// TimeC is the change of the time tracking of the issue.
+	TimeC *BurndownTimeChange `json:"timeC,omitempty" structs:"timeC,omitempty"`
+	// Column is the change of the column of the issue on the board.
+	Column *BurndownColumnChange `json:"column,omitempty" structs:"column,omitempty"`
+}
+
+// BurndownValueChange is the change of the estimate of an issue.
+type BurndownValueChange struct {
+	OldValue *float64 `json:"oldValue,omitempty" structs:"oldValue,omitempty"`
+	NewValue *float64 `json:"newValue,omitempty" structs:"newValue,omitempty"`
+}
+
+// BurndownTimeChange is the change of the time tracking of an issue, in seconds.
+type BurndownTimeChange struct {
+	TimeSpent   *int64 `json:"timeSpent,omitempty" structs:"timeSpent,omitempty"`
+	OldEstimate *int64 `json:"oldEstimate,omitempty" structs:"oldEstimate,omitempty"`
+	NewEstimate *int64 `json:"newEstimate,omitempty" structs:"newEstimate,omitempty"`
+	ChangeDate  *int64 `json:"changeDate,omitempty" structs:"changeDate,omitempty"`
+	WorklogID   string `json:"worklogId,omitempty" structs:"worklogId,omitempty"`
+}
+
+// BurndownColumnChange is the change of the column of an issue.
+type BurndownColumnChange struct {
+	NotDone   bool   `json:"notDone" structs:"notDone"`
+	NewStatus string `json:"newStatus,omitempty" structs:"newStatus,omitempty"`
+	Done      bool   `json:"done,omitempty" structs:"done,omitempty"`
+}
+
+// BurndownStatisticField is the estimation field of a BurndownChart.
+type BurndownStatisticField struct {
+	TypeID    string `json:"typeId" structs:"typeId"`
+	FieldID   string `json:"fieldId,omitempty" structs:"fieldId,omitempty"`
+	ID        string `json:"id" structs:"id"`
+	Name      string `json:"name" structs:"name"`
+	IsValid   bool   `json:"isValid" structs:"isValid"`
+	IsEnabled bool   `json:"isEnabled" structs:"isEnabled"`
+}
+
+// BurndownChart is the scope change burndown chart of a sprint of a board.
+// The times are Unix timestamps in milliseconds.
+type BurndownChart struct {
+	StartTime    int64 `json:"startTime" structs:"startTime"`
+	EndTime      int64 `json:"endTime" structs:"endTime"`
+	CompleteTime int64 `json:"completeTime,omitempty" structs:"completeTime,omitempty"`
+	Now          int64 `json:"now" structs:"now"`
+	// Changes are the changes of the issues by the Unix timestamp in milliseconds they were made at.
+	Changes        map[string][]BurndownChange `json:"changes" structs:"changes"`
+	StatisticField BurndownStatisticField      `json:"statisticField" structs:"statisticField"`
+	// IssueToParentKeys are the keys of the parents of the sub-tasks.
+	IssueToParentKeys map[string]string `json:"issueToParentKeys,omitempty" structs:"issueToParentKeys,omitempty"`
+	IssueToSummary    map[string]string `json:"issueToSummary,omitempty" structs:"issueToSummary,omitempty"`
+}
+
+// Start returns the start time of the sprint of the chart.
+func (c *BurndownChart) Start() time.Time {
+	return time.UnixMilli(c.StartTime)
+}
+
+// End returns the planned end time of the sprint of the chart.
+func (c *BurndownChart) End() time.Time {
+	return time.UnixMilli(c.EndTime)
+}
+
+// GetSprintReport returns the sprint report of the sprint of the board, with the completed, not completed
+// and removed issues and their estimates.
+//
+// It uses the internal API of Jira Software, which can change between versions.
+func (s *BoardService) GetSprintReport(ctx context.Context, boardID, sprintID int64) (*SprintReport, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=%d&sprintId=%d", boardID, sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(SprintReport)
+	resp, err := s.client.Do(req, report)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return report, resp, nil
+}
+
+// GetVelocityReport returns the velocity chart of the board with the committed and completed estimates
+// of its last closed sprints.
+//
+// It uses the internal API of Jira Software, which can change between versions.
+func (s *BoardService) GetVelocityReport(ctx context.Context, boardID int64) (*VelocityReport, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/velocity?rapidViewId=%d", boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(VelocityReport)
+	resp, err := s.client.Do(req, report)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return report, resp, nil
+}
+
+// GetBurndownChart returns the scope change burndown chart of the sprint of the board,
+// with every change of the scope, estimates and status of its issues.
+//
+// It uses the internal API of Jira Software, which can change between versions.
+func (s *BoardService) GetBurndownChart(ctx context.Context, boardID, sprintID int64) (*BurndownChart, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/scopechangeburndownchart?rapidViewId=%d&sprintId=%d", boardID, sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chart := new(BurndownChart)
+	resp, err := s.client.Do(req, chart)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return chart, resp, nil
+}
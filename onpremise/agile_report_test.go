@@ -0,0 +1,113 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBoardService_GetSprintReport(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/greenhopper/1.0/rapid/charts/sprintreport"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		testRequestParams(t, r, map[string]string{"rapidViewId": "12", "sprintId": "7"})
+		fmt.Fprint(w, `{"contents":{
+			"completedIssues":[{"id":10001,"key":"EX-1","summary":"Login fails","typeName":"Bug","done":true,"statusName":"Done","estimateStatistic":{"statFieldId":"customfield_10002","statFieldValue":{"value":3.0,"text":"3.0"}}}],
+			"issuesNotCompletedInCurrentSprint":[{"id":10002,"key":"EX-2","done":false,"estimateStatistic":{"statFieldId":"customfield_10002","statFieldValue":{}}}],
+			"puntedIssues":[],
+			"issuesCompletedInAnotherSprint":[],
+			"completedIssuesEstimateSum":{"value":3.0,"text":"3.0"},
+			"issuesNotCompletedEstimateSum":{"text":"null"},
+			"issueKeysAddedDuringSprint":{"EX-2":true}
+		},"sprint":{"id":7,"sequence":7,"name":"Sprint 7","state":"CLOSED","goal":"Ship login"}}`)
+	})
+
+	report, _, err := testClient.Board.GetSprintReport(context.Background(), 12, 7)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if report.Sprint.Name != "Sprint 7" || report.Sprint.State != "CLOSED" {
+		t.Errorf("Unexpected sprint %+v", report.Sprint)
+	}
+	if len(report.Contents.CompletedIssues) != 1 || report.Contents.CompletedIssues[0].Key != "EX-1" {
+		t.Fatalf("Unexpected completed issues %+v", report.Contents.CompletedIssues)
+	}
+	if v := report.Contents.CompletedIssues[0].EstimateStatistic.StatFieldValue.Value; v == nil || *v != 3 {
+		t.Errorf("Expected an estimate of 3, got %v", v)
+	}
+	if v := report.Contents.IssuesNotCompletedInCurrentSprint[0].EstimateStatistic.StatFieldValue.Value; v != nil {
+		t.Errorf("Expected no estimate, got %v", *v)
+	}
+	if !report.Contents.IssueKeysAddedDuringSprint["EX-2"] {
+		t.Error("Expected EX-2 to be added during the sprint")
+	}
+}
+
+func TestBoardService_GetVelocityReport(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/greenhopper/1.0/rapid/charts/velocity"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		testRequestParams(t, r, map[string]string{"rapidViewId": "12"})
+		fmt.Fprint(w, `{"sprints":[{"id":7,"sequence":7,"name":"Sprint 7","state":"CLOSED"}],
+			"velocityStatEntries":{"7":{"estimated":{"value":8.0,"text":"8.0"},"completed":{"value":5.0,"text":"5.0"}}}}`)
+	})
+
+	report, _, err := testClient.Board.GetVelocityReport(context.Background(), 12)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(report.Sprints) != 1 {
+		t.Fatalf("Expected 1 sprint, got %d", len(report.Sprints))
+	}
+	entry, ok := report.Entry(report.Sprints[0].ID)
+	if !ok {
+		t.Fatal("Expected the estimates of sprint 7")
+	}
+	if *entry.Estimated.Value != 8 || *entry.Completed.Value != 5 {
+		t.Errorf("Unexpected estimates %+v", entry)
+	}
+}
+
+func TestBoardService_GetBurndownChart(t *testing.T) {
+	setup()
+	defer teardown()
+	testapiEndpoint := "/rest/greenhopper/1.0/rapid/charts/scopechangeburndownchart"
+
+	testMux.HandleFunc(testapiEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testapiEndpoint)
+		testRequestParams(t, r, map[string]string{"rapidViewId": "12", "sprintId": "7"})
+		fmt.Fprint(w, `{"startTime":1709280000000,"endTime":1710489600000,"now":1710000000000,
+			"changes":{"1709290000000":[{"key":"EX-1","added":true},{"key":"EX-1","statC":{"newValue":3.0}}],
+				"1709390000000":[{"key":"EX-1","column":{"notDone":false,"newStatus":"10001","done":true}}]},
+			"statisticField":{"typeId":"field","fieldId":"customfield_10002","id":"field_customfield_10002","name":"Story Points","isValid":true,"isEnabled":true},
+			"issueToSummary":{"EX-1":"Login fails"}}`)
+	})
+
+	chart, _, err := testClient.Board.GetBurndownChart(context.Background(), 12, 7)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if chart.Start().UnixMilli() != 1709280000000 || chart.End().UnixMilli() != 1710489600000 {
+		t.Errorf("Unexpected times %s - %s", chart.Start(), chart.End())
+	}
+	changes := chart.Changes["1709290000000"]
+	if len(changes) != 2 || changes[0].Added == nil || !*changes[0].Added || *changes[1].StatC.NewValue != 3 {
+		t.Errorf("Unexpected changes %+v", changes)
+	}
+	if column := chart.Changes["1709390000000"][0].Column; column == nil || !column.Done {
+		t.Errorf("Unexpected column change %+v", column)
+	}
+	if chart.StatisticField.Name != "Story Points" || chart.IssueToSummary["EX-1"] != "Login fails" {
+		t.Errorf("Unexpected chart %+v", chart)
+	}
+}
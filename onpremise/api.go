@@ -0,0 +1,405 @@
+package onpremise
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// API contains the services of a Client as interfaces, so code depending on API instead of
+// the Client can be tested with mocks, e.g. generated by gomock or mockery from the interfaces.
+// The Iterate methods are not part of the interfaces, as they need Go 1.23.
+type API struct {
+	Authentication   AuthenticationAPI
+	Issue            IssueAPI
+	Search           SearchAPI
+	Project          ProjectAPI
+	Board            BoardAPI
+	Sprint           SprintAPI
+	User             UserAPI
+	Group            GroupAPI
+	Version          VersionAPI
+	Priority         PriorityAPI
+	Field            FieldAPI
+	Component        ComponentAPI
+	Resolution       ResolutionAPI
+	StatusCategory   StatusCategoryAPI
+	Filter           FilterAPI
+	Role             RoleAPI
+	PermissionScheme PermissionSchemeAPI
+	Status           StatusAPI
+	IssueLinkType    IssueLinkTypeAPI
+	Organization     OrganizationAPI
+	ServiceDesk      ServiceDeskAPI
+	Customer         CustomerAPI
+	Request          RequestAPI
+	Configuration    ConfigurationAPI
+	Reindex          ReindexAPI
+	Cluster          ClusterAPI
+	Plugin           PluginAPI
+	Webhook          WebhookAPI
+}
+
+// API returns the services of c as interfaces.
+func (c *Client) API() *API {
+	return &API{
+		Authentication:   c.Authentication,
+		Issue:            c.Issue,
+		Search:           c.Issue,
+		Project:          c.Project,
+		Board:            c.Board,
+		Sprint:           c.Sprint,
+		User:             c.User,
+		Group:            c.Group,
+		Version:          c.Version,
+		Priority:         c.Priority,
+		Field:            c.Field,
+		Component:        c.Component,
+		Resolution:       c.Resolution,
+		StatusCategory:   c.StatusCategory,
+		Filter:           c.Filter,
+		Role:             c.Role,
+		PermissionScheme: c.PermissionScheme,
+		Status:           c.Status,
+		IssueLinkType:    c.IssueLinkType,
+		Organization:     c.Organization,
+		ServiceDesk:      c.ServiceDesk,
+		Customer:         c.Customer,
+		Request:          c.Request,
+		Configuration:    c.Configuration,
+		Reindex:          c.Reindex,
+		Cluster:          c.Cluster,
+		Plugin:           c.Plugin,
+		Webhook:          c.Webhook,
+	}
+}
+
+// AuthenticationAPI is the interface of AuthenticationService.
+type AuthenticationAPI interface {
+}
+
+// SearchAPI is the interface of the issue search methods of IssueService.
+type SearchAPI interface {
+	Search(ctx context.Context, jql string, options *SearchOptions, opts ...RequestOption) ([]Issue, *Response, error)
+	SearchPages(ctx context.Context, jql string, options *SearchOptions, f func(Issue) error) error
+}
+
+// IssueAPI is the interface of IssueService.
+type IssueAPI interface {
+	SearchAPI
+
+	Get(ctx context.Context, issueID string, options *GetQueryOptions, opts ...RequestOption) (*Issue, *Response, error)
+	DownloadAttachment(ctx context.Context, attachmentID string) (*Response, error)
+	PostAttachment(ctx context.Context, issueID string, r io.Reader, attachmentName string) (*[]Attachment, *Response, error)
+	DeleteAttachment(ctx context.Context, attachmentID string) (*Response, error)
+	DeleteLink(ctx context.Context, linkID string) (*Response, error)
+	GetWorklogs(ctx context.Context, issueID string, options ...RequestOption) (*Worklog, *Response, error)
+	Create(ctx context.Context, issue *Issue) (*Issue, *Response, error)
+	Update(ctx context.Context, issue *Issue, opts *UpdateQueryOptions) (*Issue, *Response, error)
+	UpdateIssue(ctx context.Context, jiraID string, data map[string]interface{}) (*Response, error)
+	GetComments(ctx context.Context, issueID string, opts ...RequestOption) (*Comments, *Response, error)
+	AddComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	UpdateComment(ctx context.Context, issueID string, comment *Comment) (*Comment, *Response, error)
+	DeleteComment(ctx context.Context, issueID, commentID string) error
+	AddWorklogRecord(ctx context.Context, issueID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+	UpdateWorklogRecord(ctx context.Context, issueID, worklogID string, record *WorklogRecord, options ...func(*http.Request) error) (*WorklogRecord, *Response, error)
+	AddLink(ctx context.Context, issueLink *IssueLink) (*Response, error)
+	GetCustomFields(ctx context.Context, issueID string) (CustomFields, *Response, error)
+	GetTransitions(ctx context.Context, id string) ([]Transition, *Response, error)
+	DoTransition(ctx context.Context, ticketID, transitionID string) (*Response, error)
+	DoTransitionWithPayload(ctx context.Context, ticketID, payload interface{}) (*Response, error)
+	Delete(ctx context.Context, issueID string) (*Response, error)
+	GetWatchers(ctx context.Context, issueID string) (*[]User, *Response, error)
+	AddWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+	RemoveWatcher(ctx context.Context, issueID string, userName string) (*Response, error)
+	UpdateAssignee(ctx context.Context, issueID string, assignee *User) (*Response, error)
+	GetRemoteLinks(ctx context.Context, id string) (*[]RemoteLink, *Response, error)
+	AddRemoteLink(ctx context.Context, issueID string, remotelink *RemoteLink) (*RemoteLink, *Response, error)
+	UpdateRemoteLink(ctx context.Context, issueID string, linkID int, remotelink *RemoteLink) (*Response, error)
+	GetSubtasks(ctx context.Context, issueID string) ([]Subtasks, *Response, error)
+	MoveSubtask(ctx context.Context, issueID string, original, current int64) (*Response, error)
+	CreatePayload(ctx context.Context, payload *IssuePayload) (*Issue, *Response, error)
+	UpdatePayload(ctx context.Context, issueID string, payload *IssuePayload, opts *UpdateQueryOptions) (*Response, error)
+	Export(ctx context.Context, jql string, w io.Writer, options *ExportOptions) (*Response, error)
+	GetCreateMeta(ctx context.Context, options *GetQueryOptions) (*CreateMetaInfo, *Response, error)
+	GetEditMeta(ctx context.Context, issue *Issue) (*EditMetaInfo, *Response, error)
+}
+
+// ProjectAPI is the interface of ProjectService.
+type ProjectAPI interface {
+	GetAll(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error)
+	Get(ctx context.Context, projectID string) (*Project, *Response, error)
+	GetPermissionScheme(ctx context.Context, projectID string) (*PermissionScheme, *Response, error)
+	Archive(ctx context.Context, projectIDOrKey string) (*Response, error)
+	Restore(ctx context.Context, projectIDOrKey string) (*Response, error)
+	GetArchived(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error)
+}
+
+// BoardAPI is the interface of BoardService.
+type BoardAPI interface {
+	GetSprintReport(ctx context.Context, boardID, sprintID int64) (*SprintReport, *Response, error)
+	GetVelocityReport(ctx context.Context, boardID int64) (*VelocityReport, *Response, error)
+	GetBurndownChart(ctx context.Context, boardID, sprintID int64) (*BurndownChart, *Response, error)
+	GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error)
+	GetBoard(ctx context.Context, boardID int64) (*Board, *Response, error)
+	CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error)
+	DeleteBoard(ctx context.Context, boardID int) (*Board, *Response, error)
+	GetBoardsByFilter(ctx context.Context, filterID int64, options *SearchOptions) (*BoardsByFilterList, *Response, error)
+	GetBacklogIssues(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetEpics(ctx context.Context, boardID int64, options *GetEpicsOptions) (*EpicsList, *Response, error)
+	GetEpicIssues(ctx context.Context, boardID, epicID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetIssuesWithoutEpic(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetProjects(ctx context.Context, boardID int64, options *SearchOptions) (*BoardProjectsList, *Response, error)
+	GetVersions(ctx context.Context, boardID int64, options *GetVersionsOptions) (*BoardVersionsList, *Response, error)
+	GetPropertyKeys(ctx context.Context, boardID int64) (*EntityPropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, boardID int64, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, boardID int64, propertyKey string, value interface{}) (*Response, error)
+	DeleteProperty(ctx context.Context, boardID int64, propertyKey string) (*Response, error)
+	GetQuickFilters(ctx context.Context, boardID int64, options *SearchOptions) (*QuickFiltersList, *Response, error)
+	GetQuickFilter(ctx context.Context, boardID, quickFilterID int64) (*QuickFilter, *Response, error)
+	GetAllSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions) (*SprintsList, *Response, error)
+	GetSprintIssues(ctx context.Context, boardID, sprintID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error)
+}
+
+// SprintAPI is the interface of SprintService.
+type SprintAPI interface {
+	Create(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error)
+	Get(ctx context.Context, sprintID int) (*Sprint, *Response, error)
+	Update(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error)
+	PartialUpdate(ctx context.Context, sprintID int, sprint *Sprint) (*Sprint, *Response, error)
+	Delete(ctx context.Context, sprintID int) (*Response, error)
+	Swap(ctx context.Context, sprintID, sprintToSwapWith int) (*Response, error)
+	GetPropertyKeys(ctx context.Context, sprintID int) (*EntityPropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, sprintID int, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, sprintID int, propertyKey string, value interface{}) (*Response, error)
+	DeleteProperty(ctx context.Context, sprintID int, propertyKey string) (*Response, error)
+	MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*Response, error)
+	MoveIssues(ctx context.Context, sprintID int, issues *IssuesWrapper) (*Response, error)
+	GetIssuesForSprint(ctx context.Context, sprintID int) ([]Issue, *Response, error)
+	GetIssues(ctx context.Context, sprintID int, options *AgileIssueListOptions) (*AgileIssueList, *Response, error)
+	GetIssue(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error)
+}
+
+// UserAPI is the interface of UserService.
+type UserAPI interface {
+	Get(ctx context.Context, accountId string) (*User, *Response, error)
+	GetByAccountID(ctx context.Context, accountID string) (*User, *Response, error)
+	Create(ctx context.Context, user *User) (*User, *Response, error)
+	Delete(ctx context.Context, accountId string) (*Response, error)
+	GetGroups(ctx context.Context, accountId string) (*[]UserGroup, *Response, error)
+	GetSelf(ctx context.Context) (*User, *Response, error)
+	Find(ctx context.Context, property string, tweaks ...userSearchF) ([]User, *Response, error)
+	ValidateAnonymization(ctx context.Context, options *AnonymizationOptions) (*AnonymizationValidation, *Response, error)
+	ScheduleAnonymization(ctx context.Context, anonymization *AnonymizationRequest) (*AnonymizationProgress, *Response, error)
+	GetAnonymizationProgress(ctx context.Context, taskID int64) (*AnonymizationProgress, *Response, error)
+	ValidateAnonymizationRerun(ctx context.Context, options *AnonymizationRerunOptions) (*AnonymizationValidation, *Response, error)
+	ScheduleAnonymizationRerun(ctx context.Context, rerun *AnonymizationRerunRequest) (*AnonymizationProgress, *Response, error)
+	UnlockAnonymization(ctx context.Context) (*Response, error)
+	SetPassword(ctx context.Context, username string, change *PasswordChange) (*Response, error)
+	GetPasswordPolicy(ctx context.Context, hasOldPassword bool) ([]string, *Response, error)
+	CheckPasswordPolicyCreateUser(ctx context.Context, user *PasswordPolicyUser) ([]string, *Response, error)
+	CheckPasswordPolicyUpdateUser(ctx context.Context, user *PasswordPolicyUser) ([]string, *Response, error)
+	AddToApplication(ctx context.Context, username, applicationKey string) (*Response, error)
+	RemoveFromApplication(ctx context.Context, username, applicationKey string) (*Response, error)
+}
+
+// GroupAPI is the interface of GroupService.
+type GroupAPI interface {
+	Get(ctx context.Context, name string, options *GroupSearchOptions) ([]GroupMember, *Response, error)
+	Add(ctx context.Context, groupname string, username string) (*Group, *Response, error)
+	Remove(ctx context.Context, groupname string, username string) (*Response, error)
+}
+
+// VersionAPI is the interface of VersionService.
+type VersionAPI interface {
+	Get(ctx context.Context, versionID int) (*Version, *Response, error)
+	Create(ctx context.Context, version *Version) (*Version, *Response, error)
+	Update(ctx context.Context, version *Version) (*Version, *Response, error)
+}
+
+// PriorityAPI is the interface of PriorityService.
+type PriorityAPI interface {
+	GetList(ctx context.Context) ([]Priority, *Response, error)
+}
+
+// FieldAPI is the interface of FieldService.
+type FieldAPI interface {
+	GetList(ctx context.Context) ([]Field, *Response, error)
+	CreateCustom(ctx context.Context, options *FieldCreateOptions) (*Field, *Response, error)
+	UpdateCustom(ctx context.Context, fieldId string, options *FieldCreateOptions) (*Response, error)
+	DeleteCustom(ctx context.Context, fieldId string) (*Response, error)
+}
+
+// ComponentAPI is the interface of ComponentService.
+type ComponentAPI interface {
+	Create(ctx context.Context, options *CreateComponentOptions) (*ProjectComponent, *Response, error)
+}
+
+// ResolutionAPI is the interface of ResolutionService.
+type ResolutionAPI interface {
+	GetList(ctx context.Context) ([]Resolution, *Response, error)
+}
+
+// StatusCategoryAPI is the interface of StatusCategoryService.
+type StatusCategoryAPI interface {
+	GetList(ctx context.Context) ([]StatusCategory, *Response, error)
+	Get(ctx context.Context, statusCategoryID string) (*StatusCategory, *Response, error)
+}
+
+// FilterAPI is the interface of FilterService.
+type FilterAPI interface {
+	GetList(ctx context.Context) ([]*Filter, *Response, error)
+	GetFavouriteList(ctx context.Context) ([]*Filter, *Response, error)
+	Get(ctx context.Context, filterID int) (*Filter, *Response, error)
+	GetMyFilters(ctx context.Context, opts *GetMyFiltersQueryOptions) ([]*Filter, *Response, error)
+	Search(ctx context.Context, opt *FilterSearchOptions) (*FiltersList, *Response, error)
+}
+
+// RoleAPI is the interface of RoleService.
+type RoleAPI interface {
+	GetList(ctx context.Context) (*[]Role, *Response, error)
+	Get(ctx context.Context, roleID int) (*Role, *Response, error)
+}
+
+// PermissionSchemeAPI is the interface of PermissionSchemeService.
+type PermissionSchemeAPI interface {
+	GetList(ctx context.Context) (*PermissionSchemes, *Response, error)
+	Get(ctx context.Context, schemeID int) (*PermissionScheme, *Response, error)
+}
+
+// StatusAPI is the interface of StatusService.
+type StatusAPI interface {
+	GetAllStatuses(ctx context.Context) ([]Status, *Response, error)
+}
+
+// IssueLinkTypeAPI is the interface of IssueLinkTypeService.
+type IssueLinkTypeAPI interface {
+	GetList(ctx context.Context) ([]IssueLinkType, *Response, error)
+	Get(ctx context.Context, ID string) (*IssueLinkType, *Response, error)
+	Create(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Update(ctx context.Context, linkType *IssueLinkType) (*IssueLinkType, *Response, error)
+	Delete(ctx context.Context, ID string) (*Response, error)
+}
+
+// OrganizationAPI is the interface of OrganizationService.
+type OrganizationAPI interface {
+	GetAllOrganizations(ctx context.Context, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+	CreateOrganization(ctx context.Context, name string) (*Organization, *Response, error)
+	GetOrganization(ctx context.Context, organizationID int) (*Organization, *Response, error)
+	DeleteOrganization(ctx context.Context, organizationID int) (*Response, error)
+	GetPropertiesKeys(ctx context.Context, organizationID int) (*PropertyKeys, *Response, error)
+	GetProperty(ctx context.Context, organizationID int, propertyKey string) (*EntityProperty, *Response, error)
+	SetProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error)
+	DeleteProperty(ctx context.Context, organizationID int, propertyKey string) (*Response, error)
+	GetUsers(ctx context.Context, organizationID int, start int, limit int) (*PagedDTO, *Response, error)
+	AddUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+	RemoveUsers(ctx context.Context, organizationID int, users OrganizationUsersDTO) (*Response, error)
+}
+
+// ServiceDeskAPI is the interface of ServiceDeskService.
+type ServiceDeskAPI interface {
+	GetOrganizations(ctx context.Context, serviceDeskID interface{}, start int, limit int, accountID string) (*PagedDTO, *Response, error)
+	AddOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+	RemoveOrganization(ctx context.Context, serviceDeskID interface{}, organizationID int) (*Response, error)
+	AddCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+	RemoveCustomers(ctx context.Context, serviceDeskID interface{}, acountIDs ...string) (*Response, error)
+	ListCustomers(ctx context.Context, serviceDeskID interface{}, options *CustomerListOptions) (*CustomerList, *Response, error)
+}
+
+// CustomerAPI is the interface of CustomerService.
+type CustomerAPI interface {
+	Create(ctx context.Context, email, displayName string) (*Customer, *Response, error)
+}
+
+// RequestAPI is the interface of RequestService.
+type RequestAPI interface {
+	Create(ctx context.Context, requester string, participants []string, request *Request) (*Request, *Response, error)
+	CreateComment(ctx context.Context, issueIDOrKey string, comment *RequestComment) (*RequestComment, *Response, error)
+}
+
+// ConfigurationAPI is the interface of ConfigurationService.
+type ConfigurationAPI interface {
+	GetApplicationProperties(ctx context.Context, options *ApplicationPropertiesOptions) ([]ApplicationProperty, *Response, error)
+	GetAdvancedSettings(ctx context.Context) ([]ApplicationProperty, *Response, error)
+	SetApplicationProperty(ctx context.Context, key, value string) (*ApplicationProperty, *Response, error)
+	GetAttachmentSettings(ctx context.Context) (*AttachmentSettings, *Response, error)
+	SetAttachmentSettings(ctx context.Context, settings *AttachmentSettings) (*Response, error)
+	Get(ctx context.Context) (*Configuration, *Response, error)
+}
+
+// ReindexAPI is the interface of ReindexService.
+type ReindexAPI interface {
+	Start(ctx context.Context, options *ReindexOptions) (*Reindex, *Response, error)
+	GetProgress(ctx context.Context, taskID int64) (*Reindex, *Response, error)
+	ReindexIssues(ctx context.Context, issueIDs []string, options *IssueReindexOptions) (*Reindex, *Response, error)
+}
+
+// ClusterAPI is the interface of ClusterService.
+type ClusterAPI interface {
+	GetNodes(ctx context.Context) ([]ClusterNode, *Response, error)
+	SetNodeOffline(ctx context.Context, nodeID string) (*Response, error)
+	DeleteNode(ctx context.Context, nodeID string) (*Response, error)
+	GetZDUState(ctx context.Context) (*ZDUState, *Response, error)
+	StartZDU(ctx context.Context) (*Response, error)
+	CancelZDU(ctx context.Context) (*Response, error)
+	ApproveZDU(ctx context.Context) (*Response, error)
+	RetryZDU(ctx context.Context) (*Response, error)
+	AcknowledgeZDUErrors(ctx context.Context) (*Response, error)
+}
+
+// PluginAPI is the interface of PluginService.
+type PluginAPI interface {
+	GetAll(ctx context.Context) (*PluginList, *Response, error)
+	Get(ctx context.Context, pluginKey string) (*Plugin, *Response, error)
+	Enable(ctx context.Context, pluginKey string) (*Plugin, *Response, error)
+	Disable(ctx context.Context, pluginKey string) (*Plugin, *Response, error)
+	Uninstall(ctx context.Context, pluginKey string) (*Response, error)
+	InstallFromFile(ctx context.Context, r io.Reader, fileName string) (*PluginTask, *Response, error)
+	InstallFromURL(ctx context.Context, pluginURL string) (*PluginTask, *Response, error)
+	GetTask(ctx context.Context, taskID string) (*PluginTask, *Response, error)
+	GetLicense(ctx context.Context, pluginKey string) (*PluginLicense, *Response, error)
+	UpdateLicense(ctx context.Context, pluginKey, rawLicense string) (*PluginLicense, *Response, error)
+}
+
+// WebhookAPI is the interface of WebhookService.
+type WebhookAPI interface {
+	GetAll(ctx context.Context) ([]Webhook, *Response, error)
+	Get(ctx context.Context, webhookID string) (*Webhook, *Response, error)
+	Create(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error)
+	Update(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, *Response, error)
+	Delete(ctx context.Context, webhookID string) (*Response, error)
+	Enable(ctx context.Context, webhookID string) (*Webhook, *Response, error)
+	Disable(ctx context.Context, webhookID string) (*Webhook, *Response, error)
+}
+
+var (
+	_ AuthenticationAPI   = (*AuthenticationService)(nil)
+	_ IssueAPI            = (*IssueService)(nil)
+	_ ProjectAPI          = (*ProjectService)(nil)
+	_ BoardAPI            = (*BoardService)(nil)
+	_ SprintAPI           = (*SprintService)(nil)
+	_ UserAPI             = (*UserService)(nil)
+	_ GroupAPI            = (*GroupService)(nil)
+	_ VersionAPI          = (*VersionService)(nil)
+	_ PriorityAPI         = (*PriorityService)(nil)
+	_ FieldAPI            = (*FieldService)(nil)
+	_ ComponentAPI        = (*ComponentService)(nil)
+	_ ResolutionAPI       = (*ResolutionService)(nil)
+	_ StatusCategoryAPI   = (*StatusCategoryService)(nil)
+	_ FilterAPI           = (*FilterService)(nil)
+	_ RoleAPI             = (*RoleService)(nil)
+	_ PermissionSchemeAPI = (*PermissionSchemeService)(nil)
+	_ StatusAPI           = (*StatusService)(nil)
+	_ IssueLinkTypeAPI    = (*IssueLinkTypeService)(nil)
+	_ OrganizationAPI     = (*OrganizationService)(nil)
+	_ ServiceDeskAPI      = (*ServiceDeskService)(nil)
+	_ CustomerAPI         = (*CustomerService)(nil)
+	_ RequestAPI          = (*RequestService)(nil)
+	_ ConfigurationAPI    = (*ConfigurationService)(nil)
+	_ ReindexAPI          = (*ReindexService)(nil)
+	_ ClusterAPI          = (*ClusterService)(nil)
+	_ PluginAPI           = (*PluginService)(nil)
+	_ WebhookAPI          = (*WebhookService)(nil)
+	_ SearchAPI           = (*IssueService)(nil)
+)
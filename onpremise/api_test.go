@@ -0,0 +1,45 @@
+package onpremise
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestClient_API(t *testing.T) {
+	setup()
+	defer teardown()
+
+	api := testClient.API()
+
+	v := reflect.ValueOf(api).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).IsNil() {
+			t.Errorf("Expected API.%s to be set", v.Type().Field(i).Name)
+		}
+	}
+	if api.Issue != testClient.Issue {
+		t.Error("Expected API.Issue to be the IssueService of the client")
+	}
+}
+
+// fakeIssueAPI overrides Get of the embedded IssueAPI, like a mock would.
+type fakeIssueAPI struct {
+	IssueAPI
+}
+
+func (fakeIssueAPI) Get(ctx context.Context, issueID string, options *GetQueryOptions, opts ...RequestOption) (*Issue, *Response, error) {
+	return &Issue{Key: issueID}, nil, nil
+}
+
+func TestAPI_Mock(t *testing.T) {
+	api := &API{Issue: fakeIssueAPI{}}
+
+	issue, _, err := api.Issue.Get(context.Background(), "EX-1", nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "EX-1" {
+		t.Errorf("Expected EX-1, got %s", issue.Key)
+	}
+}
@@ -0,0 +1,157 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Keys of the application properties of the attachment settings.
+const (
+	ApplicationPropertyAllowAttachments = "jira.option.allowattachments"
+	ApplicationPropertyAttachmentSize   = "webwork.multipart.maxSize"
+)
+
+// ApplicationProperty is an application property of the Jira instance, e.g. a general or advanced setting.
+type ApplicationProperty struct {
+	ID            string   `json:"id,omitempty" structs:"id,omitempty"`
+	Key           string   `json:"key,omitempty" structs:"key,omitempty"`
+	Value         string   `json:"value" structs:"value"`
+	Name          string   `json:"name,omitempty" structs:"name,omitempty"`
+	Desc          string   `json:"desc,omitempty" structs:"desc,omitempty"`
+	Type          string   `json:"type,omitempty" structs:"type,omitempty"`
+	DefaultValue  string   `json:"defaultValue,omitempty" structs:"defaultValue,omitempty"`
+	Example       string   `json:"example,omitempty" structs:"example,omitempty"`
+	AllowedValues []string `json:"allowedValues,omitempty" structs:"allowedValues,omitempty"`
+}
+
+// ApplicationPropertiesOptions are the options of ConfigurationService.GetApplicationProperties.
+type ApplicationPropertiesOptions struct {
+	// Key returns only the property with the key.
+	Key string `url:"key,omitempty"`
+	// PermissionLevel returns the properties visible with the permission level, e.g. "SYSADMIN_ONLY".
+	PermissionLevel string `url:"permissionLevel,omitempty"`
+	// KeyFilter returns the properties with keys matching the regular expression, e.g. "jira\.option\..*".
+	KeyFilter string `url:"keyFilter,omitempty"`
+}
+
+// AttachmentSettings are the attachment settings of the Jira instance.
+type AttachmentSettings struct {
+	Enabled bool `json:"enabled" structs:"enabled"`
+	// UploadLimit is the maximum size of attachments in bytes.
+	UploadLimit int64 `json:"uploadLimit,omitempty" structs:"uploadLimit,omitempty"`
+}
+
+// ApplicationPropertyDiff is a property with different values, as returned by DiffApplicationProperties.
+type ApplicationPropertyDiff struct {
+	Key string
+	// Want and Got are the values, empty if the property is missing.
+	Want, Got string
+}
+
+// GetApplicationProperties returns the application properties, e.g. to compare the settings of instances.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/application-properties-getProperty
+func (s *ConfigurationService) GetApplicationProperties(ctx context.Context, options *ApplicationPropertiesOptions) ([]ApplicationProperty, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/application-properties", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getApplicationProperties(ctx, apiEndpoint)
+}
+
+// GetAdvancedSettings returns the application properties shown on the advanced settings page of the administration.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/application-properties-getAdvancedSettings
+func (s *ConfigurationService) GetAdvancedSettings(ctx context.Context) ([]ApplicationProperty, *Response, error) {
+	return s.getApplicationProperties(ctx, "rest/api/2/application-properties/advanced-settings")
+}
+
+func (s *ConfigurationService) getApplicationProperties(ctx context.Context, apiEndpoint string) ([]ApplicationProperty, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var properties []ApplicationProperty
+	resp, err := s.client.Do(req, &properties)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return properties, resp, nil
+}
+
+// SetApplicationProperty sets the value of the application property with the key.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/application-properties-setPropertyViaRestfulTable
+func (s *ConfigurationService) SetApplicationProperty(ctx context.Context, key, value string) (*ApplicationProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/application-properties/%s", key)
+	body := struct {
+		ID    string `json:"id"`
+		Value string `json:"value"`
+	}{key, value}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(ApplicationProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return property, resp, nil
+}
+
+// GetAttachmentSettings returns whether attachments are enabled and their maximum size.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/attachment-getAttachmentMeta
+func (s *ConfigurationService) GetAttachmentSettings(ctx context.Context) (*AttachmentSettings, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/attachment/meta", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings := new(AttachmentSettings)
+	resp, err := s.client.Do(req, settings)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return settings, resp, nil
+}
+
+// SetAttachmentSettings enables or disables attachments and sets their maximum size,
+// with the application properties ApplicationPropertyAllowAttachments and ApplicationPropertyAttachmentSize.
+// The size is not changed if UploadLimit is 0.
+func (s *ConfigurationService) SetAttachmentSettings(ctx context.Context, settings *AttachmentSettings) (*Response, error) {
+	_, resp, err := s.SetApplicationProperty(ctx, ApplicationPropertyAllowAttachments, strconv.FormatBool(settings.Enabled))
+	if err != nil || settings.UploadLimit == 0 {
+		return resp, err
+	}
+	_, resp, err = s.SetApplicationProperty(ctx, ApplicationPropertyAttachmentSize, strconv.FormatInt(settings.UploadLimit, 10))
+	return resp, err
+}
+
+// DiffApplicationProperties returns the properties of want with a different value in got, sorted by key,
+// e.g. to detect the configuration drift of an instance from a reference instance.
+// Properties only in got are ignored.
+func DiffApplicationProperties(want, got []ApplicationProperty) []ApplicationPropertyDiff {
+	values := make(map[string]string, len(got))
+	for _, property := range got {
+		values[property.Key] = property.Value
+	}
+
+	var diffs []ApplicationPropertyDiff
+	for _, property := range want {
+		if value, ok := values[property.Key]; !ok || value != property.Value {
+			diffs = append(diffs, ApplicationPropertyDiff{Key: property.Key, Want: property.Value, Got: value})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Key < diffs[j].Key })
+	return diffs
+}
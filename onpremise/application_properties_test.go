@@ -0,0 +1,99 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestConfigurationService_GetApplicationProperties(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/application-properties", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"keyFilter": `jira\.option\..*`})
+		fmt.Fprint(w, `[{"id":"jira.option.allowattachments","key":"jira.option.allowattachments","value":"true","name":"Allow attachments","type":"boolean","defaultValue":"true"}]`)
+	})
+
+	properties, _, err := testClient.Configuration.GetApplicationProperties(context.Background(), &ApplicationPropertiesOptions{KeyFilter: `jira\.option\..*`})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(properties) != 1 || properties[0].Key != ApplicationPropertyAllowAttachments || properties[0].Value != "true" {
+		t.Errorf("Unexpected properties %+v", properties)
+	}
+}
+
+func TestConfigurationService_GetAdvancedSettings(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/application-properties/advanced-settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"jira.issue.cache.capacity","key":"jira.issue.cache.capacity","value":"1000","type":"string"}]`)
+	})
+
+	settings, _, err := testClient.Configuration.GetAdvancedSettings(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(settings) != 1 || settings[0].Value != "1000" {
+		t.Errorf("Unexpected settings %+v", settings)
+	}
+}
+
+func TestConfigurationService_AttachmentSettings(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/attachment/meta", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"enabled":true,"uploadLimit":10485760}`)
+	})
+	set := map[string]string{}
+	testMux.HandleFunc("/rest/api/2/application-properties/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		set[body["id"]] = body["value"]
+		fmt.Fprintf(w, `{"id":%q,"key":%q,"value":%q}`, body["id"], body["id"], body["value"])
+	})
+
+	ctx := context.Background()
+	settings, _, err := testClient.Configuration.GetAttachmentSettings(ctx)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !settings.Enabled || settings.UploadLimit != 10485760 {
+		t.Errorf("Unexpected settings %+v", settings)
+	}
+
+	if _, err := testClient.Configuration.SetAttachmentSettings(ctx, &AttachmentSettings{Enabled: true, UploadLimit: 20971520}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if set[ApplicationPropertyAllowAttachments] != "true" || set[ApplicationPropertyAttachmentSize] != "20971520" {
+		t.Errorf("Unexpected properties set %v", set)
+	}
+}
+
+func TestDiffApplicationProperties(t *testing.T) {
+	want := []ApplicationProperty{
+		{Key: "jira.option.allowattachments", Value: "true"},
+		{Key: "webwork.multipart.maxSize", Value: "20971520"},
+		{Key: "jira.title", Value: "Jira"},
+	}
+	got := []ApplicationProperty{
+		{Key: "jira.option.allowattachments", Value: "true"},
+		{Key: "webwork.multipart.maxSize", Value: "10485760"},
+		{Key: "jira.baseurl", Value: "https://jira.example.com"},
+	}
+
+	diffs := DiffApplicationProperties(want, got)
+	if len(diffs) != 2 {
+		t.Fatalf("Expected 2 differences, got %+v", diffs)
+	}
+	if diffs[0] != (ApplicationPropertyDiff{Key: "jira.title", Want: "Jira"}) ||
+		diffs[1] != (ApplicationPropertyDiff{Key: "webwork.multipart.maxSize", Want: "20971520", Got: "10485760"}) {
+		t.Errorf("Unexpected differences %+v", diffs)
+	}
+}
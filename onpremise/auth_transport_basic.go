@@ -1,6 +1,9 @@
 package onpremise
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
 // using HTTP Basic Authentication with the provided username and password.
@@ -8,6 +11,10 @@ type BasicAuthTransport struct {
 	Username string
 	Password string
 
+	// PasswordProvider provides the password for each request.
+	// If it is set, Password is ignored.
+	PasswordProvider TokenProvider
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
@@ -16,9 +23,14 @@ type BasicAuthTransport struct {
 // RoundTrip implements the RoundTripper interface.  We just add the
 // basic auth and return the RoundTripper for this transport type.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	password, err := providedToken(req.Context(), t.PasswordProvider, t.Password)
+	if err != nil {
+		return nil, fmt.Errorf("basicAuth: %w", err)
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 
-	req2.SetBasicAuth(t.Username, t.Password)
+	req2.SetBasicAuth(t.Username, password)
 	return t.transport().RoundTrip(req2)
 }
 
@@ -10,6 +10,10 @@ import (
 type BearerAuthTransport struct {
 	Token string
 
+	// TokenProvider provides the token for each request.
+	// If it is set, Token is ignored.
+	TokenProvider TokenProvider
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
@@ -18,9 +22,14 @@ type BearerAuthTransport struct {
 // RoundTrip implements the RoundTripper interface.  We just add the
 // bearer token and return the RoundTripper for this transport type.
 func (t *BearerAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := providedToken(req.Context(), t.TokenProvider, t.Token)
+	if err != nil {
+		return nil, fmt.Errorf("bearerAuth: %w", err)
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
 
-	req2.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.Token))
+	req2.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	return t.transport().RoundTrip(req2)
 }
 
@@ -2,9 +2,12 @@ package onpremise
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -14,12 +17,19 @@ import (
 // Note that it is generally preferable to use HTTP BASIC authentication with the REST API.
 // However, this resource may be used to mimic the behaviour of Jira's log-in page (e.g. to display log-in errors to a user).
 //
+// If a request fails with 401 Unauthorized because the session expired,
+// the transport creates a new session and retries the request once.
+//
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#auth/1/session
 type CookieAuthTransport struct {
 	Username string
 	Password string
 	AuthURL  string
 
+	// PasswordProvider provides the password for creating a session.
+	// If it is set, Password is ignored.
+	PasswordProvider TokenProvider
+
 	// SessionObject is the authenticated cookie string.s
 	// It's passed in each call to prove the client is authenticated.
 	SessionObject []*http.Cookie
@@ -27,26 +37,45 @@ type CookieAuthTransport struct {
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
+
+	mu sync.Mutex
 }
 
 // RoundTrip adds the session object to the request.
 func (t *CookieAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	if t.SessionObject == nil {
-		err := t.setSessionObject()
+	session, renewed, err := t.session(req.Context(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
+	}
+
+	resp, err := t.transport().RoundTrip(t.withSession(req, session))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || renewed {
+		return resp, err
+	}
+
+	// The session probably expired. Retry once with a new session,
+	// if the body of the request can be sent again.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+	retry := req
+	if req.GetBody != nil {
+		body, err := req.GetBody()
 		if err != nil {
-			return nil, fmt.Errorf("cookieauth: no session object has been set: %w", err)
+			return resp, nil
 		}
+		retry = req.Clone(req.Context())
+		retry.Body = body
 	}
 
-	req2 := cloneRequest(req) // per RoundTripper contract
-	for _, cookie := range t.SessionObject {
-		// Don't add an empty value cookie to the request
-		if cookie.Value != "" {
-			req2.AddCookie(cookie)
-		}
+	session, _, err = t.session(req.Context(), session)
+	if err != nil {
+		return resp, nil
 	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
 
-	return t.transport().RoundTrip(req2)
+	return t.transport().RoundTrip(t.withSession(retry, session))
 }
 
 // Client returns an *http.Client that makes requests that are authenticated
@@ -55,16 +84,60 @@ func (t *CookieAuthTransport) Client() *http.Client {
 	return &http.Client{Transport: t}
 }
 
+// session returns the current session object, creating a new session if there is none
+// or if the current one is expired. renewed reports whether a new session was created.
+func (t *CookieAuthTransport) session(ctx context.Context, expired []*http.Cookie) (session []*http.Cookie, renewed bool, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Another request may have renewed the session in the meantime
+	if t.SessionObject != nil && (expired == nil || !sameCookies(t.SessionObject, expired)) {
+		return t.SessionObject, false, nil
+	}
+	if err := t.setSessionObject(ctx); err != nil {
+		return nil, false, err
+	}
+	return t.SessionObject, true, nil
+}
+
+func (t *CookieAuthTransport) withSession(req *http.Request, session []*http.Cookie) *http.Request {
+	req2 := cloneRequest(req) // per RoundTripper contract
+	for _, cookie := range session {
+		// Don't add an empty value cookie to the request
+		if cookie.Value != "" {
+			req2.AddCookie(cookie)
+		}
+	}
+	return req2
+}
+
+func sameCookies(a, b []*http.Cookie) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // setSessionObject attempts to authenticate the user and set
 // the session object (e.g. cookie)
-func (t *CookieAuthTransport) setSessionObject() error {
-	req, err := t.buildAuthRequest()
+func (t *CookieAuthTransport) setSessionObject(ctx context.Context) error {
+	password, err := providedToken(ctx, t.PasswordProvider, t.Password)
+	if err != nil {
+		return err
+	}
+	req, err := t.buildAuthRequest(ctx, password)
 	if err != nil {
 		return err
 	}
 
 	var authClient = &http.Client{
-		Timeout: time.Second * 60,
+		Timeout:   time.Second * 60,
+		Transport: t.transport(),
 	}
 	resp, err := authClient.Do(req)
 	if err != nil {
@@ -72,25 +145,28 @@ func (t *CookieAuthTransport) setSessionObject() error {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return fmt.Errorf("authentication failed with status %d", resp.StatusCode)
+	}
+
 	t.SessionObject = resp.Cookies()
 	return nil
 }
 
 // getAuthRequest assembles the request to get the authenticated cookie
-func (t *CookieAuthTransport) buildAuthRequest() (*http.Request, error) {
+func (t *CookieAuthTransport) buildAuthRequest(ctx context.Context, password string) (*http.Request, error) {
 	body := struct {
 		Username string `json:"username"`
 		Password string `json:"password"`
 	}{
 		t.Username,
-		t.Password,
+		password,
 	}
 
 	b := new(bytes.Buffer)
 	json.NewEncoder(b).Encode(body)
 
-	// TODO Use a context here
-	req, err := http.NewRequest(http.MethodPost, t.AuthURL, b)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.AuthURL, b)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,8 @@ package onpremise
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -118,3 +120,110 @@ func TestCookieAuthTransport_SessionObject_DoesNotExist(t *testing.T) {
 	req, _ := basicAuthClient.NewRequest(context.Background(), http.MethodGet, ".", nil)
 	basicAuthClient.Do(req, nil)
 }
+
+// Test that an expired session is renewed and the request is retried once
+func TestCookieAuthTransport_SessionExpired(t *testing.T) {
+	setup()
+	defer teardown()
+
+	logins := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logins++
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "fresh"})
+		w.Write([]byte(`OK`))
+	}))
+	defer ts.Close()
+
+	requests := 0
+	testMux.HandleFunc("/rest/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != "fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"fields":{"summary":"Retried"}}`+"\n" {
+			t.Errorf("Unexpected body of retried request %q", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":"10000","key":"TEST-1"}`)
+	})
+
+	tp := &CookieAuthTransport{
+		Username:      "username",
+		Password:      "password",
+		AuthURL:       ts.URL,
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	issue, _, err := client.Issue.Create(context.Background(), &Issue{Fields: &IssueFields{Summary: "Retried"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if issue.Key != "TEST-1" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+	if logins != 1 || requests != 2 {
+		t.Errorf("Expected 1 login and 2 requests, got %d and %d", logins, requests)
+	}
+}
+
+// Test that a request is retried only once if it keeps failing with 401
+func TestCookieAuthTransport_SessionExpired_RetriesOnce(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: "fresh"})
+	}))
+	defer ts.Close()
+
+	requests := 0
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	tp := &CookieAuthTransport{
+		AuthURL:       ts.URL,
+		SessionObject: []*http.Cookie{{Name: "JSESSIONID", Value: "expired"}},
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, ".", nil)
+	resp, err := client.Do(req, nil)
+	if err == nil {
+		t.Error("Expected an error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %v", resp)
+	}
+	if requests != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+// Test that a failed login is reported
+func TestCookieAuthTransport_LoginFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("No request should be sent without a session")
+	})
+
+	tp := &CookieAuthTransport{AuthURL: ts.URL}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	req, _ := client.NewRequest(context.Background(), http.MethodGet, ".", nil)
+	if _, err := client.Do(req, nil); err == nil {
+		t.Error("Expected an error")
+	}
+}
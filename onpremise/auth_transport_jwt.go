@@ -27,6 +27,10 @@ type JWTAuthTransport struct {
 	Secret []byte
 	Issuer string
 
+	// SecretProvider provides the shared secret for each request.
+	// If it is set, Secret is ignored.
+	SecretProvider TokenProvider
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
@@ -55,7 +59,16 @@ func (t *JWTAuthTransport) RoundTrip(req *http.Request) (*http.Response, error)
 		"qsh": qsh,
 	})
 
-	jwtStr, err := token.SignedString(t.Secret)
+	secret := t.Secret
+	if t.SecretProvider != nil {
+		s, err := t.SecretProvider.Token(req.Context())
+		if err != nil {
+			return nil, fmt.Errorf("jwtAuth: error getting secret: %w", err)
+		}
+		secret = []byte(s)
+	}
+
+	jwtStr, err := token.SignedString(secret)
 	if err != nil {
 		return nil, fmt.Errorf("jwtAuth: error signing JWT: %w", err)
 	}
@@ -0,0 +1,276 @@
+package onpremise
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OAuth1Config is the configuration of an application link using OAuth 1.0a (RSA-SHA1).
+// It provides the token dance to obtain an access token.
+//
+// The consumer key and the public key of PrivateKey have to be configured
+// in Jira as incoming authentication of an application link.
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Config struct {
+	// BaseURL is the base URL of the Jira instance, e.g. https://jira.example.com.
+	BaseURL     string
+	ConsumerKey string
+	PrivateKey  *rsa.PrivateKey
+
+	// CallbackURL receives the verifier after the user authorized the request token.
+	// It will default to "oob" if empty, in which case the verifier is shown to the user.
+	CallbackURL string
+
+	// HTTPClient is used for the token requests.
+	// It will default to http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// RequestToken obtains a temporary request token.
+// The user has to authorize it at AuthorizationURL afterwards.
+func (c *OAuth1Config) RequestToken(ctx context.Context) (token, secret string, err error) {
+	callback := c.CallbackURL
+	if callback == "" {
+		callback = "oob"
+	}
+	return c.requestToken(ctx, "plugins/servlet/oauth/request-token", map[string]string{
+		"oauth_callback": callback,
+	})
+}
+
+// AuthorizationURL returns the URL the user has to visit for authorizing the request token.
+func (c *OAuth1Config) AuthorizationURL(requestToken string) string {
+	return c.endpoint("plugins/servlet/oauth/authorize") + "?oauth_token=" + url.QueryEscape(requestToken)
+}
+
+// AccessToken exchanges an authorized request token for an access token.
+// verifier is the oauth_verifier passed to the CallbackURL or shown to the user.
+func (c *OAuth1Config) AccessToken(ctx context.Context, requestToken, verifier string) (token, secret string, err error) {
+	return c.requestToken(ctx, "plugins/servlet/oauth/access-token", map[string]string{
+		"oauth_token":    requestToken,
+		"oauth_verifier": verifier,
+	})
+}
+
+func (c *OAuth1Config) endpoint(path string) string {
+	return strings.TrimRight(c.BaseURL, "/") + "/" + path
+}
+
+func (c *OAuth1Config) requestToken(ctx context.Context, path string, params map[string]string) (string, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(path), nil)
+	if err != nil {
+		return "", "", err
+	}
+	if err := oauth1Sign(req, c.ConsumerKey, c.PrivateKey, params); err != nil {
+		return "", "", err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", "", fmt.Errorf("oauth1: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", "", fmt.Errorf("oauth1: %w", err)
+	}
+	values, err := url.ParseQuery(string(body))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		if problem := values.Get("oauth_problem"); err == nil && problem != "" {
+			return "", "", fmt.Errorf("oauth1: %s (status %d)", problem, resp.StatusCode)
+		}
+		return "", "", fmt.Errorf("oauth1: request to %s failed with status %d", req.URL, resp.StatusCode)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("oauth1: error decoding response: %w", err)
+	}
+	if values.Get("oauth_token") == "" {
+		return "", "", fmt.Errorf("oauth1: server response is missing oauth_token")
+	}
+	return values.Get("oauth_token"), values.Get("oauth_token_secret"), nil
+}
+
+// OAuth1Transport is an http.RoundTripper that signs all requests
+// with OAuth 1.0a (RSA-SHA1) using the access token of an application link.
+// Use OAuth1Config to obtain the access token.
+//
+// Jira docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Transport struct {
+	ConsumerKey string
+	PrivateKey  *rsa.PrivateKey
+	// Token is the access token.
+	Token string
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface.  We sign
+// the request and return the RoundTripper for this transport type.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req) // per RoundTripper contract
+
+	if err := oauth1Sign(req2, t.ConsumerKey, t.PrivateKey, map[string]string{"oauth_token": t.Token}); err != nil {
+		return nil, err
+	}
+	return t.transport().RoundTrip(req2)
+}
+
+// Client returns an *http.Client that makes requests that are signed
+// with OAuth 1.0a.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// oauth1Sign adds the OAuth 1.0a Authorization header with an RSA-SHA1 signature to req.
+// params are additional protocol parameters, e.g. oauth_token.
+func oauth1Sign(req *http.Request, consumerKey string, key *rsa.PrivateKey, params map[string]string) error {
+	if key == nil {
+		return fmt.Errorf("oauth1: private key is missing")
+	}
+
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("oauth1: error creating nonce: %w", err)
+	}
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     consumerKey,
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	for k, v := range params {
+		if v != "" {
+			oauthParams[k] = v
+		}
+	}
+
+	form, err := oauth1FormParams(req)
+	if err != nil {
+		return err
+	}
+	base := oauth1SignatureBase(req.Method, req.URL, form, oauthParams)
+	h := sha1.Sum([]byte(base))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA1, h[:])
+	if err != nil {
+		return fmt.Errorf("oauth1: error signing request: %w", err)
+	}
+	oauthParams["oauth_signature"] = base64.StdEncoding.EncodeToString(signature)
+
+	keys := make([]string, 0, len(oauthParams))
+	for k := range oauthParams {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	header := make([]string, len(keys))
+	for i, k := range keys {
+		header[i] = fmt.Sprintf(`%s="%s"`, oauth1Encode(k), oauth1Encode(oauthParams[k]))
+	}
+	req.Header.Set("Authorization", "OAuth "+strings.Join(header, ", "))
+	return nil
+}
+
+// oauth1FormParams returns the parameters of a form encoded body, they are part of the signature.
+func oauth1FormParams(req *http.Request) (url.Values, error) {
+	if req.Body == nil || req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return nil, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: error reading body: %w", err)
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: error reading body: %w", err)
+	}
+	return url.ParseQuery(string(data))
+}
+
+// oauth1SignatureBase returns the signature base string of a request.
+//
+// RFC 5849: https://www.rfc-editor.org/rfc/rfc5849#section-3.4.1
+func oauth1SignatureBase(method string, u *url.URL, form url.Values, oauthParams map[string]string) string {
+	var params [][2]string
+	add := func(k, v string) {
+		params = append(params, [2]string{oauth1Encode(k), oauth1Encode(v)})
+	}
+	for k, vs := range u.Query() {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	for k, vs := range form {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	for k, v := range oauthParams {
+		add(k, v)
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	normalized := make([]string, len(params))
+	for i, p := range params {
+		normalized[i] = p[0] + "=" + p[1]
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Host)
+	if (scheme == "http" && strings.HasSuffix(host, ":80")) || (scheme == "https" && strings.HasSuffix(host, ":443")) {
+		host = host[:strings.LastIndex(host, ":")]
+	}
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	baseURI := scheme + "://" + host + path
+
+	return strings.ToUpper(method) + "&" + oauth1Encode(baseURI) + "&" + oauth1Encode(strings.Join(normalized, "&"))
+}
+
+// oauth1Encode percent-encodes s, leaving only the unreserved characters of RFC 3986.
+func oauth1Encode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
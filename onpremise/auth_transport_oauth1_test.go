@@ -0,0 +1,166 @@
+package onpremise
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestOAuth1SignatureBase(t *testing.T) {
+	// Example of RFC 5849, section 3.4.1.1
+	u, _ := url.Parse("http://EXAMPLE.COM:80/request?b5=%3D%253D&a3=a&c%40=&a2=r%20b")
+	form, _ := url.ParseQuery("c2&a3=2+q")
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     "9djdj82h48djs9d2",
+		"oauth_token":            "kkk9d7dh3k39sjv7",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "137131201",
+		"oauth_nonce":            "7d8f3e4a",
+	}
+
+	want := "POST&http%3A%2F%2Fexample.com%2Frequest&a2%3Dr%2520b%26a3%3D2%2520q" +
+		"%26a3%3Da%26b5%3D%253D%25253D%26c%2540%3D%26c2%3D%26oauth_consumer_" +
+		"key%3D9djdj82h48djs9d2%26oauth_nonce%3D7d8f3e4a%26oauth_signature_m" +
+		"ethod%3DHMAC-SHA1%26oauth_timestamp%3D137131201%26oauth_token%3Dkkk" +
+		"9d7dh3k39sjv7"
+	if got := oauth1SignatureBase("post", u, form, oauthParams); got != want {
+		t.Errorf("oauth1SignatureBase() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// parseOAuth1Header returns the parameters of an OAuth Authorization header.
+func parseOAuth1Header(t *testing.T, header string) map[string]string {
+	if !strings.HasPrefix(header, "OAuth ") {
+		t.Fatalf("Unexpected Authorization header %q", header)
+	}
+	params := map[string]string{}
+	for _, p := range strings.Split(strings.TrimPrefix(header, "OAuth "), ", ") {
+		kv := strings.SplitN(p, "=", 2)
+		v, err := url.PathUnescape(strings.Trim(kv[1], `"`))
+		if err != nil {
+			t.Fatalf("Error decoding %q: %s", p, err)
+		}
+		params[kv[0]] = v
+	}
+	return params
+}
+
+// verifyOAuth1Signature checks the RSA-SHA1 signature of a request received by the test server.
+func verifyOAuth1Signature(t *testing.T, r *http.Request, key *rsa.PublicKey) map[string]string {
+	params := parseOAuth1Header(t, r.Header.Get("Authorization"))
+	signature, err := base64.StdEncoding.DecodeString(params["oauth_signature"])
+	if err != nil {
+		t.Fatalf("Error decoding signature: %s", err)
+	}
+	delete(params, "oauth_signature")
+
+	u := *r.URL
+	u.Scheme = "http"
+	u.Host = r.Host
+	h := sha1.Sum([]byte(oauth1SignatureBase(r.Method, &u, nil, params)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA1, h[:], signature); err != nil {
+		t.Errorf("Invalid signature: %s", err)
+	}
+	return params
+}
+
+func TestOAuth1Transport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testMux.HandleFunc("/rest/api/2/myself", func(w http.ResponseWriter, r *http.Request) {
+		params := verifyOAuth1Signature(t, r, &key.PublicKey)
+		if params["oauth_consumer_key"] != "go-jira" || params["oauth_token"] != "access-token" || params["oauth_signature_method"] != "RSA-SHA1" {
+			t.Errorf("Unexpected OAuth parameters %v", params)
+		}
+		fmt.Fprint(w, `{"name":"fred"}`)
+	})
+
+	tp := &OAuth1Transport{ConsumerKey: "go-jira", PrivateKey: key, Token: "access-token"}
+	client, _ := NewClient(testServer.URL, tp.Client())
+	if _, _, err := client.User.GetSelf(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestOAuth1Config_TokenDance(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := verifyOAuth1Signature(t, r, &key.PublicKey)
+		if params["oauth_callback"] != "oob" {
+			t.Errorf("Unexpected oauth_callback %q", params["oauth_callback"])
+		}
+		fmt.Fprint(w, "oauth_token=request-token&oauth_token_secret=request-secret")
+	})
+	testMux.HandleFunc("/plugins/servlet/oauth/access-token", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		params := verifyOAuth1Signature(t, r, &key.PublicKey)
+		if params["oauth_token"] != "request-token" || params["oauth_verifier"] != "verifier" {
+			t.Errorf("Unexpected OAuth parameters %v", params)
+		}
+		fmt.Fprint(w, "oauth_token=access-token&oauth_token_secret=access-secret&oauth_expires_in=157680000")
+	})
+
+	c := &OAuth1Config{BaseURL: testServer.URL + "/", ConsumerKey: "go-jira", PrivateKey: key}
+
+	requestToken, _, err := c.RequestToken(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requestToken != "request-token" {
+		t.Errorf("Unexpected request token %q", requestToken)
+	}
+	if got, want := c.AuthorizationURL(requestToken), testServer.URL+"/plugins/servlet/oauth/authorize?oauth_token=request-token"; got != want {
+		t.Errorf("Unexpected authorization URL %q, want %q", got, want)
+	}
+
+	accessToken, _, err := c.AccessToken(context.Background(), requestToken, "verifier")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if accessToken != "access-token" {
+		t.Errorf("Unexpected access token %q", accessToken)
+	}
+}
+
+func TestOAuth1Config_RequestToken_Problem(t *testing.T) {
+	setup()
+	defer teardown()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testMux.HandleFunc("/plugins/servlet/oauth/request-token", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, "oauth_problem=consumer_key_unknown")
+	})
+
+	c := &OAuth1Config{BaseURL: testServer.URL, ConsumerKey: "unknown", PrivateKey: key}
+	_, _, err = c.RequestToken(context.Background())
+	if err == nil || err.Error() != "oauth1: consumer_key_unknown (status 401)" {
+		t.Errorf("Unexpected error %v", err)
+	}
+}
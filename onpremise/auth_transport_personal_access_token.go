@@ -1,29 +1,43 @@
 package onpremise
 
-import "net/http"
+import (
+	"fmt"
+	"net/http"
+)
 
 // PATAuthTransport is an http.RoundTripper that authenticates all requests
 // using the Personal Access Token specified.
+// The token is sent as "Authorization: Bearer <token>".
+// Personal Access Tokens are available since Jira 8.14 and are the recommended replacement for basic auth.
 // See here for more info: https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
 type PATAuthTransport struct {
 	// Token is the key that was provided by Jira when creating the Personal Access Token.
 	Token string
 
+	// TokenProvider provides the token for each request.
+	// If it is set, Token is ignored.
+	TokenProvider TokenProvider
+
 	// Transport is the underlying HTTP transport to use when making requests.
 	// It will default to http.DefaultTransport if nil.
 	Transport http.RoundTripper
 }
 
 // RoundTrip implements the RoundTripper interface.  We just add the
-// basic auth and return the RoundTripper for this transport type.
+// token as bearer token and return the RoundTripper for this transport type.
 func (t *PATAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := providedToken(req.Context(), t.TokenProvider, t.Token)
+	if err != nil {
+		return nil, fmt.Errorf("patAuth: %w", err)
+	}
+
 	req2 := cloneRequest(req) // per RoundTripper contract
-	req2.Header.Set("Authorization", "Bearer "+t.Token)
+	req2.Header.Set("Authorization", "Bearer "+token)
 	return t.transport().RoundTrip(req2)
 }
 
 // Client returns an *http.Client that makes requests that are authenticated
-// using HTTP Basic Authentication.  This is a nice little bit of sugar
+// using the Personal Access Token.  This is a nice little bit of sugar
 // so we can just get the client instead of creating the client in the calling code.
 // If it's necessary to send more information on client init, the calling code can
 // always skip this and set the transport itself.
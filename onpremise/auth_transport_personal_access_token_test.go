@@ -16,7 +16,9 @@ func TestPATAuthTransport_HeaderContainsAuth(t *testing.T) {
 		Token: token,
 	}
 
+	called := false
 	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		called = true
 		val := r.Header.Get("Authorization")
 		expected := "Bearer " + token
 		if val != expected {
@@ -27,4 +29,42 @@ func TestPATAuthTransport_HeaderContainsAuth(t *testing.T) {
 	client, _ := NewClient(testServer.URL, patTransport.Client())
 	client.User.GetSelf(context.Background())
 
+	if !called {
+		t.Errorf("request was not sent")
+	}
+}
+
+func TestPATAuthTransport_transport(t *testing.T) {
+	// default transport
+	tp := &PATAuthTransport{}
+	if tp.transport() != http.DefaultTransport {
+		t.Errorf("Expected http.DefaultTransport to be used.")
+	}
+
+	// custom transport
+	tp = &PATAuthTransport{
+		Transport: &http.Transport{},
+	}
+	if tp.transport() == http.DefaultTransport {
+		t.Errorf("Expected custom transport to be used.")
+	}
+}
+
+func TestPATAuthTransport_TokenProvider(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if val := r.Header.Get("Authorization"); val != "Bearer from-provider" {
+			t.Errorf("Unexpected Authorization header %q", val)
+		}
+	})
+
+	tp := &PATAuthTransport{
+		Token:         "ignored",
+		TokenProvider: StaticToken("from-provider"),
+	}
+
+	client, _ := NewClient(testServer.URL, tp.Client())
+	client.User.GetSelf(context.Background())
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -23,11 +24,24 @@ type BoardsList struct {
 
 // Board represents a Jira agile board
 type Board struct {
-	ID       int    `json:"id,omitempty" structs:"id,omitempty"`
-	Self     string `json:"self,omitempty" structs:"self,omitempty"`
-	Name     string `json:"name,omitempty" structs:"name,omitemtpy"`
-	Type     string `json:"type,omitempty" structs:"type,omitempty"`
-	FilterID int    `json:"filterId,omitempty" structs:"filterId,omitempty"`
+	ID       int           `json:"id,omitempty" structs:"id,omitempty"`
+	Self     string        `json:"self,omitempty" structs:"self,omitempty"`
+	Name     string        `json:"name,omitempty" structs:"name,omitemtpy"`
+	Type     string        `json:"type,omitempty" structs:"type,omitempty"`
+	Location BoardLocation `json:"location,omitempty" structs:"location,omitempty"`
+	FilterID int           `json:"filterId,omitempty" structs:"filterId,omitempty"`
+}
+
+// BoardLocation represents the location of a Jira board
+type BoardLocation struct {
+	ProjectID      int    `json:"projectId"`
+	UserID         int    `json:"userId"`
+	UserAccountID  string `json:"userAccountId"`
+	DisplayName    string `json:"displayName"`
+	ProjectName    string `json:"projectName"`
+	ProjectKey     string `json:"projectKey"`
+	ProjectTypeKey string `json:"projectTypeKey"`
+	Name           string `json:"name"`
 }
 
 // BoardListOptions specifies the optional parameters to the BoardService.GetList
@@ -40,13 +54,27 @@ type BoardListOptions struct {
 	// ProjectKeyOrID filters results to boards that are relevant to a project.
 	// Relevance meaning that the JQL filter defined in board contains a reference to a project.
 	ProjectKeyOrID string `url:"projectKeyOrId,omitempty"`
+	// FilterID filters results to boards that are based on the filter with the given ID.
+	FilterID int64 `url:"filterId,omitempty"`
+	// IncludePrivate appends private boards to the end of the list.
+	IncludePrivate bool `url:"includePrivate,omitempty"`
+	// OrderBy orders the results by a field. Valid values: name, -name, +name.
+	OrderBy string `url:"orderBy,omitempty"`
 
 	SearchOptions
 }
 
-// GetAllSprintsOptions specifies the optional parameters to the BoardService.GetList
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
+)
+
+// GetAllSprintsOptions specifies the optional parameters to the BoardService.GetAllSprints
 type GetAllSprintsOptions struct {
-	// State filters results to sprints in the specified states, comma-separate list
+	// State filters results to sprints in the specified states, comma-separate list.
+	// Valid values: future, active, closed.
+	// Example: SprintStateActive + "," + SprintStateFuture
 	State string `url:"state,omitempty"`
 
 	SearchOptions
@@ -63,25 +91,61 @@ type SprintsList struct {
 
 // Sprint represents a sprint on Jira agile board
 type Sprint struct {
-	ID            int        `json:"id" structs:"id"`
-	Name          string     `json:"name" structs:"name"`
-	CompleteDate  *time.Time `json:"completeDate" structs:"completeDate"`
-	EndDate       *time.Time `json:"endDate" structs:"endDate"`
-	StartDate     *time.Time `json:"startDate" structs:"startDate"`
-	OriginBoardID int        `json:"originBoardId" structs:"originBoardId"`
-	Self          string     `json:"self" structs:"self"`
-	State         string     `json:"state" structs:"state"`
+	ID            int        `json:"id,omitempty" structs:"id,omitempty"`
+	Name          string     `json:"name,omitempty" structs:"name,omitempty"`
+	CreatedDate   *time.Time `json:"createdDate,omitempty" structs:"createdDate,omitempty"`
+	CompleteDate  *time.Time `json:"completeDate,omitempty" structs:"completeDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty" structs:"endDate,omitempty"`
+	StartDate     *time.Time `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	OriginBoardID int        `json:"originBoardId,omitempty" structs:"originBoardId,omitempty"`
+	Self          string     `json:"self,omitempty" structs:"self,omitempty"`
+	State         string     `json:"state,omitempty" structs:"state,omitempty"`
+	Goal          string     `json:"goal,omitempty" structs:"goal,omitempty"`
 }
 
 // BoardConfiguration represents a boardConfiguration of a jira board
 type BoardConfiguration struct {
 	ID           int                            `json:"id"`
 	Name         string                         `json:"name"`
+	Type         string                         `json:"type"`
 	Self         string                         `json:"self"`
 	Location     BoardConfigurationLocation     `json:"location"`
 	Filter       BoardConfigurationFilter       `json:"filter"`
 	SubQuery     BoardConfigurationSubQuery     `json:"subQuery"`
 	ColumnConfig BoardConfigurationColumnConfig `json:"columnConfig"`
+	Estimation   *BoardConfigurationEstimation  `json:"estimation,omitempty"`
+	Ranking      *BoardConfigurationRanking     `json:"ranking,omitempty"`
+}
+
+// ColumnForStatus returns the column the status with the given ID is mapped to.
+// If the status is not mapped to any column of the board, nil is returned.
+func (c *BoardConfiguration) ColumnForStatus(statusID string) *BoardConfigurationColumn {
+	for i, column := range c.ColumnConfig.Columns {
+		for _, status := range column.Status {
+			if status.ID == statusID {
+				return &c.ColumnConfig.Columns[i]
+			}
+		}
+	}
+	return nil
+}
+
+// BoardConfigurationEstimation (Scrum only) - the estimation statistic configured for the board.
+// Type can take the values none, issueCount and field.
+type BoardConfigurationEstimation struct {
+	Type  string                             `json:"type"`
+	Field *BoardConfigurationEstimationField `json:"field,omitempty"`
+}
+
+// BoardConfigurationEstimationField is the field used for estimation, e.g. "Story Points".
+type BoardConfigurationEstimationField struct {
+	FieldID     string `json:"fieldId"`
+	DisplayName string `json:"displayName"`
+}
+
+// BoardConfigurationRanking references the custom field used to rank the issues of the board.
+type BoardConfigurationRanking struct {
+	RankCustomFieldID int64 `json:"rankCustomFieldId"`
 }
 
 // BoardConfigurationFilter reference to the filter used by the given board.
@@ -97,11 +161,12 @@ type BoardConfigurationSubQuery struct {
 
 // BoardConfigurationLocation reference to the container that the board is located in
 type BoardConfigurationLocation struct {
-	Type string `json:"type"`
-	Key  string `json:"key"`
-	ID   string `json:"id"`
-	Self string `json:"self"`
-	Name string `json:"name"`
+	Type           string `json:"type"`
+	Key            string `json:"key"`
+	ID             string `json:"id"`
+	ProjectKeyOrID string `json:"projectKeyOrId,omitempty"`
+	Self           string `json:"self"`
+	Name           string `json:"name"`
 }
 
 // BoardConfigurationColumnConfig lists the columns for a given board in the order defined in the column configuration
@@ -126,11 +191,9 @@ type BoardConfigurationColumnStatus struct {
 }
 
 // GetAllBoards will returns all boards. This only includes boards that the user has permission to view.
+// The result is paginated, use BoardListOptions.StartAt and BoardListOptions.MaxResults to page through it.
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getAllBoards
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-get
 func (s *BoardService) GetAllBoards(ctx context.Context, opt *BoardListOptions) (*BoardsList, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	url, err := addOptions(apiEndpoint, opt)
@@ -152,14 +215,12 @@ func (s *BoardService) GetAllBoards(ctx context.Context, opt *BoardListOptions)
 	return boards, resp, err
 }
 
-// GetBoard will returns the board for the given boardID.
+// GetBoard returns the board for the given board ID.
 // This board will only be returned if the user has permission to view it.
+// Admins without the view permission will see the board as a private one, so will see only a subset of the board's data (board location for instance).
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-getBoard
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *BoardService) GetBoard(ctx context.Context, boardID int) (*Board, *Response, error) {
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-get
+func (s *BoardService) GetBoard(ctx context.Context, boardID int64) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
@@ -183,10 +244,7 @@ func (s *BoardService) GetBoard(ctx context.Context, boardID int) (*Board, *Resp
 // Note, if the user does not have the 'Create shared objects' permission and tries to create a shared board, a private
 // board will be created instead (remember that board sharing depends on the filter sharing).
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-createBoard
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-post
 func (s *BoardService) CreateBoard(ctx context.Context, board *Board) (*Board, *Response, error) {
 	apiEndpoint := "rest/agile/1.0/board"
 	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, board)
@@ -206,11 +264,8 @@ func (s *BoardService) CreateBoard(ctx context.Context, board *Board) (*Board, *
 
 // DeleteBoard will delete an agile board.
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/board-deleteBoard
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-delete
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *BoardService) DeleteBoard(ctx context.Context, boardID int) (*Board, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%v", boardID)
 	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
@@ -225,14 +280,382 @@ func (s *BoardService) DeleteBoard(ctx context.Context, boardID int) (*Board, *R
 	return nil, resp, err
 }
 
+// BoardsByFilterList reflects a list of agile boards that use a given filter
+type BoardsByFilterList struct {
+	MaxResults int                `json:"maxResults" structs:"maxResults"`
+	StartAt    int                `json:"startAt" structs:"startAt"`
+	Total      int                `json:"total" structs:"total"`
+	IsLast     bool               `json:"isLast" structs:"isLast"`
+	Values     []BoardFilterEntry `json:"values" structs:"values"`
+}
+
+// BoardFilterEntry is the short representation of a board returned by BoardService.GetBoardsByFilter
+type BoardFilterEntry struct {
+	ID   int    `json:"id,omitempty" structs:"id,omitempty"`
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Name string `json:"name,omitempty" structs:"name,omitempty"`
+}
+
+// GetBoardsByFilter returns the boards that use the filter with the given filter ID.
+// This only includes boards that the user has permission to view.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-filter-filterid-get
+func (s *BoardService) GetBoardsByFilter(ctx context.Context, filterID int64, options *SearchOptions) (*BoardsByFilterList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/board/filter/%d", filterID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	boards := new(BoardsByFilterList)
+	resp, err := s.client.Do(req, boards)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return boards, resp, nil
+}
+
+// AgileIssueListOptions specifies the optional parameters for the agile API methods that return a list of issues,
+// like BoardService.GetBacklogIssues
+type AgileIssueListOptions struct {
+	// JQL filters the returned issues further.
+	JQL string `url:"jql,omitempty"`
+	// ValidateQuery specifies whether to validate the JQL query. Default: true.
+	ValidateQuery *bool `url:"validateQuery,omitempty"`
+	// Fields is the list of fields to return for each issue. By default, all navigable and agile fields are returned.
+	Fields []string `url:"fields,comma,omitempty"`
+	// Expand specific sections in the returned issues
+	Expand     string `url:"expand,omitempty"`
+	StartAt    int    `url:"startAt,omitempty"`
+	MaxResults int    `url:"maxResults,omitempty"`
+}
+
+// AgileIssueList reflects a page of issues returned by the agile API
+type AgileIssueList struct {
+	Expand     string  `json:"expand,omitempty" structs:"expand,omitempty"`
+	StartAt    int     `json:"startAt" structs:"startAt"`
+	MaxResults int     `json:"maxResults" structs:"maxResults"`
+	Total      int     `json:"total" structs:"total"`
+	Issues     []Issue `json:"issues" structs:"issues"`
+}
+
+// GetBacklogIssues returns all issues from the board's backlog, for the given board ID.
+// This only includes issues that the user has permission to view.
+// The backlog contains incomplete issues that are not assigned to any future or active sprint.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-backlog-get
+func (s *BoardService) GetBacklogIssues(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/backlog", boardID), options)
+}
+
+// EpicsList reflects a list of agile epics
+type EpicsList struct {
+	MaxResults int    `json:"maxResults" structs:"maxResults"`
+	StartAt    int    `json:"startAt" structs:"startAt"`
+	Total      int    `json:"total" structs:"total"`
+	IsLast     bool   `json:"isLast" structs:"isLast"`
+	Values     []Epic `json:"values" structs:"values"`
+}
+
+// GetEpicsOptions specifies the optional parameters to the BoardService.GetEpics
+type GetEpicsOptions struct {
+	// Done filters results to epics that are either done or not done.
+	Done *bool `url:"done,omitempty"`
+
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// GetEpics returns all epics from the board, for the given board ID.
+// This only includes epics that the user has permission to view.
+// Note, if the user does not have permission to view the board, no epics will be returned at all.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-epic-get
+func (s *BoardService) GetEpics(ctx context.Context, boardID int64, options *GetEpicsOptions) (*EpicsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/board/%d/epic", boardID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epics := new(EpicsList)
+	resp, err := s.client.Do(req, epics)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return epics, resp, nil
+}
+
+// GetEpicIssues returns all issues that belong to an epic on the board, for the given epic ID and the board ID.
+// This only includes issues that the user has permission to view.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-epic-epicid-issue-get
+func (s *BoardService) GetEpicIssues(ctx context.Context, boardID, epicID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/epic/%d/issue", boardID, epicID), options)
+}
+
+// GetIssuesWithoutEpic returns all issues that do not belong to any epic on a board, for a given board ID.
+// This only includes issues that the user has permission to view.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-epic-none-issue-get
+func (s *BoardService) GetIssuesWithoutEpic(ctx context.Context, boardID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/epic/none/issue", boardID), options)
+}
+
+// getIssues fetches a page of issues from the given agile API endpoint
+func (s *BoardService) getIssues(ctx context.Context, apiEndpoint string, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	apiEndpoint, err := addOptions(apiEndpoint, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := new(AgileIssueList)
+	resp, err := s.client.Do(req, issues)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return issues, resp, nil
+}
+
+// BoardProjectsList reflects a list of projects associated with an agile board
+type BoardProjectsList struct {
+	MaxResults int       `json:"maxResults" structs:"maxResults"`
+	StartAt    int       `json:"startAt" structs:"startAt"`
+	Total      int       `json:"total" structs:"total"`
+	IsLast     bool      `json:"isLast" structs:"isLast"`
+	Values     []Project `json:"values" structs:"values"`
+}
+
+// GetProjects returns all projects that are associated with the board, for the given board ID.
+// A project is associated with a board if the board filter contains reference the project or
+// there is an issue from the project that belongs to the board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-project-get
+func (s *BoardService) GetProjects(ctx context.Context, boardID int64, options *SearchOptions) (*BoardProjectsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/board/%d/project", boardID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projects := new(BoardProjectsList)
+	resp, err := s.client.Do(req, projects)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return projects, resp, nil
+}
+
+// BoardVersionsList reflects a list of versions of the projects associated with an agile board
+type BoardVersionsList struct {
+	MaxResults int       `json:"maxResults" structs:"maxResults"`
+	StartAt    int       `json:"startAt" structs:"startAt"`
+	Total      int       `json:"total" structs:"total"`
+	IsLast     bool      `json:"isLast" structs:"isLast"`
+	Values     []Version `json:"values" structs:"values"`
+}
+
+// GetVersionsOptions specifies the optional parameters to the BoardService.GetVersions
+type GetVersionsOptions struct {
+	// Released filters results to versions that are either released or unreleased.
+	Released *bool `url:"released,omitempty"`
+
+	StartAt    int `url:"startAt,omitempty"`
+	MaxResults int `url:"maxResults,omitempty"`
+}
+
+// GetVersions returns all versions from a board, for a given board ID.
+// This only includes versions that the user has permission to view.
+// Versions are ordered by the name of the project from which they belong and then by sequence defined by user.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-version-get
+func (s *BoardService) GetVersions(ctx context.Context, boardID int64, options *GetVersionsOptions) (*BoardVersionsList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/board/%d/version", boardID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	versions := new(BoardVersionsList)
+	resp, err := s.client.Do(req, versions)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return versions, resp, nil
+}
+
+// GetPropertyKeys returns the keys of all properties for the board identified by the id.
+// The user who retrieves the property keys is required to have permissions to view the board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-properties-get
+func (s *BoardService) GetPropertyKeys(ctx context.Context, boardID int64) (*EntityPropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties", boardID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(EntityPropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return keys, resp, nil
+}
+
+// GetProperty returns the value of the property with a given key from the board identified by the provided id.
+// The user who retrieves the property is required to have permissions to view the board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-properties-propertykey-get
+func (s *BoardService) GetProperty(ctx context.Context, boardID int64, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return property, resp, nil
+}
+
+// SetProperty sets the value of the specified board's property.
+// The value is JSON encoded and must not exceed 32 KB.
+// The user who stores the data is required to have permissions to modify the board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-properties-propertykey-put
+// Caller must close resp.Body
+func (s *BoardService) SetProperty(ctx context.Context, boardID int64, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteProperty removes the property from the board identified by the id.
+// The user removing the property is required to have permissions to modify the board.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-properties-propertykey-delete
+// Caller must close resp.Body
+func (s *BoardService) DeleteProperty(ctx context.Context, boardID int64, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/properties/%s", boardID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// QuickFiltersList reflects a list of quick filters of an agile board
+type QuickFiltersList struct {
+	MaxResults int           `json:"maxResults" structs:"maxResults"`
+	StartAt    int           `json:"startAt" structs:"startAt"`
+	Total      int           `json:"total" structs:"total"`
+	IsLast     bool          `json:"isLast" structs:"isLast"`
+	Values     []QuickFilter `json:"values" structs:"values"`
+}
+
+// QuickFilter represents a quick filter of an agile board.
+// JQL is the query that is added to the board filter when the quick filter is selected.
+type QuickFilter struct {
+	ID          int64  `json:"id" structs:"id"`
+	BoardID     int64  `json:"boardId" structs:"boardId"`
+	Name        string `json:"name" structs:"name"`
+	JQL         string `json:"jql" structs:"jql"`
+	Description string `json:"description,omitempty" structs:"description,omitempty"`
+	Position    int    `json:"position" structs:"position"`
+}
+
+// GetQuickFilters returns all quick filters from a board, for a given board ID.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-quickfilter-get
+func (s *BoardService) GetQuickFilters(ctx context.Context, boardID int64, options *SearchOptions) (*QuickFiltersList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/board/%d/quickfilter", boardID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quickFilters := new(QuickFiltersList)
+	resp, err := s.client.Do(req, quickFilters)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return quickFilters, resp, nil
+}
+
+// GetQuickFilter returns the quick filter for a given quick filter ID.
+// The quick filter will only be returned if the user can view the board that the quick filter belongs to.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-quickfilter-quickfilterid-get
+func (s *BoardService) GetQuickFilter(ctx context.Context, boardID, quickFilterID int64) (*QuickFilter, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/quickfilter/%d", boardID, quickFilterID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quickFilter := new(QuickFilter)
+	resp, err := s.client.Do(req, quickFilter)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return quickFilter, resp, nil
+}
+
 // GetAllSprints returns all sprints from a board, for a given board ID.
 // This only includes sprints that the user has permission to view.
 //
 // Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-sprint-get
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *BoardService) GetAllSprints(ctx context.Context, boardID int, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
+func (s *BoardService) GetAllSprints(ctx context.Context, boardID int64, options *GetAllSprintsOptions) (*SprintsList, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/sprint", boardID)
 	url, err := addOptions(apiEndpoint, options)
 	if err != nil {
@@ -252,11 +675,19 @@ func (s *BoardService) GetAllSprints(ctx context.Context, boardID int, options *
 	return result, resp, err
 }
 
-// GetBoardConfiguration will return a board configuration for a given board Id
-// Jira API docs:https://developer.atlassian.com/cloud/jira/software/rest/#api-rest-agile-1-0-board-boardId-configuration-get
+// GetSprintIssues returns all issues in a sprint, for a given board ID and sprint ID.
+// This only includes issues that the user has permission to view.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-sprint-sprintid-issue-get
+func (s *BoardService) GetSprintIssues(ctx context.Context, boardID, sprintID int64, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	return s.getIssues(ctx, fmt.Sprintf("rest/agile/1.0/board/%d/sprint/%d/issue", boardID, sprintID), options)
+}
+
+// GetBoardConfiguration will return a board configuration for a given board Id.
+// The configuration contains the column config, the estimation field and the rank field of the board.
 //
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-configuration-get
 func (s *BoardService) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/agile/1.0/board/%d/configuration", boardID)
 
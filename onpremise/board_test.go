@@ -2,6 +2,7 @@ package onpremise
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -155,6 +156,295 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 	}
 }
 
+func TestBoardService_GetBoardsByFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/filter/10040"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"maxResults": "10"})
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":2,"isLast":true,"values":[{"id":84,"self":"https://your-domain.atlassian.net/rest/agile/1.0/board/84","name":"scrum board"},{"id":92,"self":"https://your-domain.atlassian.net/rest/agile/1.0/board/92","name":"kanban board"}]}`)
+	})
+
+	boards, _, err := testClient.Board.GetBoardsByFilter(context.Background(), 10040, &SearchOptions{MaxResults: 10})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if boards == nil || len(boards.Values) != 2 || boards.Values[1].Name != "kanban board" {
+		t.Errorf("Unexpected boards: %+v", boards)
+	}
+}
+
+func TestBoardService_GetBacklogIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/backlog"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{
+			"jql":        "assignee = currentUser()",
+			"fields":     "summary,status",
+			"startAt":    "50",
+			"maxResults": "50",
+		})
+		fmt.Fprint(w, `{"expand":"names,schema","startAt":50,"maxResults":50,"total":51,"issues":[{"id":"10001","key":"HSP-1","self":"https://your-domain.atlassian.net/rest/agile/1.0/issue/10001","fields":{"summary":"Backlog item","status":{"name":"To Do"}}}]}`)
+	})
+
+	issues, resp, err := testClient.Board.GetBacklogIssues(context.Background(), 5, &AgileIssueListOptions{
+		JQL:        "assignee = currentUser()",
+		Fields:     []string{"summary", "status"},
+		StartAt:    50,
+		MaxResults: 50,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "HSP-1" {
+		t.Fatalf("Unexpected issues: %+v", issues)
+	}
+	if resp.StartAt != 50 || resp.Total != 51 {
+		t.Errorf("Expected paging values StartAt 50 and Total 51. Got %d and %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestBoardService_GetEpics(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/epic"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"done": "false"})
+		fmt.Fprint(w, `{"maxResults":2,"startAt":0,"total":1,"isLast":true,"values":[{"id":37,"key":"EX-1","self":"https://your-domain.atlassian.net/rest/agile/1.0/epic/37","name":"epic 1","summary":"epic 1 summary","color":{"key":"color_4"},"done":false}]}`)
+	})
+
+	epics, _, err := testClient.Board.GetEpics(context.Background(), 5, &GetEpicsOptions{Done: Bool(false)})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if epics == nil || len(epics.Values) != 1 {
+		t.Fatalf("Unexpected epics: %+v", epics)
+	}
+	if epic := epics.Values[0]; epic.Key != "EX-1" || epic.Color == nil || epic.Color.Key != "color_4" {
+		t.Errorf("Unexpected epic: %+v", epic)
+	}
+}
+
+func TestBoardService_GetEpicIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/epic/37/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"id":"10001","key":"EX-2","fields":{"summary":"Story in epic"}}]}`)
+	})
+
+	issues, _, err := testClient.Board.GetEpicIssues(context.Background(), 5, 37, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "EX-2" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestBoardService_GetIssuesWithoutEpic(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/epic/none/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"jql": "status = Open"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"id":"10002","key":"EX-3","fields":{"summary":"Orphan story"}}]}`)
+	})
+
+	issues, _, err := testClient.Board.GetIssuesWithoutEpic(context.Background(), 5, &AgileIssueListOptions{JQL: "status = Open"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "EX-3" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestBoardService_GetProjects(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/project"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":1,"isLast":true,"values":[{"self":"https://your-domain.atlassian.net/rest/api/2/project/EX","id":"10000","key":"EX","name":"Example"}]}`)
+	})
+
+	projects, _, err := testClient.Board.GetProjects(context.Background(), 5, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if projects == nil || len(projects.Values) != 1 || projects.Values[0].Key != "EX" {
+		t.Errorf("Unexpected projects: %+v", projects)
+	}
+}
+
+func TestBoardService_GetVersions(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/version"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"released": "false"})
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":1,"isLast":true,"values":[{"self":"https://your-domain.atlassian.net/rest/api/2/version/10001","id":"10001","projectId":10000,"name":"Version 2","description":"Minor Bugfix Version","archived":false,"released":false,"releaseDate":"2010-07-06"}]}`)
+	})
+
+	versions, _, err := testClient.Board.GetVersions(context.Background(), 5, &GetVersionsOptions{Released: Bool(false)})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if versions == nil || len(versions.Values) != 1 {
+		t.Fatalf("Unexpected versions: %+v", versions)
+	}
+	if v := versions.Values[0]; v.Name != "Version 2" || v.Released == nil || *v.Released {
+		t.Errorf("Unexpected version: %+v", v)
+	}
+}
+
+func TestBoardService_GetPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/agile/1.0/board/5/properties/issue.support","key":"issue.support"}]}`)
+	})
+
+	keys, _, err := testClient.Board.GetPropertyKeys(context.Background(), 5)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if keys == nil || len(keys.Keys) != 1 || keys.Keys[0].Key != "issue.support" {
+		t.Errorf("Unexpected keys: %+v", keys)
+	}
+}
+
+func TestBoardService_GetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/properties/issue.support"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"issue.support","value":{"system.conversation.id":"b1bf38be-5e94-4b40-a3b8-9278735ee1e6","system.support.time":"1m"}}`)
+	})
+
+	property, _, err := testClient.Board.GetProperty(context.Background(), 5, "issue.support")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Key != "issue.support" {
+		t.Fatalf("Unexpected property: %+v", property)
+	}
+	if value, ok := property.Value.(map[string]interface{}); !ok || value["system.support.time"] != "1m" {
+		t.Errorf("Unexpected property value: %+v", property.Value)
+	}
+}
+
+func TestBoardService_SetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/properties/issue.support"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["system.support.time"] != "1m" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.Board.SetProperty(context.Background(), 5, "issue.support", map[string]string{"system.support.time": "1m"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBoardService_DeleteProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/properties/issue.support"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Board.DeleteProperty(context.Background(), 5, "issue.support")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestBoardService_GetQuickFilters(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/quickfilter"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"maxResults":10,"startAt":0,"total":2,"isLast":true,"values":[{"id":1,"boardId":5,"name":"Bugs","jql":"issueType = bug","description":"Issues of type bug","position":0},{"id":2,"boardId":5,"name":"Only mine","jql":"assignee = currentUser()","position":1}]}`)
+	})
+
+	quickFilters, _, err := testClient.Board.GetQuickFilters(context.Background(), 5, nil)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if quickFilters == nil || len(quickFilters.Values) != 2 || quickFilters.Values[1].JQL != "assignee = currentUser()" {
+		t.Errorf("Unexpected quick filters: %+v", quickFilters)
+	}
+}
+
+func TestBoardService_GetQuickFilter(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/quickfilter/1"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"id":1,"boardId":5,"name":"Bugs","jql":"issueType = bug","description":"Issues of type bug","position":0}`)
+	})
+
+	quickFilter, _, err := testClient.Board.GetQuickFilter(context.Background(), 5, 1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if quickFilter == nil || quickFilter.Name != "Bugs" || quickFilter.JQL != "issueType = bug" {
+		t.Errorf("Unexpected quick filter: %+v", quickFilter)
+	}
+}
+
 func TestBoardService_GetAllSprints(t *testing.T) {
 	setup()
 	defer teardown()
@@ -187,6 +477,33 @@ func TestBoardService_GetAllSprints(t *testing.T) {
 	}
 }
 
+func TestBoardService_GetSprintIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/board/5/sprint/37/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{"fields": "summary", "maxResults": "1"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":3,"issues":[{"id":"10001","key":"EX-1","fields":{"summary":"In sprint"}}]}`)
+	})
+
+	issues, resp, err := testClient.Board.GetSprintIssues(context.Background(), 5, 37, &AgileIssueListOptions{
+		Fields:     []string{"summary"},
+		MaxResults: 1,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "EX-1" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+	if resp.Total != 3 {
+		t.Errorf("Expected total 3. Got %d", resp.Total)
+	}
+}
+
 func TestBoardService_GetBoardConfigoration(t *testing.T) {
 	setup()
 	defer teardown()
@@ -232,4 +549,21 @@ func TestBoardService_GetBoardConfigoration(t *testing.T) {
 	if inProgressColumn.Max != 0 {
 		t.Errorf("Expected a max of 0 issues in progress. Got %d", inProgressColumn.Max)
 	}
+	if boardConfiguration.Estimation == nil || boardConfiguration.Estimation.Field == nil {
+		t.Fatal("Expected estimation field. Got nil.")
+	}
+	if boardConfiguration.Estimation.Field.FieldID != "customfield_10002" {
+		t.Errorf("Expected estimation field customfield_10002. Got %s", boardConfiguration.Estimation.Field.FieldID)
+	}
+	if boardConfiguration.Ranking == nil || boardConfiguration.Ranking.RankCustomFieldID != 10002 {
+		t.Errorf("Expected rank custom field 10002. Got %+v", boardConfiguration.Ranking)
+	}
+
+	column := boardConfiguration.ColumnForStatus("10602")
+	if column == nil || column.Name != "In Progress" {
+		t.Errorf("Expected status 10602 to map to column In Progress. Got %+v", column)
+	}
+	if column := boardConfiguration.ColumnForStatus("99999"); column != nil {
+		t.Errorf("Expected unmapped status to return nil. Got %+v", column)
+	}
 }
@@ -0,0 +1,259 @@
+package onpremise
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// CachedResponseHeader is set on responses that a CachingTransport served from its cache
+// after Jira validated them with 304 Not Modified.
+const CachedResponseHeader = "X-From-Cache"
+
+// Cache stores the responses of a CachingTransport.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response stored for key.
+	Get(key string) ([]byte, bool)
+	// Set stores the response for key.
+	Set(key string, response []byte)
+	// Delete removes the response stored for key.
+	Delete(key string)
+}
+
+// CachingTransport is an http.RoundTripper that caches the responses of GET requests
+// that have an ETag or Last-Modified header.
+// Later requests to the same URL are sent with If-None-Match and If-Modified-Since,
+// and if Jira responds with 304 Not Modified, the cached response is returned.
+// This saves the payload of frequently polled resources like fields, statuses or projects.
+//
+// Requests with other methods than GET invalidate the cached response of their URL.
+// Requests that set their own conditional headers are not cached.
+//
+// The responses are cached per credentials, by the Authorization and Cookie headers of the requests.
+// Set the CachingTransport as Transport of the auth transport, so it sees the headers the auth transport adds.
+// In front of the auth transport, the responses of all credentials sharing the Cache would be served to each other:
+//
+//	tp := onpremise.BasicAuthTransport{Username: "...", Password: "...", Transport: &onpremise.CachingTransport{}}
+//	client, err := onpremise.NewClient("https://jira.example.com", tp.Client())
+type CachingTransport struct {
+	// Cache stores the responses.
+	// It will default to an in-memory cache with up to 1000 responses if nil.
+	Cache Cache
+
+	// Transport is the underlying HTTP transport to use when making requests.
+	// It will default to http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	once         sync.Once
+	defaultCache Cache
+}
+
+// RoundTrip implements the RoundTripper interface.
+func (t *CachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := cacheKey(req)
+	if req.Method != http.MethodGet {
+		resp, err := t.transport().RoundTrip(req)
+		if err == nil && resp.StatusCode < 400 {
+			t.cache().Delete(key)
+		}
+		return resp, err
+	}
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" || req.Header.Get("Range") != "" {
+		return t.transport().RoundTrip(req)
+	}
+
+	cached := t.cachedResponse(key, req)
+	req2 := req
+	if cached != nil {
+		req2 = cloneRequest(req) // per RoundTripper contract
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			req2.Header.Set("If-None-Match", etag)
+		}
+		if lastModified := cached.Header.Get("Last-Modified"); lastModified != "" {
+			req2.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.transport().RoundTrip(req2)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		for _, h := range []string{"Date", "ETag", "Last-Modified", "Cache-Control", "Expires"} {
+			if v := resp.Header.Get(h); v != "" {
+				cached.Header.Set(h, v)
+			}
+		}
+		t.store(key, cached)
+		cached.Header.Set(CachedResponseHeader, "1")
+		return cached, nil
+	case resp.StatusCode == http.StatusOK && (resp.Header.Get("ETag") != "" || resp.Header.Get("Last-Modified") != ""):
+		if cached != nil {
+			cached.Body.Close()
+		}
+		t.store(key, resp)
+		return resp, nil
+	default:
+		if cached != nil {
+			cached.Body.Close()
+		}
+		if resp.StatusCode == http.StatusOK {
+			t.cache().Delete(key)
+		}
+		return resp, nil
+	}
+}
+
+// Client returns an *http.Client that caches the responses with this transport.
+func (t *CachingTransport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// cachedResponse returns the response stored for key, nil if there is none.
+func (t *CachingTransport) cachedResponse(key string, req *http.Request) *http.Response {
+	b, ok := t.cache().Get(key)
+	if !ok {
+		return nil
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+	if err != nil {
+		t.cache().Delete(key)
+		return nil
+	}
+	return resp
+}
+
+// store stores resp for key. The body of resp is read and replaced, so it can still be read by the caller.
+func (t *CachingTransport) store(key string, resp *http.Response) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+
+	// The body is dumped without transfer encoding and read back on lookups.
+	stored := *resp
+	stored.Header = resp.Header.Clone()
+	stored.Header.Del(CachedResponseHeader)
+	stored.TransferEncoding = nil
+	stored.ContentLength = int64(len(body))
+	stored.Body = io.NopCloser(bytes.NewReader(body))
+	b, err := httputil.DumpResponse(&stored, true)
+	if err != nil {
+		return
+	}
+	t.cache().Set(key, b)
+}
+
+func (t *CachingTransport) cache() Cache {
+	if t.Cache != nil {
+		return t.Cache
+	}
+	t.once.Do(func() {
+		t.defaultCache = NewMemoryCache(1000)
+	})
+	return t.defaultCache
+}
+
+func (t *CachingTransport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// cacheKey returns the key of the response to req.
+// Credentials set on the request are hashed into the key, so responses are not shared between users.
+func cacheKey(req *http.Request) string {
+	key := req.URL.String()
+	for _, name := range []string{"Authorization", "Cookie"} {
+		if v := req.Header.Get(name); v != "" {
+			sum := sha256.Sum256([]byte(name + ": " + v))
+			key += " " + hex.EncodeToString(sum[:])
+		}
+	}
+	return key
+}
+
+// MemoryCache is a Cache that keeps up to a maximum number of responses in memory.
+// The least recently used response is removed if the maximum is reached.
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type memoryCacheEntry struct {
+	key      string
+	response []byte
+}
+
+// NewMemoryCache returns a MemoryCache that keeps up to maxEntries responses.
+// There is no limit if maxEntries is 0.
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+	return e.Value.(*memoryCacheEntry).response, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, response []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		e.Value.(*memoryCacheEntry).response = response
+		c.lru.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&memoryCacheEntry{key: key, response: response})
+	if c.maxEntries > 0 && c.lru.Len() > c.maxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Delete implements Cache.
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[key]; ok {
+		c.lru.Remove(e)
+		delete(c.entries, key)
+	}
+}
+
+// Len returns the number of cached responses.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
@@ -0,0 +1,164 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ClusterService handles the nodes and the zero downtime upgrades of Jira Data Center clusters.
+// It requires the system administrator permission.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster
+type ClusterService service
+
+// States of a ClusterNode.
+const (
+	ClusterNodeStateActive      = "ACTIVE"
+	ClusterNodeStateActivating  = "ACTIVATING"
+	ClusterNodeStatePassive     = "PASSIVE"
+	ClusterNodeStatePassivating = "PASSIVATING"
+	ClusterNodeStateOffline     = "OFFLINE"
+)
+
+// States of the zero downtime upgrade of a cluster.
+const (
+	// ZDUStateStable is the state without an upgrade in progress.
+	ZDUStateStable = "STABLE"
+	// ZDUStateReadyToUpgrade is the state after StartZDU, the nodes can be upgraded one by one.
+	ZDUStateReadyToUpgrade = "READY_TO_UPGRADE"
+	// ZDUStateMixed is the state while the nodes run different versions.
+	ZDUStateMixed = "MIXED"
+	// ZDUStateReadyToRunUpgradeTasks is the state after all nodes were upgraded, the upgrade can be approved.
+	ZDUStateReadyToRunUpgradeTasks = "READY_TO_RUN_UPGRADE_TASKS"
+	// ZDUStateRunningUpgradeTasks is the state while the upgrade tasks run after ApproveZDU.
+	ZDUStateRunningUpgradeTasks = "RUNNING_UPGRADE_TASKS"
+	// ZDUStateUpgradeTasksFailed is the state after the upgrade tasks failed, they can be retried.
+	ZDUStateUpgradeTasksFailed = "UPGRADE_TASKS_FAILED"
+)
+
+// ClusterNode is a node of a Jira Data Center cluster.
+type ClusterNode struct {
+	NodeID string `json:"nodeId,omitempty" structs:"nodeId,omitempty"`
+	// NodeState is one of the ClusterNodeState constants.
+	NodeState         string `json:"nodeState,omitempty" structs:"nodeState,omitempty"`
+	Alive             bool   `json:"alive,omitempty" structs:"alive,omitempty"`
+	IP                string `json:"ip,omitempty" structs:"ip,omitempty"`
+	CacheListenerPort int    `json:"cacheListenerPort,omitempty" structs:"cacheListenerPort,omitempty"`
+	NodeBuildNumber   int    `json:"nodeBuildNumber,omitempty" structs:"nodeBuildNumber,omitempty"`
+	NodeVersion       string `json:"nodeVersion,omitempty" structs:"nodeVersion,omitempty"`
+}
+
+// ClusterBuildInfo is the version the nodes of a cluster are upgraded from.
+type ClusterBuildInfo struct {
+	Version     string `json:"version,omitempty" structs:"version,omitempty"`
+	BuildNumber int    `json:"buildNumber,omitempty" structs:"buildNumber,omitempty"`
+}
+
+// ZDUState is the state of the zero downtime upgrade of a cluster.
+type ZDUState struct {
+	// State is one of the ZDUState constants.
+	State     string           `json:"state,omitempty" structs:"state,omitempty"`
+	BuildInfo ClusterBuildInfo `json:"buildInfo,omitempty" structs:"buildInfo,omitempty"`
+}
+
+// GetNodes returns the nodes of the cluster.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster-getAllNodes
+func (s *ClusterService) GetNodes(ctx context.Context) ([]ClusterNode, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/cluster/nodes", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nodes []ClusterNode
+	resp, err := s.client.Do(req, &nodes)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return nodes, resp, nil
+}
+
+// SetNodeOffline changes the state of the node to offline, e.g. after it was shut down without heartbeat.
+// Only nodes which are not alive can be set offline.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster-changeNodeStateToOffline
+func (s *ClusterService) SetNodeOffline(ctx context.Context, nodeID string) (*Response, error) {
+	return s.do(ctx, http.MethodPut, fmt.Sprintf("rest/api/2/cluster/node/%s/offline", nodeID))
+}
+
+// DeleteNode removes the offline node from the cluster.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster-deleteNode
+func (s *ClusterService) DeleteNode(ctx context.Context, nodeID string) (*Response, error) {
+	return s.do(ctx, http.MethodDelete, fmt.Sprintf("rest/api/2/cluster/node/%s", nodeID))
+}
+
+// GetZDUState returns the state of the zero downtime upgrade.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-getState
+func (s *ClusterService) GetZDUState(ctx context.Context) (*ZDUState, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/cluster/zdu/state", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := new(ZDUState)
+	resp, err := s.client.Do(req, state)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return state, resp, nil
+}
+
+// StartZDU starts a zero downtime upgrade, putting the cluster in the state ZDUStateReadyToUpgrade.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-setReadyToUpgrade
+func (s *ClusterService) StartZDU(ctx context.Context) (*Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/api/2/cluster/zdu/start")
+}
+
+// CancelZDU cancels the zero downtime upgrade. It is only possible before a node was upgraded.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-cancelUpgrade
+func (s *ClusterService) CancelZDU(ctx context.Context) (*Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/api/2/cluster/zdu/cancel")
+}
+
+// ApproveZDU approves the zero downtime upgrade after all nodes were upgraded, running the upgrade tasks.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-approveUpgrade
+func (s *ClusterService) ApproveZDU(ctx context.Context) (*Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/api/2/cluster/zdu/approve")
+}
+
+// RetryZDU retries the upgrade tasks in the state ZDUStateUpgradeTasksFailed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-retryUpgrade
+func (s *ClusterService) RetryZDU(ctx context.Context) (*Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/api/2/cluster/zdu/retryUpgrade")
+}
+
+// AcknowledgeZDUErrors acknowledges the errors of the upgrade tasks, returning the cluster to ZDUStateStable.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/cluster/zdu-acknowledgeErrors
+func (s *ClusterService) AcknowledgeZDUErrors(ctx context.Context) (*Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/api/2/cluster/zdu/acknowledge")
+}
+
+// do sends a request without body, ignoring the body of the response.
+func (s *ClusterService) do(ctx context.Context, method, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,102 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClusterService_GetNodes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/cluster/nodes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"nodeId":"node1","nodeState":"ACTIVE","alive":true,"ip":"10.0.0.1","cacheListenerPort":40001,"nodeBuildNumber":813000,"nodeVersion":"8.13.0"},
+			{"nodeId":"node2","nodeState":"OFFLINE","alive":false,"ip":"10.0.0.2","cacheListenerPort":40001}]`)
+	})
+
+	nodes, _, err := testClient.Cluster.GetNodes(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(nodes) != 2 || nodes[0].NodeState != ClusterNodeStateActive || !nodes[0].Alive || nodes[0].NodeVersion != "8.13.0" {
+		t.Errorf("Unexpected nodes %+v", nodes)
+	}
+}
+
+func TestClusterService_Nodes(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/cluster/node/node2/offline", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	testMux.HandleFunc("/rest/api/2/cluster/node/node2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Cluster.SetNodeOffline(context.Background(), "node2"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Cluster.DeleteNode(context.Background(), "node2"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestClusterService_ZDU(t *testing.T) {
+	setup()
+	defer teardown()
+	var called []string
+	for _, action := range []string{"start", "cancel", "approve", "retryUpgrade", "acknowledge"} {
+		action := action
+		testMux.HandleFunc("/rest/api/2/cluster/zdu/"+action, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPost)
+			called = append(called, action)
+		})
+	}
+	testMux.HandleFunc("/rest/api/2/cluster/zdu/state", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"state":"READY_TO_UPGRADE","buildInfo":{"version":"8.13.0","buildNumber":813000}}`)
+	})
+
+	ctx := context.Background()
+	state, _, err := testClient.Cluster.GetZDUState(ctx)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if state.State != ZDUStateReadyToUpgrade || state.BuildInfo.BuildNumber != 813000 {
+		t.Errorf("Unexpected state %+v", state)
+	}
+
+	for _, f := range []func(context.Context) (*Response, error){
+		testClient.Cluster.StartZDU,
+		testClient.Cluster.CancelZDU,
+		testClient.Cluster.ApproveZDU,
+		testClient.Cluster.RetryZDU,
+		testClient.Cluster.AcknowledgeZDUErrors,
+	} {
+		if _, err := f(ctx); err != nil {
+			t.Errorf("Error given: %s", err)
+		}
+	}
+	if len(called) != 5 {
+		t.Errorf("Expected all actions to be called, got %v", called)
+	}
+}
+
+func TestClusterService_ZDU_Conflict(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/cluster/zdu/cancel", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"errorMessages":["Cannot cancel the upgrade in the state MIXED."],"errors":{}}`)
+	})
+
+	resp, err := testClient.Cluster.CancelZDU(context.Background())
+	if err == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+}
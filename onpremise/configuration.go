@@ -0,0 +1,42 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+)
+
+// ConfigurationService handles the global settings of the Jira instance / API.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/configuration-getConfiguration
+type ConfigurationService service
+
+// Configuration represents the global settings of the Jira instance.
+type Configuration struct {
+	VotingEnabled             bool                       `json:"votingEnabled" structs:"votingEnabled"`
+	WatchingEnabled           bool                       `json:"watchingEnabled" structs:"watchingEnabled"`
+	UnassignedIssuesAllowed   bool                       `json:"unassignedIssuesAllowed" structs:"unassignedIssuesAllowed"`
+	SubTasksEnabled           bool                       `json:"subTasksEnabled" structs:"subTasksEnabled"`
+	IssueLinkingEnabled       bool                       `json:"issueLinkingEnabled" structs:"issueLinkingEnabled"`
+	TimeTrackingEnabled       bool                       `json:"timeTrackingEnabled" structs:"timeTrackingEnabled"`
+	AttachmentsEnabled        bool                       `json:"attachmentsEnabled" structs:"attachmentsEnabled"`
+	TimeTrackingConfiguration *TimeTrackingConfiguration `json:"timeTrackingConfiguration,omitempty" structs:"timeTrackingConfiguration,omitempty"`
+}
+
+// Get returns the global settings of the Jira instance, e.g. the working hours used by time tracking.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/configuration-getConfiguration
+func (s *ConfigurationService) Get(ctx context.Context) (*Configuration, *Response, error) {
+	apiEndpoint := "/rest/api/2/configuration"
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(Configuration)
+	resp, err := s.client.Do(req, configuration)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return configuration, resp, nil
+}
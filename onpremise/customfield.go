@@ -0,0 +1,238 @@
+package onpremise
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ErrCustomFieldNotSet is returned by the custom field accessors of IssueFields if the field is missing or null.
+var ErrCustomFieldNotSet = errors.New("jira: custom field is not set")
+
+// CustomFieldTypeError is returned by the custom field accessors of IssueFields
+// if the value of the field does not have the requested shape.
+type CustomFieldTypeError struct {
+	// Field is the ID of the custom field, e.g. "customfield_10010".
+	Field string
+	// Type is the requested type, e.g. "number".
+	Type string
+	// Value is the value of the field.
+	Value interface{}
+}
+
+func (e *CustomFieldTypeError) Error() string {
+	return fmt.Sprintf("jira: custom field %s is not a %s but %T: %v", e.Field, e.Type, e.Value, e.Value)
+}
+
+// CustomFieldOption is an option of a select list, checkbox, radio button or cascading select custom field.
+type CustomFieldOption struct {
+	Self     string `json:"self,omitempty" structs:"self,omitempty"`
+	ID       string `json:"id,omitempty" structs:"id,omitempty"`
+	Value    string `json:"value,omitempty" structs:"value,omitempty"`
+	Disabled bool   `json:"disabled,omitempty" structs:"disabled,omitempty"`
+	// Child is the selected child option of a cascading select.
+	Child *CustomFieldOption `json:"child,omitempty" structs:"child,omitempty"`
+}
+
+// customField returns the value of the custom field with the ID or ErrCustomFieldNotSet.
+func (i *IssueFields) customField(id string) (interface{}, error) {
+	if i == nil || i.Unknowns == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCustomFieldNotSet, id)
+	}
+	value, ok := i.Unknowns[id]
+	if !ok || value == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCustomFieldNotSet, id)
+	}
+	return value, nil
+}
+
+// decodeCustomField decodes the value of a custom field, as decoded from JSON or set by the caller, into v.
+func decodeCustomField(id, typ string, value, v interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return &CustomFieldTypeError{Field: id, Type: typ, Value: value}
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return &CustomFieldTypeError{Field: id, Type: typ, Value: value}
+	}
+	return nil
+}
+
+// CustomString returns the value of a text custom field, e.g. issue.Fields.CustomString("customfield_10010").
+// Numbers and booleans are formatted and the value of select list options is returned.
+func (i *IssueFields) CustomString(id string) (string, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return "", err
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case json.Number:
+		return v.String(), nil
+	}
+
+	option := new(CustomFieldOption)
+	if err := decodeCustomField(id, "string", value, option); err != nil || option.Value == "" {
+		return "", &CustomFieldTypeError{Field: id, Type: "string", Value: value}
+	}
+	return option.Value, nil
+}
+
+// CustomNumber returns the value of a number custom field, like story points.
+// Numeric strings are parsed.
+func (i *IssueFields) CustomNumber(id string) (float64, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return 0, err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case float32:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, &CustomFieldTypeError{Field: id, Type: "number", Value: value}
+		}
+		return f, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, &CustomFieldTypeError{Field: id, Type: "number", Value: value}
+		}
+		return f, nil
+	}
+	return 0, &CustomFieldTypeError{Field: id, Type: "number", Value: value}
+}
+
+// CustomUser returns the value of a user picker custom field.
+func (i *IssueFields) CustomUser(id string) (*User, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return nil, err
+	}
+	switch value.(type) {
+	case map[string]interface{}, User, *User:
+	default:
+		return nil, &CustomFieldTypeError{Field: id, Type: "user", Value: value}
+	}
+
+	user := new(User)
+	if err := decodeCustomField(id, "user", value, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// CustomOption returns the selected option of a select list or radio button custom field.
+// A string value is returned as option with this value.
+func (i *IssueFields) CustomOption(id string) (*CustomFieldOption, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := value.(string); ok {
+		return &CustomFieldOption{Value: s}, nil
+	}
+
+	option := new(CustomFieldOption)
+	if err := decodeCustomField(id, "option", value, option); err != nil {
+		return nil, err
+	}
+	if option.Value == "" && option.ID == "" {
+		return nil, &CustomFieldTypeError{Field: id, Type: "option", Value: value}
+	}
+	return option, nil
+}
+
+// CustomCascading returns the selected parent and child option values of a cascading select custom field.
+// child is empty if only the parent is selected.
+func (i *IssueFields) CustomCascading(id string) (parent, child string, err error) {
+	option, err := i.CustomOption(id)
+	if err != nil {
+		var typeErr *CustomFieldTypeError
+		if errors.As(err, &typeErr) {
+			typeErr.Type = "cascading select"
+		}
+		return "", "", err
+	}
+	if option.Child != nil {
+		child = option.Child.Value
+	}
+	return option.Value, child, nil
+}
+
+// CustomMultiSelect returns the selected options of a multi select or checkbox custom field.
+// Strings, like the values of label custom fields, are returned as options with this value.
+func (i *IssueFields) CustomMultiSelect(id string) ([]CustomFieldOption, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	if err := decodeCustomField(id, "multi select", value, &values); err != nil {
+		return nil, err
+	}
+	options := make([]CustomFieldOption, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			options = append(options, CustomFieldOption{Value: s})
+			continue
+		}
+		var option CustomFieldOption
+		if err := decodeCustomField(id, "multi select", v, &option); err != nil {
+			return nil, &CustomFieldTypeError{Field: id, Type: "multi select", Value: value}
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+// customDateLayouts are the layouts of the values of date and date time custom fields.
+var customDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04:05.999-0700",
+	time.RFC3339Nano,
+}
+
+// CustomDate returns the value of a date or date time custom field.
+// Dates without time are returned as midnight UTC.
+func (i *IssueFields) CustomDate(id string) (time.Time, error) {
+	value, err := i.customField(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case Time:
+		return time.Time(v), nil
+	case Date:
+		return time.Time(v), nil
+	case string:
+		for _, layout := range customDateLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, &CustomFieldTypeError{Field: id, Type: "date", Value: value}
+}
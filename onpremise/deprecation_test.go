@@ -0,0 +1,80 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestClient_Do_Deprecation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Deprecation", "@1688169599")
+		w.Header().Set("Sunset", "Sun, 31 Dec 2023 23:59:59 GMT")
+		w.Header().Set("Link", `<https://developer.atlassian.com/changelog/#CHANGE-1>; rel="deprecation"`)
+		w.Header().Add("Warning", `299 - "The endpoint is deprecated"`)
+	})
+
+	var deprecated *Response
+	testClient.OnDeprecation = func(resp *Response) {
+		deprecated = resp
+	}
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1/watchers", nil)
+	resp, err := testClient.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if deprecated != resp {
+		t.Error("Expected OnDeprecation to be called with the response")
+	}
+
+	d := resp.Deprecation
+	if d == nil || !d.Deprecated {
+		t.Fatalf("Expected a deprecation, got %+v", d)
+	}
+	if want := time.Unix(1688169599, 0).UTC(); !d.Date.Equal(want) {
+		t.Errorf("Date = %v, want %v", d.Date, want)
+	}
+	if want := time.Date(2023, 12, 31, 23, 59, 59, 0, time.UTC); !d.Sunset.Equal(want) {
+		t.Errorf("Sunset = %v, want %v", d.Sunset, want)
+	}
+	if d.Link != "https://developer.atlassian.com/changelog/#CHANGE-1" {
+		t.Errorf("Unexpected link %q", d.Link)
+	}
+	if len(d.Warnings) != 1 || d.Warnings[0] != "The endpoint is deprecated" {
+		t.Errorf("Unexpected warnings %q", d.Warnings)
+	}
+}
+
+func TestClient_Do_NotDeprecated(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+	testClient.OnDeprecation = func(resp *Response) {
+		t.Error("Expected OnDeprecation not to be called")
+	}
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	resp, err := testClient.Do(req, nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.Deprecation != nil {
+		t.Errorf("Expected no deprecation, got %+v", resp.Deprecation)
+	}
+}
+
+func TestParseDeprecation_True(t *testing.T) {
+	h := http.Header{}
+	h.Set("Deprecation", "true")
+
+	d := parseDeprecation(h)
+	if d == nil || !d.Deprecated || !d.Date.IsZero() {
+		t.Errorf("Expected a deprecation without date, got %+v", d)
+	}
+}
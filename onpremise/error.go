@@ -3,17 +3,196 @@ package onpremise
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// Kinds of errors returned by NewJiraError, to be checked with errors.Is.
+// Use errors.As with *Error to access the details, e.g. the field errors or the retry delay.
+var (
+	// ErrNotFound is returned for 404 Not Found responses.
+	ErrNotFound = errors.New("jira: not found")
+	// ErrUnauthorized is returned for 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("jira: unauthorized")
+	// ErrForbidden is returned for 403 Forbidden responses.
+	ErrForbidden = errors.New("jira: forbidden")
+	// ErrRateLimited is returned for 429 Too Many Requests responses, see Error.RetryAfter.
+	ErrRateLimited = errors.New("jira: rate limited")
+	// ErrValidation is returned for 400 Bad Request responses, see Error.Errors for the field errors.
+	ErrValidation = errors.New("jira: validation failed")
+)
+
+// maxErrorBodySize is the number of bytes of the response body kept in a ResponseError.
+const maxErrorBodySize = 8 << 10
+
+// ResponseError is returned by Client.Do and CheckResponse for responses
+// with a status code outside the 200 range.
+// Pass it to NewJiraError to parse the error message of Jira.
+type ResponseError struct {
+	// Method and URL of the request, the URL is redacted.
+	Method string
+	URL    string
+	// StatusCode is the status code of the response.
+	StatusCode int
+	// Body is the raw response body, truncated to 8 KiB.
+	Body []byte
+}
+
+func (e *ResponseError) Error() string {
+	msg := fmt.Sprintf("request failed. Please analyze the request body for more details. Status code: %d", e.StatusCode)
+	if e.Method != "" {
+		msg += fmt.Sprintf(" (%s %s)", e.Method, e.URL)
+	}
+	return msg
+}
+
+// Is reports whether the error is of the kind target, e.g. ErrNotFound.
+func (e *ResponseError) Is(target error) bool {
+	kind := errorKind(e.StatusCode)
+	return kind != nil && target == kind
+}
+
+// readErrorBody keeps the beginning of the body of resp in respErr.
+// The body of resp can still be read completely afterwards.
+func (c *Client) readErrorBody(resp *http.Response, respErr *ResponseError) {
+	if resp.Request != nil {
+		respErr.URL = c.Redactor.URL(resp.Request.URL)
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return
+	}
+	if len(data) > maxErrorBodySize {
+		data = data[:maxErrorBodySize]
+	}
+	respErr.Body = []byte(c.Redactor.String(string(data)))
+}
+
 // Error message from Jira
 // See https://docs.atlassian.com/jira/REST/cloud/#error-responses
 type Error struct {
-	HTTPError     error
-	ErrorMessages []string          `json:"errorMessages"`
-	Errors        map[string]string `json:"errors"`
+	HTTPError       error
+	ErrorMessages   []string          `json:"errorMessages"`
+	Errors          map[string]string `json:"errors"`
+	WarningMessages []string          `json:"warningMessages"`
+	// ElementErrors are the errors of the failed elements of bulk operations, e.g. creating issues in bulk.
+	ElementErrors []ElementError `json:"-"`
+
+	// StatusCode is the status code of the response.
+	StatusCode int `json:"-"`
+	// RetryAfter is the delay requested by Jira before retrying a rate limited request.
+	RetryAfter time.Duration `json:"-"`
+
+	// message is used by Error if the response had no JSON body.
+	message string
+}
+
+// ElementError is the error of a single element of a bulk operation.
+type ElementError struct {
+	Status              int               `json:"status"`
+	FailedElementNumber int               `json:"failedElementNumber"`
+	ErrorMessages       []string          `json:"errorMessages"`
+	Errors              map[string]string `json:"errors"`
+}
+
+// UnmarshalJSON decodes the error formats of Jira:
+// the common errorMessages and errors, the element errors of bulk operations
+// and the single message of some endpoints, e.g. Jira Service Management and the API gateway.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ErrorMessages   []string        `json:"errorMessages"`
+		WarningMessages []string        `json:"warningMessages"`
+		Errors          json.RawMessage `json:"errors"`
+		ErrorMessage    string          `json:"errorMessage"`
+		Message         string          `json:"message"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	e.ErrorMessages = raw.ErrorMessages
+	e.WarningMessages = raw.WarningMessages
+	for _, msg := range []string{raw.ErrorMessage, raw.Message} {
+		if msg != "" {
+			e.ErrorMessages = append(e.ErrorMessages, msg)
+		}
+	}
+
+	errs := bytes.TrimSpace(raw.Errors)
+	switch {
+	case len(errs) == 0 || bytes.Equal(errs, []byte("null")):
+	case errs[0] == '[':
+		var elements []struct {
+			Status              int `json:"status"`
+			FailedElementNumber int `json:"failedElementNumber"`
+			ElementErrors       struct {
+				ErrorMessages []string          `json:"errorMessages"`
+				Errors        map[string]string `json:"errors"`
+			} `json:"elementErrors"`
+		}
+		if err := json.Unmarshal(errs, &elements); err != nil {
+			return err
+		}
+		for _, el := range elements {
+			e.ElementErrors = append(e.ElementErrors, ElementError{
+				Status:              el.Status,
+				FailedElementNumber: el.FailedElementNumber,
+				ErrorMessages:       el.ElementErrors.ErrorMessages,
+				Errors:              el.ElementErrors.Errors,
+			})
+		}
+	default:
+		var fields map[string]interface{}
+		if err := json.Unmarshal(errs, &fields); err != nil {
+			return err
+		}
+		e.Errors = make(map[string]string, len(fields))
+		for k, v := range fields {
+			if str, ok := v.(string); ok {
+				e.Errors[k] = str
+			} else {
+				e.Errors[k] = fmt.Sprint(v)
+			}
+		}
+	}
+	return nil
+}
+
+// htmlTitlePattern matches the title of HTML error pages, e.g. of proxies.
+var htmlTitlePattern = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// htmlTagPattern matches the tags of HTML pages.
+var htmlTagPattern = regexp.MustCompile(`(?s)<[^>]*>`)
+
+// htmlText returns a short text of an HTML page: its title or the beginning of its text.
+func htmlText(body []byte) string {
+	if m := htmlTitlePattern.FindSubmatch(body); m != nil {
+		if title := strings.TrimSpace(string(m[1])); title != "" {
+			return title
+		}
+	}
+	text := strings.Join(strings.Fields(htmlTagPattern.ReplaceAllString(string(body), " ")), " ")
+	if len(text) > 200 {
+		text = text[:200] + "..."
+	}
+	return text
+}
+
+// isHTML reports whether the response body is an HTML page.
+func isHTML(contentType string, body []byte) bool {
+	if strings.HasPrefix(contentType, "text/html") {
+		return true
+	}
+	trimmed := bytes.TrimSpace(body)
+	return bytes.HasPrefix(trimmed, []byte("<!DOCTYPE")) || bytes.HasPrefix(bytes.ToLower(trimmed), []byte("<html"))
 }
 
 // NewJiraError creates a new jira Error
@@ -27,7 +206,11 @@ func NewJiraError(resp *Response, httpError error) error {
 	if err != nil {
 		return fmt.Errorf("%s: %w", httpError.Error(), err)
 	}
-	jerr := Error{HTTPError: httpError}
+	body = []byte(resp.redactor.String(string(body)))
+	jerr := Error{HTTPError: httpError, StatusCode: resp.StatusCode}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		jerr.RetryAfter, _ = retryAfter(resp.Header, time.Now())
+	}
 	contentType := resp.Header.Get("Content-Type")
 	if strings.HasPrefix(contentType, "application/json") {
 		err = json.Unmarshal(body, &jerr)
@@ -35,17 +218,54 @@ func NewJiraError(resp *Response, httpError error) error {
 			return fmt.Errorf("%s: could not parse JSON: %w", httpError.Error(), err)
 		}
 	} else {
+		text := string(body)
+		// HTML error pages, e.g. of proxies, are reduced to their title.
+		if isHTML(contentType, body) {
+			text = htmlText(body)
+		}
 		if httpError == nil {
-			return fmt.Errorf("got response status %s:%s", resp.Status, string(body))
+			jerr.message = fmt.Sprintf("got response status %s:%s", resp.Status, text)
+		} else {
+			jerr.message = fmt.Sprintf("%s: %s: %s", resp.Status, text, httpError)
 		}
-		return fmt.Errorf("%s: %s: %w", resp.Status, string(body), httpError)
 	}
 
 	return &jerr
 }
 
+// Is reports whether the error is of the kind target, e.g. ErrNotFound.
+func (e *Error) Is(target error) bool {
+	kind := errorKind(e.StatusCode)
+	return kind != nil && target == kind
+}
+
+// errorKind returns the kind of error of a response with statusCode, nil if there is no specific kind.
+func errorKind(statusCode int) error {
+	switch statusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadRequest:
+		return ErrValidation
+	}
+	return nil
+}
+
+// Unwrap returns the original HTTP error.
+func (e *Error) Unwrap() error {
+	return e.HTTPError
+}
+
 // Error is a short string representing the error
 func (e *Error) Error() string {
+	if e.message != "" {
+		return e.message
+	}
 	if len(e.ErrorMessages) > 0 {
 		// return fmt.Sprintf("%v", e.HTTPError)
 		return fmt.Sprintf("%s: %v", e.ErrorMessages[0], e.HTTPError)
@@ -55,6 +275,14 @@ func (e *Error) Error() string {
 			return fmt.Sprintf("%s - %s: %v", key, value, e.HTTPError)
 		}
 	}
+	for _, el := range e.ElementErrors {
+		if len(el.ErrorMessages) > 0 {
+			return fmt.Sprintf("element %d: %s: %v", el.FailedElementNumber, el.ErrorMessages[0], e.HTTPError)
+		}
+		for key, value := range el.Errors {
+			return fmt.Sprintf("element %d: %s - %s: %v", el.FailedElementNumber, key, value, e.HTTPError)
+		}
+	}
 	return e.HTTPError.Error()
 }
 
@@ -83,5 +311,43 @@ func (e *Error) LongError() string {
 			msg.WriteString("\n")
 		}
 	}
+	if len(e.WarningMessages) > 0 {
+		msg.WriteString("Warnings:\n")
+		for _, v := range e.WarningMessages {
+			msg.WriteString(" - ")
+			msg.WriteString(v)
+			msg.WriteString("\n")
+		}
+	}
+	for _, el := range e.ElementErrors {
+		msg.WriteString(fmt.Sprintf("Element %d (status %d):\n", el.FailedElementNumber, el.Status))
+		for _, v := range el.ErrorMessages {
+			msg.WriteString(" - ")
+			msg.WriteString(v)
+			msg.WriteString("\n")
+		}
+		for key, value := range el.Errors {
+			msg.WriteString(" - ")
+			msg.WriteString(key)
+			msg.WriteString(" - ")
+			msg.WriteString(value)
+			msg.WriteString("\n")
+		}
+	}
 	return msg.String()
 }
+
+// retryAfter returns the delay requested by the Retry-After header.
+func retryAfter(h http.Header, now time.Time) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
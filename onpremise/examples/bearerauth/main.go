@@ -12,8 +12,8 @@ func main() {
 
 	// See "Using Personal Access Tokens"
 	// https://confluence.atlassian.com/enterprise/using-personal-access-tokens-1026032365.html
-	tp := jira.BearerAuthTransport{
-		Token: "<persona-access-token>",
+	tp := jira.PATAuthTransport{
+		Token: "<personal-access-token>",
 	}
 	client, err := jira.NewClient(jiraURL, tp.Client())
 	if err != nil {
@@ -47,6 +47,29 @@ type Issue struct {
 	Changelog      *Changelog           `json:"changelog,omitempty" structs:"changelog,omitempty"`
 	Transitions    []Transition         `json:"transitions,omitempty" structs:"transitions,omitempty"`
 	Names          map[string]string    `json:"names,omitempty" structs:"names,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Issue struct.
+// It adds the Unknowns to the encoded fields.
+func (i Issue) MarshalJSON() ([]byte, error) {
+	type Alias Issue
+	return marshalWithUnknowns(Alias(i), i.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Issue struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	type Alias Issue
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(i))
+	if err != nil {
+		return err
+	}
+	i.Unknowns = unknowns
+	return nil
 }
 
 // ChangelogItems reflects one single changelog item of a history item
@@ -63,7 +86,7 @@ type ChangelogItems struct {
 type ChangelogHistory struct {
 	Id      string           `json:"id" structs:"id"`
 	Author  User             `json:"author" structs:"author"`
-	Created string           `json:"created" structs:"created"`
+	Created Time             `json:"created" structs:"created"`
 	Items   []ChangelogItems `json:"items" structs:"items"`
 }
 
@@ -78,7 +101,7 @@ type Attachment struct {
 	ID        string `json:"id,omitempty" structs:"id,omitempty"`
 	Filename  string `json:"filename,omitempty" structs:"filename,omitempty"`
 	Author    *User  `json:"author,omitempty" structs:"author,omitempty"`
-	Created   string `json:"created,omitempty" structs:"created,omitempty"`
+	Created   *Time  `json:"created,omitempty" structs:"created,omitempty"`
 	Size      int    `json:"size,omitempty" structs:"size,omitempty"`
 	MimeType  string `json:"mimeType,omitempty" structs:"mimeType,omitempty"`
 	Content   string `json:"content,omitempty" structs:"content,omitempty"`
@@ -86,14 +109,19 @@ type Attachment struct {
 }
 
 // Epic represents the epic to which an issue is associated
-// Not that this struct does not process the returned "color" value
 type Epic struct {
-	ID      int    `json:"id" structs:"id"`
-	Key     string `json:"key" structs:"key"`
-	Self    string `json:"self" structs:"self"`
-	Name    string `json:"name" structs:"name"`
-	Summary string `json:"summary" structs:"summary"`
-	Done    bool   `json:"done" structs:"done"`
+	ID      int        `json:"id" structs:"id"`
+	Key     string     `json:"key" structs:"key"`
+	Self    string     `json:"self" structs:"self"`
+	Name    string     `json:"name" structs:"name"`
+	Summary string     `json:"summary" structs:"summary"`
+	Color   *EpicColor `json:"color,omitempty" structs:"color,omitempty"`
+	Done    bool       `json:"done" structs:"done"`
+}
+
+// EpicColor is the color of an epic, e.g. "color_1" up to "color_14"
+type EpicColor struct {
+	Key string `json:"key" structs:"key"`
 }
 
 // IssueFields represents single fields of a Jira issue.
@@ -209,21 +237,90 @@ func (i *IssueFields) UnmarshalJSON(data []byte) error {
 }
 
 // IssueRenderedFields represents rendered fields of a Jira issue.
+// They are returned if the issue is requested with WithExpand("renderedFields").
+// Rich text fields, like the description, environment and comments, are rendered as HTML
+// and dates and durations as human readable strings, e.g. "3 days ago" or "1h 30m".
 // Not all IssueFields are rendered.
 type IssueRenderedFields struct {
-	// TODO Missing fields
-	//      * "aggregatetimespent": null,
-	//      * "workratio": -1,
-	//      * "lastViewed": null,
-	//      * "aggregatetimeoriginalestimate": null,
-	//      * "aggregatetimeestimate": null,
-	//      * "environment": null,
-	Resolutiondate string    `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
-	Created        string    `json:"created,omitempty" structs:"created,omitempty"`
-	Duedate        string    `json:"duedate,omitempty" structs:"duedate,omitempty"`
-	Updated        string    `json:"updated,omitempty" structs:"updated,omitempty"`
-	Comments       *Comments `json:"comment,omitempty" structs:"comment,omitempty"`
-	Description    string    `json:"description,omitempty" structs:"description,omitempty"`
+	Resolutiondate                string        `json:"resolutiondate,omitempty" structs:"resolutiondate,omitempty"`
+	Created                       string        `json:"created,omitempty" structs:"created,omitempty"`
+	Duedate                       string        `json:"duedate,omitempty" structs:"duedate,omitempty"`
+	Updated                       string        `json:"updated,omitempty" structs:"updated,omitempty"`
+	LastViewed                    string        `json:"lastViewed,omitempty" structs:"lastViewed,omitempty"`
+	Comments                      *Comments     `json:"comment,omitempty" structs:"comment,omitempty"`
+	Description                   string        `json:"description,omitempty" structs:"description,omitempty"`
+	Environment                   string        `json:"environment,omitempty" structs:"environment,omitempty"`
+	TimeTracking                  *TimeTracking `json:"timetracking,omitempty" structs:"timetracking,omitempty"`
+	TimeSpent                     string        `json:"timespent,omitempty" structs:"timespent,omitempty"`
+	TimeEstimate                  string        `json:"timeestimate,omitempty" structs:"timeestimate,omitempty"`
+	TimeOriginalEstimate          string        `json:"timeoriginalestimate,omitempty" structs:"timeoriginalestimate,omitempty"`
+	AggregateTimeSpent            string        `json:"aggregatetimespent,omitempty" structs:"aggregatetimespent,omitempty"`
+	AggregateTimeEstimate         string        `json:"aggregatetimeestimate,omitempty" structs:"aggregatetimeestimate,omitempty"`
+	AggregateTimeOriginalEstimate string        `json:"aggregatetimeoriginalestimate,omitempty" structs:"aggregatetimeoriginalestimate,omitempty"`
+	Worklog                       *Worklog      `json:"worklog,omitempty" structs:"worklog,omitempty"`
+
+	// CustomFields are the rendered custom fields by their ID, e.g. "customfield_10010".
+	// Custom fields without rendered value, like select lists and user pickers, are omitted.
+	CustomFields map[string]string `json:"-" structs:"-"`
+}
+
+// CustomField returns the rendered value of the custom field with the ID, e.g. "customfield_10010".
+// ok is false if the field is not rendered.
+func (r *IssueRenderedFields) CustomField(id string) (value string, ok bool) {
+	if r == nil {
+		return "", false
+	}
+	value, ok = r.CustomFields[id]
+	return value, ok
+}
+
+// MarshalJSON is a custom JSON marshal function for the IssueRenderedFields struct.
+// It adds the CustomFields next to the other fields.
+func (r *IssueRenderedFields) MarshalJSON() ([]byte, error) {
+	type Alias IssueRenderedFields
+	data, err := json.Marshal((*Alias)(r))
+	if err != nil || len(r.CustomFields) == 0 {
+		return data, err
+	}
+
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for id, value := range r.CustomFields {
+		m[id] = value
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the IssueRenderedFields struct.
+// It decodes the rendered custom fields into CustomFields.
+func (r *IssueRenderedFields) UnmarshalJSON(data []byte) error {
+	type Alias IssueRenderedFields
+	if err := json.Unmarshal(data, (*Alias)(r)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	r.CustomFields = nil
+	for id, raw := range fields {
+		if !strings.HasPrefix(id, "customfield_") {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil || value == "" {
+			// null and values which are not rendered as text
+			continue
+		}
+		if r.CustomFields == nil {
+			r.CustomFields = map[string]string{}
+		}
+		r.CustomFields[id] = value
+	}
+	return nil
 }
 
 // IssueType represents a type of a Jira issue.
@@ -285,16 +382,6 @@ type Parent struct {
 	Key string `json:"key,omitempty" structs:"key,omitempty"`
 }
 
-// Time represents the Time definition of Jira as a time.Time of go
-type Time time.Time
-
-func (t Time) Equal(u Time) bool {
-	return time.Time(t).Equal(time.Time(u))
-}
-
-// Date represents the Date definition of Jira as a time.Time of go
-type Date time.Time
-
 // Wrapper struct for search result
 type transitionResult struct {
 	Transitions []Transition `json:"transitions" structs:"transitions"`
@@ -351,50 +438,6 @@ type Option struct {
 	Value string `json:"value" structs:"value"`
 }
 
-// UnmarshalJSON will transform the Jira time into a time.Time
-// during the transformation of the Jira JSON response
-func (t *Time) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02T15:04:05.999-0700\"", string(b))
-	if err != nil {
-		return err
-	}
-	*t = Time(ti)
-	return nil
-}
-
-// MarshalJSON will transform the time.Time into a Jira time
-// during the creation of a Jira request
-func (t Time) MarshalJSON() ([]byte, error) {
-	return []byte(time.Time(t).Format("\"2006-01-02T15:04:05.000-0700\"")), nil
-}
-
-// UnmarshalJSON will transform the Jira date into a time.Time
-// during the transformation of the Jira JSON response
-func (t *Date) UnmarshalJSON(b []byte) error {
-	// Ignore null, like in the main JSON package.
-	if string(b) == "null" {
-		return nil
-	}
-	ti, err := time.Parse("\"2006-01-02\"", string(b))
-	if err != nil {
-		return err
-	}
-	*t = Date(ti)
-	return nil
-}
-
-// MarshalJSON will transform the Date object into a short
-// date string as Jira expects during the creation of a
-// Jira request
-func (t Date) MarshalJSON() ([]byte, error) {
-	time := time.Time(t)
-	return []byte(time.Format("\"2006-01-02\"")), nil
-}
-
 // Worklog represents the work log of a Jira issue.
 // One Worklog contains zero or n WorklogRecords
 // Jira Wiki: https://confluence.atlassian.com/jira/logging-work-on-an-issue-185729605.html
@@ -426,6 +469,17 @@ type EntityProperty struct {
 	Value interface{} `json:"value"`
 }
 
+// EntityPropertyKeys is the list of property keys of an entity, like a board or a sprint
+type EntityPropertyKeys struct {
+	Keys []EntityPropertyKey `json:"keys" structs:"keys"`
+}
+
+// EntityPropertyKey is the reference to a single property of an entity
+type EntityPropertyKey struct {
+	Self string `json:"self,omitempty" structs:"self,omitempty"`
+	Key  string `json:"key" structs:"key"`
+}
+
 // TimeTracking represents the timetracking fields of a Jira issue.
 type TimeTracking struct {
 	OriginalEstimate         string `json:"originalEstimate,omitempty" structs:"originalEstimate,omitempty"`
@@ -466,7 +520,10 @@ type IssueLinkType struct {
 
 // Comments represents a list of Comment.
 type Comments struct {
-	Comments []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
+	StartAt    int        `json:"startAt,omitempty" structs:"startAt,omitempty"`
+	MaxResults int        `json:"maxResults,omitempty" structs:"maxResults,omitempty"`
+	Total      int        `json:"total,omitempty" structs:"total,omitempty"`
+	Comments   []*Comment `json:"comments,omitempty" structs:"comments,omitempty"`
 }
 
 // Comment represents a comment by a person to an issue in Jira.
@@ -477,12 +534,37 @@ type Comment struct {
 	Author       User              `json:"author,omitempty" structs:"author,omitempty"`
 	Body         string            `json:"body,omitempty" structs:"body,omitempty"`
 	UpdateAuthor User              `json:"updateAuthor,omitempty" structs:"updateAuthor,omitempty"`
-	Updated      string            `json:"updated,omitempty" structs:"updated,omitempty"`
-	Created      string            `json:"created,omitempty" structs:"created,omitempty"`
+	Updated      *Time             `json:"updated,omitempty" structs:"updated,omitempty"`
+	Created      *Time             `json:"created,omitempty" structs:"created,omitempty"`
 	Visibility   CommentVisibility `json:"visibility,omitempty" structs:"visibility,omitempty"`
+	// RenderedBody is the body rendered as HTML, if the comment is requested with WithExpand("renderedBody").
+	RenderedBody string `json:"renderedBody,omitempty" structs:"renderedBody,omitempty"`
 
 	// A list of comment properties. Optional on create and update.
 	Properties []EntityProperty `json:"properties,omitempty" structs:"properties,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Comment struct.
+// It adds the Unknowns to the encoded fields.
+func (c Comment) MarshalJSON() ([]byte, error) {
+	type Alias Comment
+	return marshalWithUnknowns(Alias(c), c.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Comment struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type Alias Comment
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Unknowns = unknowns
+	return nil
 }
 
 // FixVersion represents a software release in which an issue is fixed.
@@ -493,10 +575,10 @@ type FixVersion struct {
 	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty" structs:"startDate,omitempty"`
 }
 
 // AffectsVersion represents a software release which is affected by an issue.
@@ -615,11 +697,13 @@ type RemoteLinkStatus struct {
 //
 // # The given options will be appended to the query string
 //
+// opts like WithFields or WithExpand are applied after options.
+//
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-getIssue
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQueryOptions) (*Issue, *Response, error) {
+func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQueryOptions, opts ...RequestOption) (*Issue, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s", issueID)
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
 	if err != nil {
@@ -633,6 +717,9 @@ func (s *IssueService) Get(ctx context.Context, issueID string, options *GetQuer
 		}
 		req.URL.RawQuery = q.Encode()
 	}
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, nil, err
+	}
 
 	issue := new(Issue)
 	resp, err := s.client.Do(req, issue)
@@ -759,7 +846,7 @@ func (s *IssueService) DeleteLink(ctx context.Context, linkID string) (*Response
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...func(*http.Request) error) (*Worklog, *Response, error) {
+func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options ...RequestOption) (*Worklog, *Response, error) {
 	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/worklog", issueID)
 
 	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
@@ -767,11 +854,8 @@ func (s *IssueService) GetWorklogs(ctx context.Context, issueID string, options
 		return nil, nil, err
 	}
 
-	for _, option := range options {
-		err = option(req)
-		if err != nil {
-			return nil, nil, err
-		}
+	if err := applyRequestOptions(req, options); err != nil {
+		return nil, nil, err
 	}
 
 	v := new(Worklog)
@@ -882,6 +966,29 @@ func (s *IssueService) UpdateIssue(ctx context.Context, jiraID string, data map[
 	return resp, nil
 }
 
+// GetComments returns a page of the comments of issueID.
+// opts like WithExpand("renderedBody") are added to the query.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue-getComments
+func (s *IssueService) GetComments(ctx context.Context, issueID string, opts ...RequestOption) (*Comments, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/comment", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := applyRequestOptions(req, opts); err != nil {
+		return nil, nil, err
+	}
+
+	comments := new(Comments)
+	resp, err := s.client.Do(req, comments)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return comments, resp, nil
+}
+
 // AddComment adds a new comment to issueID.
 //
 // Jira API docs: https://docs.atlassian.com/jira/REST/latest/#api/2/issue-addComment
@@ -1038,12 +1145,13 @@ func (s *IssueService) AddLink(ctx context.Context, issueLink *IssueLink) (*Resp
 }
 
 // Search will search for tickets according to the jql
+// opts like WithFields or WithValidateQuery are applied after options.
 //
 // Jira API docs: https://developer.atlassian.com/jiradev/jira-apis/jira-rest-apis/jira-rest-api-tutorials/jira-rest-api-example-query-issues
 //
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
-func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOptions) ([]Issue, *Response, error) {
+func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOptions, opts ...RequestOption) ([]Issue, *Response, error) {
 	u := url.URL{
 		Path: "rest/api/2/search",
 	}
@@ -1076,6 +1184,9 @@ func (s *IssueService) Search(ctx context.Context, jql string, options *SearchOp
 	if err != nil {
 		return []Issue{}, nil, err
 	}
+	if err := applyRequestOptions(req, opts); err != nil {
+		return []Issue{}, nil, err
+	}
 
 	v := new(searchResult)
 	resp, err := s.client.Do(req, v)
@@ -1448,13 +1559,7 @@ func (s *IssueService) UpdateAssignee(ctx context.Context, issueID string, assig
 // TODO Double check this method if this works as expected, is using the latest API and the response is complete
 // This double check effort is done for v2 - Remove this two lines if this is completed.
 func (c ChangelogHistory) CreatedTime() (time.Time, error) {
-	var t time.Time
-	// Ignore null
-	if string(c.Created) == "null" {
-		return t, nil
-	}
-	t, err := time.Parse("2006-01-02T15:04:05.999-0700", c.Created)
-	return t, err
+	return time.Time(c.Created), nil
 }
 
 // GetRemoteLinks gets remote issue links on the issue.
@@ -1523,3 +1628,50 @@ func (s *IssueService) UpdateRemoteLink(ctx context.Context, issueID string, lin
 
 	return resp, nil
 }
+
+// GetSubtasks returns the sub-tasks of the issue, in the order they are displayed on the parent issue.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/subtask-getSubTasks
+func (s *IssueService) GetSubtasks(ctx context.Context, issueID string) ([]Subtasks, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/subtask", issueID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subtasks := []Subtasks{}
+	resp, err := s.client.Do(req, &subtasks)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return subtasks, resp, nil
+}
+
+// MoveSubtask reorders the sub-tasks of an issue by moving the sub-task
+// at the zero-based position original to the position current.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/latest/#api/2/issue/{issueIdOrKey}/subtask-moveSubTasks
+// Caller must close resp.Body
+func (s *IssueService) MoveSubtask(ctx context.Context, issueID string, original, current int64) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/api/2/issue/%s/subtask/move", issueID)
+
+	payload := struct {
+		Original int64 `json:"original"`
+		Current  int64 `json:"current"`
+	}{
+		Original: original,
+		Current:  current,
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
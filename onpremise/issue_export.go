@@ -0,0 +1,94 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ExportFormat is a format of the issue export of IssueService.Export.
+type ExportFormat string
+
+// Formats of the issue export.
+const (
+	ExportCSVAllFields      ExportFormat = "csv-all-fields"
+	ExportCSVCurrentFields  ExportFormat = "csv-current-fields"
+	ExportXML               ExportFormat = "xml"
+	ExportHTMLAllFields     ExportFormat = "html-all-fields"
+	ExportHTMLCurrentFields ExportFormat = "html-current-fields"
+	ExportWord              ExportFormat = "word"
+)
+
+// extension returns the file extension of the export URL of the format.
+func (f ExportFormat) extension() string {
+	switch f {
+	case ExportCSVAllFields, ExportCSVCurrentFields:
+		return "csv"
+	case ExportXML:
+		return "xml"
+	case ExportWord:
+		return "doc"
+	default:
+		return "html"
+	}
+}
+
+// ExportOptions are the options of IssueService.Export.
+type ExportOptions struct {
+	// Format is the format of the export. It will default to ExportCSVAllFields if empty.
+	Format ExportFormat `url:"-"`
+	// FilterID exports the issues of the saved filter instead of the JQL query.
+	FilterID int `url:"-"`
+	// TempMax is the maximum number of exported issues. Jira limits it to 1000 by default.
+	TempMax int `url:"tempMax,omitempty"`
+	// PagerStart is the index of the first exported issue, to export more issues than TempMax page by page.
+	PagerStart int `url:"pager/start,omitempty"`
+	// Delimiter is the delimiter of CSV exports, e.g. ";". It will default to "," if empty.
+	Delimiter string `url:"delimiter,omitempty"`
+}
+
+// Export streams the export of the issues matching the JQL query to w, e.g. a CSV file of all fields.
+// The export is sent with the authentication of the client, so reports don't need another HTTP client.
+//
+// Jira docs: https://confluence.atlassian.com/jirakb/how-to-export-more-than-1000-issues-using-save-as-function-in-jira-779160811.html
+func (s *IssueService) Export(ctx context.Context, jql string, w io.Writer, options *ExportOptions) (*Response, error) {
+	if options == nil {
+		options = &ExportOptions{}
+	}
+	format := options.Format
+	if format == "" {
+		format = ExportCSVAllFields
+	}
+	params := struct {
+		JQLQuery string `url:"jqlQuery,omitempty"`
+		*ExportOptions
+	}{ExportOptions: options}
+
+	apiEndpoint := fmt.Sprintf("sr/jira.issueviews:searchrequest-%s/temp/SearchRequest.%s", format, format.extension())
+	if options.FilterID != 0 {
+		apiEndpoint = fmt.Sprintf("sr/jira.issueviews:searchrequest-%s/%d/SearchRequest-%d.%s", format, options.FilterID, options.FilterID, format.extension())
+	} else {
+		params.JQLQuery = jql
+	}
+	apiEndpoint, err := addOptions(apiEndpoint, &params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Del("Content-Type")
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	defer resp.Body.Close()
+
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return resp, err
+	}
+	return resp, nil
+}
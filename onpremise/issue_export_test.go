@@ -0,0 +1,62 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestIssueService_Export(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/sr/jira.issueviews:searchrequest-csv-all-fields/temp/SearchRequest.csv", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"jqlQuery": "project = EX", "tempMax": "1000", "delimiter": ";"})
+		fmt.Fprint(w, "Summary;Issue key\nLogin fails;EX-1\n")
+	})
+
+	var buf bytes.Buffer
+	if _, err := testClient.Issue.Export(context.Background(), "project = EX", &buf, &ExportOptions{TempMax: 1000, Delimiter: ";"}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if got := buf.String(); got != "Summary;Issue key\nLogin fails;EX-1\n" {
+		t.Errorf("Unexpected export %q", got)
+	}
+}
+
+func TestIssueService_Export_Filter(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/sr/jira.issueviews:searchrequest-xml/10000/SearchRequest-10000.xml", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"pager/start": "1000"})
+		fmt.Fprint(w, "<rss><channel></channel></rss>")
+	})
+
+	var buf bytes.Buffer
+	if _, err := testClient.Issue.Export(context.Background(), "", &buf, &ExportOptions{Format: ExportXML, FilterID: 10000, PagerStart: 1000}); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if buf.String() != "<rss><channel></channel></rss>" {
+		t.Errorf("Unexpected export %q", buf.String())
+	}
+}
+
+func TestIssueService_Export_Error(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/sr/jira.issueviews:searchrequest-csv-all-fields/temp/SearchRequest.csv", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":["The value 'XX' does not exist for the field 'project'."]}`)
+	})
+
+	var buf bytes.Buffer
+	if _, err := testClient.Issue.Export(context.Background(), "project = XX", &buf, nil); err == nil {
+		t.Error("Expected an error for an invalid query")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("Expected nothing to be written, got %q", buf.String())
+	}
+}
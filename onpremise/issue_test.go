@@ -396,6 +396,50 @@ func TestIssueService_Get_RenderedFields(t *testing.T) {
 	}
 }
 
+func TestIssueService_Get_RenderedCustomFields(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/10002", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"expand": "renderedFields"})
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1","fields":{"customfield_10010":"*bold*"},"renderedFields":{"description":"<p>Hello</p>","environment":"<p>Linux</p>","timespent":"1h 30m","customfield_10010":"<p><b>bold</b></p>","customfield_10011":null,"customfield_10012":{"value":"red"}}}`)
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "10002", nil, WithExpand("renderedFields"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+
+	rendered := issue.RenderedFields
+	if rendered.Description != "<p>Hello</p>" || rendered.Environment != "<p>Linux</p>" || rendered.TimeSpent != "1h 30m" {
+		t.Errorf("Unexpected rendered fields %+v", rendered)
+	}
+	if value, ok := rendered.CustomField("customfield_10010"); !ok || value != "<p><b>bold</b></p>" {
+		t.Errorf("Expected the rendered custom field, got %q", value)
+	}
+	if len(rendered.CustomFields) != 1 {
+		t.Errorf("Expected only the rendered text custom field, got %v", rendered.CustomFields)
+	}
+	if raw, _ := issue.Fields.Unknowns.String("customfield_10010"); raw != "*bold*" {
+		t.Errorf("Expected the raw custom field to be kept, got %q", raw)
+	}
+}
+
+func TestIssueRenderedFields_MarshalJSON(t *testing.T) {
+	rendered := &IssueRenderedFields{Description: "<p>Hello</p>", CustomFields: map[string]string{"customfield_10010": "<p>x</p>"}}
+	data, err := json.Marshal(rendered)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	got := new(IssueRenderedFields)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reflect.DeepEqual(got, rendered) {
+		t.Errorf("Expected %+v after a round trip, got %+v", rendered, got)
+	}
+}
+
 func TestIssueService_DownloadAttachment(t *testing.T) {
 	var testAttachment = "Here is an attachment"
 
@@ -1084,7 +1128,7 @@ func TestIssueFields_MarshalJSON_Success(t *testing.T) {
 				ID:          "10705",
 				Name:        "2.1.0-rc3",
 				Self:        "http://www.example.com/jira/rest/api/2/version/10705",
-				ReleaseDate: "2018-09-30",
+				ReleaseDate: NewDate(2018, time.September, 30),
 			},
 		},
 	}
@@ -1706,7 +1750,7 @@ func TestIssueService_Get_Fields_Changelog(t *testing.T) {
 		t.Errorf("Expected one history item, %v found", len(issue.Changelog.Histories))
 	}
 
-	if issue.Changelog.Histories[0].Created != "2018-06-20T16:50:35.000+0300" {
+	if issue.Changelog.Histories[0].Created.String() != "2018-06-20T16:50:35.000+0300" {
 		t.Errorf("Expected created time of history item 2018-06-20T16:50:35.000+0300, %v got", issue.Changelog.Histories[0].Created)
 	}
 
@@ -1771,7 +1815,7 @@ func TestIssueService_Get_Fields_AffectsVersions(t *testing.T) {
 			ID:          "10705",
 			Name:        "2.1.0-rc3",
 			Self:        "http://www.example.com/jira/rest/api/2/version/10705",
-			ReleaseDate: "2018-09-30",
+			ReleaseDate: NewDate(2018, time.September, 30),
 			Released:    Bool(false),
 			Archived:    Bool(false),
 			Description: "test description",
@@ -1931,3 +1975,105 @@ func TestTime_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueService_GetSubtasks(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issue/EX-1/subtask"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `[{"id":"10002","key":"EX-2","self":"http://www.example.com/jira/rest/api/2/issue/10002","fields":{"summary":"First sub-task","status":{"name":"Open"}}},{"id":"10003","key":"EX-3","self":"http://www.example.com/jira/rest/api/2/issue/10003","fields":{"summary":"Second sub-task","status":{"name":"Done"}}}]`)
+	})
+
+	subtasks, _, err := testClient.Issue.GetSubtasks(context.Background(), "EX-1")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(subtasks) != 2 {
+		t.Fatalf("Expected 2 sub-tasks. Got %d", len(subtasks))
+	}
+	if subtasks[1].Key != "EX-3" || subtasks[1].Fields.Summary != "Second sub-task" {
+		t.Errorf("Unexpected sub-task: %+v", subtasks[1])
+	}
+}
+
+func TestIssueService_MoveSubtask(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/api/2/issue/EX-1/subtask/move"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]int64
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["original"] != 0 || payload["current"] != 1 {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Issue.MoveSubtask(context.Background(), "EX-1", 0, 1)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestIssueService_Get_WithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1?expand=changelog&fields=summary%2Cstatus")
+		fmt.Fprint(w, `{"id":"10002","key":"EX-1"}`)
+	})
+
+	issue, _, err := testClient.Issue.Get(context.Background(), "EX-1", &GetQueryOptions{Expand: "foo"}, WithFields("summary", "status"), WithExpand("changelog"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issue == nil || issue.Key != "EX-1" {
+		t.Errorf("Unexpected issue %+v", issue)
+	}
+}
+
+func TestIssueService_Search_WithRequestOptions(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"jql": "project = EX", "fields": "summary", "validateQuery": "none"})
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"issues":[{"id":"10002","key":"EX-1"}]}`)
+	})
+
+	issues, _, err := testClient.Issue.Search(context.Background(), "project = EX", nil, WithFields("summary"), WithValidateQuery("none"))
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("Expected 1 issue, got %d", len(issues))
+	}
+}
+
+func TestIssueService_GetComments(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/issue/EX-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/issue/EX-1/comment?expand=renderedBody")
+		fmt.Fprint(w, `{"startAt":0,"maxResults":50,"total":1,"comments":[{"id":"10000","body":"Lorem ipsum","renderedBody":"<p>Lorem ipsum</p>"}]}`)
+	})
+
+	comments, _, err := testClient.Issue.GetComments(context.Background(), "EX-1", WithExpand("renderedBody"))
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if comments.Total != 1 || len(comments.Comments) != 1 || comments.Comments[0].ID != "10000" {
+		t.Errorf("Unexpected comments %+v", comments)
+	}
+}
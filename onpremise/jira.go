@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -34,6 +33,24 @@ type Client struct {
 	// User agent used when communicating with the Jira API.
 	UserAgent string
 
+	// DefaultHeaders are set on every request created by the client, e.g. "X-Force-Accept-Language".
+	// Headers required by a request, like Content-Type, take precedence.
+	DefaultHeaders http.Header
+
+	// ExperimentalAPI opts in to experimental APIs for every request created by the client,
+	// by setting the "X-ExperimentalApi: opt-in" header. Use WithExperimentalAPI to opt in for single requests.
+	ExperimentalAPI bool
+
+	// Redactor masks credentials in errors returned by the client.
+	// Authorization headers, URL passwords and common credential query parameters are always masked.
+	// Configure it to also mask custom secrets.
+	Redactor *Redactor
+
+	// OnDeprecation is called with every response of a deprecated endpoint,
+	// i.e. a response with a Deprecation, Sunset or Warning header.
+	// Use it to learn about endpoints Atlassian is sunsetting, e.g. by logging or counting them.
+	OnDeprecation func(resp *Response)
+
 	// Session storage if the user authenticates with a Session cookie
 	// TODO Needed in Cloud and/or onpremise?
 	session *Session
@@ -64,6 +81,11 @@ type Client struct {
 	ServiceDesk      *ServiceDeskService
 	Customer         *CustomerService
 	Request          *RequestService
+	Configuration    *ConfigurationService
+	Reindex          *ReindexService
+	Cluster          *ClusterService
+	Plugin           *PluginService
+	Webhook          *WebhookService
 }
 
 // service is the base structure to bundle API services
@@ -128,6 +150,11 @@ func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
 	c.ServiceDesk = (*ServiceDeskService)(&c.common)
 	c.Customer = (*CustomerService)(&c.common)
 	c.Request = (*RequestService)(&c.common)
+	c.Configuration = (*ConfigurationService)(&c.common)
+	c.Reindex = (*ReindexService)(&c.common)
+	c.Cluster = (*ClusterService)(&c.common)
+	c.Plugin = (*PluginService)(&c.common)
+	c.Webhook = (*WebhookService)(&c.common)
 
 	return c, nil
 }
@@ -150,6 +177,10 @@ func (c *Client) NewRawRequest(ctx context.Context, method, urlStr string, body
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 
@@ -199,6 +230,10 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	req.Header.Set("Content-Type", "application/json")
 
@@ -220,6 +255,19 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body int
 	return req, nil
 }
 
+// setDefaultHeaders sets the User-Agent, the DefaultHeaders and the experimental API opt-in of the client on req.
+func (c *Client) setDefaultHeaders(req *http.Request) {
+	for key, values := range c.DefaultHeaders {
+		req.Header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	if c.ExperimentalAPI {
+		req.Header.Set(experimentalAPIHeader, "opt-in")
+	}
+}
+
 // addOptions adds the parameters in opts as URL query parameters to s. opts
 // must be a struct whose fields may contain "url" tags.
 func addOptions(s string, opts interface{}) (string, error) {
@@ -259,6 +307,10 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 	if err != nil {
 		return nil, err
 	}
+	c.setDefaultHeaders(req)
+	if err := applyRequestOptions(req, requestOptionsFromContext(ctx)); err != nil {
+		return nil, err
+	}
 
 	// Set required headers
 	req.Header.Set("X-Atlassian-Token", "nocheck")
@@ -284,16 +336,26 @@ func (c *Client) NewMultiPartRequest(ctx context.Context, method, urlStr string,
 // Do sends an API request and returns the API response.
 // The API response is JSON decoded and stored in the value pointed to by v, or returned as an error if an API error has occurred.
 func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
+	req, cancel := applyRequestTimeout(req)
 	httpResp, err := c.client.Do(req)
+	if cancel != nil {
+		if err != nil {
+			cancel()
+		} else {
+			httpResp.Body = &cancelOnClose{ReadCloser: httpResp.Body, cancel: cancel}
+		}
+	}
 	if err != nil {
-		return nil, err
+		return nil, c.Redactor.redactError(err)
 	}
+	applyResponseProgress(req, httpResp)
 
 	err = CheckResponse(httpResp)
 	if err != nil {
+		c.readErrorBody(httpResp, err.(*ResponseError))
 		// Even though there was an error, we still return the response
 		// in case the caller wants to inspect it further
-		return newResponse(httpResp, nil), err
+		return c.newResponse(httpResp, nil), err
 	}
 
 	if v != nil {
@@ -302,10 +364,67 @@ func (c *Client) Do(req *http.Request, v interface{}) (*Response, error) {
 		err = json.NewDecoder(httpResp.Body).Decode(v)
 	}
 
-	resp := newResponse(httpResp, v)
+	resp := c.newResponse(httpResp, v)
 	return resp, err
 }
 
+// Call sends a request to an arbitrary REST path of Jira, e.g. of an app like "rest/scriptrunner/latest/custom/myEndpoint",
+// and decodes the JSON response into v.
+// The request is sent like the requests of all API methods: authenticated by the HTTP client and with the error handling of Do.
+// query is added to the path and may be nil.
+// body is JSON encoded, an io.Reader is sent as it is. No body is sent if nil.
+// The response is not decoded if v is nil.
+func (c *Client) Call(ctx context.Context, method, path string, query url.Values, body, v interface{}) (*Response, error) {
+	if len(query) > 0 {
+		sep := "?"
+		if strings.Contains(path, "?") {
+			sep = "&"
+		}
+		path += sep + query.Encode()
+	}
+
+	var req *http.Request
+	var err error
+	if r, ok := body.(io.Reader); ok {
+		req, err = c.NewRawRequest(ctx, method, path, r)
+	} else {
+		req, err = c.NewRequest(ctx, method, path, body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req, v)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Do sends an API request with client and returns the JSON decoded response as *T.
+// It is meant for endpoints that are not wrapped by this library yet.
+// The request is sent with ctx and errors are returned as the Error of NewJiraError,
+// like for all API methods.
+//
+// Example:
+//
+//	req, _ := client.NewRequest(ctx, http.MethodGet, "rest/api/2/serverInfo", nil)
+//	info, _, err := Do[ServerInfo](ctx, client, req)
+func Do[T any](ctx context.Context, client *Client, req *http.Request) (*T, *Response, error) {
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	v := new(T)
+	resp, err := client.Do(req, v)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return v, resp, nil
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
 // A response is considered an error if it has a status code outside the 200 range.
 // The caller is responsible to analyze the response body.
@@ -315,7 +434,11 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
-	err := fmt.Errorf("request failed. Please analyze the request body for more details. Status code: %d", r.StatusCode)
+	err := &ResponseError{StatusCode: r.StatusCode}
+	if r.Request != nil {
+		err.Method = r.Request.Method
+		err.URL = r.Request.URL.Redacted()
+	}
 	return err
 }
 
@@ -327,11 +450,28 @@ type Response struct {
 	StartAt    int
 	MaxResults int
 	Total      int
+
+	// Deprecation is the deprecation information of the endpoint, nil if the endpoint is not deprecated.
+	Deprecation *Deprecation
+
+	redactor *Redactor
 }
 
 func newResponse(r *http.Response, v interface{}) *Response {
 	resp := &Response{Response: r}
 	resp.populatePageValues(v)
+	if r != nil {
+		resp.Deprecation = parseDeprecation(r.Header)
+	}
+	return resp
+}
+
+func (c *Client) newResponse(r *http.Response, v interface{}) *Response {
+	resp := newResponse(r, v)
+	resp.redactor = c.Redactor
+	if resp.Deprecation != nil && c.OnDeprecation != nil {
+		c.OnDeprecation(resp)
+	}
 	return resp
 }
 
@@ -347,5 +487,9 @@ func (r *Response) populatePageValues(v interface{}) {
 		r.StartAt = value.StartAt
 		r.MaxResults = value.MaxResults
 		r.Total = value.Total
+	case *AgileIssueList:
+		r.StartAt = value.StartAt
+		r.MaxResults = value.MaxResults
+		r.Total = value.Total
 	}
 }
@@ -3,6 +3,7 @@ package onpremise
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -212,6 +213,34 @@ func testURLParseError(t *testing.T, err error) {
 	}
 }
 
+func TestClient_NewRequest_DefaultHeaders(t *testing.T) {
+	c, err := NewClient(testJiraInstanceURL, nil)
+	if err != nil {
+		t.Fatalf("An error occurred: %s", err)
+	}
+	c.UserAgent = "my-app/1.0"
+	c.DefaultHeaders = http.Header{
+		"x-force-accept-language": []string{"true"},
+		"Content-Type":            []string{"text/plain"},
+	}
+
+	req, _ := c.NewRequest(context.Background(), http.MethodGet, "rest/api/2/issue/EX-1", nil)
+	if got := req.Header.Get("User-Agent"); got != "my-app/1.0" {
+		t.Errorf("User-Agent = %s, want my-app/1.0", got)
+	}
+	if got := req.Header.Get("X-Force-Accept-Language"); got != "true" {
+		t.Errorf("X-Force-Accept-Language = %s, want true", got)
+	}
+	if got := req.Header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %s, want application/json", got)
+	}
+
+	req, _ = c.NewMultiPartRequest(context.Background(), http.MethodPost, "rest/api/2/issue/EX-1/attachments", new(bytes.Buffer))
+	if got := req.Header.Get("User-Agent"); got != "my-app/1.0" {
+		t.Errorf("User-Agent of multipart request = %s, want my-app/1.0", got)
+	}
+}
+
 func TestClient_NewRequest_BadURL(t *testing.T) {
 	c, err := NewClient(testJiraInstanceURL, nil)
 	if err != nil {
@@ -374,6 +403,100 @@ func TestClient_Do(t *testing.T) {
 	}
 }
 
+func TestClient_Call(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/scriptrunner/latest/custom/report", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"project": "EX", "format": "json"})
+		b, _ := io.ReadAll(r.Body)
+		if want := `{"name":"report"}` + "\n"; string(b) != want {
+			t.Errorf("Request body = %q, want %q", b, want)
+		}
+		fmt.Fprint(w, `{"id":"1"}`)
+	})
+
+	var result map[string]string
+	query := url.Values{"project": []string{"EX"}}
+	resp, err := testClient.Call(context.Background(), http.MethodPost, "rest/scriptrunner/latest/custom/report?format=json", query, map[string]string{"name": "report"}, &result)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp == nil {
+		t.Fatal("Expected the response")
+	}
+	if result["id"] != "1" {
+		t.Errorf("Unexpected result %v", result)
+	}
+}
+
+func TestClient_Call_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/rest/plugin/1.0/thing", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["Forbidden."]}`)
+	})
+
+	_, err := testClient.Call(context.Background(), http.MethodDelete, "/rest/plugin/1.0/thing", nil, nil, nil)
+	if !errors.Is(err, ErrForbidden) {
+		t.Errorf("Expected a forbidden error, got %v", err)
+	}
+}
+
+func TestDo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	type foo struct {
+		A string
+	}
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"A":"a"}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[foo](context.Background(), testClient, req)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected the response, got %v", resp)
+	}
+	if want := (&foo{"a"}); !reflect.DeepEqual(body, want) {
+		t.Errorf("Response body = %v, want %v", body, want)
+	}
+}
+
+func TestDo_Error(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Not found."],"errors":{}}`)
+	})
+
+	req, _ := testClient.NewRequest(context.Background(), http.MethodGet, "/", nil)
+	body, resp, err := Do[map[string]string](context.Background(), testClient, req)
+	if body != nil {
+		t.Errorf("Expected no body, got %v", body)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected the response, got %v", resp)
+	}
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "Not found.") {
+		t.Errorf("Expected the Jira error, got %v", err)
+	}
+}
+
 func TestClient_Do_HTTPResponse(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,334 @@
+package onpremise
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// PluginService handles the apps of the Jira instance with the REST API of the Universal Plugin Manager (UPM).
+// It requires the system administrator permission.
+//
+// UPM REST API docs: https://developer.atlassian.com/platform/marketplace/registering-apps/#using-the-upm-rest-api
+type PluginService service
+
+// Media types and endpoints of the UPM REST API.
+const (
+	pluginsInstalledMediaType = "application/vnd.atl.plugins.installed+json"
+	pluginMediaType           = "application/vnd.atl.plugins.plugin+json"
+	pluginInstallURIMediaType = "application/vnd.atl.plugins.install.uri+json"
+	pluginLicenseMediaType    = "application/vnd.atl.plugins+json"
+	pluginTokenHeader         = "upm-token"
+	pluginsAPIEndpoint        = "rest/plugins/1.0/"
+)
+
+// PluginList is the list of installed apps.
+type PluginList struct {
+	Plugins []Plugin          `json:"plugins" structs:"plugins"`
+	Links   map[string]string `json:"links,omitempty" structs:"links,omitempty"`
+}
+
+// Plugin is an app installed in the Jira instance.
+type Plugin struct {
+	Key         string       `json:"key,omitempty" structs:"key,omitempty"`
+	Name        string       `json:"name,omitempty" structs:"name,omitempty"`
+	Version     string       `json:"version,omitempty" structs:"version,omitempty"`
+	Description string       `json:"description,omitempty" structs:"description,omitempty"`
+	Enabled     bool         `json:"enabled" structs:"enabled"`
+	Vendor      PluginVendor `json:"vendor,omitempty" structs:"vendor,omitempty"`
+	// UserInstalled reports whether the app was installed by an administrator, in contrast to the apps bundled with Jira.
+	UserInstalled bool `json:"userInstalled,omitempty" structs:"userInstalled,omitempty"`
+	// Optional reports whether the app can be disabled.
+	Optional      bool              `json:"optional,omitempty" structs:"optional,omitempty"`
+	Static        bool              `json:"static,omitempty" structs:"static,omitempty"`
+	Unloadable    bool              `json:"unloadable,omitempty" structs:"unloadable,omitempty"`
+	UsesLicensing bool              `json:"usesLicensing,omitempty" structs:"usesLicensing,omitempty"`
+	Modules       []PluginModule    `json:"modules,omitempty" structs:"modules,omitempty"`
+	Links         map[string]string `json:"links,omitempty" structs:"links,omitempty"`
+}
+
+// PluginVendor is the vendor of an app.
+type PluginVendor struct {
+	Name            string `json:"name,omitempty" structs:"name,omitempty"`
+	Link            string `json:"link,omitempty" structs:"link,omitempty"`
+	MarketplaceLink string `json:"marketplaceLink,omitempty" structs:"marketplaceLink,omitempty"`
+}
+
+// PluginModule is a module of an app.
+type PluginModule struct {
+	Key         string `json:"key,omitempty" structs:"key,omitempty"`
+	CompleteKey string `json:"completeKey,omitempty" structs:"completeKey,omitempty"`
+	Name        string `json:"name,omitempty" structs:"name,omitempty"`
+	Enabled     bool   `json:"enabled,omitempty" structs:"enabled,omitempty"`
+	Optional    bool   `json:"optional,omitempty" structs:"optional,omitempty"`
+}
+
+// PluginTask is the pending task of the installation of an app.
+type PluginTask struct {
+	Type string `json:"type,omitempty" structs:"type,omitempty"`
+	// PingAfter is the number of milliseconds to wait before getting the task again.
+	PingAfter int               `json:"pingAfter,omitempty" structs:"pingAfter,omitempty"`
+	Status    PluginTaskStatus  `json:"status,omitempty" structs:"status,omitempty"`
+	Links     map[string]string `json:"links,omitempty" structs:"links,omitempty"`
+	// Key is the key of the installed app. It is set once the installation finished,
+	// as the task then redirects to the app.
+	Key string `json:"key,omitempty" structs:"key,omitempty"`
+}
+
+// PluginTaskStatus is the status of a PluginTask.
+type PluginTaskStatus struct {
+	Done         bool   `json:"done,omitempty" structs:"done,omitempty"`
+	StatusCode   int    `json:"statusCode,omitempty" structs:"statusCode,omitempty"`
+	ContentType  string `json:"contentType,omitempty" structs:"contentType,omitempty"`
+	Source       string `json:"source,omitempty" structs:"source,omitempty"`
+	Name         string `json:"name,omitempty" structs:"name,omitempty"`
+	ErrorMessage string `json:"errorMessage,omitempty" structs:"errorMessage,omitempty"`
+	Subcode      string `json:"subCode,omitempty" structs:"subCode,omitempty"`
+}
+
+// ID returns the ID of the task from its self link, to get it with PluginService.GetTask.
+func (t *PluginTask) ID() string {
+	return path.Base(t.Links["self"])
+}
+
+// Done reports whether the installation finished, successfully or not.
+func (t *PluginTask) Done() bool {
+	return t.Status.Done || t.Key != ""
+}
+
+// PluginLicense is the license of an app.
+type PluginLicense struct {
+	Valid                 bool              `json:"valid,omitempty" structs:"valid,omitempty"`
+	Evaluation            bool              `json:"evaluation,omitempty" structs:"evaluation,omitempty"`
+	NearlyExpired         bool              `json:"nearlyExpired,omitempty" structs:"nearlyExpired,omitempty"`
+	MaintenanceExpired    bool              `json:"maintenanceExpired,omitempty" structs:"maintenanceExpired,omitempty"`
+	MaximumNumberOfUsers  int               `json:"maximumNumberOfUsers,omitempty" structs:"maximumNumberOfUsers,omitempty"`
+	LicenseType           string            `json:"licenseType,omitempty" structs:"licenseType,omitempty"`
+	CreationDateString    string            `json:"creationDateString,omitempty" structs:"creationDateString,omitempty"`
+	ExpiryDate            *Time             `json:"expiryDate,omitempty" structs:"expiryDate,omitempty"`
+	ExpiryDateString      string            `json:"expiryDateString,omitempty" structs:"expiryDateString,omitempty"`
+	MaintenanceExpiryDate *Time             `json:"maintenanceExpiryDate,omitempty" structs:"maintenanceExpiryDate,omitempty"`
+	SupportEntitlement    string            `json:"supportEntitlementNumber,omitempty" structs:"supportEntitlementNumber,omitempty"`
+	OrganizationName      string            `json:"organizationName,omitempty" structs:"organizationName,omitempty"`
+	ContactEmail          string            `json:"contactEmail,omitempty" structs:"contactEmail,omitempty"`
+	RawLicense            string            `json:"rawLicense,omitempty" structs:"rawLicense,omitempty"`
+	PluginKey             string            `json:"pluginKey,omitempty" structs:"pluginKey,omitempty"`
+	Links                 map[string]string `json:"links,omitempty" structs:"links,omitempty"`
+}
+
+// GetAll returns the installed apps, including the apps bundled with Jira.
+func (s *PluginService) GetAll(ctx context.Context) (*PluginList, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, pluginsAPIEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", pluginsInstalledMediaType)
+
+	plugins := new(PluginList)
+	resp, err := s.client.Do(req, plugins)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return plugins, resp, nil
+}
+
+// Get returns the app with the key, e.g. "com.example.jira.myapp".
+func (s *PluginService) Get(ctx context.Context, pluginKey string) (*Plugin, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, pluginEndpoint(pluginKey), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	plugin := new(Plugin)
+	resp, err := s.client.Do(req, plugin)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return plugin, resp, nil
+}
+
+// Enable enables the app with the key.
+func (s *PluginService) Enable(ctx context.Context, pluginKey string) (*Plugin, *Response, error) {
+	return s.setEnabled(ctx, pluginKey, true)
+}
+
+// Disable disables the app with the key.
+func (s *PluginService) Disable(ctx context.Context, pluginKey string) (*Plugin, *Response, error) {
+	return s.setEnabled(ctx, pluginKey, false)
+}
+
+func (s *PluginService) setEnabled(ctx context.Context, pluginKey string, enabled bool) (*Plugin, *Response, error) {
+	body := struct {
+		Enabled bool `json:"enabled"`
+	}{enabled}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, pluginEndpoint(pluginKey), body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", pluginMediaType)
+
+	plugin := new(Plugin)
+	resp, err := s.client.Do(req, plugin)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return plugin, resp, nil
+}
+
+// Uninstall uninstalls the app with the key.
+func (s *PluginService) Uninstall(ctx context.Context, pluginKey string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, pluginEndpoint(pluginKey), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// InstallFromFile uploads and installs the app in r, e.g. a .jar or .obr file, under the file name.
+// The app is installed asynchronously, the progress is returned by GetTask.
+func (s *PluginService) InstallFromFile(ctx context.Context, r io.Reader, fileName string) (*PluginTask, *Response, error) {
+	b := new(bytes.Buffer)
+	writer := multipart.NewWriter(b)
+	fw, err := writer.CreateFormFile("plugin", fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = io.Copy(fw, r); err != nil {
+		return nil, nil, err
+	}
+	writer.Close()
+
+	apiEndpoint, resp, err := s.installEndpoint(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	req, err := s.client.NewMultiPartRequest(ctx, http.MethodPost, apiEndpoint, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return s.install(req)
+}
+
+// InstallFromURL installs the app from the URL, e.g. the download URL of an app version of the Atlassian Marketplace.
+// The app is installed asynchronously, the progress is returned by GetTask.
+func (s *PluginService) InstallFromURL(ctx context.Context, pluginURL string) (*PluginTask, *Response, error) {
+	apiEndpoint, resp, err := s.installEndpoint(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+	body := struct {
+		PluginURI string `json:"pluginUri"`
+	}{pluginURL}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", pluginInstallURIMediaType)
+
+	return s.install(req)
+}
+
+// installEndpoint returns the endpoint to install apps with the token UPM requires against cross site request forgery.
+func (s *PluginService) installEndpoint(ctx context.Context) (string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, pluginsAPIEndpoint, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", pluginsInstalledMediaType)
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return "", resp, NewJiraError(resp, err)
+	}
+	resp.Body.Close()
+
+	token := resp.Header.Get(pluginTokenHeader)
+	if token == "" {
+		return "", resp, fmt.Errorf("no %s header returned by the Universal Plugin Manager", pluginTokenHeader)
+	}
+	return pluginsAPIEndpoint + "?token=" + url.QueryEscape(token), resp, nil
+}
+
+func (s *PluginService) install(req *http.Request) (*PluginTask, *Response, error) {
+	task := new(PluginTask)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return task, resp, nil
+}
+
+// GetTask returns the pending task of an installation with the ID of PluginTask.ID.
+// Once the installation finished, the task has the key of the installed app.
+func (s *PluginService) GetTask(ctx context.Context, taskID string) (*PluginTask, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, pluginsAPIEndpoint+"pending/"+url.PathEscape(taskID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(PluginTask)
+	resp, err := s.client.Do(req, task)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return task, resp, nil
+}
+
+// GetLicense returns the license of the app with the key.
+func (s *PluginService) GetLicense(ctx context.Context, pluginKey string) (*PluginLicense, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, pluginEndpoint(pluginKey)+"/license", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	license := new(PluginLicense)
+	resp, err := s.client.Do(req, license)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return license, resp, nil
+}
+
+// UpdateLicense replaces the license of the app with the key by the raw license.
+func (s *PluginService) UpdateLicense(ctx context.Context, pluginKey, rawLicense string) (*PluginLicense, *Response, error) {
+	body := struct {
+		RawLicense string `json:"rawLicense"`
+	}{rawLicense}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, pluginEndpoint(pluginKey)+"/license", body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", pluginLicenseMediaType)
+
+	license := new(PluginLicense)
+	resp, err := s.client.Do(req, license)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return license, resp, nil
+}
+
+// pluginEndpoint returns the endpoint of the app with the key.
+func pluginEndpoint(pluginKey string) string {
+	return pluginsAPIEndpoint + url.PathEscape(pluginKey) + "-key"
+}
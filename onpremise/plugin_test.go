@@ -0,0 +1,182 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPluginService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if accept := r.Header.Get("Accept"); accept != "application/vnd.atl.plugins.installed+json" {
+			t.Errorf("Unexpected Accept header %q", accept)
+		}
+		fmt.Fprint(w, `{"plugins":[{"key":"com.example.jira.myapp","name":"My App","version":"1.2.0","enabled":true,"userInstalled":true,
+			"optional":true,"usesLicensing":true,"vendor":{"name":"Example","link":"https://example.com"},
+			"links":{"self":"/rest/plugins/1.0/com.example.jira.myapp-key"}},
+			{"key":"com.atlassian.jira.plugin.system.reports","name":"Reports","enabled":true,"userInstalled":false}],"links":{}}`)
+	})
+
+	plugins, _, err := testClient.Plugin.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(plugins.Plugins) != 2 || !plugins.Plugins[0].UserInstalled || plugins.Plugins[0].Vendor.Name != "Example" {
+		t.Errorf("Unexpected plugins %+v", plugins)
+	}
+}
+
+func TestPluginService_EnableDisable(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/com.example.jira.myapp-key", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"key":"com.example.jira.myapp","enabled":true}`)
+		case http.MethodPut:
+			if ct := r.Header.Get("Content-Type"); ct != "application/vnd.atl.plugins.plugin+json" {
+				t.Errorf("Unexpected Content-Type %q", ct)
+			}
+			var body map[string]bool
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fmt.Fprintf(w, `{"key":"com.example.jira.myapp","enabled":%t}`, body["enabled"])
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	ctx := context.Background()
+	plugin, _, err := testClient.Plugin.Get(ctx, "com.example.jira.myapp")
+	if err != nil || !plugin.Enabled {
+		t.Fatalf("Unexpected plugin %+v: %v", plugin, err)
+	}
+	if plugin, _, err = testClient.Plugin.Disable(ctx, "com.example.jira.myapp"); err != nil || plugin.Enabled {
+		t.Errorf("Expected the app to be disabled, got %+v: %v", plugin, err)
+	}
+	if plugin, _, err = testClient.Plugin.Enable(ctx, "com.example.jira.myapp"); err != nil || !plugin.Enabled {
+		t.Errorf("Expected the app to be enabled, got %+v: %v", plugin, err)
+	}
+	if _, err := testClient.Plugin.Uninstall(ctx, "com.example.jira.myapp"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPluginService_InstallFromFile(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("upm-token", "1234")
+			fmt.Fprint(w, `{"plugins":[]}`)
+		case http.MethodPost:
+			testRequestURL(t, r, "/rest/plugins/1.0/?token=1234")
+			file, header, err := r.FormFile("plugin")
+			if err != nil {
+				t.Fatalf("Error given: %s", err)
+			}
+			data, _ := io.ReadAll(file)
+			if header.Filename != "myapp-1.2.0.jar" || string(data) != "jar" {
+				t.Errorf("Unexpected file %s: %s", header.Filename, data)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"type":"INSTALL","pingAfter":100,"status":{"done":false,"contentType":"application/vnd.atl.plugins.install.installing+json"},
+				"links":{"self":"/rest/plugins/1.0/pending/5a2c0b4d"}}`)
+		}
+	})
+	testMux.HandleFunc("/rest/plugins/1.0/pending/5a2c0b4d", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"key":"com.example.jira.myapp","enabled":true}`)
+	})
+
+	task, resp, err := testClient.Plugin.InstallFromFile(context.Background(), strings.NewReader("jar"), "myapp-1.2.0.jar")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || task.Done() || task.ID() != "5a2c0b4d" {
+		t.Errorf("Unexpected task %+v", task)
+	}
+
+	task, _, err = testClient.Plugin.GetTask(context.Background(), task.ID())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !task.Done() || task.Key != "com.example.jira.myapp" {
+		t.Errorf("Expected the installation to be done, got %+v", task)
+	}
+}
+
+func TestPluginService_InstallFromURL(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("upm-token", "1234")
+		case http.MethodPost:
+			if ct := r.Header.Get("Content-Type"); ct != "application/vnd.atl.plugins.install.uri+json" {
+				t.Errorf("Unexpected Content-Type %q", ct)
+			}
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["pluginUri"] != "https://marketplace.atlassian.com/download/apps/1212137/version/1" {
+				t.Errorf("Unexpected body %v", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"type":"INSTALL","status":{"done":false},"links":{"self":"/rest/plugins/1.0/pending/5a2c0b4d"}}`)
+		}
+	})
+
+	if _, _, err := testClient.Plugin.InstallFromURL(context.Background(), "https://marketplace.atlassian.com/download/apps/1212137/version/1"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestPluginService_InstallWithoutToken(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("Unexpected %s without token", r.Method)
+		}
+	})
+
+	if _, _, err := testClient.Plugin.InstallFromURL(context.Background(), "https://example.com/myapp.jar"); err == nil {
+		t.Error("Expected an error without upm-token")
+	}
+}
+
+func TestPluginService_License(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/plugins/1.0/com.example.jira.myapp-key/license", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"valid":true,"evaluation":false,"maximumNumberOfUsers":500,"licenseType":"COMMERCIAL",
+				"expiryDate":1767225600000,"pluginKey":"com.example.jira.myapp"}`)
+		case http.MethodPut:
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fmt.Fprintf(w, `{"valid":true,"rawLicense":%q}`, body["rawLicense"])
+		}
+	})
+
+	ctx := context.Background()
+	license, _, err := testClient.Plugin.GetLicense(ctx, "com.example.jira.myapp")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !license.Valid || license.MaximumNumberOfUsers != 500 || license.ExpiryDate == nil || license.ExpiryDate.IsZero() {
+		t.Errorf("Unexpected license %+v", license)
+	}
+	if license, _, err = testClient.Plugin.UpdateLicense(ctx, "com.example.jira.myapp", "AAAB"); err != nil || license.RawLicense != "AAAB" {
+		t.Errorf("Unexpected license %+v: %v", license, err)
+	}
+}
@@ -0,0 +1,89 @@
+package onpremise
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// ProgressFunc is called while a body is transferred with the number of bytes transferred so far
+// and the total size, -1 if it is unknown. Returning an error aborts the transfer with the error,
+// e.g. to abort slow transfers.
+type ProgressFunc func(transferred, total int64) error
+
+// ProgressReader reports the progress of reading Reader to Func, e.g. to render a progress bar.
+type ProgressReader struct {
+	Reader io.Reader
+	// Total is the size of Reader, -1 if it is unknown.
+	Total int64
+	Func  ProgressFunc
+
+	transferred int64
+}
+
+// NewProgressReader returns a ProgressReader reporting the progress of reading r to f.
+func NewProgressReader(r io.Reader, total int64, f ProgressFunc) *ProgressReader {
+	return &ProgressReader{Reader: r, Total: total, Func: f}
+}
+
+// Read implements io.Reader.
+func (r *ProgressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.transferred += int64(n)
+		if ferr := r.Func(r.transferred, r.Total); ferr != nil {
+			return n, ferr
+		}
+	}
+	return n, err
+}
+
+// Close closes Reader if it is an io.Closer.
+func (r *ProgressReader) Close() error {
+	if c, ok := r.Reader.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+type progressKey struct{}
+
+// WithProgress reports the progress of the transfer of the request body to f,
+// or of the response body if the request has no body, e.g. of IssueService.PostAttachment
+// or IssueService.DownloadAttachment:
+//
+//	ctx = ContextWithRequestOptions(ctx, WithProgress(func(transferred, total int64) error {
+//		fmt.Printf("\r%d of %d bytes", transferred, total)
+//		return nil
+//	}))
+//	attachments, _, err := client.Issue.PostAttachment(ctx, "EX-1", file, "report.pdf")
+func WithProgress(f ProgressFunc) RequestOption {
+	return func(r *http.Request) error {
+		if r.Body == nil || r.Body == http.NoBody {
+			*r = *r.WithContext(context.WithValue(r.Context(), progressKey{}, f))
+			return nil
+		}
+		total := r.ContentLength
+		if total == 0 {
+			total = -1
+		}
+		r.Body = NewProgressReader(r.Body, total, f)
+		if getBody := r.GetBody; getBody != nil {
+			r.GetBody = func() (io.ReadCloser, error) {
+				body, err := getBody()
+				if err != nil {
+					return nil, err
+				}
+				return NewProgressReader(body, total, f), nil
+			}
+		}
+		return nil
+	}
+}
+
+// applyResponseProgress reports the progress of reading the body of resp to the ProgressFunc of WithProgress.
+func applyResponseProgress(req *http.Request, resp *http.Response) {
+	if f, ok := req.Context().Value(progressKey{}).(ProgressFunc); ok {
+		resp.Body = NewProgressReader(resp.Body, resp.ContentLength, f)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/google/go-querystring/query"
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // ProjectService handles projects for the Jira instance / API.
@@ -24,6 +25,8 @@ type ProjectList []struct {
 	ProjectTypeKey  string          `json:"projectTypeKey" structs:"projectTypeKey"`
 	ProjectCategory ProjectCategory `json:"projectCategory,omitempty" structs:"projectsCategory,omitempty"`
 	IssueTypes      []IssueType     `json:"issueTypes,omitempty" structs:"issueTypes,omitempty"`
+	// Archived is set for the projects returned by ProjectService.GetArchived.
+	Archived bool `json:"archived,omitempty" structs:"archived,omitempty"`
 }
 
 // ProjectCategory represents a single project category
@@ -52,6 +55,30 @@ type Project struct {
 	Roles           map[string]string  `json:"roles,omitempty" structs:"roles,omitempty"`
 	AvatarUrls      AvatarUrls         `json:"avatarUrls,omitempty" structs:"avatarUrls,omitempty"`
 	ProjectCategory ProjectCategory    `json:"projectCategory,omitempty" structs:"projectCategory,omitempty"`
+	Archived        bool               `json:"archived,omitempty" structs:"archived,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Project struct.
+// It adds the Unknowns to the encoded fields.
+func (p Project) MarshalJSON() ([]byte, error) {
+	type Alias Project
+	return marshalWithUnknowns(Alias(p), p.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Project struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (p *Project) UnmarshalJSON(data []byte) error {
+	type Alias Project
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Unknowns = unknowns
+	return nil
 }
 
 // ProjectComponent represents a single component of a project
@@ -0,0 +1,71 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Archive archives the project. Its issues stay searchable, but can not be changed until it is restored.
+// It requires Jira Data Center 7.10 or later and the administrator permission.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-archiveProject
+func (s *ProjectService) Archive(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.archive(ctx, fmt.Sprintf("rest/api/2/project/%s/archive", projectIDOrKey))
+}
+
+// Restore restores the archived project.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-restoreProject
+func (s *ProjectService) Restore(ctx context.Context, projectIDOrKey string) (*Response, error) {
+	return s.archive(ctx, fmt.Sprintf("rest/api/2/project/%s/restore", projectIDOrKey))
+}
+
+func (s *ProjectService) archive(ctx context.Context, apiEndpoint string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetArchived returns the archived projects, with the optional query params of GetAll.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/project-getAllProjects
+func (s *ProjectService) GetArchived(ctx context.Context, options *GetQueryOptions) (*ProjectList, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/api/2/project", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	q := req.URL.Query()
+	if options != nil {
+		if q, err = query.Values(options); err != nil {
+			return nil, nil, err
+		}
+	}
+	q.Set("includeArchived", "true")
+	req.URL.RawQuery = q.Encode()
+
+	projects := new(ProjectList)
+	resp, err := s.client.Do(req, projects)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	archived := ProjectList{}
+	for _, project := range *projects {
+		if project.Archived {
+			archived = append(archived, project)
+		}
+	}
+	return &archived, resp, nil
+}
@@ -0,0 +1,59 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestProjectService_ArchiveRestore(t *testing.T) {
+	setup()
+	defer teardown()
+	for _, action := range []string{"archive", "restore"} {
+		testMux.HandleFunc("/rest/api/2/project/EX/"+action, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPut)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	if _, err := testClient.Project.Archive(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.Project.Restore(context.Background(), "EX"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestProjectService_Archive_Forbidden(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project/EX/archive", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages":["You must have global administrator rights to archive a project."],"errors":{}}`)
+	})
+
+	resp, err := testClient.Project.Archive(context.Background(), "EX")
+	if err == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected forbidden, got %v", err)
+	}
+}
+
+func TestProjectService_GetArchived(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/project", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"includeArchived": "true", "expand": "description"})
+		fmt.Fprint(w, `[{"id":"10000","key":"EX","name":"Example"},{"id":"10001","key":"OLD","name":"Legacy","archived":true}]`)
+	})
+
+	projects, _, err := testClient.Project.GetArchived(context.Background(), &GetQueryOptions{Expand: "description"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(*projects) != 1 || (*projects)[0].Key != "OLD" || !(*projects)[0].Archived {
+		t.Errorf("Unexpected projects %+v", projects)
+	}
+}
@@ -0,0 +1,141 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+)
+
+// ReindexService handles the reindexing of the Jira instance / API.
+// It requires the system administrator permission.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/reindex
+type ReindexService service
+
+// Types of reindexes.
+const (
+	// ReindexTypeForeground locks Jira while the index is rebuilt. It is the fastest reindex.
+	ReindexTypeForeground = "FOREGROUND"
+	// ReindexTypeBackground reindexes while Jira stays available.
+	ReindexTypeBackground = "BACKGROUND"
+	// ReindexTypeBackgroundPreferred reindexes in the background if possible, in the foreground otherwise.
+	ReindexTypeBackgroundPreferred = "BACKGROUND_PREFERRED"
+	// ReindexTypeForegroundPreferred reindexes in the foreground if possible, in the background otherwise.
+	ReindexTypeForegroundPreferred = "FOREGROUND_PREFERRED"
+)
+
+// Reindex is the progress of a reindex.
+type Reindex struct {
+	// ProgressURL is the URL of the progress, e.g. "/secure/admin/jira/IndexProgress.jspa?taskId=10000".
+	ProgressURL     string `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int    `json:"currentProgress,omitempty" structs:"currentProgress,omitempty"`
+	CurrentSubTask  string `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	// Type is one of the ReindexType constants.
+	Type          string `json:"type,omitempty" structs:"type,omitempty"`
+	SubmittedTime *Time  `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime     *Time  `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime    *Time  `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Success       bool   `json:"success,omitempty" structs:"success,omitempty"`
+}
+
+// Done reports whether the reindex finished, successfully or not.
+func (r *Reindex) Done() bool {
+	return r.FinishTime != nil && !r.FinishTime.IsZero()
+}
+
+// ReindexOptions are the options of ReindexService.Start.
+type ReindexOptions struct {
+	// Type is one of the ReindexType constants. Jira uses ReindexTypeBackgroundPreferred if empty.
+	Type string `url:"type,omitempty"`
+	// IndexComments, IndexChangeHistory and IndexWorklogs also reindex the comments,
+	// the change history and the worklogs of background reindexes.
+	IndexComments      bool `url:"indexComments,omitempty"`
+	IndexChangeHistory bool `url:"indexChangeHistory,omitempty"`
+	IndexWorklogs      bool `url:"indexWorklogs,omitempty"`
+}
+
+// IssueReindexOptions are the options of ReindexService.ReindexIssues.
+type IssueReindexOptions struct {
+	// IndexComments, IndexChangeHistory and IndexWorklogs also reindex the comments,
+	// the change history and the worklogs of the issues.
+	IndexComments      bool `url:"indexComments,omitempty"`
+	IndexChangeHistory bool `url:"indexChangeHistory,omitempty"`
+	IndexWorklogs      bool `url:"indexWorklogs,omitempty"`
+}
+
+// Start starts a reindex of the instance, e.g. after a large import.
+// Its progress is returned by GetProgress.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/reindex-reindex
+func (s *ReindexService) Start(ctx context.Context, options *ReindexOptions) (*Reindex, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/reindex", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reindex := new(Reindex)
+	resp, err := s.client.Do(req, reindex)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return reindex, resp, nil
+}
+
+// GetProgress returns the progress of the reindex with the task ID,
+// or of the last reindex if taskID is 0.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/reindex-getReindexProgress
+func (s *ReindexService) GetProgress(ctx context.Context, taskID int64) (*Reindex, *Response, error) {
+	options := struct {
+		TaskID int64 `url:"taskId,omitempty"`
+	}{taskID}
+	apiEndpoint, err := addOptions("rest/api/2/reindex/progress", &options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reindex := new(Reindex)
+	resp, err := s.client.Do(req, reindex)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return reindex, resp, nil
+}
+
+// ReindexIssues reindexes the issues with the IDs. They are reindexed synchronously.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/reindex-reindexIssues
+func (s *ReindexService) ReindexIssues(ctx context.Context, issueIDs []string, options *IssueReindexOptions) (*Reindex, *Response, error) {
+	params := struct {
+		IssueID []string `url:"issueId"`
+		IssueReindexOptions
+	}{IssueID: issueIDs}
+	if options != nil {
+		params.IssueReindexOptions = *options
+	}
+	apiEndpoint, err := addOptions("rest/api/2/reindex/issue", &params)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reindex := new(Reindex)
+	resp, err := s.client.Do(req, reindex)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return reindex, resp, nil
+}
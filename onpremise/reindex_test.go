@@ -0,0 +1,69 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReindexService_Start(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/reindex", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestParams(t, r, map[string]string{"type": ReindexTypeBackground, "indexComments": "true"})
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"progressUrl":"/secure/admin/jira/IndexProgress.jspa?taskId=10000","currentProgress":0,"type":"BACKGROUND",
+			"submittedTime":"2020-12-01T10:00:00.000+0100","success":false}`)
+	})
+
+	reindex, resp, err := testClient.Reindex.Start(context.Background(), &ReindexOptions{Type: ReindexTypeBackground, IndexComments: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || reindex.Type != ReindexTypeBackground || reindex.Done() {
+		t.Errorf("Unexpected reindex %+v", reindex)
+	}
+}
+
+func TestReindexService_GetProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/reindex/progress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/reindex/progress?taskId=10000")
+		fmt.Fprint(w, `{"currentProgress":100,"type":"BACKGROUND","finishTime":"2020-12-01T10:30:00.000+0100","success":true}`)
+	})
+
+	reindex, _, err := testClient.Reindex.GetProgress(context.Background(), 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reindex.Done() || !reindex.Success || reindex.CurrentProgress != 100 {
+		t.Errorf("Unexpected reindex %+v", reindex)
+	}
+}
+
+func TestReindexService_ReindexIssues(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/reindex/issue", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if ids := r.URL.Query()["issueId"]; len(ids) != 2 || ids[0] != "10000" || ids[1] != "10001" {
+			t.Errorf("Unexpected issue IDs %v", ids)
+		}
+		if r.URL.Query().Get("indexWorklogs") != "true" {
+			t.Errorf("Expected the worklogs to be reindexed, got %s", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `{"currentProgress":100,"type":"FOREGROUND","success":true}`)
+	})
+
+	reindex, _, err := testClient.Reindex.ReindexIssues(context.Background(), []string{"10000", "10001"}, &IssueReindexOptions{IndexWorklogs: true})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !reindex.Success {
+		t.Errorf("Unexpected reindex %+v", reindex)
+	}
+}
@@ -186,7 +186,7 @@ func (s *ServiceDeskService) ListCustomers(ctx context.Context, serviceDeskID in
 	}
 
 	// this is an experiemntal endpoint
-	req.Header.Set("X-ExperimentalApi", "opt-in")
+	req.Header.Set(experimentalAPIHeader, "opt-in")
 
 	if options != nil {
 		q, err := query.Values(options)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 
 	"github.com/google/go-querystring/query"
 )
@@ -12,9 +13,13 @@ import (
 // See https://docs.atlassian.com/jira-software/REST/cloud/
 type SprintService service
 
-// IssuesWrapper represents a wrapper struct for moving issues to sprint
+// IssuesWrapper represents a wrapper struct for moving issues to a sprint or the backlog.
+// The rank fields are optional and position the moved issues relative to another issue.
 type IssuesWrapper struct {
-	Issues []string `json:"issues"`
+	Issues            []string `json:"issues"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty"`
+	RankCustomFieldID int64    `json:"rankCustomFieldId,omitempty"`
 }
 
 // IssuesInSprintResult represents a wrapper struct for search result
@@ -22,31 +27,248 @@ type IssuesInSprintResult struct {
 	Issues []Issue `json:"issues"`
 }
 
+// Create creates a future sprint.
+// Name and OriginBoardID are required, StartDate and EndDate are optional.
+// Sprint name is trimmed and must not exceed 30 characters.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-post
+func (s *SprintService) Create(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error) {
+	apiEndpoint := "rest/agile/1.0/sprint"
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseSprint := new(Sprint)
+	resp, err := s.client.Do(req, responseSprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseSprint, resp, nil
+}
+
+// Get returns the sprint for a given sprint ID.
+// The sprint will only be returned if the user can view the board that the sprint was created on,
+// or view at least one of the issues in the sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-get
+func (s *SprintService) Get(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sprint := new(Sprint)
+	resp, err := s.client.Do(req, sprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return sprint, resp, nil
+}
+
+// Update performs a full update of a sprint, identified by sprint.ID.
+// Fields that are not set will be reset to their default values.
+// Use PartialUpdate to only change some fields of a sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-put
+func (s *SprintService) Update(ctx context.Context, sprint *Sprint) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprint.ID)
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseSprint := new(Sprint)
+	resp, err := s.client.Do(req, responseSprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseSprint, resp, nil
+}
+
+// PartialUpdate updates only the fields of a sprint that are set.
+//
+// It is also used for state transitions:
+// A future sprint is started by setting State to SprintStateActive (StartDate and EndDate must be set, either on the sprint already or in this call).
+// An active sprint is completed by setting State to SprintStateClosed.
+// Closed sprints can not be updated.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-post
+func (s *SprintService) PartialUpdate(ctx context.Context, sprintID int, sprint *Sprint) (*Sprint, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, sprint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseSprint := new(Sprint)
+	resp, err := s.client.Do(req, responseSprint)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return responseSprint, resp, nil
+}
+
+// Delete deletes a sprint.
+// Once a sprint is deleted, all open issues in the sprint will be moved to the backlog.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-delete
+// Caller must close resp.Body
+func (s *SprintService) Delete(ctx context.Context, sprintID int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// swapSprintRequest is the payload of SprintService.Swap
+type swapSprintRequest struct {
+	SprintToSwapWith int `json:"sprintToSwapWith"`
+}
+
+// Swap swaps the position of the sprint with the second sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-swap-post
+// Caller must close resp.Body
+func (s *SprintService) Swap(ctx context.Context, sprintID, sprintToSwapWith int) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/swap", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, &swapSprintRequest{SprintToSwapWith: sprintToSwapWith})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetPropertyKeys returns the keys of all properties for the sprint identified by the id.
+// The user who retrieves the property keys is required to have permissions to view the sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-properties-get
+func (s *SprintService) GetPropertyKeys(ctx context.Context, sprintID int) (*EntityPropertyKeys, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/properties", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := new(EntityPropertyKeys)
+	resp, err := s.client.Do(req, keys)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return keys, resp, nil
+}
+
+// GetProperty returns the value of the property with a given key from the sprint identified by the provided id.
+// The user who retrieves the property is required to have permissions to view the sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-properties-propertykey-get
+func (s *SprintService) GetProperty(ctx context.Context, sprintID int, propertyKey string) (*EntityProperty, *Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/properties/%s", sprintID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(EntityProperty)
+	resp, err := s.client.Do(req, property)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return property, resp, nil
+}
+
+// SetProperty sets the value of the specified sprint's property.
+// The value is JSON encoded and must not exceed 32 KB.
+// The user who stores the data is required to have permissions to modify the sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-properties-propertykey-put
+// Caller must close resp.Body
+func (s *SprintService) SetProperty(ctx context.Context, sprintID int, propertyKey string, value interface{}) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/properties/%s", sprintID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, value)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// DeleteProperty removes the property from the sprint identified by the id.
+// The user removing the property is required to have permissions to modify the sprint.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-properties-propertykey-delete
+// Caller must close resp.Body
+func (s *SprintService) DeleteProperty(ctx context.Context, sprintID int, propertyKey string) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/properties/%s", sprintID, url.PathEscape(propertyKey))
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
 // MoveIssuesToSprint moves issues to a sprint, for a given sprint Id.
 // Issues can only be moved to open or active sprints.
 // The maximum number of issues that can be moved in one operation is 50.
 //
-// Jira API docs: https://docs.atlassian.com/jira-software/REST/cloud/#agile/1.0/sprint-moveIssuesToSprint
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-issue-post
 // Caller must close resp.Body
-//
-// TODO Double check this method if this works as expected, is using the latest API and the response is complete
-// This double check effort is done for v2 - Remove this two lines if this is completed.
 func (s *SprintService) MoveIssuesToSprint(ctx context.Context, sprintID int, issueIDs []string) (*Response, error) {
-	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
-
-	payload := IssuesWrapper{Issues: issueIDs}
-
-	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, payload)
+	return s.MoveIssues(ctx, sprintID, &IssuesWrapper{Issues: issueIDs})
+}
 
+// MoveIssues moves issues to a sprint, for a given sprint Id.
+// Unlike MoveIssuesToSprint, the moved issues can be ranked before or after another issue.
+// Issues can only be moved to open or active sprints.
+// The maximum number of issues that can be moved in one operation is 50.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-issue-post
+// Caller must close resp.Body
+func (s *SprintService) MoveIssues(ctx context.Context, sprintID int, issues *IssuesWrapper) (*Response, error) {
+	apiEndpoint := fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID)
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, issues)
 	if err != nil {
 		return nil, err
 	}
 
 	resp, err := s.client.Do(req, nil)
 	if err != nil {
-		err = NewJiraError(resp, err)
+		return resp, NewJiraError(resp, err)
 	}
-	return resp, err
+
+	return resp, nil
 }
 
 // GetIssuesForSprint returns all issues in a sprint, for a given sprint Id.
@@ -75,6 +297,31 @@ func (s *SprintService) GetIssuesForSprint(ctx context.Context, sprintID int) ([
 	return result.Issues, resp, err
 }
 
+// GetIssues returns a page of issues in a sprint, for a given sprint Id.
+// Unlike GetIssuesForSprint, the issues can be filtered with JQL and paginated via options.
+// This only includes issues that the user has permission to view.
+// By default, the returned issues are ordered by rank.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/software/rest/api-group-sprint/#api-rest-agile-1-0-sprint-sprintid-issue-get
+func (s *SprintService) GetIssues(ctx context.Context, sprintID int, options *AgileIssueListOptions) (*AgileIssueList, *Response, error) {
+	apiEndpoint, err := addOptions(fmt.Sprintf("rest/agile/1.0/sprint/%d/issue", sprintID), options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issues := new(AgileIssueList)
+	resp, err := s.client.Do(req, issues)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return issues, resp, nil
+}
+
 // GetIssue returns a full representation of the issue for the given issue key.
 // Jira will attempt to identify the issue by the issueIdOrKey path parameter.
 // This can be an issue id, or an issue key.
@@ -115,3 +115,328 @@ func TestSprintService_GetIssue(t *testing.T) {
 		t.Errorf("Error given: %s", err)
 	}
 }
+
+func TestSprintService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["name"] != "sprint 1" {
+			t.Errorf("Expected name %q, got %v", "sprint 1", payload["name"])
+		}
+		if _, ok := payload["id"]; ok {
+			t.Errorf("Expected id to be omitted from payload, got %v", payload["id"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":37,"self":"https://your-domain.atlassian.net/rest/agile/1.0/sprint/23","state":"future","name":"sprint 1","originBoardId":5,"goal":"sprint 1 goal"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Create(context.Background(), &Sprint{
+		Name:          "sprint 1",
+		OriginBoardID: 5,
+		Goal:          "sprint 1 goal",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil {
+		t.Fatal("Expected sprint. Sprint is nil")
+	}
+	if sprint.ID != 37 {
+		t.Errorf("Expected sprint ID 37, got %d", sprint.ID)
+	}
+	if sprint.State != SprintStateFuture {
+		t.Errorf("Expected state %s, got %s", SprintStateFuture, sprint.State)
+	}
+}
+
+func TestSprintService_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		fmt.Fprint(w, `{"id":37,"self":"https://your-domain.atlassian.net/rest/agile/1.0/sprint/23","state":"active","name":"sprint 1","startDate":"2015-04-11T15:22:00.000+10:00","endDate":"2015-04-20T01:22:00.000+10:00","createdDate":"2015-04-10T15:22:00.000+10:00","originBoardId":5,"goal":"sprint 1 goal"}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Get(context.Background(), 37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil {
+		t.Fatal("Expected sprint. Sprint is nil")
+	}
+	if sprint.CreatedDate == nil || sprint.StartDate == nil {
+		t.Errorf("Expected createdDate and startDate to be set, got %+v", sprint)
+	}
+	if sprint.OriginBoardID != 5 {
+		t.Errorf("Expected originBoardId 5, got %d", sprint.OriginBoardID)
+	}
+}
+
+func TestSprintService_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		fmt.Fprint(w, `{"id":37,"state":"future","name":"sprint 2","originBoardId":5}`)
+	})
+
+	sprint, _, err := testClient.Sprint.Update(context.Background(), &Sprint{ID: 37, Name: "sprint 2", State: SprintStateFuture})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.Name != "sprint 2" {
+		t.Errorf("Expected updated sprint, got %+v", sprint)
+	}
+}
+
+func TestSprintService_PartialUpdate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if len(payload) != 1 || payload["state"] != SprintStateClosed {
+			t.Errorf("Expected payload to only contain state %q, got %v", SprintStateClosed, payload)
+		}
+
+		fmt.Fprint(w, `{"id":37,"state":"closed","name":"sprint 1","originBoardId":5}`)
+	})
+
+	sprint, _, err := testClient.Sprint.PartialUpdate(context.Background(), 37, &Sprint{State: SprintStateClosed})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if sprint == nil || sprint.State != SprintStateClosed {
+		t.Errorf("Expected closed sprint, got %+v", sprint)
+	}
+}
+
+func TestSprintService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Sprint.Delete(context.Background(), 37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSprintService_MoveIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/123/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["rankAfterIssue"] != "KEY-3" {
+			t.Errorf("Expected rankAfterIssue KEY-3, got %v", payload["rankAfterIssue"])
+		}
+		if _, ok := payload["rankBeforeIssue"]; ok {
+			t.Errorf("Expected rankBeforeIssue to be omitted, got %v", payload["rankBeforeIssue"])
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Sprint.MoveIssues(context.Background(), 123, &IssuesWrapper{
+		Issues:         []string{"KEY-1", "KEY-2"},
+		RankAfterIssue: "KEY-3",
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSprintService_GetIssues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/issue"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		testRequestParams(t, r, map[string]string{
+			"jql":        "status = Done",
+			"fields":     "summary,status",
+			"expand":     "changelog",
+			"startAt":    "50",
+			"maxResults": "50",
+		})
+		fmt.Fprint(w, `{"expand":"schema,names","startAt":50,"maxResults":50,"total":51,"issues":[{"id":"10001","key":"EX-51","fields":{"summary":"Last one"}}]}`)
+	})
+
+	issues, resp, err := testClient.Sprint.GetIssues(context.Background(), 37, &AgileIssueListOptions{
+		JQL:        "status = Done",
+		Fields:     []string{"summary", "status"},
+		Expand:     "changelog",
+		StartAt:    50,
+		MaxResults: 50,
+	})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if issues == nil || len(issues.Issues) != 1 || issues.Issues[0].Key != "EX-51" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+	if resp.StartAt != 50 || resp.Total != 51 {
+		t.Errorf("Expected startAt 50 and total 51. Got %d and %d", resp.StartAt, resp.Total)
+	}
+}
+
+func TestSprintService_Swap(t *testing.T) {
+	setup()
+	defer teardown()
+
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/swap"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]int
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("Got error: %v", err)
+		}
+		if payload["sprintToSwapWith"] != 3 {
+			t.Errorf("Expected sprintToSwapWith 3, got %v", payload)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Sprint.Swap(context.Background(), 37, 3)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSprintService_GetPropertyKeys(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/properties"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"keys":[{"self":"https://your-domain.atlassian.net/rest/agile/1.0/sprint/37/properties/goal.sync","key":"goal.sync"}]}`)
+	})
+
+	keys, _, err := testClient.Sprint.GetPropertyKeys(context.Background(), 37)
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if keys == nil || len(keys.Keys) != 1 || keys.Keys[0].Key != "goal.sync" {
+		t.Errorf("Unexpected keys: %+v", keys)
+	}
+}
+
+func TestSprintService_GetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/properties/goal.sync"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, testAPIEndpoint)
+		fmt.Fprint(w, `{"key":"goal.sync","value":{"externalId":"ABC-123"}}`)
+	})
+
+	property, _, err := testClient.Sprint.GetProperty(context.Background(), 37, "goal.sync")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if property == nil || property.Key != "goal.sync" {
+		t.Fatalf("Unexpected property: %+v", property)
+	}
+	if value, ok := property.Value.(map[string]interface{}); !ok || value["externalId"] != "ABC-123" {
+		t.Errorf("Unexpected property value: %+v", property.Value)
+	}
+}
+
+func TestSprintService_SetProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/properties/goal.sync"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestURL(t, r, testAPIEndpoint)
+
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if payload["externalId"] != "ABC-123" {
+			t.Errorf("Unexpected payload: %+v", payload)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := testClient.Sprint.SetProperty(context.Background(), 37, "goal.sync", map[string]string{"externalId": "ABC-123"})
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestSprintService_DeleteProperty(t *testing.T) {
+	setup()
+	defer teardown()
+	testAPIEndpoint := "/rest/agile/1.0/sprint/37/properties/goal.sync"
+
+	testMux.HandleFunc(testAPIEndpoint, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		testRequestURL(t, r, testAPIEndpoint)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := testClient.Sprint.DeleteProperty(context.Background(), 37, "goal.sync")
+	if err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
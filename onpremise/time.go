@@ -0,0 +1,153 @@
+package onpremise
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Layouts of the timestamps returned by Jira.
+// Jira Cloud uses TimeLayout, Jira Data Center also uses RFC 3339 offsets like "+02:00"
+// and omits the fraction of seconds at some endpoints.
+const (
+	// TimeLayout is the layout of timestamps sent to Jira.
+	TimeLayout = "2006-01-02T15:04:05.000-0700"
+	// DateLayout is the layout of dates, e.g. of due dates and release dates.
+	DateLayout = "2006-01-02"
+)
+
+// timeLayouts are the layouts accepted when parsing timestamps.
+var timeLayouts = []string{
+	"2006-01-02T15:04:05.999-0700",
+	"2006-01-02T15:04:05.999Z07:00",
+	"2006-01-02T15:04:05.999",
+	DateLayout,
+}
+
+// Time represents the Time definition of Jira as a time.Time of go
+type Time time.Time
+
+// Date represents the Date definition of Jira as a time.Time of go
+type Date time.Time
+
+// Equal reports whether t and u represent the same time instant.
+func (t Time) Equal(u Time) bool {
+	return time.Time(t).Equal(time.Time(u))
+}
+
+// IsZero reports whether t is not set.
+func (t Time) IsZero() bool {
+	return time.Time(t).IsZero()
+}
+
+// String returns the time in the format Jira expects.
+func (t Time) String() string {
+	return time.Time(t).Format(TimeLayout)
+}
+
+// Equal reports whether t and u are the same date.
+func (t Date) Equal(u Date) bool {
+	return time.Time(t).Equal(time.Time(u))
+}
+
+// IsZero reports whether t is not set.
+func (t Date) IsZero() bool {
+	return time.Time(t).IsZero()
+}
+
+// String returns the date in the format Jira expects.
+func (t Date) String() string {
+	return time.Time(t).Format(DateLayout)
+}
+
+// parseTime parses a timestamp of Jira: a string in one of the timeLayouts or
+// the number of milliseconds since the epoch, as returned by some Data Center endpoints.
+// null and empty strings are parsed as zero time.
+func parseTime(b []byte) (time.Time, error) {
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || string(b) == "null" {
+		return time.Time{}, nil
+	}
+
+	if b[0] != '"' {
+		ms, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("jira: can not parse time %s", b)
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return time.Time{}, err
+	}
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("jira: can not parse time %q", s)
+}
+
+// UnmarshalJSON will transform the Jira time into a time.Time
+// during the transformation of the Jira JSON response
+func (t *Time) UnmarshalJSON(b []byte) error {
+	ti, err := parseTime(b)
+	if err != nil {
+		return err
+	}
+	*t = Time(ti)
+	return nil
+}
+
+// MarshalJSON will transform the time.Time into a Jira time
+// during the creation of a Jira request.
+// A zero time is sent as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// UnmarshalJSON will transform the Jira date into a time.Time
+// during the transformation of the Jira JSON response.
+// Timestamps are accepted as well.
+func (t *Date) UnmarshalJSON(b []byte) error {
+	ti, err := parseTime(b)
+	if err != nil {
+		return err
+	}
+	*t = Date(ti)
+	return nil
+}
+
+// MarshalJSON will transform the Date object into a short
+// date string as Jira expects during the creation of a
+// Jira request.
+// A zero date is sent as null.
+func (t Date) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.String() + `"`), nil
+}
+
+// NewTime is a helper routine that allocates a new Time value
+// to store t and returns a pointer to it.
+func NewTime(t time.Time) *Time {
+	p := Time(t)
+	return &p
+}
+
+// NewDate is a helper routine that allocates a new Date value
+// for the date of year, month and day and returns a pointer to it.
+func NewDate(year int, month time.Month, day int) *Date {
+	p := Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	return &p
+}
@@ -0,0 +1,121 @@
+package onpremise
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// TransportOptions tunes the connection pool and timeouts of the transport returned by NewTransport.
+// Fields that are 0 keep the defaults of http.DefaultTransport.
+//
+// High-throughput jobs should raise MaxIdleConnsPerHost, which is only 2 by default.
+// Otherwise most connections to Jira are closed after each request
+// and the job may run out of ephemeral ports.
+type TransportOptions struct {
+	// MaxIdleConns is the maximum number of idle connections across all hosts.
+	MaxIdleConns int
+	// MaxIdleConnsPerHost is the maximum number of idle connections kept per host.
+	MaxIdleConnsPerHost int
+	// MaxConnsPerHost limits the number of connections per host, including connections in use.
+	MaxConnsPerHost int
+	// IdleConnTimeout is how long an idle connection is kept before it is closed.
+	IdleConnTimeout time.Duration
+	// TLSHandshakeTimeout is the maximum time waited for a TLS handshake.
+	TLSHandshakeTimeout time.Duration
+	// ResponseHeaderTimeout is the maximum time waited for the response headers after the request is written.
+	ResponseHeaderTimeout time.Duration
+	// DisableHTTP2 only uses HTTP/1.1, e.g. if a proxy in front of Jira handles HTTP/2 badly.
+	DisableHTTP2 bool
+
+	// ProxyURL is the URL of the HTTP proxy all requests are sent through, e.g. "http://proxy.example.com:3128".
+	// The proxy of the environment variables HTTP_PROXY, HTTPS_PROXY and NO_PROXY is used if it is nil.
+	ProxyURL *url.URL
+	// RootCAs are the certificate authorities trusted to verify the certificate of Jira,
+	// e.g. a private CA loaded with LoadCertPool. The CAs of the system are trusted if it is nil.
+	RootCAs *x509.CertPool
+	// Certificates are the client certificates presented for mutual TLS,
+	// e.g. loaded with tls.LoadX509KeyPair.
+	Certificates []tls.Certificate
+}
+
+// NewTransport returns a copy of http.DefaultTransport tuned with options.
+// options may be nil.
+// Use it as the Transport of an auth transport or the http.Client passed to NewClient.
+//
+// Example:
+//
+//	tr := NewTransport(&TransportOptions{MaxIdleConnsPerHost: 50, IdleConnTimeout: time.Minute})
+//	client, err := NewClient("https://jira.example.com", &http.Client{Transport: tr})
+//
+// Example of an instance behind a corporate proxy with a private CA and mutual TLS:
+//
+//	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+//	rootCAs, err := LoadCertPool("/etc/ssl/corporate-ca.pem")
+//	cert, err := tls.LoadX509KeyPair("client.crt", "client.key")
+//	tr := NewTransport(&TransportOptions{ProxyURL: proxyURL, RootCAs: rootCAs, Certificates: []tls.Certificate{cert}})
+func NewTransport(options *TransportOptions) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if options == nil {
+		return t
+	}
+
+	if options.MaxIdleConns > 0 {
+		t.MaxIdleConns = options.MaxIdleConns
+	}
+	if options.MaxIdleConnsPerHost > 0 {
+		t.MaxIdleConnsPerHost = options.MaxIdleConnsPerHost
+	}
+	if options.MaxConnsPerHost > 0 {
+		t.MaxConnsPerHost = options.MaxConnsPerHost
+	}
+	if options.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = options.IdleConnTimeout
+	}
+	if options.TLSHandshakeTimeout > 0 {
+		t.TLSHandshakeTimeout = options.TLSHandshakeTimeout
+	}
+	if options.ResponseHeaderTimeout > 0 {
+		t.ResponseHeaderTimeout = options.ResponseHeaderTimeout
+	}
+	if options.DisableHTTP2 {
+		t.ForceAttemptHTTP2 = false
+		// A non-nil, empty map disables HTTP/2.
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	if options.ProxyURL != nil {
+		t.Proxy = http.ProxyURL(options.ProxyURL)
+	}
+	if options.RootCAs != nil || len(options.Certificates) > 0 {
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{}
+		}
+		t.TLSClientConfig.RootCAs = options.RootCAs
+		t.TLSClientConfig.Certificates = options.Certificates
+	}
+	return t
+}
+
+// LoadCertPool returns the certificate authorities of the system extended with the PEM encoded
+// certificates of the files, e.g. a CA bundle of a private CA, for TransportOptions.RootCAs.
+func LoadCertPool(files ...string) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("jira: reading CA certificates: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.New("jira: no PEM encoded certificates in " + file)
+		}
+	}
+	return pool, nil
+}
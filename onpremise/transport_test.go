@@ -0,0 +1,127 @@
+package onpremise
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewTransport(t *testing.T) {
+	tr := NewTransport(&TransportOptions{
+		MaxIdleConns:          200,
+		MaxIdleConnsPerHost:   50,
+		MaxConnsPerHost:       100,
+		IdleConnTimeout:       time.Minute,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+	})
+
+	if tr.MaxIdleConns != 200 || tr.MaxIdleConnsPerHost != 50 || tr.MaxConnsPerHost != 100 {
+		t.Errorf("Unexpected connection limits %d, %d, %d", tr.MaxIdleConns, tr.MaxIdleConnsPerHost, tr.MaxConnsPerHost)
+	}
+	if tr.IdleConnTimeout != time.Minute || tr.TLSHandshakeTimeout != 5*time.Second || tr.ResponseHeaderTimeout != 30*time.Second {
+		t.Errorf("Unexpected timeouts %s, %s, %s", tr.IdleConnTimeout, tr.TLSHandshakeTimeout, tr.ResponseHeaderTimeout)
+	}
+	if !tr.ForceAttemptHTTP2 {
+		t.Error("Expected HTTP/2 to be enabled")
+	}
+	if tr == http.DefaultTransport {
+		t.Error("Expected a copy of http.DefaultTransport")
+	}
+}
+
+func TestNewTransport_Defaults(t *testing.T) {
+	tr := NewTransport(nil)
+	def := http.DefaultTransport.(*http.Transport)
+
+	if tr.MaxIdleConns != def.MaxIdleConns || tr.IdleConnTimeout != def.IdleConnTimeout || tr.TLSHandshakeTimeout != def.TLSHandshakeTimeout {
+		t.Errorf("Expected the defaults of http.DefaultTransport, got %+v", tr)
+	}
+}
+
+func TestNewTransport_DisableHTTP2(t *testing.T) {
+	tr := NewTransport(&TransportOptions{DisableHTTP2: true})
+
+	if tr.ForceAttemptHTTP2 || tr.TLSNextProto == nil {
+		t.Error("Expected HTTP/2 to be disabled")
+	}
+}
+
+func TestNewTransport_Proxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		fmt.Fprint(w, `{}`)
+	}))
+	defer proxy.Close()
+	proxyURL, _ := url.Parse(proxy.URL)
+
+	client := &http.Client{Transport: NewTransport(&TransportOptions{ProxyURL: proxyURL})}
+	resp, err := client.Get("http://jira.example.com/rest/api/2/serverInfo")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	resp.Body.Close()
+
+	if proxied != "http://jira.example.com/rest/api/2/serverInfo" {
+		t.Errorf("Expected the request to be sent through the proxy, got %q", proxied)
+	}
+}
+
+func TestNewTransport_TLS(t *testing.T) {
+	var clientCerts int
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		clientCerts = len(r.TLS.PeerCertificates)
+		fmt.Fprint(w, `{}`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	// The certificate of the server is used as CA and client certificate.
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pemCertificate(server.Certificate().Raw), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rootCAs, err := LoadCertPool(caFile)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	cert := server.TLS.Certificates[0]
+
+	client := &http.Client{Transport: NewTransport(&TransportOptions{RootCAs: rootCAs, Certificates: []tls.Certificate{cert}})}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	resp.Body.Close()
+
+	if clientCerts != 1 {
+		t.Errorf("Expected a client certificate, got %d", clientCerts)
+	}
+}
+
+func TestLoadCertPool_Invalid(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(file, []byte("no certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadCertPool(file); err == nil {
+		t.Error("Expected an error for a file without certificates")
+	}
+	if _, err := LoadCertPool(filepath.Join(t.TempDir(), "missing.pem")); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+func pemCertificate(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
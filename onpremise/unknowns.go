@@ -0,0 +1,97 @@
+package onpremise
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/trivago/tgo/tcontainer"
+)
+
+// unmarshalWithUnknowns decodes data into v and returns the fields of data
+// which are not covered by the JSON tags of v, nil if there are none.
+// v must be a pointer to a type without UnmarshalJSON method, e.g. a type alias of the struct.
+func unmarshalWithUnknowns(data []byte, v interface{}) (tcontainer.MarshalMap, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	// The values are kept raw, so only the unknown fields are decoded again, not e.g. the fields of an issue.
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		// Not an object, e.g. null.
+		return nil, nil
+	}
+	for key := range jsonFieldNames(reflect.TypeOf(v).Elem()) {
+		delete(raw, key)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	fields := make(tcontainer.MarshalMap, len(raw))
+	for key, value := range raw {
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return nil, err
+		}
+		fields[key] = decoded
+	}
+	return fields, nil
+}
+
+// marshalWithUnknowns encodes v with the unknowns added.
+// Fields of v take precedence over unknowns with the same name.
+// v must be a type without MarshalJSON method, e.g. a type alias of the struct.
+func marshalWithUnknowns(v interface{}, unknowns tcontainer.MarshalMap) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(unknowns) == 0 {
+		return data, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range unknowns {
+		if _, ok := fields[key]; ok {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = raw
+	}
+	return json.Marshal(fields)
+}
+
+// jsonFieldNames returns the JSON names of the fields of the struct type t, including embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := map[string]struct{}{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for n := range jsonFieldNames(ft) {
+					names[n] = struct{}{}
+				}
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = struct{}{}
+	}
+	return names
+}
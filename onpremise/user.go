@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // UserService handles users for the Jira instance / API.
@@ -27,6 +29,29 @@ type User struct {
 	TimeZone        string     `json:"timeZone,omitempty" structs:"timeZone,omitempty"`
 	Locale          string     `json:"locale,omitempty" structs:"locale,omitempty"`
 	ApplicationKeys []string   `json:"applicationKeys,omitempty" structs:"applicationKeys,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the User struct.
+// It adds the Unknowns to the encoded fields.
+func (u User) MarshalJSON() ([]byte, error) {
+	type Alias User
+	return marshalWithUnknowns(Alias(u), u.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the User struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (u *User) UnmarshalJSON(data []byte) error {
+	type Alias User
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(u))
+	if err != nil {
+		return err
+	}
+	u.Unknowns = unknowns
+	return nil
 }
 
 // UserGroup represents the group list
@@ -0,0 +1,230 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+)
+
+// Statuses of an AnonymizationProgress.
+const (
+	AnonymizationStatusInProgress       = "IN_PROGRESS"
+	AnonymizationStatusCompleted        = "COMPLETED"
+	AnonymizationStatusInterrupted      = "INTERRUPTED"
+	AnonymizationStatusValidationFailed = "VALIDATION_FAILED"
+)
+
+// AnonymizationErrors are the errors or warnings of an anonymization.
+type AnonymizationErrors struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty" structs:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty" structs:"errors,omitempty"`
+}
+
+// AnonymizationAffectedEntity is an entity changed by the anonymization of a user, e.g. a filter owned by the user.
+type AnonymizationAffectedEntity struct {
+	Type                string `json:"type,omitempty" structs:"type,omitempty"`
+	Description         string `json:"description,omitempty" structs:"description,omitempty"`
+	NumberOfOccurrences int    `json:"numberOfOccurrences,omitempty" structs:"numberOfOccurrences,omitempty"`
+	URIDisplayName      string `json:"uriDisplayName,omitempty" structs:"uriDisplayName,omitempty"`
+	URI                 string `json:"uri,omitempty" structs:"uri,omitempty"`
+}
+
+// AnonymizationValidation is the result of the validation of an anonymization.
+// The anonymization can be scheduled if Success is true.
+type AnonymizationValidation struct {
+	Errors   AnonymizationErrors `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings AnonymizationErrors `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	Expand   string              `json:"expand,omitempty" structs:"expand,omitempty"`
+	Deleted  bool                `json:"deleted,omitempty" structs:"deleted,omitempty"`
+	Email    string              `json:"email,omitempty" structs:"email,omitempty"`
+	// AffectedEntities are the entities changed by the anonymization by their handler, with the expand "affectedEntities".
+	AffectedEntities              map[string][]AnonymizationAffectedEntity `json:"affectedEntities,omitempty" structs:"affectedEntities,omitempty"`
+	UserKey                       string                                   `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName                      string                                   `json:"userName,omitempty" structs:"userName,omitempty"`
+	DisplayName                   string                                   `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	Success                       bool                                     `json:"success,omitempty" structs:"success,omitempty"`
+	Operations                    []string                                 `json:"operations,omitempty" structs:"operations,omitempty"`
+	BusinessLogicValidationFailed bool                                     `json:"businessLogicValidationFailed,omitempty" structs:"businessLogicValidationFailed,omitempty"`
+}
+
+// AnonymizationProgress is the progress of a scheduled anonymization.
+type AnonymizationProgress struct {
+	Errors   AnonymizationErrors `json:"errors,omitempty" structs:"errors,omitempty"`
+	Warnings AnonymizationErrors `json:"warnings,omitempty" structs:"warnings,omitempty"`
+	UserKey  string              `json:"userKey,omitempty" structs:"userKey,omitempty"`
+	UserName string              `json:"userName,omitempty" structs:"userName,omitempty"`
+	FullName string              `json:"fullName,omitempty" structs:"fullName,omitempty"`
+	// ProgressURL is the URL of the progress, e.g. "/rest/api/2/user/anonymization/progress?taskId=10000".
+	ProgressURL     string   `json:"progressUrl,omitempty" structs:"progressUrl,omitempty"`
+	CurrentProgress int      `json:"currentProgress,omitempty" structs:"currentProgress,omitempty"`
+	CurrentSubTask  string   `json:"currentSubTask,omitempty" structs:"currentSubTask,omitempty"`
+	SubmittedTime   *Time    `json:"submittedTime,omitempty" structs:"submittedTime,omitempty"`
+	StartTime       *Time    `json:"startTime,omitempty" structs:"startTime,omitempty"`
+	FinishTime      *Time    `json:"finishTime,omitempty" structs:"finishTime,omitempty"`
+	Operations      []string `json:"operations,omitempty" structs:"operations,omitempty"`
+	// Status is one of the AnonymizationStatus constants.
+	Status        string `json:"status,omitempty" structs:"status,omitempty"`
+	IsRerun       bool   `json:"isRerun,omitempty" structs:"isRerun,omitempty"`
+	ExecutingNode string `json:"executingNode,omitempty" structs:"executingNode,omitempty"`
+}
+
+// Done reports whether the anonymization finished, successfully or not.
+func (p *AnonymizationProgress) Done() bool {
+	return p.Status != "" && p.Status != AnonymizationStatusInProgress
+}
+
+// AnonymizationOptions are the options of UserService.ValidateAnonymization.
+type AnonymizationOptions struct {
+	// UserKey is the key of the user to anonymize.
+	UserKey string `url:"userKey"`
+	// Expand expands the validation, e.g. with "affectedEntities".
+	Expand string `url:"expand,omitempty"`
+}
+
+// AnonymizationRequest schedules the anonymization of a user.
+type AnonymizationRequest struct {
+	// UserKey is the key of the user to anonymize.
+	UserKey string `json:"userKey" structs:"userKey"`
+	// NewOwnerKey is the key of the user the entities owned by the anonymized user are transferred to.
+	NewOwnerKey string `json:"newOwnerKey,omitempty" structs:"newOwnerKey,omitempty"`
+}
+
+// AnonymizationRerunOptions are the options of UserService.ValidateAnonymizationRerun.
+type AnonymizationRerunOptions struct {
+	// OldUserKey is the key of the user before the anonymization.
+	OldUserKey string `url:"oldUserKey,omitempty"`
+	// OldUserName is the name of the user before the anonymization.
+	OldUserName string `url:"oldUserName,omitempty"`
+	// Expand expands the validation, e.g. with "affectedEntities".
+	Expand string `url:"expand,omitempty"`
+}
+
+// AnonymizationRerunRequest schedules the rerun of an anonymization,
+// e.g. to anonymize data the previous anonymization missed.
+type AnonymizationRerunRequest struct {
+	// UserKey is the key of the anonymized user.
+	UserKey string `json:"userKey" structs:"userKey"`
+	// OldUserKey is the key of the user before the anonymization.
+	OldUserKey string `json:"oldUserKey,omitempty" structs:"oldUserKey,omitempty"`
+	// OldUserName is the name of the user before the anonymization.
+	OldUserName string `json:"oldUserName,omitempty" structs:"oldUserName,omitempty"`
+	// NewOwnerKey is the key of the user the entities owned by the anonymized user are transferred to.
+	NewOwnerKey string `json:"newOwnerKey,omitempty" structs:"newOwnerKey,omitempty"`
+}
+
+// ValidateAnonymization validates the anonymization of a user before it is scheduled.
+// It requires the system administrator permission.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-validateUserAnonymization
+func (s *UserService) ValidateAnonymization(ctx context.Context, options *AnonymizationOptions) (*AnonymizationValidation, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/anonymization", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validation := new(AnonymizationValidation)
+	resp, err := s.client.Do(req, validation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return validation, resp, nil
+}
+
+// ScheduleAnonymization schedules the anonymization of a user.
+// Only one anonymization runs at a time, its progress is returned by GetAnonymizationProgress.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-scheduleUserAnonymization
+func (s *UserService) ScheduleAnonymization(ctx context.Context, anonymization *AnonymizationRequest) (*AnonymizationProgress, *Response, error) {
+	return s.scheduleAnonymization(ctx, "rest/api/2/user/anonymization", anonymization)
+}
+
+// GetAnonymizationProgress returns the progress of the anonymization with the task ID,
+// or of the last anonymization if taskID is 0.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-getProgress
+func (s *UserService) GetAnonymizationProgress(ctx context.Context, taskID int64) (*AnonymizationProgress, *Response, error) {
+	options := struct {
+		TaskID int64 `url:"taskId,omitempty"`
+	}{taskID}
+	apiEndpoint, err := addOptions("rest/api/2/user/anonymization/progress", &options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(AnonymizationProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return progress, resp, nil
+}
+
+// ValidateAnonymizationRerun validates the rerun of the anonymization of a user before it is scheduled.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-validateUserAnonymizationRerun
+func (s *UserService) ValidateAnonymizationRerun(ctx context.Context, options *AnonymizationRerunOptions) (*AnonymizationValidation, *Response, error) {
+	apiEndpoint, err := addOptions("rest/api/2/user/anonymization/rerun", options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	validation := new(AnonymizationValidation)
+	resp, err := s.client.Do(req, validation)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return validation, resp, nil
+}
+
+// ScheduleAnonymizationRerun schedules the rerun of the anonymization of a user.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-scheduleUserAnonymizationRerun
+func (s *UserService) ScheduleAnonymizationRerun(ctx context.Context, rerun *AnonymizationRerunRequest) (*AnonymizationProgress, *Response, error) {
+	return s.scheduleAnonymization(ctx, "rest/api/2/user/anonymization/rerun", rerun)
+}
+
+// UnlockAnonymization removes a stale lock of an anonymization, e.g. after a node of the cluster failed.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user/anonymization-unlockAnonymization
+func (s *UserService) UnlockAnonymization(ctx context.Context) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, "rest/api/2/user/anonymization/unlock", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+func (s *UserService) scheduleAnonymization(ctx context.Context, apiEndpoint string, body interface{}) (*AnonymizationProgress, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(AnonymizationProgress)
+	resp, err := s.client.Do(req, progress)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return progress, resp, nil
+}
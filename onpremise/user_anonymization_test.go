@@ -0,0 +1,131 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_ValidateAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"userKey": "JIRAUSER10100", "expand": "affectedEntities"})
+		fmt.Fprint(w, `{"errors":{"errorMessages":[],"errors":{}},"warnings":{"errorMessages":["The user is the lead of a project."],"errors":{}},
+			"expand":"affectedEntities","deleted":false,"email":"fred@example.com","userKey":"JIRAUSER10100","userName":"fred","displayName":"Fred F. User",
+			"success":true,"operations":["USER_NAME_CHANGE","USER_KEY_CHANGE"],"businessLogicValidationFailed":false,
+			"affectedEntities":{"ANONYMIZE":[{"type":"ANONYMIZE","description":"Filters","numberOfOccurrences":2,"uriDisplayName":"Filters","uri":"/secure/ManageFilters.jspa"}]}}`)
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymization(context.Background(), &AnonymizationOptions{UserKey: "JIRAUSER10100", Expand: "affectedEntities"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !validation.Success || validation.UserName != "fred" || len(validation.Operations) != 2 {
+		t.Errorf("Unexpected validation %+v", validation)
+	}
+	if entities := validation.AffectedEntities["ANONYMIZE"]; len(entities) != 1 || entities[0].NumberOfOccurrences != 2 {
+		t.Errorf("Unexpected affected entities %+v", validation.AffectedEntities)
+	}
+	if len(validation.Warnings.ErrorMessages) != 1 {
+		t.Errorf("Expected a warning, got %+v", validation.Warnings)
+	}
+}
+
+func TestUserService_ScheduleAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body AnonymizationRequest
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if body.UserKey != "JIRAUSER10100" || body.NewOwnerKey != "admin" {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","userName":"fred","progressUrl":"/rest/api/2/user/anonymization/progress?taskId=10000",
+			"currentProgress":0,"submittedTime":"2020-12-01T10:00:00.000+0100","status":"IN_PROGRESS","isRerun":false}`)
+	})
+
+	progress, resp, err := testClient.User.ScheduleAnonymization(context.Background(), &AnonymizationRequest{UserKey: "JIRAUSER10100", NewOwnerKey: "admin"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if resp.StatusCode != http.StatusAccepted || progress.Status != AnonymizationStatusInProgress || progress.Done() {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+	if progress.SubmittedTime == nil || progress.SubmittedTime.IsZero() {
+		t.Error("Expected the submitted time")
+	}
+}
+
+func TestUserService_GetAnonymizationProgress(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/progress", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestURL(t, r, "/rest/api/2/user/anonymization/progress?taskId=10000")
+		fmt.Fprint(w, `{"userKey":"JIRAUSER10100","currentProgress":100,"finishTime":"2020-12-01T10:05:00.000+0100","status":"COMPLETED"}`)
+	})
+
+	progress, _, err := testClient.User.GetAnonymizationProgress(context.Background(), 10000)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !progress.Done() || progress.CurrentProgress != 100 {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+}
+
+func TestUserService_AnonymizationRerun(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/rerun", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			testRequestParams(t, r, map[string]string{"oldUserKey": "fred"})
+			fmt.Fprint(w, `{"userKey":"JIRAUSER10100","success":true}`)
+		case http.MethodPost:
+			var body AnonymizationRerunRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body.UserKey != "JIRAUSER10100" || body.OldUserKey != "fred" {
+				t.Errorf("Unexpected body %+v", body)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"userKey":"JIRAUSER10100","status":"IN_PROGRESS","isRerun":true}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	validation, _, err := testClient.User.ValidateAnonymizationRerun(context.Background(), &AnonymizationRerunOptions{OldUserKey: "fred"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !validation.Success {
+		t.Errorf("Unexpected validation %+v", validation)
+	}
+	progress, _, err := testClient.User.ScheduleAnonymizationRerun(context.Background(), &AnonymizationRerunRequest{UserKey: "JIRAUSER10100", OldUserKey: "fred"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !progress.IsRerun {
+		t.Errorf("Unexpected progress %+v", progress)
+	}
+}
+
+func TestUserService_UnlockAnonymization(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/anonymization/unlock", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.UnlockAnonymization(context.Background()); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,143 @@
+package onpremise
+
+import (
+	"context"
+	"net/http"
+)
+
+// PasswordChange changes the password of a user with UserService.SetPassword.
+type PasswordChange struct {
+	Password string `json:"password" structs:"password"`
+	// CurrentPassword is the current password, required to change the password of the current user.
+	CurrentPassword string `json:"currentPassword,omitempty" structs:"currentPassword,omitempty"`
+}
+
+// PasswordPolicyUser is a user whose new password is checked against the password policy.
+type PasswordPolicyUser struct {
+	Username     string `json:"username" structs:"username"`
+	DisplayName  string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	EmailAddress string `json:"emailAddress,omitempty" structs:"emailAddress,omitempty"`
+	// Password is the password of a new user for UserService.CheckPasswordPolicyCreateUser.
+	Password string `json:"password,omitempty" structs:"password,omitempty"`
+	// OldPassword and NewPassword are the passwords of an existing user for UserService.CheckPasswordPolicyUpdateUser.
+	OldPassword string `json:"oldPassword,omitempty" structs:"oldPassword,omitempty"`
+	NewPassword string `json:"newPassword,omitempty" structs:"newPassword,omitempty"`
+}
+
+// SetPassword sets the password of the user with the username in the internal directory.
+// It requires the system administrator permission for other users.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-changeUserPassword
+func (s *UserService) SetPassword(ctx context.Context, username string, change *PasswordChange) (*Response, error) {
+	options := struct {
+		Username string `url:"username"`
+	}{username}
+	apiEndpoint, err := addOptions("rest/api/2/user/password", &options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodPut, apiEndpoint, change)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// GetPasswordPolicy returns the descriptions of the rules of the password policy.
+// hasOldPassword includes the rules about the old password, e.g. for password changes.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/password-getPasswordPolicy
+func (s *UserService) GetPasswordPolicy(ctx context.Context, hasOldPassword bool) ([]string, *Response, error) {
+	options := struct {
+		HasOldPassword bool `url:"hasOldPassword"`
+	}{hasOldPassword}
+	apiEndpoint, err := addOptions("rest/api/2/password/policy", &options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest(ctx, http.MethodGet, apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []string
+	resp, err := s.client.Do(req, &rules)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return rules, resp, nil
+}
+
+// CheckPasswordPolicyCreateUser checks the password of a new user against the password policy,
+// e.g. before the user is created. It returns the violated rules, empty if the password is valid.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/password-policyCheckCreateUser
+func (s *UserService) CheckPasswordPolicyCreateUser(ctx context.Context, user *PasswordPolicyUser) ([]string, *Response, error) {
+	return s.checkPasswordPolicy(ctx, "rest/api/2/password/policy/createUser", user)
+}
+
+// CheckPasswordPolicyUpdateUser checks the new password of an existing user against the password policy.
+// It returns the violated rules, empty if the password is valid.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/password-policyCheckUpdateUser
+func (s *UserService) CheckPasswordPolicyUpdateUser(ctx context.Context, user *PasswordPolicyUser) ([]string, *Response, error) {
+	return s.checkPasswordPolicy(ctx, "rest/api/2/password/policy/updateUser", user)
+}
+
+func (s *UserService) checkPasswordPolicy(ctx context.Context, apiEndpoint string, user *PasswordPolicyUser) ([]string, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodPost, apiEndpoint, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var violations []string
+	resp, err := s.client.Do(req, &violations)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return violations, resp, nil
+}
+
+// AddToApplication grants the user with the username access to the application, e.g. "jira-software".
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-addUserToApplication
+func (s *UserService) AddToApplication(ctx context.Context, username, applicationKey string) (*Response, error) {
+	return s.application(ctx, http.MethodPost, username, applicationKey)
+}
+
+// RemoveFromApplication revokes the access of the user with the username to the application.
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/8.13.0/#api/2/user-removeUserFromApplication
+func (s *UserService) RemoveFromApplication(ctx context.Context, username, applicationKey string) (*Response, error) {
+	return s.application(ctx, http.MethodDelete, username, applicationKey)
+}
+
+func (s *UserService) application(ctx context.Context, method, username, applicationKey string) (*Response, error) {
+	options := struct {
+		Username       string `url:"username"`
+		ApplicationKey string `url:"applicationKey"`
+	}{username, applicationKey}
+	apiEndpoint, err := addOptions("rest/api/2/user/application", &options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
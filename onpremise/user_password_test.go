@@ -0,0 +1,91 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestUserService_SetPassword(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/password", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		testRequestParams(t, r, map[string]string{"username": "fred"})
+		var body PasswordChange
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Password != "s3cret!" {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.User.SetPassword(context.Background(), "fred", &PasswordChange{Password: "s3cret!"}); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
+
+func TestUserService_GetPasswordPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/password/policy", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testRequestParams(t, r, map[string]string{"hasOldPassword": "false"})
+		fmt.Fprint(w, `["The password must be at least 8 characters long."]`)
+	})
+
+	rules, _, err := testClient.User.GetPasswordPolicy(context.Background(), false)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(rules) != 1 {
+		t.Errorf("Expected 1 rule, got %v", rules)
+	}
+}
+
+func TestUserService_CheckPasswordPolicyCreateUser(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/password/policy/createUser", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body PasswordPolicyUser
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Username != "fred" || body.Password != "short" {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		fmt.Fprint(w, `["The password must be at least 8 characters long."]`)
+	})
+
+	violations, _, err := testClient.User.CheckPasswordPolicyCreateUser(context.Background(), &PasswordPolicyUser{Username: "fred", Password: "short"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(violations) != 1 {
+		t.Errorf("Expected 1 violation, got %v", violations)
+	}
+}
+
+func TestUserService_AddToApplication(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/api/2/user/application", func(w http.ResponseWriter, r *http.Request) {
+		testRequestParams(t, r, map[string]string{"username": "fred", "applicationKey": "jira-software"})
+		if r.Method != http.MethodPost && r.Method != http.MethodDelete {
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := testClient.User.AddToApplication(ctx, "fred", "jira-software"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+	if _, err := testClient.User.RemoveFromApplication(ctx, "fred", "jira-software"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
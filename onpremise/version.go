@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/trivago/tgo/tcontainer"
 )
 
 // VersionService handles Versions for the Jira instance / API.
@@ -20,10 +22,33 @@ type Version struct {
 	Description     string `json:"description,omitempty" structs:"description,omitempty"`
 	Archived        *bool  `json:"archived,omitempty" structs:"archived,omitempty"`
 	Released        *bool  `json:"released,omitempty" structs:"released,omitempty"`
-	ReleaseDate     string `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
+	ReleaseDate     *Date  `json:"releaseDate,omitempty" structs:"releaseDate,omitempty"`
 	UserReleaseDate string `json:"userReleaseDate,omitempty" structs:"userReleaseDate,omitempty"`
 	ProjectID       int    `json:"projectId,omitempty" structs:"projectId,omitempty"` // Unlike other IDs, this is returned as a number
-	StartDate       string `json:"startDate,omitempty" structs:"startDate,omitempty"`
+	StartDate       *Date  `json:"startDate,omitempty" structs:"startDate,omitempty"`
+
+	// Unknowns are the fields returned by Jira which are not covered by the struct.
+	// They are kept, so they are sent again if the value is sent back to Jira.
+	Unknowns tcontainer.MarshalMap `json:"-" structs:"-"`
+}
+
+// MarshalJSON is a custom JSON marshal function for the Version struct.
+// It adds the Unknowns to the encoded fields.
+func (v Version) MarshalJSON() ([]byte, error) {
+	type Alias Version
+	return marshalWithUnknowns(Alias(v), v.Unknowns)
+}
+
+// UnmarshalJSON is a custom JSON unmarshal function for the Version struct.
+// It keeps the fields not covered by the struct in Unknowns.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	type Alias Version
+	unknowns, err := unmarshalWithUnknowns(data, (*Alias)(v))
+	if err != nil {
+		return err
+	}
+	v.Unknowns = unknowns
+	return nil
 }
 
 // Get gets version info from Jira
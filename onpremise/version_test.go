@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestVersionService_Get_Success(t *testing.T) {
@@ -64,9 +65,9 @@ func TestVersionService_Create(t *testing.T) {
 		ProjectID:       10000,
 		Released:        Bool(true),
 		Archived:        Bool(false),
-		ReleaseDate:     "2010-07-06",
+		ReleaseDate:     NewDate(2010, time.July, 6),
 		UserReleaseDate: "6/Jul/2010",
-		StartDate:       "2018-07-01",
+		StartDate:       NewDate(2018, time.July, 1),
 	}
 
 	version, _, err := testClient.Version.Create(context.Background(), v)
@@ -0,0 +1,156 @@
+package onpremise
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+// WebhookService handles the webhooks of the Jira instance with the REST API of Jira Server and Data Center.
+// It requires the administrator permission.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/
+type WebhookService service
+
+// WebhookIssueFilter is the key of Webhook.Filters of the JQL filtering the issue related events.
+const WebhookIssueFilter = "issue-related-events-section"
+
+// Events of a Webhook, see the API docs for all events.
+const (
+	WebhookEventIssueCreated     = "jira:issue_created"
+	WebhookEventIssueUpdated     = "jira:issue_updated"
+	WebhookEventIssueDeleted     = "jira:issue_deleted"
+	WebhookEventWorklogUpdated   = "jira:worklog_updated"
+	WebhookEventCommentCreated   = "comment_created"
+	WebhookEventCommentUpdated   = "comment_updated"
+	WebhookEventCommentDeleted   = "comment_deleted"
+	WebhookEventProjectCreated   = "project_created"
+	WebhookEventProjectUpdated   = "project_updated"
+	WebhookEventProjectDeleted   = "project_deleted"
+	WebhookEventVersionReleased  = "jira:version_released"
+	WebhookEventSprintStarted    = "sprint_started"
+	WebhookEventSprintClosed     = "sprint_closed"
+	WebhookEventUserCreated      = "user_created"
+	WebhookEventIssueLinkCreated = "issuelink_created"
+)
+
+// Webhook is a webhook of the Jira instance.
+type Webhook struct {
+	// Self is the URL of the webhook, ending with its ID.
+	Self   string   `json:"self,omitempty" structs:"self,omitempty"`
+	Name   string   `json:"name" structs:"name"`
+	URL    string   `json:"url" structs:"url"`
+	Events []string `json:"events" structs:"events"`
+	// Filters are the filters of the events by section, e.g. the JQL of the issue related events with WebhookIssueFilter.
+	Filters map[string]string `json:"filters,omitempty" structs:"filters,omitempty"`
+	// ExcludeBody sends the requests of the webhook without body.
+	ExcludeBody bool `json:"excludeBody" structs:"excludeBody"`
+	Enabled     bool `json:"enabled" structs:"enabled"`
+	// LastUpdated is the time of the last update in milliseconds since the epoch.
+	LastUpdated            int64  `json:"lastUpdated,omitempty" structs:"lastUpdated,omitempty"`
+	LastUpdatedUser        string `json:"lastUpdatedUser,omitempty" structs:"lastUpdatedUser,omitempty"`
+	LastUpdatedDisplayName string `json:"lastUpdatedDisplayName,omitempty" structs:"lastUpdatedDisplayName,omitempty"`
+}
+
+// ID returns the ID of the webhook, the last element of Self.
+func (w *Webhook) ID() string {
+	if w.Self == "" {
+		return ""
+	}
+	return path.Base(w.Self)
+}
+
+// GetAll returns all webhooks.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#querying-webhooks
+func (s *WebhookService) GetAll(ctx context.Context) ([]Webhook, *Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodGet, "rest/webhooks/1.0/webhook", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var webhooks []Webhook
+	resp, err := s.client.Do(req, &webhooks)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return webhooks, resp, nil
+}
+
+// Get returns the webhook with the ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#querying-webhooks
+func (s *WebhookService) Get(ctx context.Context, webhookID string) (*Webhook, *Response, error) {
+	return s.do(ctx, http.MethodGet, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID), nil)
+}
+
+// Create creates the webhook and returns it with its Self URL.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Create(ctx context.Context, webhook *Webhook) (*Webhook, *Response, error) {
+	return s.do(ctx, http.MethodPost, "rest/webhooks/1.0/webhook", webhook)
+}
+
+// Update replaces the webhook with the ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#registering-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Update(ctx context.Context, webhookID string, webhook *Webhook) (*Webhook, *Response, error) {
+	return s.do(ctx, http.MethodPut, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID), webhook)
+}
+
+// Delete deletes the webhook with the ID.
+//
+// Jira API docs: https://developer.atlassian.com/server/jira/platform/webhooks/#deleting-a-webhook-via-the-jira-rest-api
+func (s *WebhookService) Delete(ctx context.Context, webhookID string) (*Response, error) {
+	req, err := s.client.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", webhookID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+
+	return resp, nil
+}
+
+// Enable enables the webhook with the ID.
+// The API has no endpoint for it, so the webhook is updated with Enabled set.
+func (s *WebhookService) Enable(ctx context.Context, webhookID string) (*Webhook, *Response, error) {
+	return s.setEnabled(ctx, webhookID, true)
+}
+
+// Disable disables the webhook with the ID, like Enable.
+func (s *WebhookService) Disable(ctx context.Context, webhookID string) (*Webhook, *Response, error) {
+	return s.setEnabled(ctx, webhookID, false)
+}
+
+func (s *WebhookService) setEnabled(ctx context.Context, webhookID string, enabled bool) (*Webhook, *Response, error) {
+	webhook, resp, err := s.Get(ctx, webhookID)
+	if err != nil {
+		return nil, resp, err
+	}
+	if webhook.Enabled == enabled {
+		return webhook, resp, nil
+	}
+	webhook.Enabled = enabled
+	return s.Update(ctx, webhookID, webhook)
+}
+
+func (s *WebhookService) do(ctx context.Context, method, apiEndpoint string, body interface{}) (*Webhook, *Response, error) {
+	req, err := s.client.NewRequest(ctx, method, apiEndpoint, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	webhook := new(Webhook)
+	resp, err := s.client.Do(req, webhook)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+
+	return webhook, resp, nil
+}
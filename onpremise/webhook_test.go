@@ -0,0 +1,108 @@
+package onpremise
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testWebhook = `{"self":"https://jira.example.com/rest/webhooks/1.0/webhook/7","name":"Build","url":"https://ci.example.com/hook","events":["jira:issue_created","jira:issue_updated"],"filters":{"issue-related-events-section":"project = EX"},"excludeBody":false,"enabled":%t,"lastUpdated":1609459200000,"lastUpdatedUser":"admin"}`
+
+func TestWebhookService_GetAll(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, "["+testWebhook+"]", true)
+	})
+
+	webhooks, _, err := testClient.Webhook.GetAll(context.Background())
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(webhooks) != 1 {
+		t.Fatalf("Expected 1 webhook, got %d", len(webhooks))
+	}
+	webhook := webhooks[0]
+	if webhook.ID() != "7" || webhook.Name != "Build" || !webhook.Enabled || webhook.Filters[WebhookIssueFilter] != "project = EX" {
+		t.Errorf("Unexpected webhook %+v", webhook)
+	}
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body Webhook
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Name != "Build" || len(body.Events) != 2 || !body.Enabled {
+			t.Errorf("Unexpected body %+v", body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, testWebhook, true)
+	})
+
+	webhook, _, err := testClient.Webhook.Create(context.Background(), &Webhook{
+		Name:    "Build",
+		URL:     "https://ci.example.com/hook",
+		Events:  []string{WebhookEventIssueCreated, WebhookEventIssueUpdated},
+		Filters: map[string]string{WebhookIssueFilter: "project = EX"},
+		Enabled: true,
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if webhook.ID() != "7" {
+		t.Errorf("Expected ID 7, got %s", webhook.ID())
+	}
+}
+
+func TestWebhookService_Disable(t *testing.T) {
+	setup()
+	defer teardown()
+	updated := false
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook/7", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprintf(w, testWebhook, true)
+		case http.MethodPut:
+			var body Webhook
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.Enabled || body.Name != "Build" {
+				t.Errorf("Unexpected body %+v", body)
+			}
+			updated = true
+			fmt.Fprintf(w, testWebhook, false)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+
+	webhook, _, err := testClient.Webhook.Disable(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if !updated || webhook.Enabled {
+		t.Errorf("Expected the webhook to be disabled, got %+v", webhook)
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+	testMux.HandleFunc("/rest/webhooks/1.0/webhook/7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, err := testClient.Webhook.Delete(context.Background(), "7"); err != nil {
+		t.Errorf("Error given: %s", err)
+	}
+}
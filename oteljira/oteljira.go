@@ -0,0 +1,125 @@
+// Package oteljira provides OpenTelemetry tracing for the Jira clients of go-jira.
+//
+// It is a separate module, so the OpenTelemetry dependencies are only pulled in
+// if tracing is used.
+//
+//	client, _ := cloud.NewClient(baseURL, tp.Client())
+//	client.Use(oteljira.Middleware())
+package oteljira
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer.
+const instrumentationName = "github.com/andygrunwald/go-jira/v2/oteljira"
+
+// config is the configuration of the middleware.
+type config struct {
+	tracerProvider trace.TracerProvider
+	propagators    propagation.TextMapPropagator
+}
+
+// Option configures the middleware.
+type Option func(*config)
+
+// WithTracerProvider sets the TracerProvider used to create the spans.
+// It will default to the global TracerProvider.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithPropagators sets the propagators used to inject the trace context into the requests.
+// It will default to the global TextMapPropagator.
+func WithPropagators(p propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagators = p
+	}
+}
+
+// Middleware returns a cloud.Middleware that creates a client span for every Jira API call.
+//
+// The span is a child of the span in the context of the request. It is named
+// after the method and the endpoint template (see cloud.EndpointTemplate), so
+// issue keys and identifiers don't end up in span names.
+// The status code and the rate limit headers of the response are recorded as attributes.
+func Middleware(opts ...Option) cloud.Middleware {
+	c := config{}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.tracerProvider == nil {
+		c.tracerProvider = otel.GetTracerProvider()
+	}
+	if c.propagators == nil {
+		c.propagators = otel.GetTextMapPropagator()
+	}
+	tracer := c.tracerProvider.Tracer(instrumentationName)
+
+	return func(next cloud.Doer) cloud.Doer {
+		return cloud.DoerFunc(func(req *http.Request) (*http.Response, error) {
+			template := cloud.EndpointTemplate(req.URL)
+			ctx, span := tracer.Start(req.Context(), req.Method+" "+template,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("url.template", template),
+					attribute.String("server.address", req.URL.Hostname()),
+				),
+			)
+			defer span.End()
+
+			req = req.WithContext(ctx)
+			c.propagators.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+			resp, err := next.Do(req)
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return resp, err
+			}
+
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			span.SetAttributes(rateLimitAttributes(resp.Header)...)
+			if resp.StatusCode >= 400 {
+				span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+			}
+			return resp, nil
+		})
+	}
+}
+
+// rateLimitAttributes returns the rate limit headers of a response as attributes.
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rate-limiting/
+func rateLimitAttributes(h http.Header) []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	for key, header := range map[string]string{
+		"jira.ratelimit.limit":     "X-RateLimit-Limit",
+		"jira.ratelimit.remaining": "X-RateLimit-Remaining",
+	} {
+		if v, err := strconv.Atoi(h.Get(header)); err == nil {
+			attrs = append(attrs, attribute.Int(key, v))
+		}
+	}
+	if v := h.Get("X-RateLimit-Reset"); v != "" {
+		attrs = append(attrs, attribute.String("jira.ratelimit.reset", v))
+	}
+	if v := h.Get("RateLimit-Reason"); v != "" {
+		attrs = append(attrs, attribute.String("jira.ratelimit.reason", v))
+	}
+	if v, err := strconv.Atoi(h.Get("Retry-After")); err == nil {
+		attrs = append(attrs, attribute.Int("jira.ratelimit.retry_after", v))
+	}
+	return attrs
+}
@@ -0,0 +1,66 @@
+package oteljira
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Traceparent") == "" {
+			t.Error("Expected the trace context to be propagated")
+		}
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client, _ := cloud.NewClient(server.URL, nil)
+	client.Use(Middleware(WithTracerProvider(tp), WithPropagators(propagation.TraceContext{})))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	req, _ := client.NewRequest(ctx, http.MethodGet, "rest/api/2/issue/PRJ-1", nil)
+	_, _ = client.Do(req, nil)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "GET /rest/api/2/issue/{key}" {
+		t.Errorf("Unexpected span name %q", span.Name())
+	}
+	if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Error("Expected the span to be a child of the span of the context")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("Expected status Error, got %v", span.Status().Code)
+	}
+
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if v := attrs["http.response.status_code"]; v.AsInt64() != http.StatusNotFound {
+		t.Errorf("Expected status code 404, got %v", v.Emit())
+	}
+	if v := attrs["jira.ratelimit.remaining"]; v.AsInt64() != 42 {
+		t.Errorf("Expected remaining rate limit 42, got %v", v.Emit())
+	}
+	if v := attrs["url.template"]; v.AsString() != "/rest/api/2/issue/{key}" {
+		t.Errorf("Unexpected url.template %v", v.Emit())
+	}
+}
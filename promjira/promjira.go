@@ -0,0 +1,72 @@
+// Package promjira provides a Prometheus implementation of the MetricsRecorder of go-jira.
+//
+// It is a separate module, so the Prometheus dependencies are only pulled in
+// if the metrics are used.
+//
+//	client, _ := cloud.NewClient(baseURL, tp.Client())
+//	client.MetricsRecorder = promjira.NewRecorder(prometheus.DefaultRegisterer)
+package promjira
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Recorder records the requests of a Jira client as Prometheus metrics:
+//
+//   - jira_requests_total: counter of requests by method, endpoint and status code
+//     ("error" if no response was received)
+//   - jira_request_duration_seconds: histogram of the request durations by method and endpoint
+//   - jira_request_retries_total: counter of retries by method and endpoint
+//   - jira_ratelimit_remaining: gauge of the remaining rate limit as reported by Jira
+type Recorder struct {
+	requests           *prometheus.CounterVec
+	duration           *prometheus.HistogramVec
+	retries            *prometheus.CounterVec
+	rateLimitRemaining prometheus.Gauge
+}
+
+// NewRecorder creates a Recorder and registers its metrics with reg.
+// It panics if the metrics can't be registered, like prometheus.MustRegister.
+func NewRecorder(reg prometheus.Registerer) *Recorder {
+	r := &Recorder{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "jira_requests_total",
+			Help: "Number of requests to the Jira API.",
+		}, []string{"method", "endpoint", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "jira_request_duration_seconds",
+			Help:    "Duration of requests to the Jira API, including retries.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "endpoint"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "jira_request_retries_total",
+			Help: "Number of retried requests to the Jira API.",
+		}, []string{"method", "endpoint"}),
+		rateLimitRemaining: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "jira_ratelimit_remaining",
+			Help: "Remaining rate limit of the Jira API as reported by the last response.",
+		}),
+	}
+	reg.MustRegister(r.requests, r.duration, r.retries, r.rateLimitRemaining)
+	return r
+}
+
+// RecordRequest implements cloud.MetricsRecorder.
+func (r *Recorder) RecordRequest(ctx context.Context, m cloud.RequestMetrics) {
+	status := "error"
+	if m.StatusCode != 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	r.requests.WithLabelValues(m.Method, m.Endpoint, status).Inc()
+	r.duration.WithLabelValues(m.Method, m.Endpoint).Observe(m.Duration.Seconds())
+	if m.Retries > 0 {
+		r.retries.WithLabelValues(m.Method, m.Endpoint).Add(float64(m.Retries))
+	}
+	if m.RateLimitRemaining >= 0 {
+		r.rateLimitRemaining.Set(float64(m.RateLimitRemaining))
+	}
+}
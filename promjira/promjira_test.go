@@ -0,0 +1,45 @@
+package promjira
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecorder(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	r := NewRecorder(reg)
+
+	r.RecordRequest(context.Background(), cloud.RequestMetrics{
+		Method: "GET", Endpoint: "/rest/api/2/issue/{key}", StatusCode: 200,
+		Duration: 100 * time.Millisecond, Retries: 2, RateLimitRemaining: 42,
+	})
+	r.RecordRequest(context.Background(), cloud.RequestMetrics{
+		Method: "GET", Endpoint: "/rest/api/2/issue/{key}", Err: errors.New("timeout"), RateLimitRemaining: -1,
+	})
+
+	expected := `
+# HELP jira_requests_total Number of requests to the Jira API.
+# TYPE jira_requests_total counter
+jira_requests_total{endpoint="/rest/api/2/issue/{key}",method="GET",status="200"} 1
+jira_requests_total{endpoint="/rest/api/2/issue/{key}",method="GET",status="error"} 1
+# HELP jira_request_retries_total Number of retried requests to the Jira API.
+# TYPE jira_request_retries_total counter
+jira_request_retries_total{endpoint="/rest/api/2/issue/{key}",method="GET"} 2
+# HELP jira_ratelimit_remaining Remaining rate limit of the Jira API as reported by the last response.
+# TYPE jira_ratelimit_remaining gauge
+jira_ratelimit_remaining 42
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(expected), "jira_requests_total", "jira_request_retries_total", "jira_ratelimit_remaining"); err != nil {
+		t.Error(err)
+	}
+	if n := testutil.CollectAndCount(r.duration); n != 1 {
+		t.Errorf("Expected 1 duration series, got %d", n)
+	}
+}
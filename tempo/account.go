@@ -0,0 +1,138 @@
+package tempo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// AccountService handles the accounts of Tempo, which time is billed to.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts
+type AccountService service
+
+// The states of an Account.
+const (
+	AccountStatusOpen     = "OPEN"
+	AccountStatusClosed   = "CLOSED"
+	AccountStatusArchived = "ARCHIVED"
+)
+
+// Account is an account of Tempo.
+type Account struct {
+	Self          string           `json:"self,omitempty"`
+	ID            int64            `json:"id"`
+	Key           string           `json:"key"`
+	Name          string           `json:"name"`
+	Status        string           `json:"status"`
+	Global        bool             `json:"global"`
+	MonthlyBudget *float64         `json:"monthlyBudget,omitempty"`
+	Lead          *User            `json:"lead,omitempty"`
+	Contact       *AccountContact  `json:"contact,omitempty"`
+	Category      *AccountCategory `json:"category,omitempty"`
+	Customer      *AccountCustomer `json:"customer,omitempty"`
+	Links         *Self            `json:"links,omitempty"`
+}
+
+// AccountContact is the contact of an Account, a user of Jira or an external contact with a display name.
+type AccountContact struct {
+	Self        string `json:"self,omitempty"`
+	AccountID   string `json:"accountId,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+	// Type is USER or EXTERNAL.
+	Type string `json:"type,omitempty"`
+}
+
+// AccountCategory is the category of an Account.
+type AccountCategory struct {
+	Self string `json:"self,omitempty"`
+	ID   int64  `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// AccountCustomer is the customer of an Account.
+type AccountCustomer struct {
+	Self string `json:"self,omitempty"`
+	ID   int64  `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+// AccountInput is the account created with AccountService.Create or updated with AccountService.Update.
+type AccountInput struct {
+	Key           string   `json:"key"`
+	Name          string   `json:"name"`
+	Status        string   `json:"status"`
+	LeadAccountID string   `json:"leadAccountId"`
+	Global        bool     `json:"global,omitempty"`
+	MonthlyBudget *float64 `json:"monthlyBudget,omitempty"`
+	CategoryKey   string   `json:"categoryKey,omitempty"`
+	CustomerKey   string   `json:"customerKey,omitempty"`
+	// ContactAccountID is the account ID of a contact in Jira, ExternalContactName the name of an external contact.
+	ContactAccountID    string `json:"contactAccountId,omitempty"`
+	ExternalContactName string `json:"externalContactName,omitempty"`
+}
+
+// AccountListOptions specifies the optional parameters for AccountService.List
+type AccountListOptions struct {
+	// Status filters the accounts by their AccountStatus values.
+	Status []string `url:"status,omitempty"`
+}
+
+// List returns the accounts.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts/operation/getAccounts
+func (s *AccountService) List(ctx context.Context, options *AccountListOptions) ([]Account, *jira.Response, error) {
+	result := new(List[Account])
+	resp, err := s.client.call(ctx, http.MethodGet, "accounts", options, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Results, resp, nil
+}
+
+// Get returns the account with the key.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts/operation/getAccount
+func (s *AccountService) Get(ctx context.Context, key string) (*Account, *jira.Response, error) {
+	account := new(Account)
+	resp, err := s.client.call(ctx, http.MethodGet, "accounts/"+url.PathEscape(key), nil, nil, account)
+	if err != nil {
+		return nil, resp, err
+	}
+	return account, resp, nil
+}
+
+// Create creates the account and returns it.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts/operation/createAccount
+func (s *AccountService) Create(ctx context.Context, account *AccountInput) (*Account, *jira.Response, error) {
+	created := new(Account)
+	resp, err := s.client.call(ctx, http.MethodPost, "accounts", nil, account, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update replaces the account with the key and returns the updated account.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts/operation/updateAccount
+func (s *AccountService) Update(ctx context.Context, key string, account *AccountInput) (*Account, *jira.Response, error) {
+	updated := new(Account)
+	resp, err := s.client.call(ctx, http.MethodPut, "accounts/"+url.PathEscape(key), nil, account, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete deletes the account with the key.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Accounts/operation/deleteAccount
+func (s *AccountService) Delete(ctx context.Context, key string) (*jira.Response, error) {
+	return s.client.call(ctx, http.MethodDelete, "accounts/"+url.PathEscape(key), nil, nil, nil)
+}
@@ -0,0 +1,43 @@
+package tempo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAccountService_List(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/4/accounts" || r.URL.Query().Get("status") != AccountStatusOpen {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"metadata":{"count":1,"offset":0,"limit":50},"results":[
+			{"id":1,"key":"ACME","name":"ACME Corp","status":"OPEN","global":false,"lead":{"accountId":"5b10a2844c20165700ede21g"},"customer":{"id":2,"key":"ACME","name":"ACME"}}]}`)
+	})
+
+	accounts, _, err := client.Account.List(context.Background(), &AccountListOptions{Status: []string{AccountStatusOpen}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(accounts) != 1 || accounts[0].Key != "ACME" || accounts[0].Customer.Name != "ACME" {
+		t.Errorf("Unexpected accounts %+v", accounts)
+	}
+}
+
+func TestAccountService_Get(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/4/accounts/ACME%2FEU" {
+			t.Errorf("Expected an escaped key, got %s", r.URL.EscapedPath())
+		}
+		fmt.Fprint(w, `{"id":1,"key":"ACME/EU","name":"ACME Europe","status":"CLOSED"}`)
+	})
+
+	account, _, err := client.Account.Get(context.Background(), "ACME/EU")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if account.Status != AccountStatusClosed {
+		t.Errorf("Unexpected account %+v", account)
+	}
+}
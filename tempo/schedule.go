@@ -0,0 +1,68 @@
+package tempo
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// ScheduleService handles the work schedules of users in Tempo.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/User-Schedule
+type ScheduleService service
+
+// The types of a ScheduleDay.
+const (
+	ScheduleWorkingDay              = "WORKING_DAY"
+	ScheduleNonWorkingDay           = "NON_WORKING_DAY"
+	ScheduleHoliday                 = "HOLIDAY"
+	ScheduleHolidayAndNonWorkingDay = "HOLIDAY_AND_NON_WORKING_DAY"
+)
+
+// ScheduleDay is a day of the schedule of a user.
+type ScheduleDay struct {
+	// Date is formatted as "2006-01-02".
+	Date string `json:"date"`
+	// RequiredSeconds is the time the user is required to work on the day.
+	RequiredSeconds int64    `json:"requiredSeconds"`
+	Type            string   `json:"type"`
+	Holiday         *Holiday `json:"holiday,omitempty"`
+}
+
+// Holiday is the holiday of a ScheduleDay.
+type Holiday struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	DurationSeconds int64  `json:"durationSeconds"`
+}
+
+// ScheduleOptions are the dates of the requested schedule, formatted as "2006-01-02".
+type ScheduleOptions struct {
+	From string `url:"from"`
+	To   string `url:"to"`
+}
+
+// Get returns the days of the schedule of the user with the account ID.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/User-Schedule/operation/getUserScheduleByUser
+func (s *ScheduleService) Get(ctx context.Context, accountID string, options *ScheduleOptions) ([]ScheduleDay, *jira.Response, error) {
+	return s.get(ctx, "user-schedule/"+url.PathEscape(accountID), options)
+}
+
+// GetMine returns the days of the schedule of the user of the token.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/User-Schedule/operation/getUserSchedule
+func (s *ScheduleService) GetMine(ctx context.Context, options *ScheduleOptions) ([]ScheduleDay, *jira.Response, error) {
+	return s.get(ctx, "user-schedule", options)
+}
+
+func (s *ScheduleService) get(ctx context.Context, path string, options *ScheduleOptions) ([]ScheduleDay, *jira.Response, error) {
+	result := new(List[ScheduleDay])
+	resp, err := s.client.call(ctx, http.MethodGet, path, options, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Results, resp, nil
+}
@@ -0,0 +1,27 @@
+package tempo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestScheduleService_Get(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/4/user-schedule/5b10a2844c20165700ede21g" || r.URL.Query().Get("from") != "2024-12-24" || r.URL.Query().Get("to") != "2024-12-25" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"metadata":{"count":2},"results":[
+			{"date":"2024-12-24","requiredSeconds":14400,"type":"WORKING_DAY"},
+			{"date":"2024-12-25","requiredSeconds":0,"type":"HOLIDAY","holiday":{"name":"Christmas Day","durationSeconds":28800}}]}`)
+	})
+
+	days, _, err := client.Schedule.Get(context.Background(), "5b10a2844c20165700ede21g", &ScheduleOptions{From: "2024-12-24", To: "2024-12-25"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(days) != 2 || days[0].RequiredSeconds != 14400 || days[1].Type != ScheduleHoliday || days[1].Holiday.Name != "Christmas Day" {
+		t.Errorf("Unexpected schedule %+v", days)
+	}
+}
@@ -0,0 +1,149 @@
+package tempo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// TeamService handles the teams of Tempo and their members.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams
+type TeamService service
+
+// Team is a team of Tempo.
+type Team struct {
+	Self    string `json:"self,omitempty"`
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Summary string `json:"summary,omitempty"`
+	Lead    *User  `json:"lead,omitempty"`
+	// Administrative teams are not used for planning, only to group users, e.g. for permissions.
+	Administrative bool  `json:"administrative"`
+	Members        *Self `json:"members,omitempty"`
+	Permissions    *Self `json:"permissions,omitempty"`
+}
+
+// TeamInput is the team created with TeamService.Create or updated with TeamService.Update.
+type TeamInput struct {
+	Name           string `json:"name"`
+	Summary        string `json:"summary,omitempty"`
+	LeadAccountID  string `json:"leadAccountId,omitempty"`
+	Administrative bool   `json:"administrative,omitempty"`
+}
+
+// TeamMembership is the membership of a user in a team.
+type TeamMembership struct {
+	Self       string          `json:"self,omitempty"`
+	ID         int64           `json:"id"`
+	Team       *Self           `json:"team,omitempty"`
+	Member     *User           `json:"member,omitempty"`
+	Role       *TeamMemberRole `json:"role,omitempty"`
+	Commitment int             `json:"commitmentPercent"`
+	// From and To are the dates of the membership, formatted as "2006-01-02", empty if open.
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// TeamMemberRole is the role of a member in a team.
+type TeamMemberRole struct {
+	Self    string `json:"self,omitempty"`
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Default bool   `json:"default,omitempty"`
+}
+
+// TeamListOptions specifies the optional parameters for TeamService.List
+type TeamListOptions struct {
+	Pagination
+	// TeamMembers filters the teams by the account IDs of their members.
+	TeamMembers []string `url:"teamMembers,omitempty"`
+	// Name filters the teams by a part of their name.
+	Name string `url:"name,omitempty"`
+}
+
+// List returns a page of the teams.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams/operation/getTeams
+func (s *TeamService) List(ctx context.Context, options *TeamListOptions) (*List[Team], *jira.Response, error) {
+	result := new(List[Team])
+	resp, err := s.client.call(ctx, http.MethodGet, "teams", options, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// ListAll returns the teams of all pages.
+// options.Offset is ignored, the page size and a limit of teams can be set with pager.
+func (s *TeamService) ListAll(ctx context.Context, options *TeamListOptions, pager *jira.PagerOptions) ([]Team, error) {
+	var opts TeamListOptions
+	if options != nil {
+		opts = *options
+	}
+	return jira.NewPager(func(ctx context.Context, page jira.PageRequest) (*jira.Page[Team], *jira.Response, error) {
+		opts.setPage(page)
+		result, resp, err := s.List(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.page(), resp, nil
+	}, pager).All(ctx)
+}
+
+// Get returns the team with the ID.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams/operation/getTeam
+func (s *TeamService) Get(ctx context.Context, teamID int64) (*Team, *jira.Response, error) {
+	team := new(Team)
+	resp, err := s.client.call(ctx, http.MethodGet, fmt.Sprintf("teams/%d", teamID), nil, nil, team)
+	if err != nil {
+		return nil, resp, err
+	}
+	return team, resp, nil
+}
+
+// Create creates the team and returns it.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams/operation/createTeam
+func (s *TeamService) Create(ctx context.Context, team *TeamInput) (*Team, *jira.Response, error) {
+	created := new(Team)
+	resp, err := s.client.call(ctx, http.MethodPost, "teams", nil, team, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update replaces the team with the ID and returns the updated team.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams/operation/updateTeam
+func (s *TeamService) Update(ctx context.Context, teamID int64, team *TeamInput) (*Team, *jira.Response, error) {
+	updated := new(Team)
+	resp, err := s.client.call(ctx, http.MethodPut, fmt.Sprintf("teams/%d", teamID), nil, team, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete deletes the team with the ID.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Teams/operation/deleteTeam
+func (s *TeamService) Delete(ctx context.Context, teamID int64) (*jira.Response, error) {
+	return s.client.call(ctx, http.MethodDelete, fmt.Sprintf("teams/%d", teamID), nil, nil, nil)
+}
+
+// GetMemberships returns the current and past memberships of the team.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Team-Memberships/operation/getTeamMemberships
+func (s *TeamService) GetMemberships(ctx context.Context, teamID int64) ([]TeamMembership, *jira.Response, error) {
+	result := new(List[TeamMembership])
+	resp, err := s.client.call(ctx, http.MethodGet, fmt.Sprintf("team-memberships/team/%d", teamID), nil, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result.Results, resp, nil
+}
@@ -0,0 +1,26 @@
+package tempo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTeamService_GetMemberships(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/4/team-memberships/team/3" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `{"metadata":{"count":1},"results":[
+			{"id":11,"team":{"self":"https://api.tempo.io/4/teams/3"},"member":{"accountId":"5b10a2844c20165700ede21g"},"role":{"id":1,"name":"Member","default":true},"commitmentPercent":50,"from":"2024-01-01"}]}`)
+	})
+
+	memberships, _, err := client.Team.GetMemberships(context.Background(), 3)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(memberships) != 1 || memberships[0].Member.AccountID != "5b10a2844c20165700ede21g" || memberships[0].Commitment != 50 {
+		t.Errorf("Unexpected memberships %+v", memberships)
+	}
+}
@@ -0,0 +1,149 @@
+// Package tempo is a client of the Tempo Cloud REST API, the time tracking app most Jira sites log work with.
+// It covers the worklogs, accounts, teams and user schedules of Tempo:
+//
+//	client, err := tempo.NewClient(nil, jira.StaticToken(os.Getenv("TEMPO_TOKEN")))
+//	worklogs, err := client.Worklog.ListAll(ctx, &tempo.WorklogListOptions{From: "2024-03-01", To: "2024-03-31"}, nil)
+//
+// Requests are sent with a client of the cloud package, so the retry policy, rate limiter, circuit breaker,
+// logging, metrics and error handling of the cloud package are available for Tempo too.
+// Configure them on Client.API:
+//
+//	client.API().RetryPolicy = &jira.RetryPolicy{MaxRetries: 3, Jitter: true}
+//
+// Errors are returned as *jira.Error, so they can be checked with errors.Is, e.g. for jira.ErrNotFound.
+//
+// Tempo API docs: https://apidocs.tempo.io/
+package tempo
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/google/go-querystring/query"
+)
+
+// DefaultBaseURL is the base URL of version 4 of the Tempo Cloud REST API.
+// Sites hosted in the EU can use "https://api.eu.tempo.io/4/".
+const DefaultBaseURL = "https://api.tempo.io/4/"
+
+// Client manages communication with the Tempo Cloud REST API.
+type Client struct {
+	api *jira.Client
+
+	// Reuse a single struct instead of allocating one for each service on the heap.
+	common service
+
+	// Services used for talking to different parts of the Tempo API.
+	Worklog  *WorklogService
+	Account  *AccountService
+	Team     *TeamService
+	Schedule *ScheduleService
+}
+
+// service is the base structure to bundle API services
+// under a sub-struct.
+type service struct {
+	client *Client
+}
+
+// NewClient returns a new client of the Tempo API at DefaultBaseURL, authenticated with the API or OAuth 2.0
+// token of Tempo, e.g. jira.StaticToken("..."). Tempo doesn't accept the credentials of Jira.
+// If a nil httpClient is provided, a new http.Client will be used.
+// The transport of httpClient must not authenticate the requests itself, the token is added to each request.
+func NewClient(httpClient *http.Client, token jira.TokenProvider) (*Client, error) {
+	if token == nil {
+		return nil, errors.New("tempo: token provider is nil")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	clientCopy := *httpClient
+	clientCopy.Transport = &jira.BearerTokenAuthTransport{Token: token, Transport: httpClient.Transport}
+
+	api, err := jira.NewClient(DefaultBaseURL, &clientCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{api: api}
+	c.common.client = c
+	c.Worklog = (*WorklogService)(&c.common)
+	c.Account = (*AccountService)(&c.common)
+	c.Team = (*TeamService)(&c.common)
+	c.Schedule = (*ScheduleService)(&c.common)
+
+	return c, nil
+}
+
+// API returns the client of the cloud package sending the requests to Tempo.
+// Set its BaseURL to use another region of Tempo, and its RetryPolicy, RateLimiter, Logger or MetricsRecorder
+// to configure how requests are sent.
+func (c *Client) API() *jira.Client {
+	return c.api
+}
+
+// call sends a request to the path of the Tempo API with the query parameters of options
+// and decodes the response into v.
+func (c *Client) call(ctx context.Context, method, path string, options, body, v interface{}) (*jira.Response, error) {
+	var values url.Values
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return nil, err
+		}
+		values = q
+	}
+	return c.api.Call(ctx, method, path, values, body, v)
+}
+
+// Metadata is the pagination metadata of a list returned by Tempo.
+type Metadata struct {
+	Count  int `json:"count"`
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+	// Next is the URL of the next page, empty for the last page.
+	Next     string `json:"next,omitempty"`
+	Previous string `json:"previous,omitempty"`
+}
+
+// Self is a link to an entity of Tempo or Jira.
+type Self struct {
+	Self string `json:"self,omitempty"`
+}
+
+// User is a user referenced by Tempo, identified by the account ID of Jira.
+type User struct {
+	Self      string `json:"self,omitempty"`
+	AccountID string `json:"accountId"`
+}
+
+// List is a page of a list returned by Tempo.
+type List[T any] struct {
+	Self     string   `json:"self,omitempty"`
+	Metadata Metadata `json:"metadata"`
+	Results  []T      `json:"results"`
+}
+
+// page returns the list as a page of a jira.Pager.
+func (l *List[T]) page() *jira.Page[T] {
+	return &jira.Page[T]{Values: l.Results, IsLast: l.Metadata.Next == "" || len(l.Results) == 0}
+}
+
+// Pagination are the offset and limit of the requested page of a list.
+type Pagination struct {
+	// Offset is the index of the first item of the page, 0 for the first page.
+	Offset int `url:"offset,omitempty"`
+	// Limit is the maximum number of items of the page, 50 by default and at most 5000.
+	Limit int `url:"limit,omitempty"`
+}
+
+// setPage sets the offset and limit of the requested page.
+func (p *Pagination) setPage(page jira.PageRequest) {
+	p.Offset = page.StartAt
+	if page.MaxResults != 0 {
+		p.Limit = page.MaxResults
+	}
+}
@@ -0,0 +1,77 @@
+package tempo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// newTestClient returns a client of the Tempo API served by handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer tempo-token" {
+			t.Errorf("Expected the Tempo token, got %q", got)
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(nil, jira.StaticToken("tempo-token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.API().BaseURL, _ = url.Parse(server.URL + "/4/")
+	return client
+}
+
+func TestNewClient_NoToken(t *testing.T) {
+	if _, err := NewClient(nil, nil); err == nil {
+		t.Error("Expected an error without a token provider")
+	}
+}
+
+func TestClient_Error(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[{"message":"Worklog not found."}]}`)
+	})
+
+	_, _, err := client.Worklog.Get(context.Background(), 1)
+	if !errors.Is(err, jira.ErrNotFound) {
+		t.Errorf("Expected jira.ErrNotFound, got %v", err)
+	}
+	var jerr *jira.Error
+	if !errors.As(err, &jerr) || len(jerr.ErrorMessages) != 1 || jerr.ErrorMessages[0] != "Worklog not found." {
+		t.Errorf("Expected the message of Tempo, got %v", err)
+	}
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id":1,"name":"Platform"}`)
+	})
+	client.API().RetryPolicy = &jira.RetryPolicy{MaxRetries: 1}
+
+	team, _, err := client.Team.Get(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if requests != 2 || team.Name != "Platform" {
+		t.Errorf("Expected the rate limited request to be retried, got %d requests", requests)
+	}
+}
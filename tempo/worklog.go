@@ -0,0 +1,155 @@
+package tempo
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// WorklogService handles the worklogs of Tempo.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs
+type WorklogService service
+
+// Worklog is the time a user logged on an issue with Tempo.
+type Worklog struct {
+	Self           string `json:"self,omitempty"`
+	TempoWorklogID int64  `json:"tempoWorklogId"`
+	// Issue is the issue the time was logged on, identified by its ID, not its key.
+	Issue *WorklogIssue `json:"issue,omitempty"`
+	// TimeSpentSeconds is the logged time, BillableSeconds the billable part of it.
+	TimeSpentSeconds int64 `json:"timeSpentSeconds"`
+	BillableSeconds  int64 `json:"billableSeconds"`
+	// StartDate is the date the work started at, formatted as "2006-01-02", StartTime the time as "15:04:05".
+	StartDate   string             `json:"startDate"`
+	StartTime   string             `json:"startTime,omitempty"`
+	Description string             `json:"description,omitempty"`
+	CreatedAt   string             `json:"createdAt,omitempty"`
+	UpdatedAt   string             `json:"updatedAt,omitempty"`
+	Author      *User              `json:"author,omitempty"`
+	Attributes  *WorklogAttributes `json:"attributes,omitempty"`
+}
+
+// WorklogIssue is the issue of a Worklog.
+type WorklogIssue struct {
+	Self string `json:"self,omitempty"`
+	ID   int64  `json:"id"`
+}
+
+// WorklogAttributes are the values of the work attributes of a Worklog.
+type WorklogAttributes struct {
+	Self   string                  `json:"self,omitempty"`
+	Values []WorklogAttributeValue `json:"values"`
+}
+
+// WorklogAttributeValue is the value of a work attribute, e.g. of the account the time is billed to.
+type WorklogAttributeValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// WorklogInput is the worklog created with WorklogService.Create or updated with WorklogService.Update.
+type WorklogInput struct {
+	AuthorAccountID string `json:"authorAccountId"`
+	// IssueID is the ID of the issue, Tempo doesn't accept issue keys.
+	IssueID          int64  `json:"issueId"`
+	TimeSpentSeconds int64  `json:"timeSpentSeconds"`
+	BillableSeconds  *int64 `json:"billableSeconds,omitempty"`
+	// StartDate is formatted as "2006-01-02", StartTime as "15:04:05".
+	StartDate   string `json:"startDate"`
+	StartTime   string `json:"startTime,omitempty"`
+	Description string `json:"description,omitempty"`
+	// RemainingEstimateSeconds sets the remaining estimate of the issue.
+	RemainingEstimateSeconds *int64                  `json:"remainingEstimateSeconds,omitempty"`
+	Attributes               []WorklogAttributeValue `json:"attributes,omitempty"`
+}
+
+// WorklogListOptions specifies the optional parameters for WorklogService.List
+type WorklogListOptions struct {
+	Pagination
+	// ProjectIDs and IssueIDs filter the worklogs by the IDs of their projects and issues.
+	ProjectIDs []int64 `url:"projectId,omitempty"`
+	IssueIDs   []int64 `url:"issueId,omitempty"`
+	// From and To filter the worklogs by their start date, formatted as "2006-01-02".
+	From string `url:"from,omitempty"`
+	To   string `url:"to,omitempty"`
+	// UpdatedFrom filters the worklogs by the time they were last updated, e.g. "2024-03-01T00:00:00Z".
+	UpdatedFrom string `url:"updatedFrom,omitempty"`
+	// OrderBy is ID, START_DATE_TIME or UPDATED.
+	OrderBy string `url:"orderBy,omitempty"`
+}
+
+// List returns a page of the worklogs.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs/operation/getWorklogs
+func (s *WorklogService) List(ctx context.Context, options *WorklogListOptions) (*List[Worklog], *jira.Response, error) {
+	result := new(List[Worklog])
+	resp, err := s.client.call(ctx, http.MethodGet, "worklogs", options, nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// ListAll returns the worklogs of all pages.
+// options.Offset is ignored, the page size and a limit of worklogs can be set with pager.
+func (s *WorklogService) ListAll(ctx context.Context, options *WorklogListOptions, pager *jira.PagerOptions) ([]Worklog, error) {
+	var opts WorklogListOptions
+	if options != nil {
+		opts = *options
+	}
+	return jira.NewPager(func(ctx context.Context, page jira.PageRequest) (*jira.Page[Worklog], *jira.Response, error) {
+		opts.setPage(page)
+		result, resp, err := s.List(ctx, &opts)
+		if err != nil {
+			return nil, resp, err
+		}
+		return result.page(), resp, nil
+	}, pager).All(ctx)
+}
+
+// Get returns the worklog with the Tempo worklog ID.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs/operation/getWorklogById
+func (s *WorklogService) Get(ctx context.Context, worklogID int64) (*Worklog, *jira.Response, error) {
+	worklog := new(Worklog)
+	resp, err := s.client.call(ctx, http.MethodGet, fmt.Sprintf("worklogs/%d", worklogID), nil, nil, worklog)
+	if err != nil {
+		return nil, resp, err
+	}
+	return worklog, resp, nil
+}
+
+// Create logs the time of the worklog and returns the created worklog.
+// Tempo also creates the worklog in Jira.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs/operation/createWorklog
+func (s *WorklogService) Create(ctx context.Context, worklog *WorklogInput) (*Worklog, *jira.Response, error) {
+	created := new(Worklog)
+	resp, err := s.client.call(ctx, http.MethodPost, "worklogs", nil, worklog, created)
+	if err != nil {
+		return nil, resp, err
+	}
+	return created, resp, nil
+}
+
+// Update replaces the worklog with the Tempo worklog ID and returns the updated worklog.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs/operation/updateWorklog
+func (s *WorklogService) Update(ctx context.Context, worklogID int64, worklog *WorklogInput) (*Worklog, *jira.Response, error) {
+	updated := new(Worklog)
+	resp, err := s.client.call(ctx, http.MethodPut, fmt.Sprintf("worklogs/%d", worklogID), nil, worklog, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+	return updated, resp, nil
+}
+
+// Delete deletes the worklog with the Tempo worklog ID.
+//
+// Tempo API docs: https://apidocs.tempo.io/#tag/Worklogs/operation/deleteWorklog
+func (s *WorklogService) Delete(ctx context.Context, worklogID int64) (*jira.Response, error) {
+	return s.client.call(ctx, http.MethodDelete, fmt.Sprintf("worklogs/%d", worklogID), nil, nil, nil)
+}
@@ -0,0 +1,103 @@
+package tempo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestWorklogService_ListAll(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/4/worklogs" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		q := r.URL.Query()
+		if q.Get("from") != "2024-03-01" || q.Get("to") != "2024-03-31" || q.Get("limit") != "1" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		switch q.Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"metadata":{"count":1,"offset":0,"limit":1,"next":"https://api.tempo.io/4/worklogs?offset=1&limit=1"},
+				"results":[{"tempoWorklogId":1,"issue":{"id":10001},"timeSpentSeconds":3600,"startDate":"2024-03-01","author":{"accountId":"5b10a2844c20165700ede21g"}}]}`)
+		case "1":
+			fmt.Fprint(w, `{"metadata":{"count":1,"offset":1,"limit":1},
+				"results":[{"tempoWorklogId":2,"issue":{"id":10002},"timeSpentSeconds":1800,"startDate":"2024-03-02"}]}`)
+		default:
+			t.Errorf("Unexpected offset %s", q.Get("offset"))
+		}
+	})
+
+	worklogs, err := client.Worklog.ListAll(context.Background(), &WorklogListOptions{From: "2024-03-01", To: "2024-03-31"}, &jira.PagerOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(worklogs) != 2 || worklogs[0].Issue.ID != 10001 || worklogs[1].TimeSpentSeconds != 1800 {
+		t.Errorf("Unexpected worklogs %+v", worklogs)
+	}
+	if worklogs[0].Author.AccountID != "5b10a2844c20165700ede21g" {
+		t.Errorf("Unexpected author %+v", worklogs[0].Author)
+	}
+}
+
+func TestWorklogService_Create(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/4/worklogs" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		var input WorklogInput
+		if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+			t.Fatal(err)
+		}
+		if input.IssueID != 10001 || input.TimeSpentSeconds != 3600 || input.Attributes[0].Key != "_Account_" {
+			t.Errorf("Unexpected worklog %+v", input)
+		}
+		fmt.Fprint(w, `{"tempoWorklogId":7,"issue":{"id":10001},"timeSpentSeconds":3600,"startDate":"2024-03-01"}`)
+	})
+
+	worklog, _, err := client.Worklog.Create(context.Background(), &WorklogInput{
+		AuthorAccountID:  "5b10a2844c20165700ede21g",
+		IssueID:          10001,
+		TimeSpentSeconds: 3600,
+		StartDate:        "2024-03-01",
+		Attributes:       []WorklogAttributeValue{{Key: "_Account_", Value: "ACME"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if worklog.TempoWorklogID != 7 {
+		t.Errorf("Expected worklog 7, got %d", worklog.TempoWorklogID)
+	}
+}
+
+func TestWorklogService_UpdateDelete(t *testing.T) {
+	var methods []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/4/worklogs/7" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"tempoWorklogId":7,"timeSpentSeconds":7200}`)
+	})
+
+	worklog, _, err := client.Worklog.Update(context.Background(), 7, &WorklogInput{IssueID: 10001, TimeSpentSeconds: 7200, StartDate: "2024-03-01"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if worklog.TimeSpentSeconds != 7200 {
+		t.Errorf("Unexpected worklog %+v", worklog)
+	}
+	if _, err := client.Worklog.Delete(context.Background(), 7); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if fmt.Sprint(methods) != "[PUT DELETE]" {
+		t.Errorf("Unexpected requests %v", methods)
+	}
+}
@@ -0,0 +1,204 @@
+package xray
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+	"github.com/google/go-querystring/query"
+)
+
+// The statuses of a TestResult of Xray Cloud.
+const (
+	StatusPassed    = "PASSED"
+	StatusFailed    = "FAILED"
+	StatusToDo      = "TODO"
+	StatusExecuting = "EXECUTING"
+)
+
+// The statuses of a TestResult of Xray Data Center, which names the passed and failed statuses differently.
+const (
+	StatusPass = "PASS"
+	StatusFail = "FAIL"
+)
+
+// ImportOptions specifies the test execution the results of ImportJUnit and ImportCucumber are imported into.
+// A new test execution is created in ProjectKey unless TestExecKey is set.
+type ImportOptions struct {
+	ProjectKey  string `url:"projectKey,omitempty"`
+	TestExecKey string `url:"testExecKey,omitempty"`
+	// TestPlanKey adds the test execution to the test plan.
+	TestPlanKey      string   `url:"testPlanKey,omitempty"`
+	TestEnvironments []string `url:"testEnvironments,omitempty,semicolon"`
+	// Revision is the source code revision the tests ran against, e.g. a commit hash.
+	Revision   string `url:"revision,omitempty"`
+	FixVersion string `url:"fixVersion,omitempty"`
+}
+
+// ExecutionResults are test results in the JSON format of Xray, imported with ImportResults.
+type ExecutionResults struct {
+	// TestExecutionKey is the key of the test execution to update, a new one is created if empty.
+	TestExecutionKey string         `json:"testExecutionKey,omitempty"`
+	Info             *ExecutionInfo `json:"info,omitempty"`
+	Tests            []TestResult   `json:"tests"`
+}
+
+// ExecutionInfo are the fields of the test execution of ExecutionResults.
+type ExecutionInfo struct {
+	Project     string `json:"project,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Revision    string `json:"revision,omitempty"`
+	User        string `json:"user,omitempty"`
+	// StartDate and FinishDate are formatted in RFC 3339.
+	StartDate        string   `json:"startDate,omitempty"`
+	FinishDate       string   `json:"finishDate,omitempty"`
+	TestPlanKey      string   `json:"testPlanKey,omitempty"`
+	TestEnvironments []string `json:"testEnvironments,omitempty"`
+}
+
+// TestResult is the result of a test of ExecutionResults.
+type TestResult struct {
+	TestKey string `json:"testKey"`
+	// Start and Finish are formatted in RFC 3339.
+	Start   string `json:"start,omitempty"`
+	Finish  string `json:"finish,omitempty"`
+	Comment string `json:"comment,omitempty"`
+	// Status is StatusPassed, StatusFailed, StatusToDo or StatusExecuting,
+	// Xray Data Center uses StatusPass and StatusFail instead.
+	Status  string   `json:"status"`
+	Defects []string `json:"defects,omitempty"`
+}
+
+// ImportResult is the test execution test results were imported into.
+type ImportResult struct {
+	IssueRef
+}
+
+// UnmarshalJSON decodes the test execution of Xray Cloud and of Xray Data Center,
+// which wraps it in "testExecIssue".
+func (r *ImportResult) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		IssueRef
+		TestExecIssue *IssueRef `json:"testExecIssue"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	r.IssueRef = raw.IssueRef
+	if raw.TestExecIssue != nil {
+		r.IssueRef = *raw.TestExecIssue
+	}
+	return nil
+}
+
+// ImportJUnit imports the test results of the JUnit XML report into a test execution.
+// Xray Data Center expects the report as the file of a multipart form, Xray Cloud as the request body.
+//
+// Xray API docs: https://docs.getxray.app/display/XRAYCLOUD/Import+Execution+Results+-+REST+v2#ImportExecutionResultsRESTv2-JUnitXMLresults
+func (c *Client) ImportJUnit(ctx context.Context, report io.Reader, options *ImportOptions) (*ImportResult, *jira.Response, error) {
+	if c.dataCenter {
+		return c.importReportFile(ctx, "import/execution/junit", "report.xml", report, options)
+	}
+	return c.importReport(ctx, "import/execution/junit", "text/xml", report, options)
+}
+
+// ImportCucumber imports the test results of the Cucumber JSON report into a test execution.
+// The scenarios of the report have to be tagged with the keys of their tests.
+//
+// Xray API docs: https://docs.getxray.app/display/XRAYCLOUD/Import+Execution+Results+-+REST+v2#ImportExecutionResultsRESTv2-CucumberJSONresults
+func (c *Client) ImportCucumber(ctx context.Context, report io.Reader, options *ImportOptions) (*ImportResult, *jira.Response, error) {
+	return c.importReport(ctx, "import/execution/cucumber", "application/json", report, options)
+}
+
+// ImportResults imports the test results in the JSON format of Xray.
+// The test execution is updated if results.TestExecutionKey is set and created from results.Info otherwise.
+//
+// Xray API docs: https://docs.getxray.app/display/XRAYCLOUD/Import+Execution+Results+-+REST+v2#ImportExecutionResultsRESTv2-XrayJSONresults
+func (c *Client) ImportResults(ctx context.Context, results *ExecutionResults) (*ImportResult, *jira.Response, error) {
+	result := new(ImportResult)
+	resp, err := c.api.Call(ctx, http.MethodPost, c.restPath("import/execution"), nil, results, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+func (c *Client) importReport(ctx context.Context, path, contentType string, report io.Reader, options *ImportOptions) (*ImportResult, *jira.Response, error) {
+	apiEndpoint, err := c.importEndpoint(path, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := c.api.NewRawRequest(ctx, http.MethodPost, apiEndpoint, report)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.sendImport(req)
+}
+
+// importReportFile imports the report sent as the file of a multipart form, for Xray Data Center.
+func (c *Client) importReportFile(ctx context.Context, path, fileName string, report io.Reader, options *ImportOptions) (*ImportResult, *jira.Response, error) {
+	apiEndpoint, err := c.importEndpoint(path, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	b := new(bytes.Buffer)
+	writer := multipart.NewWriter(b)
+	fw, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(fw, report); err != nil {
+		return nil, nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := c.api.NewMultiPartRequest(ctx, http.MethodPost, apiEndpoint, b)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return c.sendImport(req)
+}
+
+// importEndpoint returns the endpoint of the import with the options as query.
+func (c *Client) importEndpoint(path string, options *ImportOptions) (string, error) {
+	apiEndpoint := c.restPath(path)
+	if options != nil {
+		q, err := query.Values(options)
+		if err != nil {
+			return "", err
+		}
+		if len(q) > 0 {
+			apiEndpoint += "?" + q.Encode()
+		}
+	}
+	return apiEndpoint, nil
+}
+
+// sendImport sends the import request and decodes the test execution of the response.
+func (c *Client) sendImport(req *http.Request) (*ImportResult, *jira.Response, error) {
+	result := new(ImportResult)
+	resp, err := c.api.Do(req, result)
+	if err != nil {
+		return nil, resp, jira.NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// restPath returns the path of the REST endpoint of Xray Cloud or of its counterpart of Xray Data Center.
+func (c *Client) restPath(path string) string {
+	if c.dataCenter {
+		return "rest/raven/1.0/" + path
+	}
+	return path
+}
@@ -0,0 +1,115 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const junitReport = `<testsuites><testsuite name="auth"><testcase name="login" classname="auth"/></testsuite></testsuites>`
+
+func TestClient_ImportJUnit(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v2/import/execution/junit" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		if got := r.URL.Query(); got.Get("projectKey") != "EX" || got.Get("testPlanKey") != "EX-100" || got.Get("testEnvironments") != "linux;chrome" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		if got := r.Header.Get("Content-Type"); got != "text/xml" {
+			t.Errorf("Expected XML, got %s", got)
+		}
+		if body, _ := io.ReadAll(r.Body); string(body) != junitReport {
+			t.Errorf("Unexpected report %s", body)
+		}
+		fmt.Fprint(w, `{"id":"10200","key":"EX-10","self":"https://example.atlassian.net/rest/api/2/issue/10200"}`)
+	})
+
+	result, _, err := client.ImportJUnit(context.Background(), strings.NewReader(junitReport), &ImportOptions{
+		ProjectKey:       "EX",
+		TestPlanKey:      "EX-100",
+		TestEnvironments: []string{"linux", "chrome"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Key != "EX-10" || result.ID != "10200" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestClient_ImportJUnit_DataCenter(t *testing.T) {
+	client := newTestDataCenterClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/raven/1.0/import/execution/junit" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+		}
+		if got := r.URL.Query().Get("projectKey"); got != "EX" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("Expected the report as multipart file: %s", err)
+			return
+		}
+		if body, _ := io.ReadAll(file); string(body) != junitReport {
+			t.Errorf("Unexpected report %s", body)
+		}
+		fmt.Fprint(w, `{"testExecIssue":{"id":"10200","key":"EX-10","self":"https://jira.example.com/rest/api/2/issue/10200"}}`)
+	})
+
+	result, _, err := client.ImportJUnit(context.Background(), strings.NewReader(junitReport), &ImportOptions{ProjectKey: "EX"})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Key != "EX-10" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestClient_ImportCucumber_DataCenter(t *testing.T) {
+	client := newTestDataCenterClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/raven/1.0/import/execution/cucumber" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("Expected JSON, got %s", got)
+		}
+		fmt.Fprint(w, `{"testExecIssue":{"id":"10200","key":"EX-10","self":"https://jira.example.com/rest/api/2/issue/10200"}}`)
+	})
+
+	result, _, err := client.ImportCucumber(context.Background(), strings.NewReader(`[]`), nil)
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if result.Key != "EX-10" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestClient_ImportResults(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/import/execution" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		var results ExecutionResults
+		if err := json.NewDecoder(r.Body).Decode(&results); err != nil {
+			t.Fatal(err)
+		}
+		if results.TestExecutionKey != "EX-10" || len(results.Tests) != 1 || results.Tests[0].Status != StatusFailed {
+			t.Errorf("Unexpected results %+v", results)
+		}
+		fmt.Fprint(w, `{"id":"10200","key":"EX-10"}`)
+	})
+
+	_, _, err := client.ImportResults(context.Background(), &ExecutionResults{
+		TestExecutionKey: "EX-10",
+		Tests:            []TestResult{{TestKey: "EX-1", Status: StatusFailed, Comment: "timeout"}},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+}
@@ -0,0 +1,183 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TestPlanInput is the test plan created with CreateTestPlan.
+type TestPlanInput struct {
+	ProjectKey  string
+	Summary     string
+	Description string
+	// TestKeys are the keys of the tests of the test plan.
+	TestKeys []string
+}
+
+// TestExecutionInput is the test execution created with CreateTestExecution.
+type TestExecutionInput struct {
+	ProjectKey  string
+	Summary     string
+	Description string
+	// TestPlanKey adds the test execution to the test plan.
+	TestPlanKey      string
+	TestEnvironments []string
+	// TestKeys are the keys of the tests of the test execution, they are created in the status to do.
+	TestKeys []string
+}
+
+// cloudIssue is an issue returned by the GraphQL API of Xray Cloud with its key.
+type cloudIssue struct {
+	IssueID string `json:"issueId"`
+	Jira    struct {
+		Key string `json:"key"`
+	} `json:"jira"`
+}
+
+// ref returns the reference of the issue.
+func (i cloudIssue) ref() *IssueRef {
+	return &IssueRef{ID: i.IssueID, Key: i.Jira.Key}
+}
+
+const createTestPlanMutation = `mutation($testIssueIds: [String], $jira: JSON!) {
+	createTestPlan(testIssueIds: $testIssueIds, jira: $jira) {
+		testPlan { issueId jira(fields: ["key"]) }
+		warnings
+	}
+}`
+
+// CreateTestPlan creates a test plan with the tests and returns it.
+//
+// Xray Cloud API docs: https://us.xray.cloud.getxray.app/doc/graphql/createtestplan.doc.html
+//
+// Xray Data Center API docs: https://docs.getxray.app/display/XRAY/Test+Plans+-+REST
+func (c *Client) CreateTestPlan(ctx context.Context, plan *TestPlanInput) (*IssueRef, error) {
+	if c.dataCenter {
+		ref, err := c.createIssue(ctx, "Test Plan", plan.ProjectKey, plan.Summary, plan.Description)
+		if err != nil {
+			return nil, err
+		}
+		if len(plan.TestKeys) > 0 {
+			body := map[string][]string{"add": plan.TestKeys}
+			if _, err := c.api.Call(ctx, http.MethodPost, "rest/raven/1.0/api/testplan/"+url.PathEscape(ref.Key)+"/test", nil, body, nil); err != nil {
+				return ref, err
+			}
+		}
+		return ref, nil
+	}
+
+	testIDs, err := c.testIssueIDs(ctx, plan.TestKeys)
+	if err != nil {
+		return nil, err
+	}
+	var data struct {
+		CreateTestPlan struct {
+			TestPlan cloudIssue `json:"testPlan"`
+		} `json:"createTestPlan"`
+	}
+	variables := map[string]interface{}{
+		"testIssueIds": testIDs,
+		"jira":         issueFields(plan.ProjectKey, plan.Summary, plan.Description),
+	}
+	if _, err := c.graphQL(ctx, createTestPlanMutation, variables, &data); err != nil {
+		return nil, err
+	}
+	return data.CreateTestPlan.TestPlan.ref(), nil
+}
+
+// CreateTestExecution creates a test execution with the tests in the status to do and returns it.
+// It imports the execution with ImportResults, which works the same for Xray Cloud and Data Center.
+func (c *Client) CreateTestExecution(ctx context.Context, execution *TestExecutionInput) (*IssueRef, error) {
+	results := &ExecutionResults{
+		Info: &ExecutionInfo{
+			Project:          execution.ProjectKey,
+			Summary:          execution.Summary,
+			Description:      execution.Description,
+			TestPlanKey:      execution.TestPlanKey,
+			TestEnvironments: execution.TestEnvironments,
+		},
+		Tests: make([]TestResult, len(execution.TestKeys)),
+	}
+	for i, key := range execution.TestKeys {
+		results.Tests[i] = TestResult{TestKey: key, Status: StatusToDo}
+	}
+	result, _, err := c.ImportResults(ctx, results)
+	if err != nil {
+		return nil, err
+	}
+	return &result.IssueRef, nil
+}
+
+// issueFields returns the fields of a new issue in the format of the issue creation of Jira.
+func issueFields(projectKey, summary, description string) map[string]interface{} {
+	fields := map[string]interface{}{
+		"project": map[string]string{"key": projectKey},
+		"summary": summary,
+	}
+	if description != "" {
+		fields["description"] = description
+	}
+	return map[string]interface{}{"fields": fields}
+}
+
+// createIssue creates an issue of the issue type of Xray in Jira, for Xray Data Center.
+func (c *Client) createIssue(ctx context.Context, issueType, projectKey, summary, description string) (*IssueRef, error) {
+	fields := issueFields(projectKey, summary, description)
+	fields["fields"].(map[string]interface{})["issuetype"] = map[string]string{"name": issueType}
+
+	ref := new(IssueRef)
+	if _, err := c.api.Call(ctx, http.MethodPost, "rest/api/2/issue", nil, fields, ref); err != nil {
+		return nil, err
+	}
+	return ref, nil
+}
+
+const getTestsQuery = `query($jql: String, $limit: Int!) {
+	getTests(jql: $jql, limit: $limit) {
+		results { issueId jira(fields: ["key"]) }
+	}
+}`
+
+// maxGraphQLResults is the maximum number of results of a query of the GraphQL API of Xray Cloud.
+const maxGraphQLResults = 100
+
+// testIssueIDs returns the issue IDs of the tests with the keys, as the GraphQL API of Xray Cloud
+// only accepts issue IDs.
+func (c *Client) testIssueIDs(ctx context.Context, keys []string) ([]string, error) {
+	ids := make([]string, 0, len(keys))
+	for start := 0; start < len(keys); start += maxGraphQLResults {
+		end := start + maxGraphQLResults
+		if end > len(keys) {
+			end = len(keys)
+		}
+		quoted := make([]string, end-start)
+		for i, key := range keys[start:end] {
+			quoted[i] = fmt.Sprintf("%q", key)
+		}
+
+		var data struct {
+			GetTests struct {
+				Results []cloudIssue `json:"results"`
+			} `json:"getTests"`
+		}
+		variables := map[string]interface{}{"jql": "key in (" + strings.Join(quoted, ", ") + ")", "limit": maxGraphQLResults}
+		if _, err := c.graphQL(ctx, getTestsQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		byKey := make(map[string]string, len(data.GetTests.Results))
+		for _, test := range data.GetTests.Results {
+			byKey[test.Jira.Key] = test.IssueID
+		}
+		for _, key := range keys[start:end] {
+			id, ok := byKey[key]
+			if !ok {
+				return nil, fmt.Errorf("xray: test %s not found", key)
+			}
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
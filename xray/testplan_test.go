@@ -0,0 +1,105 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_CreateTestPlan(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/graphql" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case strings.Contains(body.Query, "getTests"):
+			if body.Variables["jql"] != `key in ("EX-1", "EX-2")` {
+				t.Errorf("Unexpected JQL %v", body.Variables["jql"])
+			}
+			fmt.Fprint(w, `{"data":{"getTests":{"results":[{"issueId":"10002","jira":{"key":"EX-2"}},{"issueId":"10001","jira":{"key":"EX-1"}}]}}}`)
+		case strings.Contains(body.Query, "createTestPlan"):
+			if fmt.Sprint(body.Variables["testIssueIds"]) != "[10001 10002]" {
+				t.Errorf("Unexpected tests %v", body.Variables["testIssueIds"])
+			}
+			fields := body.Variables["jira"].(map[string]interface{})["fields"].(map[string]interface{})
+			if fields["summary"] != "Release 1.0" {
+				t.Errorf("Unexpected fields %v", fields)
+			}
+			fmt.Fprint(w, `{"data":{"createTestPlan":{"testPlan":{"issueId":"10100","jira":{"key":"EX-100"}},"warnings":[]}}}`)
+		default:
+			t.Errorf("Unexpected query %s", body.Query)
+		}
+	})
+
+	plan, err := client.CreateTestPlan(context.Background(), &TestPlanInput{ProjectKey: "EX", Summary: "Release 1.0", TestKeys: []string{"EX-1", "EX-2"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if plan.ID != "10100" || plan.Key != "EX-100" {
+		t.Errorf("Unexpected test plan %+v", plan)
+	}
+}
+
+func TestClient_CreateTestPlan_DataCenter(t *testing.T) {
+	var requests []string
+	client := newTestDataCenterClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/rest/api/2/issue":
+			var body struct {
+				Fields map[string]interface{} `json:"fields"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if fmt.Sprint(body.Fields["issuetype"]) != "map[name:Test Plan]" {
+				t.Errorf("Unexpected issue type %v", body.Fields["issuetype"])
+			}
+			fmt.Fprint(w, `{"id":"10100","key":"EX-100"}`)
+		case "/rest/raven/1.0/api/testplan/EX-100/test":
+			var body map[string][]string
+			json.NewDecoder(r.Body).Decode(&body)
+			if fmt.Sprint(body["add"]) != "[EX-1]" {
+				t.Errorf("Unexpected tests %v", body)
+			}
+		}
+	})
+
+	plan, err := client.CreateTestPlan(context.Background(), &TestPlanInput{ProjectKey: "EX", Summary: "Release 1.0", TestKeys: []string{"EX-1"}})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if plan.Key != "EX-100" || len(requests) != 2 {
+		t.Errorf("Unexpected test plan %+v after %v", plan, requests)
+	}
+}
+
+func TestClient_CreateTestExecution(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var results ExecutionResults
+		json.NewDecoder(r.Body).Decode(&results)
+		if results.Info.TestPlanKey != "EX-100" || len(results.Tests) != 2 || results.Tests[1].Status != StatusToDo {
+			t.Errorf("Unexpected results %+v", results)
+		}
+		fmt.Fprint(w, `{"id":"10200","key":"EX-10"}`)
+	})
+
+	execution, err := client.CreateTestExecution(context.Background(), &TestExecutionInput{
+		ProjectKey:  "EX",
+		Summary:     "Nightly",
+		TestPlanKey: "EX-100",
+		TestKeys:    []string{"EX-1", "EX-2"},
+	})
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if execution.Key != "EX-10" {
+		t.Errorf("Unexpected test execution %+v", execution)
+	}
+}
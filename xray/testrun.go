@@ -0,0 +1,164 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// TestRun is the run of a test in a test execution.
+type TestRun struct {
+	ID          string
+	TestKey     string
+	TestExecKey string
+	// Status is the name of the status, e.g. StatusPassed.
+	Status  string
+	Comment string
+	// ExecutedBy is the account ID of the user on Xray Cloud and the user name on Xray Data Center.
+	ExecutedBy string
+	StartedOn  string
+	FinishedOn string
+	// Defects are the keys of the defects of the run on Xray Data Center and their issue IDs on Xray Cloud.
+	Defects []string
+}
+
+const getTestRunsQuery = `query($jql: String, $start: Int!, $limit: Int!) {
+	getTestExecutions(jql: $jql, limit: 1) {
+		results {
+			jira(fields: ["key"])
+			testRuns(start: $start, limit: $limit) {
+				total
+				results {
+					id
+					status { name }
+					comment
+					startedOn
+					finishedOn
+					executedById
+					defects
+					test { issueId jira(fields: ["key"]) }
+				}
+			}
+		}
+	}
+}`
+
+// GetTestRuns returns all test runs of the test execution.
+//
+// Xray Cloud API docs: https://us.xray.cloud.getxray.app/doc/graphql/gettestexecutions.doc.html
+//
+// Xray Data Center API docs: https://docs.getxray.app/display/XRAY/Test+Runs+-+REST
+func (c *Client) GetTestRuns(ctx context.Context, testExecKey string) ([]TestRun, error) {
+	if c.dataCenter {
+		return c.getDataCenterTestRuns(ctx, testExecKey)
+	}
+
+	var runs []TestRun
+	for {
+		var data struct {
+			GetTestExecutions struct {
+				Results []struct {
+					Jira struct {
+						Key string `json:"key"`
+					} `json:"jira"`
+					TestRuns struct {
+						Total   int `json:"total"`
+						Results []struct {
+							ID     string `json:"id"`
+							Status struct {
+								Name string `json:"name"`
+							} `json:"status"`
+							Comment      string     `json:"comment"`
+							StartedOn    string     `json:"startedOn"`
+							FinishedOn   string     `json:"finishedOn"`
+							ExecutedByID string     `json:"executedById"`
+							Defects      []string   `json:"defects"`
+							Test         cloudIssue `json:"test"`
+						} `json:"results"`
+					} `json:"testRuns"`
+				} `json:"results"`
+			} `json:"getTestExecutions"`
+		}
+		variables := map[string]interface{}{"jql": fmt.Sprintf("key = %q", testExecKey), "start": len(runs), "limit": maxGraphQLResults}
+		if _, err := c.graphQL(ctx, getTestRunsQuery, variables, &data); err != nil {
+			return nil, err
+		}
+		if len(data.GetTestExecutions.Results) == 0 {
+			return nil, fmt.Errorf("xray: test execution %s not found", testExecKey)
+		}
+
+		execution := data.GetTestExecutions.Results[0]
+		for _, run := range execution.TestRuns.Results {
+			runs = append(runs, TestRun{
+				ID:          run.ID,
+				TestKey:     run.Test.Jira.Key,
+				TestExecKey: execution.Jira.Key,
+				Status:      run.Status.Name,
+				Comment:     run.Comment,
+				ExecutedBy:  run.ExecutedByID,
+				StartedOn:   run.StartedOn,
+				FinishedOn:  run.FinishedOn,
+				Defects:     run.Defects,
+			})
+		}
+		if len(execution.TestRuns.Results) == 0 || len(runs) >= execution.TestRuns.Total {
+			return runs, nil
+		}
+	}
+}
+
+// dataCenterTestRun is a test run returned by Xray Data Center.
+type dataCenterTestRun struct {
+	ID          int64  `json:"id"`
+	Status      string `json:"status"`
+	TestKey     string `json:"testKey"`
+	TestExecKey string `json:"testExecKey"`
+	Comment     string `json:"comment"`
+	ExecutedBy  string `json:"executedBy"`
+	StartedOn   string `json:"startedOn"`
+	FinishedOn  string `json:"finishedOn"`
+	Defects     []struct {
+		Key string `json:"key"`
+	} `json:"defects"`
+}
+
+// dataCenterPageSize is the number of test runs requested per page from Xray Data Center.
+const dataCenterPageSize = 100
+
+// getDataCenterTestRuns returns the test runs of the test execution page by page from Xray Data Center.
+func (c *Client) getDataCenterTestRuns(ctx context.Context, testExecKey string) ([]TestRun, error) {
+	var runs []TestRun
+	for page := 1; ; page++ {
+		query := url.Values{
+			"testExecKey": {testExecKey},
+			"page":        {strconv.Itoa(page)},
+			"limit":       {strconv.Itoa(dataCenterPageSize)},
+		}
+		var result []dataCenterTestRun
+		if _, err := c.api.Call(ctx, http.MethodGet, "rest/raven/1.0/api/testruns", query, nil, &result); err != nil {
+			return nil, err
+		}
+		for _, run := range result {
+			defects := make([]string, len(run.Defects))
+			for i, d := range run.Defects {
+				defects[i] = d.Key
+			}
+			runs = append(runs, TestRun{
+				ID:          strconv.FormatInt(run.ID, 10),
+				TestKey:     run.TestKey,
+				TestExecKey: run.TestExecKey,
+				Status:      run.Status,
+				Comment:     run.Comment,
+				ExecutedBy:  run.ExecutedBy,
+				StartedOn:   run.StartedOn,
+				FinishedOn:  run.FinishedOn,
+				Defects:     defects,
+			})
+		}
+		if len(result) < dataCenterPageSize {
+			return runs, nil
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+func TestClient_GetTestRuns(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Variables["jql"] != `key = "EX-10"` {
+			t.Errorf("Unexpected JQL %v", body.Variables["jql"])
+		}
+		fmt.Fprint(w, `{"data":{"getTestExecutions":{"results":[{"jira":{"key":"EX-10"},"testRuns":{"total":1,"results":[
+			{"id":"5acc7ab0a3fe1b6fcdc3c737","status":{"name":"PASSED"},"executedById":"5b10a2844c20165700ede21g","defects":[],"test":{"issueId":"10001","jira":{"key":"EX-1"}}}]}}]}}}`)
+	})
+
+	runs, err := client.GetTestRuns(context.Background(), "EX-10")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(runs) != 1 || runs[0].TestKey != "EX-1" || runs[0].TestExecKey != "EX-10" || runs[0].Status != StatusPassed {
+		t.Errorf("Unexpected test runs %+v", runs)
+	}
+}
+
+func TestClient_GetTestRuns_Retry(t *testing.T) {
+	calls := 0
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"getTestExecutions":{"results":[{"jira":{"key":"EX-10"},"testRuns":{"total":0,"results":[]}}]}}}`)
+	})
+	client.API().RetryPolicy = &jira.RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	if _, err := client.GetTestRuns(context.Background(), "EX-10"); err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected the query to be retried, got %d calls", calls)
+	}
+}
+
+func TestClient_GetTestRuns_NotFound(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":{"getTestExecutions":{"results":[]}}}`)
+	})
+
+	if _, err := client.GetTestRuns(context.Background(), "EX-404"); err == nil {
+		t.Error("Expected an error for a missing test execution")
+	}
+}
+
+func TestClient_GetTestRuns_DataCenter(t *testing.T) {
+	client := newTestDataCenterClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/raven/1.0/api/testruns" || r.URL.Query().Get("testExecKey") != "EX-10" || r.URL.Query().Get("page") != "1" {
+			t.Errorf("Unexpected request %s", r.URL)
+		}
+		fmt.Fprint(w, `[{"id":42,"status":"FAIL","testKey":"EX-1","testExecKey":"EX-10","executedBy":"jdoe","defects":[{"key":"EX-7"}]}]`)
+	})
+
+	runs, err := client.GetTestRuns(context.Background(), "EX-10")
+	if err != nil {
+		t.Fatalf("Error given: %s", err)
+	}
+	if len(runs) != 1 || runs[0].ID != "42" || runs[0].Status != StatusFail || runs[0].Defects[0] != "EX-7" {
+		t.Errorf("Unexpected test runs %+v", runs)
+	}
+}
@@ -0,0 +1,174 @@
+// Package xray is a client of the Xray test management app for Jira, for CI pipelines that push
+// their test results to Jira:
+//
+//	client, err := xray.NewClient(nil, os.Getenv("XRAY_CLIENT_ID"), os.Getenv("XRAY_CLIENT_SECRET"))
+//	result, _, err := client.ImportJUnit(ctx, report, &xray.ImportOptions{ProjectKey: "EX", TestPlanKey: "EX-100"})
+//
+// It supports Xray Cloud, created with NewClient, and Xray Data Center, created with NewDataCenterClient.
+// Both offer the same methods, Xray Cloud is called through its REST and GraphQL API
+// and Xray Data Center through its REST API on the Jira instance.
+//
+// Requests are sent with a client of the cloud package, so the retry policy, rate limiter, logging and
+// error handling of the cloud package are available for Xray too. Configure them on Client.API.
+// Errors are returned as *jira.Error, so they can be checked with errors.Is, e.g. for jira.ErrNotFound.
+//
+// Xray API docs: https://docs.getxray.app/display/XRAYCLOUD/REST+API
+package xray
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// CloudBaseURL is the base URL of version 2 of the REST API of Xray Cloud.
+const CloudBaseURL = "https://xray.cloud.getxray.app/api/v2/"
+
+// cloudTokenLifetime is how long a token of Xray Cloud is used, it expires after 24 hours.
+const cloudTokenLifetime = 23 * time.Hour
+
+// Client manages communication with Xray Cloud or Xray Data Center.
+type Client struct {
+	api *jira.Client
+	// dataCenter is set for clients of Xray Data Center.
+	dataCenter bool
+}
+
+// NewClient returns a new client of Xray Cloud, authenticated with the client ID and secret of an API key of Xray.
+// Xray Cloud doesn't accept the credentials of Jira.
+// If a nil httpClient is provided, a new http.Client will be used.
+// The transport of httpClient must not authenticate the requests itself.
+func NewClient(httpClient *http.Client, clientID, clientSecret string) (*Client, error) {
+	if clientID == "" || clientSecret == "" {
+		return nil, errors.New("xray: client ID and secret are required")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	clientCopy := *httpClient
+	auth := &cloudAuth{clientID: clientID, clientSecret: clientSecret, httpClient: httpClient}
+	clientCopy.Transport = &jira.BearerTokenAuthTransport{Token: auth, Transport: httpClient.Transport}
+
+	api, err := jira.NewClient(CloudBaseURL, &clientCopy)
+	if err != nil {
+		return nil, err
+	}
+	auth.baseURL = func() string { return api.BaseURL.String() }
+	return &Client{api: api}, nil
+}
+
+// NewDataCenterClient returns a new client of Xray Data Center installed on the Jira instance at baseURL.
+// httpClient has to authenticate the requests with Jira, e.g. with a personal access token.
+// If a nil httpClient is provided, a new http.Client will be used.
+func NewDataCenterClient(baseURL string, httpClient *http.Client) (*Client, error) {
+	api, err := jira.NewClient(baseURL, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: api, dataCenter: true}, nil
+}
+
+// API returns the client of the cloud package sending the requests to Xray.
+// Set its RetryPolicy, RateLimiter, Logger or MetricsRecorder to configure how requests are sent.
+func (c *Client) API() *jira.Client {
+	return c.api
+}
+
+// IssueRef references an issue created by Xray, e.g. a test execution.
+type IssueRef struct {
+	ID   string `json:"id"`
+	Key  string `json:"key"`
+	Self string `json:"self,omitempty"`
+}
+
+// graphQLError is an error of the GraphQL API of Xray Cloud.
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// graphQL sends the GraphQL query with the variables to Xray Cloud and decodes the data of the response into v.
+// GraphQL errors are returned even though the status of the response is 200 OK.
+func (c *Client) graphQL(ctx context.Context, query string, variables map[string]interface{}, v interface{}) (*jira.Response, error) {
+	body := map[string]interface{}{"query": query, "variables": variables}
+	if strings.HasPrefix(query, "query") {
+		// Queries don't change anything, so they are retried like GET requests, unlike mutations.
+		ctx = jira.ContextWithRequestOptions(ctx, jira.WithRetryNonIdempotent())
+	}
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphQLError  `json:"errors"`
+	}
+	resp, err := c.api.Call(ctx, http.MethodPost, "graphql", nil, body, &result)
+	if err != nil {
+		return resp, err
+	}
+	if len(result.Errors) > 0 {
+		messages := make([]string, len(result.Errors))
+		for i, e := range result.Errors {
+			messages[i] = e.Message
+		}
+		return resp, fmt.Errorf("xray: %s", strings.Join(messages, "; "))
+	}
+	if v != nil && len(result.Data) > 0 {
+		if err := json.Unmarshal(result.Data, v); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
+// cloudAuth provides the tokens of Xray Cloud for an API key.
+// The tokens are requested from the authenticate endpoint and cached until shortly before they expire.
+type cloudAuth struct {
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+	baseURL      func() string
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// Token implements jira.TokenProvider.
+func (a *cloudAuth) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.token != "" && time.Now().Before(a.expires) {
+		return a.token, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"client_id": a.clientID, "client_secret": a.clientSecret})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.baseURL()+"authenticate", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("xray: authentication failed with status %s", resp.Status)
+	}
+
+	var token string
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("xray: could not decode token: %w", err)
+	}
+	a.token = token
+	a.expires = time.Now().Add(cloudTokenLifetime)
+	return token, nil
+}
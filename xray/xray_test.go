@@ -0,0 +1,117 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	jira "github.com/andygrunwald/go-jira/v2/cloud"
+)
+
+// newTestCloudClient returns a client of Xray Cloud served by handler.
+// The authentication is handled before handler is called.
+func newTestCloudClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/authenticate" {
+			var credentials map[string]string
+			json.NewDecoder(r.Body).Decode(&credentials)
+			if credentials["client_id"] != "id" || credentials["client_secret"] != "secret" {
+				t.Errorf("Unexpected credentials %v", credentials)
+			}
+			fmt.Fprint(w, `"xray-token"`)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer xray-token" {
+			t.Errorf("Expected the Xray token, got %q", got)
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(nil, "id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.API().BaseURL, _ = url.Parse(server.URL + "/api/v2/")
+	return client
+}
+
+// newTestDataCenterClient returns a client of Xray Data Center served by handler.
+func newTestDataCenterClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewDataCenterClient(server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestNewClient_NoCredentials(t *testing.T) {
+	if _, err := NewClient(nil, "id", ""); err == nil {
+		t.Error("Expected an error without a client secret")
+	}
+}
+
+func TestClient_TokenIsCached(t *testing.T) {
+	authentications := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/authenticate" {
+			authentications++
+			fmt.Fprint(w, `"xray-token"`)
+			return
+		}
+		fmt.Fprint(w, `{"id":"10200","key":"EX-10"}`)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(nil, "id", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.API().BaseURL, _ = url.Parse(server.URL + "/")
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.ImportResults(context.Background(), &ExecutionResults{}); err != nil {
+			t.Fatalf("Error given: %s", err)
+		}
+	}
+	if authentications != 1 {
+		t.Errorf("Expected 1 authentication, got %d", authentications)
+	}
+}
+
+func TestClient_GraphQLError(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"errors":[{"message":"Test not found"}],"data":null}`)
+	})
+
+	_, err := client.CreateTestPlan(context.Background(), &TestPlanInput{ProjectKey: "EX", Summary: "Release 1.0"})
+	if err == nil || err.Error() != "xray: Test not found" {
+		t.Errorf("Expected the GraphQL error, got %v", err)
+	}
+}
+
+func TestClient_Error(t *testing.T) {
+	client := newTestCloudClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"Error retrieving Project from Jira with key \"NOPE\""}`)
+	})
+
+	_, _, err := client.ImportResults(context.Background(), &ExecutionResults{Info: &ExecutionInfo{Project: "NOPE"}})
+	if !errors.Is(err, jira.ErrValidation) {
+		t.Errorf("Expected jira.ErrValidation, got %v", err)
+	}
+	var jerr *jira.Error
+	if !errors.As(err, &jerr) || len(jerr.ErrorMessages) != 1 {
+		t.Errorf("Expected the message of Xray, got %v", err)
+	}
+}